@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	"wealthjourney/pkg/config"
+	"wealthjourney/pkg/database"
+
+	"github.com/google/uuid"
+)
+
+// legacyTransferWindow bounds how far apart the two legs of a legacy transfer were
+// dated. Both were dated with time.Now() one after the other.
+const legacyTransferWindow = time.Minute
+
+// legacyLeg is a transaction in an "Outgoing Transfer" or "Incoming Transfer" category
+// that is not linked to a transfer.
+type legacyLeg struct {
+	ID       int32
+	WalletID int32
+	UserID   int32
+	Amount   int64
+	Date     time.Time
+}
+
+// Transfers made before transfer links existed were stored as two plain transactions
+// in the "Outgoing Transfer" and "Incoming Transfer" categories, without a fee and
+// between wallets of the same currency. This links each such pair into a transfer so
+// reports leave it out. Legs that cannot be matched one to one stay unlinked.
+func main() {
+	dryRun := flag.Bool("dry-run", true, "Run without making changes")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	db, err := database.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+
+	outgoing, err := loadLegacyLegs(ctx, db, "Outgoing Transfer")
+	if err != nil {
+		log.Fatalf("Failed to fetch outgoing transfer legs: %v", err)
+	}
+	incoming, err := loadLegacyLegs(ctx, db, "Incoming Transfer")
+	if err != nil {
+		log.Fatalf("Failed to fetch incoming transfer legs: %v", err)
+	}
+	log.Printf("Found %d outgoing and %d incoming unlinked transfer legs", len(outgoing), len(incoming))
+
+	transferRepo := repository.NewTransferRepository(db)
+	linked := 0
+	for _, pair := range matchLegacyLegs(outgoing, incoming) {
+		from, to := pair[0], pair[1]
+		if *dryRun {
+			log.Printf("[DRY RUN] Would link transactions %d -> %d (user %d, amount %d)", from.ID, to.ID, from.UserID, to.Amount)
+			linked++
+			continue
+		}
+
+		transfer := &models.Transfer{
+			ID:           uuid.New().String(),
+			UserID:       from.UserID,
+			FromWalletID: from.WalletID,
+			ToWalletID:   to.WalletID,
+			ExchangeRate: 1,
+		}
+		err := transferRepo.Link(ctx, transfer,
+			&models.Transaction{ID: from.ID, WalletID: from.WalletID},
+			&models.Transaction{ID: to.ID, WalletID: to.WalletID})
+		if err != nil {
+			log.Printf("ERROR: Failed to link transactions %d -> %d: %v", from.ID, to.ID, err)
+			continue
+		}
+		linked++
+	}
+
+	if *dryRun {
+		log.Printf("\n[DRY RUN] Would link %d transfers. Run with -dry-run=false to apply changes.", linked)
+		return
+	}
+	log.Printf("Linked %d transfers", linked)
+}
+
+// loadLegacyLegs returns the unlinked transactions in the transfer category with the
+// given name, oldest first.
+func loadLegacyLegs(ctx context.Context, db *database.Database, categoryName string) ([]*legacyLeg, error) {
+	var legs []*legacyLeg
+	err := db.DB.WithContext(ctx).Table("transaction t").
+		Select("t.id, t.wallet_id, w.user_id, t.amount, t.date").
+		Joins("JOIN category c ON c.id = t.category_id").
+		Joins("JOIN wallet w ON w.id = t.wallet_id").
+		Where("c.name = ? AND c.user_id = w.user_id", categoryName).
+		Where("t.transfer_id IS NULL AND t.deleted_at IS NULL").
+		Order("t.id").
+		Scan(&legs).Error
+	return legs, err
+}
+
+// matchLegacyLegs pairs each outgoing leg with the first later incoming leg of the same
+// user in another wallet with the opposite amount, dated within legacyTransferWindow.
+func matchLegacyLegs(outgoing, incoming []*legacyLeg) [][2]*legacyLeg {
+	used := make(map[int32]bool)
+	var pairs [][2]*legacyLeg
+	for _, from := range outgoing {
+		for _, to := range incoming {
+			if used[to.ID] || to.ID < from.ID || to.UserID != from.UserID ||
+				to.WalletID == from.WalletID || to.Amount != -from.Amount {
+				continue
+			}
+			if gap := to.Date.Sub(from.Date); gap < -legacyTransferWindow || gap > legacyTransferWindow {
+				continue
+			}
+			used[to.ID] = true
+			pairs = append(pairs, [2]*legacyLeg{from, to})
+			break
+		}
+	}
+	return pairs
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 
@@ -16,6 +17,9 @@ import (
 )
 
 func main() {
+	backfill := flag.Bool("backfill", false, "Rebuild daily snapshots for the days before each wallet's first snapshot")
+	flag.Parse()
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -40,6 +44,7 @@ func main() {
 		Investment:            repository.NewInvestmentRepository(db),
 		InvestmentTransaction: repository.NewInvestmentTransactionRepository(db),
 		MarketData:            repository.NewMarketDataRepository(db),
+		PriceHistory:          repository.NewPriceHistoryRepository(db),
 		FXRate:                repository.NewFXRateRepository(db),
 		PortfolioHistory:      repository.NewPortfolioHistoryRepository(db.DB),
 	}
@@ -58,17 +63,22 @@ func main() {
 
 	// Initialize services
 	services := service.NewServices(repos, underlyingRedisClient)
+	if cfg.MarketData.PriceFixtureFile != "" {
+		if err := services.UsePriceFixture(cfg.MarketData.PriceFixtureFile); err != nil {
+			log.Fatalf("Failed to load price fixture: %v", err)
+		}
+	}
 
 	// Run portfolio snapshot job
 	ctx := context.Background()
-	if err := createPortfolioSnapshots(ctx, repos, services.PortfolioHistory); err != nil {
+	if err := createPortfolioSnapshots(ctx, repos, services.PortfolioHistory, *backfill); err != nil {
 		log.Fatalf("Portfolio snapshot failed: %v", err)
 	}
 
 	log.Println("Portfolio snapshot completed successfully!")
 }
 
-func createPortfolioSnapshots(ctx context.Context, repos *service.Repositories, portfolioHistorySvc service.PortfolioHistoryService) error {
+func createPortfolioSnapshots(ctx context.Context, repos *service.Repositories, portfolioHistorySvc service.PortfolioHistoryService, backfill bool) error {
 	// Get all users
 	users, _, err := repos.User.List(ctx, repository.ListOptions{
 		Limit: 10000, // Large limit to get all users
@@ -109,6 +119,16 @@ func createPortfolioSnapshots(ctx context.Context, repos *service.Repositories,
 			continue
 		}
 
+		// Rebuild the days before the first snapshot from transactions and price history
+		if backfill {
+			created, err := portfolioHistorySvc.BackfillHistory(ctx, user.ID)
+			if err != nil {
+				log.Printf("Warning: failed to backfill portfolio history for user %d: %v\n", user.ID, err)
+			} else {
+				log.Printf("Backfilled %d daily snapshots for user %d\n", created, user.ID)
+			}
+		}
+
 		// Create aggregated snapshot for all investment wallets
 		log.Printf("Creating portfolio snapshot for user %d (%s)...\n", user.ID, user.Email)
 		if err := portfolioHistorySvc.CreateAggregatedSnapshot(ctx, user.ID); err != nil {
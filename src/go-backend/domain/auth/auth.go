@@ -70,6 +70,7 @@ type UserData struct {
 	Picture              string    `json:"picture"`
 	PreferredCurrency    string    `json:"preferredCurrency"`
 	ConversionInProgress bool      `json:"conversionInProgress"`
+	DefaultCostBasisMethod int32   `json:"defaultCostBasisMethod"`
 	CreatedAt            time.Time `json:"createdAt"`
 	UpdatedAt            time.Time `json:"updatedAt"`
 }
@@ -86,6 +87,7 @@ func userDataToProto(data *UserData) *authv1.User {
 		Picture:              data.Picture,
 		PreferredCurrency:    data.PreferredCurrency,
 		ConversionInProgress: data.ConversionInProgress,
+		DefaultCostBasisMethod: authv1.CostBasisMethod(data.DefaultCostBasisMethod),
 		CreatedAt:            data.CreatedAt.Unix(),
 		UpdatedAt:            data.UpdatedAt.Unix(),
 	}
@@ -364,6 +366,7 @@ func (s *Server) VerifyAuth(tokenString string) (*authv1.VerifyAuthResponse, err
 		Picture:              user.Picture,
 		PreferredCurrency:    user.PreferredCurrency,
 		ConversionInProgress: user.ConversionInProgress,
+		DefaultCostBasisMethod: user.DefaultCostBasisMethod,
 		CreatedAt:            user.CreatedAt,
 		UpdatedAt:            user.UpdatedAt,
 	}
@@ -414,6 +417,7 @@ func (s *Server) GetAuth(ctx context.Context, email string) (*authv1.GetAuthResp
 			Picture:              user.Picture,
 			PreferredCurrency:    user.PreferredCurrency,
 			ConversionInProgress: user.ConversionInProgress,
+			DefaultCostBasisMethod: user.DefaultCostBasisMethod,
 			CreatedAt:            user.CreatedAt,
 			UpdatedAt:            user.UpdatedAt,
 		}),
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// AllocationTarget is the target weight of a group of holdings in an investment wallet,
+// or across all investment wallets when WalletID is 0.
+// Only the key matching Dimension is set: InvestmentType, Symbol or AssetClass.
+type AllocationTarget struct {
+	ID             int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID         int32     `gorm:"not null;index:idx_allocation_target_scope" json:"userId"`
+	WalletID       int32     `gorm:"not null;default:0;index:idx_allocation_target_scope" json:"walletId"` // 0 = all investment wallets
+	Dimension      int32     `gorm:"type:int;not null" json:"dimension"`                                   // v1.AllocationDimension
+	InvestmentType int32     `gorm:"type:int;not null;default:0" json:"investmentType"`
+	Symbol         string    `gorm:"size:20;not null;default:''" json:"symbol"`
+	AssetClass     string    `gorm:"size:50;not null;default:''" json:"assetClass"`
+	TargetPercent  float64   `gorm:"type:double precision;not null" json:"targetPercent"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// TableName specifies the table name for AllocationTarget
+func (AllocationTarget) TableName() string {
+	return "allocation_target"
+}
+
+// ToProto converts the model to protobuf message
+func (t *AllocationTarget) ToProto() *v1.AllocationTarget {
+	return &v1.AllocationTarget{
+		Id:             t.ID,
+		WalletId:       t.WalletID,
+		Dimension:      v1.AllocationDimension(t.Dimension),
+		InvestmentType: v1.InvestmentType(t.InvestmentType),
+		Symbol:         t.Symbol,
+		AssetClass:     t.AssetClass,
+		TargetPercent:  t.TargetPercent,
+		CreatedAt:      t.CreatedAt.Unix(),
+		UpdatedAt:      t.UpdatedAt.Unix(),
+	}
+}
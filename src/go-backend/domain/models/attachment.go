@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+// Attachment is a receipt or document stored for a transaction or an investment
+// transaction. Exactly one of TransactionID and InvestmentTransactionID is set. The file
+// itself lives in the storage provider under StorageKey.
+type Attachment struct {
+	ID                      int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID                  int32     `gorm:"not null;index" json:"userId"`
+	TransactionID           *int32    `gorm:"index" json:"transactionId,omitempty"`
+	InvestmentTransactionID *int32    `gorm:"index" json:"investmentTransactionId,omitempty"`
+	FileName                string    `gorm:"size:255;not null" json:"fileName"`
+	ContentType             string    `gorm:"size:100;not null" json:"contentType"`
+	Size                    int64     `gorm:"not null" json:"size"` // Bytes
+	StorageKey              string    `gorm:"size:500;not null;uniqueIndex" json:"-"`
+	CreatedAt               time.Time `json:"createdAt"`
+}
+
+// TableName specifies the table name for Attachment model
+func (Attachment) TableName() string {
+	return "attachment"
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+// BenchmarkPrice stores the daily close of a benchmark (index, ETF or commodity)
+// Used to compare portfolio history against simply holding the benchmark
+type BenchmarkPrice struct {
+	ID        int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Symbol    string    `gorm:"size:20;not null;uniqueIndex:idx_benchmark_symbol_date" json:"symbol"` // Yahoo Finance ticker
+	PriceDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_benchmark_symbol_date" json:"priceDate"`
+	Close     float64   `gorm:"type:double precision;not null" json:"close"` // Quote units; only ratios are used, so index points keep their decimals
+	Currency  string    `gorm:"size:3" json:"currency"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TableName specifies the table name for BenchmarkPrice
+func (BenchmarkPrice) TableName() string {
+	return "benchmark_prices"
+}
@@ -13,6 +13,9 @@ type Budget struct {
 	Name      string         `gorm:"size:100;not null" json:"name"`
 	Total     int64          `gorm:"type:bigint;default:0;not null" json:"total"` // Stored in smallest currency unit
 	Currency  string         `gorm:"size:3;not null;default:'VND'" json:"currency"`
+	Period    int32          `gorm:"type:int;default:0;not null" json:"period"` // v1.BudgetPeriod, 0 tracks no spending
+	StartDate *time.Time     `json:"startDate,omitempty"`                       // Custom period start
+	EndDate   *time.Time     `json:"endDate,omitempty"`                         // Custom period end
 	CreatedAt time.Time      `json:"createdAt"`
 	UpdatedAt time.Time      `json:"updatedAt"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
@@ -27,19 +30,63 @@ func (Budget) TableName() string {
 
 // BudgetItem represents a single budget item (category allocation)
 type BudgetItem struct {
-	ID        int32          `gorm:"primaryKey;autoIncrement" json:"id"`
-	BudgetID  int32          `gorm:"not null;index" json:"budgetId"`
-	Name      string         `gorm:"size:100;not null" json:"name"`
-	Total     int64          `gorm:"type:bigint;default:0;not null" json:"total"` // Stored in smallest currency unit
-	Currency  string         `gorm:"size:3;not null;default:'VND'" json:"currency"`
-	Checked   bool           `gorm:"type:bool;default:false;not null" json:"checked"`
-	CreatedAt time.Time      `json:"createdAt"`
-	UpdatedAt time.Time      `json:"updatedAt"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
-	Budget    *Budget        `gorm:"foreignKey:BudgetID" json:"budget,omitempty"`
+	ID             int32                `gorm:"primaryKey;autoIncrement" json:"id"`
+	BudgetID       int32                `gorm:"not null;index" json:"budgetId"`
+	Name           string               `gorm:"size:100;not null" json:"name"`
+	Total          int64                `gorm:"type:bigint;default:0;not null" json:"total"` // Stored in smallest currency unit
+	Currency       string               `gorm:"size:3;not null;default:'VND'" json:"currency"`
+	Checked        bool                 `gorm:"type:bool;default:false;not null" json:"checked"`
+	RolloverPolicy int32                `gorm:"type:int;default:0;not null" json:"rolloverPolicy"` // v1.BudgetRolloverPolicy of the envelope
+	RolloverCap    int64                `gorm:"type:bigint;default:0;not null" json:"rolloverCap"` // Largest amount carried either way with the capped policy
+	CreatedAt      time.Time            `json:"createdAt"`
+	UpdatedAt      time.Time            `json:"updatedAt"`
+	DeletedAt      gorm.DeletedAt       `gorm:"index" json:"-"`
+	Budget         *Budget              `gorm:"foreignKey:BudgetID" json:"budget,omitempty"`
+	Categories     []BudgetItemCategory `gorm:"foreignKey:BudgetItemID" json:"categories,omitempty"`
+	Wallets        []BudgetItemWallet   `gorm:"foreignKey:BudgetItemID" json:"wallets,omitempty"`
 }
 
 // TableName specifies the table name for BudgetItem model
 func (BudgetItem) TableName() string {
 	return "budget_item"
 }
+
+// CategoryIDs returns the IDs of the categories the item tracks.
+func (i *BudgetItem) CategoryIDs() []int32 {
+	ids := make([]int32, 0, len(i.Categories))
+	for _, c := range i.Categories {
+		ids = append(ids, c.CategoryID)
+	}
+	return ids
+}
+
+// WalletIDs returns the IDs of the wallets the item tracks; empty means all wallets.
+func (i *BudgetItem) WalletIDs() []int32 {
+	ids := make([]int32, 0, len(i.Wallets))
+	for _, w := range i.Wallets {
+		ids = append(ids, w.WalletID)
+	}
+	return ids
+}
+
+// BudgetItemCategory binds a budget item to an expense category it tracks
+type BudgetItemCategory struct {
+	BudgetItemID int32 `gorm:"primaryKey" json:"budgetItemId"`
+	CategoryID   int32 `gorm:"primaryKey;index" json:"categoryId"`
+}
+
+// TableName specifies the table name for BudgetItemCategory model
+func (BudgetItemCategory) TableName() string {
+	return "budget_item_category"
+}
+
+// BudgetItemWallet restricts a budget item to spending from a wallet
+type BudgetItemWallet struct {
+	BudgetItemID int32 `gorm:"primaryKey" json:"budgetItemId"`
+	WalletID     int32 `gorm:"primaryKey;index" json:"walletId"`
+}
+
+// TableName specifies the table name for BudgetItemWallet model
+func (BudgetItemWallet) TableName() string {
+	return "budget_item_wallet"
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// BudgetAlertRule notifies the user when the spending of a category-linked budget item
+// crosses a percentage of its total
+type BudgetAlertRule struct {
+	ID           int32                       `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID       int32                       `gorm:"not null;index" json:"userId"`
+	BudgetID     int32                       `gorm:"not null;index" json:"budgetId"`
+	BudgetItemID int32                       `gorm:"not null;index" json:"budgetItemId"`
+	Thresholds   datatypes.JSONSlice[int32]  `gorm:"not null" json:"thresholds"` // Percentages of the item total, ascending
+	Channels     datatypes.JSONSlice[string] `gorm:"not null" json:"channels"`   // notification channel names, e.g. ["in_app", "email"]
+	WebhookURL   string                      `gorm:"size:500" json:"webhookUrl"`
+	Enabled      bool                        `gorm:"not null;default:true" json:"enabled"`
+	CreatedAt    time.Time                   `json:"createdAt"`
+	UpdatedAt    time.Time                   `json:"updatedAt"`
+}
+
+// TableName specifies the table name for BudgetAlertRule model
+func (BudgetAlertRule) TableName() string {
+	return "budget_alert_rule"
+}
+
+// BudgetAlert records a threshold of a rule crossed in a period. The unique index
+// keeps each threshold from firing more than once per period.
+type BudgetAlert struct {
+	ID          int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	RuleID      int32     `gorm:"not null;uniqueIndex:idx_budget_alert_period" json:"ruleId"`
+	Threshold   int32     `gorm:"not null;uniqueIndex:idx_budget_alert_period" json:"threshold"`
+	PeriodStart time.Time `gorm:"not null;uniqueIndex:idx_budget_alert_period" json:"periodStart"`
+	Spent       int64     `gorm:"type:bigint;not null" json:"spent"` // Stored in smallest currency unit
+	Currency    string    `gorm:"size:3;not null" json:"currency"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// TableName specifies the table name for BudgetAlert model
+func (BudgetAlert) TableName() string {
+	return "budget_alert"
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// BudgetMove records money moved from one envelope (budget item) to another
+type BudgetMove struct {
+	ID          int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	BudgetID    int32     `gorm:"not null;index:idx_budget_move_period" json:"budgetId"`
+	UserID      int32     `gorm:"not null;index" json:"userId"`
+	FromItemID  int32     `gorm:"not null" json:"fromItemId"`
+	ToItemID    int32     `gorm:"not null" json:"toItemId"`
+	Amount      int64     `gorm:"type:bigint;not null" json:"amount"` // Stored in smallest currency unit
+	Currency    string    `gorm:"size:3;not null" json:"currency"`
+	PeriodStart time.Time `gorm:"not null;index:idx_budget_move_period" json:"periodStart"` // Period the money moved in
+	Note        string    `gorm:"size:255" json:"note"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// TableName specifies the table name for BudgetMove model
+func (BudgetMove) TableName() string {
+	return "budget_move"
+}
+
+// BudgetEnvelopeSnapshot freezes an envelope's balance when its period closes, so
+// past periods stay stable when old transactions are edited and the next period
+// carries a fixed amount.
+type BudgetEnvelopeSnapshot struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	BudgetID     int32     `gorm:"not null;index" json:"budgetId"`
+	BudgetItemID int32     `gorm:"not null;uniqueIndex:idx_envelope_period" json:"budgetItemId"`
+	PeriodStart  time.Time `gorm:"not null;uniqueIndex:idx_envelope_period" json:"periodStart"`
+	PeriodEnd    time.Time `gorm:"not null" json:"periodEnd"`
+	Currency     string    `gorm:"size:3;not null" json:"currency"`
+	Allocated    int64     `gorm:"type:bigint;not null" json:"allocated"`
+	CarriedIn    int64     `gorm:"type:bigint;not null" json:"carriedIn"`
+	MovedIn      int64     `gorm:"type:bigint;not null" json:"movedIn"` // Net of moves, negative when moved out
+	Spent        int64     `gorm:"type:bigint;not null" json:"spent"`
+	CarriedOut   int64     `gorm:"type:bigint;not null" json:"carriedOut"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// TableName specifies the table name for BudgetEnvelopeSnapshot model
+func (BudgetEnvelopeSnapshot) TableName() string {
+	return "budget_envelope_snapshot"
+}
+
+// Available returns the envelope's balance at the end of the period.
+func (s *BudgetEnvelopeSnapshot) Available() int64 {
+	return s.Allocated + s.CarriedIn + s.MovedIn - s.Spent
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// BudgetTemplate is a reusable budget plan that new budgets can be created from
+type BudgetTemplate struct {
+	ID        int32                                   `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int32                                   `gorm:"not null;index" json:"userId"`
+	Name      string                                  `gorm:"size:100;not null" json:"name"`
+	Total     int64                                   `gorm:"type:bigint;default:0;not null" json:"total"` // Stored in smallest currency unit
+	Currency  string                                  `gorm:"size:3;not null;default:'VND'" json:"currency"`
+	Period    int32                                   `gorm:"type:int;default:0;not null" json:"period"` // v1.BudgetPeriod
+	Items     datatypes.JSONSlice[BudgetTemplateItem] `gorm:"not null" json:"items"`
+	CreatedAt time.Time                               `json:"createdAt"`
+	UpdatedAt time.Time                               `json:"updatedAt"`
+}
+
+// TableName specifies the table name for BudgetTemplate model
+func (BudgetTemplate) TableName() string {
+	return "budget_template"
+}
+
+// BudgetTemplateItem is an item of a budget template, stored with the template
+type BudgetTemplateItem struct {
+	Name           string  `json:"name"`
+	Total          int64   `json:"total"` // Stored in smallest currency unit, in the template currency
+	CategoryIDs    []int32 `json:"categoryIds,omitempty"`
+	WalletIDs      []int32 `json:"walletIds,omitempty"`
+	RolloverPolicy int32   `json:"rolloverPolicy"` // v1.BudgetRolloverPolicy
+	RolloverCap    int64   `json:"rolloverCap"`
+}
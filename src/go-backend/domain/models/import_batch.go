@@ -6,6 +6,14 @@ import (
 	"gorm.io/gorm"
 )
 
+// Reconciliation statuses of an import batch against its statement balances
+const (
+	ReconciliationStatusUnavailable = "unavailable"
+	ReconciliationStatusBalanced    = "balanced"
+	ReconciliationStatusMismatch    = "mismatch"
+	ReconciliationStatusAdjusted    = "adjusted"
+)
+
 type ImportBatch struct {
 	ID               string         `gorm:"primaryKey;size:36" json:"id"` // UUID
 	UserID           int32          `gorm:"not null;index" json:"userId"`
@@ -22,12 +30,20 @@ type ImportBatch struct {
 	SkippedRows      int32          `json:"skippedRows"`
 	DuplicatesMerged int32          `json:"duplicatesMerged"`
 	DuplicatesSkipped int32         `json:"duplicatesSkipped"`
+	TransfersLinked  int32          `json:"transfersLinked"` // Rows linked as the other leg of a transfer
 
 	// Financial summary (stored as smallest currency unit)
 	TotalIncome      int64          `gorm:"type:bigint" json:"totalIncome"`
 	TotalExpenses    int64          `gorm:"type:bigint" json:"totalExpenses"`
 	NetChange        int64          `gorm:"type:bigint" json:"netChange"`
 
+	// Statement reconciliation (stored as smallest currency unit, see ReconciliationStatus*)
+	ReconciliationStatus     string `gorm:"size:20" json:"reconciliationStatus"` // Empty when the statement reported no balances
+	StatementOpeningBalance  *int64 `gorm:"type:bigint" json:"statementOpeningBalance"`
+	StatementClosingBalance  *int64 `gorm:"type:bigint" json:"statementClosingBalance"`
+	ReconciliationDifference int64  `gorm:"type:bigint" json:"reconciliationDifference"` // Closing - (opening + rows)
+	BalanceAdjustment        int64  `gorm:"type:bigint" json:"balanceAdjustment"`        // Posted through AdjustBalance, 0 if none
+
 	// Date range
 	DateRangeStart   time.Time      `json:"dateRangeStart"`
 	DateRangeEnd     time.Time      `json:"dateRangeEnd"`
@@ -27,6 +27,8 @@ type Investment struct {
 	TotalDividends       int64                        `gorm:"type:bigint;default:0" json:"totalDividends"`
 	PurchaseUnit         string                       `gorm:"size:10;default:'gram'" json:"purchaseUnit"`
 	IsCustom             bool                         `gorm:"type:boolean;not null;default:false" json:"isCustom"`
+	CostBasisMethod      int32                        `gorm:"type:int;not null;default:0" json:"costBasisMethod"` // Lot selection for sells (0 = FIFO)
+	AssetClass           string                       `gorm:"size:50;not null;default:''" json:"assetClass"`      // User-defined tag for allocation targets
 	CreatedAt            time.Time                    `json:"createdAt"`
 	UpdatedAt            time.Time                    `json:"updatedAt"`
 	DeletedAt            gorm.DeletedAt               `gorm:"index" json:"-"`
@@ -122,6 +124,8 @@ func (i *Investment) ToProto() *v1.Investment {
 		TotalDividends:       i.TotalDividends,
 		PurchaseUnit:         i.PurchaseUnit,
 		IsCustom:             i.IsCustom,
+		CostBasisMethod:      v1.CostBasisMethod(i.CostBasisMethod),
+		AssetClass:           i.AssetClass,
 		CreatedAt:            i.CreatedAt.Unix(),
 		UpdatedAt:            i.UpdatedAt.Unix(),
 	}
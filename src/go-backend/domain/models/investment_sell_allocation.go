@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+)
+
+// InvestmentSellAllocation records how much of a lot a sell consumed
+//
+// The chosen cost-basis method decides which lots a sell consumes. Keeping one
+// row per consumed lot lets deleting the sell restore exactly those lots and
+// reverse exactly the realized PNL it booked.
+//
+// Example (FIFO, sell 150 shares @ $170):
+//   - Lot 1 (100 @ $150): Quantity=100, CostBasis=$15,000, RealizedPNL=$2,000
+//   - Lot 2 (50 @ $160):  Quantity=50,  CostBasis=$8,000,  RealizedPNL=$500
+type InvestmentSellAllocation struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	TransactionID int32     `gorm:"not null;index:idx_sell_allocation_tx" json:"transactionId"`
+	LotID         int32     `gorm:"not null;index" json:"lotId"`
+	Quantity      int64     `gorm:"type:bigint;not null" json:"quantity"`
+	CostBasis     int64     `gorm:"type:bigint;not null" json:"costBasis"`   // Cost of the consumed quantity
+	RealizedPNL   int64     `gorm:"type:bigint;not null" json:"realizedPnl"` // Before fees
+	CreatedAt     time.Time `json:"createdAt"`
+
+	// Relationships
+	Lot *InvestmentLot `gorm:"foreignKey:LotID" json:"lot,omitempty"`
+}
+
+// TableName specifies the table name for InvestmentSellAllocation model
+func (InvestmentSellAllocation) TableName() string {
+	return "investment_sell_allocation"
+}
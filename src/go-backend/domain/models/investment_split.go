@@ -0,0 +1,71 @@
+package models
+
+import (
+	"math/big"
+	"time"
+)
+
+// InvestmentSplitAdjustment records the values a stock split overwrote
+//
+// A split rescales quantities by numerator/denominator and per-unit costs by the
+// inverse, which rounds. Keeping the original values lets deleting the split
+// restore holdings exactly. LotID is nil for the row describing the investment itself.
+//
+// Example for a 1-for-3 reverse split of 100 shares (precision 10000):
+//   - Before: RemainingQuantity=1000000
+//   - After:  RemainingQuantity=333333 (restored to 1000000 when the split is deleted)
+type InvestmentSplitAdjustment struct {
+	ID                      int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	TransactionID           int32     `gorm:"not null;index:idx_split_adjustment_tx" json:"transactionId"`
+	LotID                   *int32    `gorm:"index" json:"lotId,omitempty"`
+	QuantityBefore          int64     `gorm:"type:bigint;not null" json:"quantityBefore"`
+	RemainingQuantityBefore int64     `gorm:"type:bigint;not null;default:0" json:"remainingQuantityBefore"`
+	AverageCostBefore       int64     `gorm:"type:bigint;not null" json:"averageCostBefore"`
+	CreatedAt               time.Time `json:"createdAt"`
+}
+
+// TableName specifies the table name for InvestmentSplitAdjustment model
+func (InvestmentSplitAdjustment) TableName() string {
+	return "investment_split_adjustment"
+}
+
+// ScaleByRatio returns value × numerator / denominator rounded half away from zero.
+// Intermediate math uses big integers so large crypto quantities cannot overflow.
+func ScaleByRatio(value int64, numerator, denominator int32) int64 {
+	if denominator == 0 {
+		return value
+	}
+
+	product := new(big.Int).Mul(big.NewInt(value), big.NewInt(int64(numerator)))
+	den := big.NewInt(int64(denominator))
+	quotient, remainder := new(big.Int).QuoRem(product, den, new(big.Int))
+
+	// Round half away from zero: compare 2×|remainder| against |denominator|
+	remainder.Abs(remainder).Lsh(remainder, 1)
+	if remainder.Cmp(new(big.Int).Abs(den)) >= 0 {
+		if product.Sign()*den.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient.Int64()
+}
+
+// ApplySplit rescales the lot for a split of numerator new units per denominator old units.
+// TotalCost is unchanged; only the number of units and the per-unit cost move.
+func (l *InvestmentLot) ApplySplit(numerator, denominator int32) {
+	l.Quantity = ScaleByRatio(l.Quantity, numerator, denominator)
+	l.RemainingQuantity = ScaleByRatio(l.RemainingQuantity, numerator, denominator)
+	l.AverageCost = ScaleByRatio(l.AverageCost, denominator, numerator)
+}
+
+// ApplySplit rescales the holding for a split of numerator new units per denominator old units.
+// TotalCost is unchanged, and the current price is rescaled so the market value does
+// not jump before the next price refresh.
+func (i *Investment) ApplySplit(numerator, denominator int32) {
+	i.Quantity = ScaleByRatio(i.Quantity, numerator, denominator)
+	i.AverageCost = ScaleByRatio(i.AverageCost, denominator, numerator)
+	i.CurrentPrice = ScaleByRatio(i.CurrentPrice, denominator, numerator)
+	i.Recalculate()
+}
@@ -0,0 +1,88 @@
+package models_test
+
+import (
+	"testing"
+
+	"wealthjourney/domain/models"
+	v1 "wealthjourney/protobuf/v1"
+)
+
+func TestInvestmentSplitAdjustment_TableName(t *testing.T) {
+	if (models.InvestmentSplitAdjustment{}).TableName() != "investment_split_adjustment" {
+		t.Errorf("unexpected table name for InvestmentSplitAdjustment")
+	}
+}
+
+func TestScaleByRatio(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       int64
+		numerator   int32
+		denominator int32
+		want        int64
+	}{
+		{name: "2-for-1", value: 1000000, numerator: 2, denominator: 1, want: 2000000},
+		{name: "1-for-10 reverse", value: 1000000, numerator: 1, denominator: 10, want: 100000},
+		{name: "rounds half up", value: 5, numerator: 1, denominator: 2, want: 3},
+		{name: "rounds down below half", value: 1000000, numerator: 1, denominator: 3, want: 333333},
+		{name: "negative rounds away from zero", value: -5, numerator: 1, denominator: 2, want: -3},
+		{name: "large crypto quantity does not overflow", value: 9000000000000000000, numerator: 1, denominator: 3, want: 3000000000000000000},
+		{name: "zero denominator leaves value", value: 42, numerator: 2, denominator: 0, want: 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := models.ScaleByRatio(tt.value, tt.numerator, tt.denominator); got != tt.want {
+				t.Errorf("ScaleByRatio(%d, %d, %d) = %d, want %d", tt.value, tt.numerator, tt.denominator, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvestmentLot_ApplySplit(t *testing.T) {
+	lot := &models.InvestmentLot{
+		Quantity:          1000000, // 100 shares
+		RemainingQuantity: 600000,  // 60 shares
+		AverageCost:       15000,   // $150.00
+		TotalCost:         1500000, // $15,000.00
+	}
+
+	lot.ApplySplit(2, 1)
+
+	if lot.Quantity != 2000000 || lot.RemainingQuantity != 1200000 {
+		t.Errorf("expected quantities doubled, got quantity=%d remaining=%d", lot.Quantity, lot.RemainingQuantity)
+	}
+	if lot.AverageCost != 7500 {
+		t.Errorf("expected average cost halved to 7500, got %d", lot.AverageCost)
+	}
+	if lot.TotalCost != 1500000 {
+		t.Errorf("expected total cost unchanged, got %d", lot.TotalCost)
+	}
+}
+
+func TestInvestment_ApplySplitKeepsValue(t *testing.T) {
+	inv := &models.Investment{
+		Type:         int32(v1.InvestmentType_INVESTMENT_TYPE_STOCK),
+		Quantity:     1000000, // 100 shares
+		AverageCost:  15000,
+		TotalCost:    1500000,
+		CurrentPrice: 20000,
+	}
+	inv.Recalculate()
+	valueBefore := inv.CurrentValue
+
+	inv.ApplySplit(1, 10)
+
+	if inv.Quantity != 100000 {
+		t.Errorf("expected quantity 100000 after 1-for-10, got %d", inv.Quantity)
+	}
+	if inv.AverageCost != 150000 || inv.CurrentPrice != 200000 {
+		t.Errorf("expected per-unit values x10, got avg=%d price=%d", inv.AverageCost, inv.CurrentPrice)
+	}
+	if inv.TotalCost != 1500000 {
+		t.Errorf("expected total cost unchanged, got %d", inv.TotalCost)
+	}
+	if inv.CurrentValue != valueBefore {
+		t.Errorf("expected market value unchanged (%d), got %d", valueBefore, inv.CurrentValue)
+	}
+}
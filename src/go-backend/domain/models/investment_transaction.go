@@ -23,6 +23,8 @@ type InvestmentTransaction struct {
 	Notes             string    `gorm:"type:text" json:"notes"`
 	LotID             *int32    `gorm:"index" json:"lotId,omitempty"`
 	RemainingQuantity int64     `gorm:"type:bigint;default:0;not null" json:"remainingQuantity"`
+	SplitNumerator    int32     `gorm:"type:int;default:0" json:"splitNumerator"`   // SPLIT only: new units...
+	SplitDenominator  int32     `gorm:"type:int;default:0" json:"splitDenominator"` // ...per old units
 	CreatedAt         time.Time `json:"createdAt"`
 	UpdatedAt         time.Time `json:"updatedAt"`
 	DeletedAt         gorm.DeletedAt `gorm:"index" json:"-"`
@@ -31,6 +33,8 @@ type InvestmentTransaction struct {
 	Investment *Investment     `gorm:"foreignKey:InvestmentID" json:"investment,omitempty"`
 	Wallet     *Wallet         `gorm:"foreignKey:WalletID" json:"wallet,omitempty"`
 	Lot        *InvestmentLot  `gorm:"foreignKey:LotID" json:"lot,omitempty"`
+	// Lots consumed by a sell; created together with the transaction
+	Allocations []InvestmentSellAllocation `gorm:"foreignKey:TransactionID" json:"allocations,omitempty"`
 }
 
 // TableName specifies the table name for InvestmentTransaction model
@@ -58,6 +62,8 @@ func (tx *InvestmentTransaction) ToProto() *investmentv1.InvestmentTransaction {
 		Notes:             tx.Notes,
 		LotId:             lotID,
 		RemainingQuantity: int32(tx.RemainingQuantity),
+		SplitNumerator:    tx.SplitNumerator,
+		SplitDenominator:  tx.SplitDenominator,
 		CreatedAt:         tx.CreatedAt.Unix(),
 		UpdatedAt:         tx.UpdatedAt.Unix(),
 	}
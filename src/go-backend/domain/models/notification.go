@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// Notification kinds
+const (
+	NotificationKindBudgetAlert = "budget_alert"
+)
+
+// Notification is a message in the user's in-app inbox
+type Notification struct {
+	ID        int32                                 `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int32                                 `gorm:"not null;index" json:"userId"`
+	Kind      string                                `gorm:"size:50;not null" json:"kind"`
+	Title     string                                `gorm:"size:255;not null" json:"title"`
+	Body      string                                `gorm:"type:text" json:"body"`
+	Data      datatypes.JSONType[map[string]string] `json:"data"`
+	ReadAt    *time.Time                            `gorm:"index" json:"readAt,omitempty"`
+	CreatedAt time.Time                             `json:"createdAt"`
+}
+
+// TableName specifies the table name for Notification model
+func (Notification) TableName() string {
+	return "notification"
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// Price history sources
+const (
+	PriceSourceYahoo = "yahoo" // Daily bars backfilled from Yahoo Finance
+	PriceSourceQuote = "quote" // Built from live prices recorded during the day
+)
+
+// PriceHistory stores the daily OHLC prices of a symbol
+// MarketData only keeps the latest price; this table values holdings on past dates
+type PriceHistory struct {
+	ID        int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Symbol    string    `gorm:"size:20;not null;uniqueIndex:idx_price_history_symbol_date" json:"symbol"`
+	Currency  string    `gorm:"size:3;not null;uniqueIndex:idx_price_history_symbol_date" json:"currency"`
+	PriceDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_price_history_symbol_date" json:"priceDate"`
+	Open      int64     `gorm:"type:bigint;not null" json:"open"` // Smallest currency unit, per storage unit (e.g. gram for VND gold)
+	High      int64     `gorm:"type:bigint;not null" json:"high"`
+	Low       int64     `gorm:"type:bigint;not null" json:"low"`
+	Close     int64     `gorm:"type:bigint;not null" json:"close"`
+	Volume    int64     `gorm:"type:bigint;default:0" json:"volume"`
+	Source    string    `gorm:"size:10;not null" json:"source"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TableName specifies the table name for PriceHistory
+func (PriceHistory) TableName() string {
+	return "price_history"
+}
+
+// AddQuote folds a live price into the day's bar
+func (p *PriceHistory) AddQuote(price int64) {
+	if p.Open == 0 {
+		p.Open, p.High, p.Low = price, price, price
+	}
+	if price > p.High {
+		p.High = price
+	}
+	if price < p.Low {
+		p.Low = price
+	}
+	p.Close = price
+}
+
+// ToProto converts the model to protobuf message
+func (p *PriceHistory) ToProto() *v1.PriceBar {
+	return &v1.PriceBar{
+		Date:   p.PriceDate.Unix(),
+		Open:   p.Open,
+		High:   p.High,
+		Low:    p.Low,
+		Close:  p.Close,
+		Volume: p.Volume,
+		Source: p.Source,
+	}
+}
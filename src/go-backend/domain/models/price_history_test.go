@@ -0,0 +1,18 @@
+package models_test
+
+import (
+	"testing"
+
+	"wealthjourney/domain/models"
+)
+
+func TestPriceHistory_AddQuote(t *testing.T) {
+	bar := &models.PriceHistory{}
+	for _, price := range []int64{100, 104, 97, 101} {
+		bar.AddQuote(price)
+	}
+
+	if bar.Open != 100 || bar.High != 104 || bar.Low != 97 || bar.Close != 101 {
+		t.Errorf("got O=%d H=%d L=%d C=%d, want O=100 H=104 L=97 C=101", bar.Open, bar.High, bar.Low, bar.Close)
+	}
+}
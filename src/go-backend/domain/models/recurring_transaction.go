@@ -0,0 +1,185 @@
+package models
+
+import (
+	"time"
+
+	v1 "wealthjourney/protobuf/v1"
+
+	"gorm.io/gorm"
+)
+
+// Occurrence statuses recorded in the recurring transaction occurrence ledger
+const (
+	RecurringOccurrenceGenerated = "generated"
+	RecurringOccurrenceSkipped   = "skipped"
+)
+
+// RecurringTransaction is a schedule that materializes regular transactions
+//
+// The schedule is anchored at StartDate and the n-th slot is computed from the
+// anchor rather than from the previous slot, so monthly schedules starting on
+// the 31st land on the last day of shorter months without drifting:
+//   - Jan 31 → Feb 28 → Mar 31 → Apr 30 ...
+//
+// ScheduleIndex is the slot index of NextOccurrence relative to StartDate.
+// OccurrenceCount is the number of slots consumed (generated or skipped) since
+// the schedule was created and is what MaxOccurrences is checked against.
+type RecurringTransaction struct {
+	ID              int32          `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID          int32          `gorm:"not null;index" json:"userId"`
+	WalletID        int32          `gorm:"not null;index" json:"walletId"`
+	CategoryID      *int32         `gorm:"index" json:"categoryId"`
+	Amount          int64          `gorm:"type:bigint;not null" json:"amount"` // Signed, stored in smallest currency unit
+	Currency        string         `gorm:"size:3;not null;default:'VND'" json:"currency"`
+	Note            string         `gorm:"type:text" json:"note"`
+	Frequency       int32          `gorm:"type:int;not null" json:"frequency"` // v1.RecurrenceFrequency
+	Interval        int32          `gorm:"type:int;not null;default:1" json:"interval"`
+	StartDate       time.Time      `gorm:"not null" json:"startDate"`
+	EndDate         *time.Time     `json:"endDate,omitempty"`
+	MaxOccurrences  *int32         `gorm:"type:int" json:"maxOccurrences,omitempty"`
+	ScheduleIndex   int32          `gorm:"type:int;not null;default:0" json:"scheduleIndex"`
+	OccurrenceCount int32          `gorm:"type:int;not null;default:0" json:"occurrenceCount"`
+	NextOccurrence  *time.Time     `gorm:"index" json:"nextOccurrence,omitempty"`
+	Status          int32          `gorm:"type:int;not null;default:1;index" json:"status"` // v1.RecurringTransactionStatus
+	CreatedAt       time.Time      `json:"createdAt"`
+	UpdatedAt       time.Time      `json:"updatedAt"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Wallet   *Wallet   `gorm:"foreignKey:WalletID" json:"wallet,omitempty"`
+	Category *Category `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
+}
+
+// TableName specifies the table name for RecurringTransaction model
+func (RecurringTransaction) TableName() string {
+	return "recurring_transaction"
+}
+
+// RecurringTransactionOccurrence records every slot of a schedule that has been
+// generated or skipped. The unique (schedule, date) index makes materialization
+// idempotent: a slot can never produce two transactions, even across restarts.
+type RecurringTransactionOccurrence struct {
+	ID                     int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	RecurringTransactionID int32     `gorm:"not null;uniqueIndex:idx_recurring_occurrence" json:"recurringTransactionId"`
+	OccurrenceDate         time.Time `gorm:"not null;uniqueIndex:idx_recurring_occurrence" json:"occurrenceDate"`
+	TransactionID          *int32    `gorm:"index" json:"transactionId,omitempty"`
+	Status                 string    `gorm:"size:20;not null" json:"status"` // 'generated', 'skipped'
+	CreatedAt              time.Time `json:"createdAt"`
+}
+
+// TableName specifies the table name for RecurringTransactionOccurrence model
+func (RecurringTransactionOccurrence) TableName() string {
+	return "recurring_transaction_occurrence"
+}
+
+// OccurrenceAt returns the date of the n-th slot counted from StartDate.
+// Calendar arithmetic is done in UTC so results do not depend on the server time zone.
+func (r *RecurringTransaction) OccurrenceAt(n int32) time.Time {
+	interval := int(r.Interval)
+	if interval < 1 {
+		interval = 1
+	}
+	step := int(n) * interval
+	start := r.StartDate.UTC()
+
+	switch v1.RecurrenceFrequency(r.Frequency) {
+	case v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY:
+		return start.AddDate(0, 0, 7*step)
+	case v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY:
+		return addMonthsClamped(start, step)
+	case v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY:
+		return addMonthsClamped(start, 12*step)
+	default:
+		return start.AddDate(0, 0, step)
+	}
+}
+
+// addMonthsClamped adds months to t, clamping the day to the end of the target month
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// withinLimits reports whether a slot at date, after consumed slots, is still part of the schedule
+func (r *RecurringTransaction) withinLimits(date time.Time, consumed int32) bool {
+	if r.MaxOccurrences != nil && consumed >= *r.MaxOccurrences {
+		return false
+	}
+	if r.EndDate != nil && date.After(*r.EndDate) {
+		return false
+	}
+	return true
+}
+
+// refreshNextOccurrence recomputes NextOccurrence and marks the schedule completed when exhausted
+func (r *RecurringTransaction) refreshNextOccurrence() {
+	next := r.OccurrenceAt(r.ScheduleIndex)
+	if !r.withinLimits(next, r.OccurrenceCount) {
+		r.NextOccurrence = nil
+		r.Status = int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_COMPLETED)
+		return
+	}
+	r.NextOccurrence = &next
+}
+
+// ResetSchedule re-anchors the schedule at anchor. Already consumed occurrences
+// still count towards MaxOccurrences.
+func (r *RecurringTransaction) ResetSchedule(anchor time.Time) {
+	r.StartDate = anchor
+	r.ScheduleIndex = 0
+	if r.Status == int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_COMPLETED) {
+		r.Status = int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE)
+	}
+	r.refreshNextOccurrence()
+}
+
+// Advance consumes the current slot and moves NextOccurrence to the following one
+func (r *RecurringTransaction) Advance() {
+	if r.NextOccurrence == nil {
+		return
+	}
+	r.ScheduleIndex++
+	r.OccurrenceCount++
+	r.refreshNextOccurrence()
+}
+
+// FastForward consumes every slot scheduled before cutoff without generating it.
+// Used when resuming a paused schedule so missed slots are not back-filled.
+func (r *RecurringTransaction) FastForward(cutoff time.Time) {
+	for r.NextOccurrence != nil && r.NextOccurrence.Before(cutoff) {
+		r.Advance()
+	}
+}
+
+// IsActive reports whether the schedule is active
+func (r *RecurringTransaction) IsActive() bool {
+	return r.Status == int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE)
+}
+
+// IsDue reports whether the next occurrence should be materialized at now
+func (r *RecurringTransaction) IsDue(now time.Time) bool {
+	return r.IsActive() && r.NextOccurrence != nil && !r.NextOccurrence.After(now)
+}
+
+// UpcomingOccurrences returns up to limit upcoming slot dates, starting with NextOccurrence
+func (r *RecurringTransaction) UpcomingOccurrences(limit int) []time.Time {
+	if r.NextOccurrence == nil || limit <= 0 {
+		return nil
+	}
+	dates := make([]time.Time, 0, limit)
+	index, consumed := r.ScheduleIndex, r.OccurrenceCount
+	for len(dates) < limit {
+		date := r.OccurrenceAt(index)
+		if !r.withinLimits(date, consumed) {
+			break
+		}
+		dates = append(dates, date)
+		index++
+		consumed++
+	}
+	return dates
+}
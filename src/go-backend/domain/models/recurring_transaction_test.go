@@ -0,0 +1,183 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	v1 "wealthjourney/protobuf/v1"
+)
+
+func newSchedule(freq v1.RecurrenceFrequency, interval int32, start time.Time) *models.RecurringTransaction {
+	r := &models.RecurringTransaction{
+		Frequency: int32(freq),
+		Interval:  interval,
+		Status:    int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE),
+	}
+	r.ResetSchedule(start)
+	return r
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+}
+
+func TestRecurringTransaction_TableNames(t *testing.T) {
+	if (models.RecurringTransaction{}).TableName() != "recurring_transaction" {
+		t.Errorf("unexpected table name for RecurringTransaction")
+	}
+	if (models.RecurringTransactionOccurrence{}).TableName() != "recurring_transaction_occurrence" {
+		t.Errorf("unexpected table name for RecurringTransactionOccurrence")
+	}
+}
+
+func TestRecurringTransaction_OccurrenceAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		freq     v1.RecurrenceFrequency
+		interval int32
+		start    time.Time
+		want     []time.Time
+	}{
+		{
+			name:     "daily every 2 days",
+			freq:     v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY,
+			interval: 2,
+			start:    date(2024, time.January, 30),
+			want:     []time.Time{date(2024, time.January, 30), date(2024, time.February, 1), date(2024, time.February, 3)},
+		},
+		{
+			name:     "weekly",
+			freq:     v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY,
+			interval: 1,
+			start:    date(2024, time.December, 25),
+			want:     []time.Time{date(2024, time.December, 25), date(2025, time.January, 1), date(2025, time.January, 8)},
+		},
+		{
+			name:     "monthly on the 31st clamps to month end without drifting",
+			freq:     v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY,
+			interval: 1,
+			start:    date(2024, time.January, 31),
+			want: []time.Time{
+				date(2024, time.January, 31), date(2024, time.February, 29), date(2024, time.March, 31),
+				date(2024, time.April, 30), date(2024, time.May, 31),
+			},
+		},
+		{
+			name:     "quarterly",
+			freq:     v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY,
+			interval: 3,
+			start:    date(2024, time.November, 30),
+			want:     []time.Time{date(2024, time.November, 30), date(2025, time.February, 28), date(2025, time.May, 30)},
+		},
+		{
+			name:     "yearly on leap day",
+			freq:     v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY,
+			interval: 1,
+			start:    date(2024, time.February, 29),
+			want:     []time.Time{date(2024, time.February, 29), date(2025, time.February, 28), date(2026, time.February, 28)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newSchedule(tt.freq, tt.interval, tt.start)
+			for i, want := range tt.want {
+				if got := r.OccurrenceAt(int32(i)); !got.Equal(want) {
+					t.Errorf("OccurrenceAt(%d) = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRecurringTransaction_AdvanceWithCount(t *testing.T) {
+	count := int32(3)
+	r := newSchedule(v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY, 1, date(2024, time.January, 15))
+	r.MaxOccurrences = &count
+
+	for i := 0; i < 3; i++ {
+		if r.NextOccurrence == nil {
+			t.Fatalf("schedule completed early after %d occurrences", i)
+		}
+		r.Advance()
+	}
+
+	if r.NextOccurrence != nil {
+		t.Errorf("expected no next occurrence after count reached, got %v", r.NextOccurrence)
+	}
+	if r.Status != int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_COMPLETED) {
+		t.Errorf("expected status COMPLETED, got %d", r.Status)
+	}
+	if r.OccurrenceCount != 3 {
+		t.Errorf("expected occurrence count 3, got %d", r.OccurrenceCount)
+	}
+}
+
+func TestRecurringTransaction_AdvanceWithEndDate(t *testing.T) {
+	end := date(2024, time.January, 21)
+	r := newSchedule(v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY, 1, date(2024, time.January, 1))
+	r.EndDate = &end
+	r.ResetSchedule(r.StartDate)
+
+	upcoming := r.UpcomingOccurrences(10)
+	if len(upcoming) != 3 {
+		t.Fatalf("expected 3 upcoming occurrences before end date, got %d", len(upcoming))
+	}
+
+	for range upcoming {
+		r.Advance()
+	}
+	if r.IsActive() || r.NextOccurrence != nil {
+		t.Errorf("expected schedule to be completed after end date")
+	}
+}
+
+func TestRecurringTransaction_IsDue(t *testing.T) {
+	r := newSchedule(v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, 1, date(2024, time.March, 1))
+
+	if r.IsDue(date(2024, time.February, 29)) {
+		t.Error("schedule should not be due before its start date")
+	}
+	if !r.IsDue(date(2024, time.March, 1)) {
+		t.Error("schedule should be due on its start date")
+	}
+
+	r.Status = int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_PAUSED)
+	if r.IsDue(date(2024, time.March, 2)) {
+		t.Error("paused schedule should never be due")
+	}
+}
+
+func TestRecurringTransaction_FastForward(t *testing.T) {
+	r := newSchedule(v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, 1, date(2024, time.March, 1))
+
+	r.FastForward(time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC))
+
+	if r.NextOccurrence == nil || !r.NextOccurrence.Equal(date(2024, time.March, 5)) {
+		t.Errorf("expected next occurrence on March 5, got %v", r.NextOccurrence)
+	}
+	if r.OccurrenceCount != 4 {
+		t.Errorf("expected 4 consumed slots, got %d", r.OccurrenceCount)
+	}
+}
+
+func TestRecurringTransaction_ResetScheduleKeepsCount(t *testing.T) {
+	count := int32(5)
+	r := newSchedule(v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY, 1, date(2024, time.January, 1))
+	r.MaxOccurrences = &count
+	r.Advance()
+	r.Advance()
+
+	r.ResetSchedule(date(2024, time.June, 10))
+
+	if r.ScheduleIndex != 0 {
+		t.Errorf("expected schedule index reset to 0, got %d", r.ScheduleIndex)
+	}
+	if r.NextOccurrence == nil || !r.NextOccurrence.Equal(date(2024, time.June, 10)) {
+		t.Errorf("expected next occurrence at new anchor, got %v", r.NextOccurrence)
+	}
+	if got := len(r.UpcomingOccurrences(10)); got != 3 {
+		t.Errorf("expected 3 remaining occurrences, got %d", got)
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Tag is a user-defined label that cuts across categories, such as
+// "trip-2026-japan", "business" or "reimbursable". A transaction can carry
+// any number of tags. Names are unique per user, ignoring case.
+type Tag struct {
+	ID        int32     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int32     `gorm:"not null;uniqueIndex:idx_tag_user_name" json:"userId"`
+	Name      string    `gorm:"size:50;not null;uniqueIndex:idx_tag_user_name" json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	User *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// TableName specifies the table name for Tag model
+func (Tag) TableName() string {
+	return "tag"
+}
+
+// TransactionTag is a row of the join table between transactions and tags
+type TransactionTag struct {
+	TransactionID int32 `gorm:"primaryKey" json:"transactionId"`
+	TagID         int32 `gorm:"primaryKey;index" json:"tagId"`
+}
+
+// TableName specifies the table name for TransactionTag model
+func (TransactionTag) TableName() string {
+	return "transaction_tag"
+}
+
+// NormalizeTagName trims a tag name and collapses inner whitespace
+func NormalizeTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
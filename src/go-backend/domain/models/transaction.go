@@ -26,12 +26,21 @@ type Transaction struct {
 	ExchangeRateDate  *time.Time `gorm:"type:date" json:"exchangeRateDate,omitempty"`
 	ExchangeRateSource *string `gorm:"size:20" json:"exchangeRateSource,omitempty"` // 'auto', 'manual'
 
+	// Reconciliation fields
+	Status           int32  `gorm:"type:int;not null;default:1;index" json:"status"` // v1.TransactionStatus
+	ReconciliationID *int32 `gorm:"index" json:"reconciliationId,omitempty"`
+
+	// Transfer leg: shared by the outgoing and incoming transactions of a Transfer
+	TransferID *string `gorm:"size:36;index" json:"transferId,omitempty"`
+
 	CreatedAt     time.Time      `json:"createdAt"`
 	UpdatedAt     time.Time      `json:"updatedAt"`
 	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
 
-	Wallet   *Wallet   `gorm:"foreignKey:WalletID" json:"wallet,omitempty"`
-	Category *Category `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
+	Wallet   *Wallet            `gorm:"foreignKey:WalletID" json:"wallet,omitempty"`
+	Category *Category          `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
+	Splits   []TransactionSplit `gorm:"foreignKey:TransactionID" json:"splits,omitempty"`
+	Tags     []Tag              `gorm:"many2many:transaction_tag" json:"tags,omitempty"`
 }
 
 // TableName specifies the table name for Transaction model
@@ -61,3 +70,19 @@ func (t *Transaction) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// IsReconciled reports whether the transaction was reconciled against a statement
+// and is locked against edits
+func (t *Transaction) IsReconciled() bool {
+	return t.Status == int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)
+}
+
+// IsSplit reports whether the transaction is split across categories
+func (t *Transaction) IsSplit() bool {
+	return len(t.Splits) > 0
+}
+
+// IsTransfer reports whether the transaction is a leg of a transfer between wallets
+func (t *Transaction) IsTransfer() bool {
+	return t.TransferID != nil
+}
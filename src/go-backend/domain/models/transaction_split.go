@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TransactionSplit attributes a portion of a transaction to its own category
+//
+// A supermarket receipt of -500,000 VND can be split into:
+//   - Groceries: -300,000
+//   - Household: -200,000
+//
+// Split amounts are signed like the parent and must sum to the parent amount.
+// Reports attribute each split to its category instead of the parent's.
+type TransactionSplit struct {
+	ID            int32          `gorm:"primaryKey;autoIncrement" json:"id"`
+	TransactionID int32          `gorm:"not null;index" json:"transactionId"`
+	CategoryID    int32          `gorm:"not null;index" json:"categoryId"`
+	Amount        int64          `gorm:"type:bigint;not null" json:"amount"` // Stored in smallest currency unit
+	Note          string         `gorm:"type:text" json:"note"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Category *Category `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
+}
+
+// TableName specifies the table name for TransactionSplit model
+func (TransactionSplit) TableName() string {
+	return "transaction_split"
+}
+
+// SumSplitAmounts returns the total of the split amounts
+func SumSplitAmounts(splits []TransactionSplit) int64 {
+	var total int64
+	for _, split := range splits {
+		total += split.Amount
+	}
+	return total
+}
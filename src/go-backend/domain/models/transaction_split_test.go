@@ -0,0 +1,40 @@
+package models_test
+
+import (
+	"testing"
+
+	"wealthjourney/domain/models"
+)
+
+func TestTransactionSplit_TableName(t *testing.T) {
+	if (models.TransactionSplit{}).TableName() != "transaction_split" {
+		t.Errorf("unexpected table name for TransactionSplit")
+	}
+}
+
+func TestSumSplitAmounts(t *testing.T) {
+	splits := []models.TransactionSplit{
+		{CategoryID: 1, Amount: -300000},
+		{CategoryID: 2, Amount: -250000},
+		{CategoryID: 3, Amount: 50000}, // refund line
+	}
+
+	if got := models.SumSplitAmounts(splits); got != -500000 {
+		t.Errorf("SumSplitAmounts() = %d, want -500000", got)
+	}
+	if got := models.SumSplitAmounts(nil); got != 0 {
+		t.Errorf("SumSplitAmounts(nil) = %d, want 0", got)
+	}
+}
+
+func TestTransaction_IsSplit(t *testing.T) {
+	tx := &models.Transaction{Amount: -500000}
+	if tx.IsSplit() {
+		t.Error("transaction without splits should not be split")
+	}
+
+	tx.Splits = []models.TransactionSplit{{CategoryID: 1, Amount: -300000}, {CategoryID: 2, Amount: -200000}}
+	if !tx.IsSplit() {
+		t.Error("transaction with splits should be split")
+	}
+}
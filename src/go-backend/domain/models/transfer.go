@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// Transfer moves money between two wallets of a user. It is recorded as a pair of
+// transactions carrying the transfer ID: an outgoing leg in the source wallet and an
+// incoming leg in the destination wallet. The legs are edited and deleted together.
+//
+// The outgoing leg equals -(amount sent). A fee is recorded as a separate expense in
+// the source wallet that does not carry the transfer ID, so reports that leave out
+// transfers still count it; it is deleted with the transfer. For wallets with the same
+// currency the exchange rate is 1 and both legs move the same amount.
+//
+// Pairs stored before transfers existed are linked by cmd/migrate-transfer-links.
+type Transfer struct {
+	ID                string    `gorm:"primaryKey;size:36" json:"id"`
+	UserID            int32     `gorm:"not null;index" json:"userId"`
+	FromWalletID      int32     `gorm:"not null;index" json:"fromWalletId"`
+	ToWalletID        int32     `gorm:"not null;index" json:"toWalletId"`
+	FromTransactionID int32     `gorm:"not null" json:"fromTransactionId"`
+	ToTransactionID   int32     `gorm:"not null" json:"toTransactionId"`
+	FeeTransactionID  *int32    `json:"feeTransactionId,omitempty"`
+	ExchangeRate      float64   `gorm:"type:decimal(20,10);not null;default:1" json:"exchangeRate"` // Destination currency units per source currency unit
+	Fee               int64     `gorm:"type:bigint;not null;default:0" json:"fee"`                  // In the source wallet's currency
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+// TableName specifies the table name for Transfer model
+func (Transfer) TableName() string {
+	return "transfer"
+}
@@ -17,6 +17,7 @@ type User struct {
 	DeletedAt           gorm.DeletedAt `gorm:"index" json:"-"`
 	PreferredCurrency   string         `gorm:"size:3;not null;default:'VND';index" json:"preferredCurrency"`
 	ConversionInProgress bool          `gorm:"default:false;index" json:"conversionInProgress"`
+	DefaultCostBasisMethod int32       `gorm:"type:int;not null;default:0" json:"defaultCostBasisMethod"` // Applied to new investments
 }
 
 // TableName specifies the table name for User model
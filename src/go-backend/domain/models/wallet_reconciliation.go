@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WalletReconciliation is a session matching a wallet against a bank statement.
+//
+// While in progress the user ticks transactions (marks them cleared) until the
+// opening balance plus the cleared transactions equals the statement balance.
+// Finishing marks the cleared transactions reconciled, which locks them, and
+// records the balances for the reconciliation history.
+type WalletReconciliation struct {
+	ID               int32          `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID           int32          `gorm:"not null;index" json:"userId"`
+	WalletID         int32          `gorm:"not null;index" json:"walletId"`
+	StatementDate    time.Time      `gorm:"not null" json:"statementDate"`
+	StatementBalance int64          `gorm:"type:bigint;not null" json:"statementBalance"`         // Stored in smallest currency unit
+	OpeningBalance   int64          `gorm:"type:bigint;not null;default:0" json:"openingBalance"` // Recorded on completion
+	ClearedBalance   int64          `gorm:"type:bigint;not null;default:0" json:"clearedBalance"` // Recorded on completion
+	TransactionCount int32          `gorm:"type:int;not null;default:0" json:"transactionCount"`
+	Status           int32          `gorm:"type:int;not null;default:1;index" json:"status"` // v1.WalletReconciliationStatus
+	CompletedAt      *time.Time     `json:"completedAt,omitempty"`
+	CreatedAt        time.Time      `json:"createdAt"`
+	UpdatedAt        time.Time      `json:"updatedAt"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Wallet *Wallet `gorm:"foreignKey:WalletID" json:"wallet,omitempty"`
+}
+
+// TableName specifies the table name for WalletReconciliation model
+func (WalletReconciliation) TableName() string {
+	return "wallet_reconciliation"
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// AllocationTargetRepository defines the interface for allocation target data access.
+type AllocationTargetRepository interface {
+	// ListByWallet retrieves the targets of a wallet (0 = all investment wallets), highest weight first.
+	ListByWallet(ctx context.Context, userID, walletID int32) ([]*models.AllocationTarget, error)
+
+	// ReplaceForWallet atomically replaces all targets of a wallet (0 = all investment wallets).
+	ReplaceForWallet(ctx context.Context, userID, walletID int32, targets []*models.AllocationTarget) error
+}
+
+// allocationTargetRepository implements AllocationTargetRepository using GORM.
+type allocationTargetRepository struct {
+	*BaseRepository
+}
+
+// NewAllocationTargetRepository creates a new AllocationTargetRepository.
+func NewAllocationTargetRepository(db *database.Database) AllocationTargetRepository {
+	return &allocationTargetRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// ListByWallet retrieves the targets of a wallet (0 = all investment wallets), highest weight first.
+func (r *allocationTargetRepository) ListByWallet(ctx context.Context, userID, walletID int32) ([]*models.AllocationTarget, error) {
+	var targets []*models.AllocationTarget
+	result := r.db.DB.WithContext(ctx).
+		Where("user_id = ? AND wallet_id = ?", userID, walletID).
+		Order("target_percent DESC, id ASC").
+		Find(&targets)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "allocation target", "list allocation targets")
+	}
+	return targets, nil
+}
+
+// ReplaceForWallet atomically replaces all targets of a wallet (0 = all investment wallets).
+func (r *allocationTargetRepository) ReplaceForWallet(ctx context.Context, userID, walletID int32, targets []*models.AllocationTarget) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND wallet_id = ?", userID, walletID).
+			Delete(&models.AllocationTarget{}).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete allocation targets", err)
+		}
+
+		if len(targets) == 0 {
+			return nil
+		}
+		for _, target := range targets {
+			target.UserID = userID
+			target.WalletID = walletID
+		}
+		if err := tx.Create(&targets).Error; err != nil {
+			return r.handleDBError(err, "allocation target", "create allocation targets")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+)
+
+// attachmentRepository implements AttachmentRepository using GORM.
+type attachmentRepository struct {
+	*BaseRepository
+}
+
+// NewAttachmentRepository creates a new AttachmentRepository.
+func NewAttachmentRepository(db *database.Database) AttachmentRepository {
+	return &attachmentRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create creates a new attachment.
+func (r *attachmentRepository) Create(ctx context.Context, attachment *models.Attachment) error {
+	return r.executeCreate(ctx, attachment, "attachment")
+}
+
+// GetByIDForUser retrieves an attachment by ID, ensuring it belongs to the user.
+func (r *attachmentRepository) GetByIDForUser(ctx context.Context, attachmentID, userID int32) (*models.Attachment, error) {
+	var attachment models.Attachment
+	result := r.db.DB.WithContext(ctx).Where("id = ? AND user_id = ?", attachmentID, userID).First(&attachment)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "attachment", "get attachment")
+	}
+	return &attachment, nil
+}
+
+// ListByTransactionID retrieves the attachments of a transaction, oldest first.
+func (r *attachmentRepository) ListByTransactionID(ctx context.Context, transactionID int32) ([]*models.Attachment, error) {
+	var attachments []*models.Attachment
+	result := r.db.DB.WithContext(ctx).
+		Where("transaction_id = ?", transactionID).
+		Order("created_at asc, id asc").
+		Find(&attachments)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list attachments", result.Error)
+	}
+	return attachments, nil
+}
+
+// ListByInvestmentTransactionID retrieves the attachments of an investment transaction, oldest first.
+func (r *attachmentRepository) ListByInvestmentTransactionID(ctx context.Context, transactionID int32) ([]*models.Attachment, error) {
+	var attachments []*models.Attachment
+	result := r.db.DB.WithContext(ctx).
+		Where("investment_transaction_id = ?", transactionID).
+		Order("created_at asc, id asc").
+		Find(&attachments)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list attachments", result.Error)
+	}
+	return attachments, nil
+}
+
+// Delete deletes an attachment by ID.
+func (r *attachmentRepository) Delete(ctx context.Context, id int32) error {
+	result := r.db.DB.WithContext(ctx).Delete(&models.Attachment{}, id)
+	if result.Error != nil {
+		return apperrors.NewInternalErrorWithCause("failed to delete attachment", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NewNotFoundError("attachment")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+
+	"gorm.io/gorm/clause"
+)
+
+// BenchmarkPriceRepository defines the interface for stored benchmark closing prices.
+type BenchmarkPriceRepository interface {
+	// ListBySymbol retrieves the closes of a symbol between from and to (inclusive), oldest first.
+	ListBySymbol(ctx context.Context, symbol string, from, to time.Time) ([]*models.BenchmarkPrice, error)
+
+	// GetLatest retrieves the most recent stored close of a symbol.
+	GetLatest(ctx context.Context, symbol string) (*models.BenchmarkPrice, error)
+
+	// Upsert stores closes, replacing the close already stored for the same symbol and date.
+	Upsert(ctx context.Context, prices []*models.BenchmarkPrice) error
+}
+
+// benchmarkPriceRepository implements BenchmarkPriceRepository using GORM.
+type benchmarkPriceRepository struct {
+	*BaseRepository
+}
+
+// NewBenchmarkPriceRepository creates a new BenchmarkPriceRepository.
+func NewBenchmarkPriceRepository(db *database.Database) BenchmarkPriceRepository {
+	return &benchmarkPriceRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// ListBySymbol retrieves the closes of a symbol between from and to (inclusive), oldest first.
+func (r *benchmarkPriceRepository) ListBySymbol(ctx context.Context, symbol string, from, to time.Time) ([]*models.BenchmarkPrice, error) {
+	var prices []*models.BenchmarkPrice
+	result := r.db.DB.WithContext(ctx).
+		Where("symbol = ? AND price_date >= ? AND price_date <= ?", symbol, from, to).
+		Order("price_date ASC").
+		Find(&prices)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "benchmark price", "list benchmark prices")
+	}
+	return prices, nil
+}
+
+// GetLatest retrieves the most recent stored close of a symbol.
+func (r *benchmarkPriceRepository) GetLatest(ctx context.Context, symbol string) (*models.BenchmarkPrice, error) {
+	var price models.BenchmarkPrice
+	result := r.db.DB.WithContext(ctx).
+		Where("symbol = ?", symbol).
+		Order("price_date DESC").
+		First(&price)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "benchmark price", "get latest benchmark price")
+	}
+	return &price, nil
+}
+
+// Upsert stores closes, replacing the close already stored for the same symbol and date.
+func (r *benchmarkPriceRepository) Upsert(ctx context.Context, prices []*models.BenchmarkPrice) error {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	result := r.db.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "symbol"}, {Name: "price_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"close", "currency", "updated_at"}),
+	}).CreateInBatches(prices, 500)
+	if result.Error != nil {
+		return r.handleDBError(result.Error, "benchmark price", "store benchmark prices")
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+
+	"gorm.io/gorm/clause"
+)
+
+// budgetAlertRepository implements BudgetAlertRepository using GORM.
+type budgetAlertRepository struct {
+	*BaseRepository
+}
+
+// NewBudgetAlertRepository creates a new BudgetAlertRepository.
+func NewBudgetAlertRepository(db *database.Database) BudgetAlertRepository {
+	return &budgetAlertRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// CreateRule creates a new alert rule.
+func (r *budgetAlertRepository) CreateRule(ctx context.Context, rule *models.BudgetAlertRule) error {
+	return r.executeCreate(ctx, rule, "budget alert rule")
+}
+
+// GetRuleByIDForUser retrieves an alert rule by ID, ensuring it belongs to the user.
+func (r *budgetAlertRepository) GetRuleByIDForUser(ctx context.Context, ruleID, userID int32) (*models.BudgetAlertRule, error) {
+	var rule models.BudgetAlertRule
+	result := r.db.DB.WithContext(ctx).Where("id = ? AND user_id = ?", ruleID, userID).First(&rule)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "budget alert rule", "get budget alert rule")
+	}
+	return &rule, nil
+}
+
+// ListRulesByBudgetID retrieves the alert rules of a budget, oldest first.
+func (r *budgetAlertRepository) ListRulesByBudgetID(ctx context.Context, budgetID int32) ([]*models.BudgetAlertRule, error) {
+	var rules []*models.BudgetAlertRule
+	result := r.db.DB.WithContext(ctx).
+		Where("budget_id = ?", budgetID).
+		Order("created_at asc, id asc").
+		Find(&rules)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list budget alert rules", result.Error)
+	}
+	return rules, nil
+}
+
+// ListEnabledRulesByUserID retrieves the enabled alert rules of a user.
+func (r *budgetAlertRepository) ListEnabledRulesByUserID(ctx context.Context, userID int32) ([]*models.BudgetAlertRule, error) {
+	var rules []*models.BudgetAlertRule
+	result := r.db.DB.WithContext(ctx).
+		Where("user_id = ? AND enabled = ?", userID, true).
+		Order("budget_id asc, id asc").
+		Find(&rules)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list budget alert rules", result.Error)
+	}
+	return rules, nil
+}
+
+// UpdateRule updates an alert rule.
+func (r *budgetAlertRepository) UpdateRule(ctx context.Context, rule *models.BudgetAlertRule) error {
+	return r.executeUpdate(ctx, rule, "budget alert rule")
+}
+
+// DeleteRule deletes an alert rule by ID.
+func (r *budgetAlertRepository) DeleteRule(ctx context.Context, id int32) error {
+	return r.executeDelete(ctx, &models.BudgetAlertRule{}, id, "budget alert rule")
+}
+
+// DeleteRulesByBudgetID deletes the alert rules of a budget.
+func (r *budgetAlertRepository) DeleteRulesByBudgetID(ctx context.Context, budgetID int32) error {
+	result := r.db.DB.WithContext(ctx).Where("budget_id = ?", budgetID).Delete(&models.BudgetAlertRule{})
+	if result.Error != nil {
+		return apperrors.NewInternalErrorWithCause("failed to delete budget alert rules", result.Error)
+	}
+	return nil
+}
+
+// DeleteRulesByBudgetItemID deletes the alert rules of a budget item.
+func (r *budgetAlertRepository) DeleteRulesByBudgetItemID(ctx context.Context, itemID int32) error {
+	result := r.db.DB.WithContext(ctx).Where("budget_item_id = ?", itemID).Delete(&models.BudgetAlertRule{})
+	if result.Error != nil {
+		return apperrors.NewInternalErrorWithCause("failed to delete budget alert rules", result.Error)
+	}
+	return nil
+}
+
+// RecordAlert records a crossed threshold. It returns false when the threshold already
+// fired for the rule in the same period.
+func (r *budgetAlertRepository) RecordAlert(ctx context.Context, alert *models.BudgetAlert) (bool, error) {
+	result := r.db.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(alert)
+	if result.Error != nil {
+		return false, apperrors.NewInternalErrorWithCause("failed to record budget alert", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
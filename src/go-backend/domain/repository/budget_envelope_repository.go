@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+
+	"gorm.io/gorm/clause"
+)
+
+// budgetEnvelopeRepository implements BudgetEnvelopeRepository using GORM.
+type budgetEnvelopeRepository struct {
+	*BaseRepository
+}
+
+// NewBudgetEnvelopeRepository creates a new BudgetEnvelopeRepository.
+func NewBudgetEnvelopeRepository(db *database.Database) BudgetEnvelopeRepository {
+	return &budgetEnvelopeRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// CreateMove records money moved between two envelopes.
+func (r *budgetEnvelopeRepository) CreateMove(ctx context.Context, move *models.BudgetMove) error {
+	result := r.db.DB.WithContext(ctx).Create(move)
+	if result.Error != nil {
+		return r.handleDBError(result.Error, "budget move", "create budget move")
+	}
+	return nil
+}
+
+// ListMoves returns the moves of a budget in the period starting at periodStart, oldest first.
+func (r *budgetEnvelopeRepository) ListMoves(ctx context.Context, budgetID int32, periodStart time.Time) ([]*models.BudgetMove, error) {
+	var moves []*models.BudgetMove
+	result := r.db.DB.WithContext(ctx).
+		Where("budget_id = ? AND period_start = ?", budgetID, periodStart).
+		Order("created_at ASC, id ASC").
+		Find(&moves)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list budget moves", result.Error)
+	}
+	return moves, nil
+}
+
+// ListSnapshots returns the envelope snapshots of a budget for the period starting at periodStart.
+func (r *budgetEnvelopeRepository) ListSnapshots(ctx context.Context, budgetID int32, periodStart time.Time) ([]*models.BudgetEnvelopeSnapshot, error) {
+	var snapshots []*models.BudgetEnvelopeSnapshot
+	result := r.db.DB.WithContext(ctx).
+		Where("budget_id = ? AND period_start = ?", budgetID, periodStart).
+		Order("budget_item_id ASC").
+		Find(&snapshots)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list envelope snapshots", result.Error)
+	}
+	return snapshots, nil
+}
+
+// LatestSnapshotEnd returns the end of the last closed period of a budget, or nil when
+// no period was closed yet.
+func (r *budgetEnvelopeRepository) LatestSnapshotEnd(ctx context.Context, budgetID int32) (*time.Time, error) {
+	var snapshots []*models.BudgetEnvelopeSnapshot
+	result := r.db.DB.WithContext(ctx).
+		Where("budget_id = ?", budgetID).
+		Order("period_end DESC").
+		Limit(1).
+		Find(&snapshots)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to get latest envelope snapshot", result.Error)
+	}
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+	return &snapshots[0].PeriodEnd, nil
+}
+
+// CreateSnapshots stores the snapshots of a closed period atomically. Envelopes already
+// closed for the period are left untouched, so closing a period twice is harmless.
+func (r *budgetEnvelopeRepository) CreateSnapshots(ctx context.Context, snapshots []*models.BudgetEnvelopeSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	result := r.db.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&snapshots)
+	if result.Error != nil {
+		return apperrors.NewInternalErrorWithCause("failed to create envelope snapshots", result.Error)
+	}
+	return nil
+}
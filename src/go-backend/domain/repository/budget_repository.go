@@ -6,6 +6,9 @@ import (
 	"wealthjourney/domain/models"
 	"wealthjourney/pkg/database"
 	apperrors "wealthjourney/pkg/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // budgetRepository implements BudgetRepository using GORM.
@@ -44,6 +47,8 @@ func (r *budgetRepository) GetByIDForUser(ctx context.Context, budgetID, userID
 	var budget models.Budget
 	result := r.db.DB.WithContext(ctx).
 		Preload("Items").
+		Preload("Items.Categories").
+		Preload("Items.Wallets").
 		Where("id = ? AND user_id = ?", budgetID, userID).
 		First(&budget)
 
@@ -68,7 +73,7 @@ func (r *budgetRepository) ListByUserID(ctx context.Context, userID int32, opts
 	query := r.db.DB.WithContext(ctx).Model(&models.Budget{}).Where("user_id = ?", userID).Order(orderClause)
 	query = r.applyPagination(query, opts)
 
-	result := query.Preload("Items").Find(&budgets)
+	result := query.Preload("Items").Preload("Items.Categories").Preload("Items.Wallets").Find(&budgets)
 	if result.Error != nil {
 		return nil, 0, apperrors.NewInternalErrorWithCause("failed to list budgets", result.Error)
 	}
@@ -76,9 +81,13 @@ func (r *budgetRepository) ListByUserID(ctx context.Context, userID int32, opts
 	return budgets, int(total), nil
 }
 
-// Update updates a budget.
+// Update updates a budget. Its items are not touched.
 func (r *budgetRepository) Update(ctx context.Context, budget *models.Budget) error {
-	return r.executeUpdate(ctx, budget, "budget")
+	result := r.db.DB.WithContext(ctx).Omit(clause.Associations).Save(budget)
+	if result.Error != nil {
+		return apperrors.NewInternalErrorWithCause("failed to update budget", result.Error)
+	}
+	return nil
 }
 
 // Delete soft deletes a budget by ID.
@@ -108,6 +117,22 @@ func (r *budgetRepository) CountByUserID(ctx context.Context, userID int32) (int
 	return int(count), nil
 }
 
+// ListByPeriods retrieves the budgets of all users with one of the periods, with their items.
+func (r *budgetRepository) ListByPeriods(ctx context.Context, periods []int32) ([]*models.Budget, error) {
+	var budgets []*models.Budget
+	result := r.db.DB.WithContext(ctx).
+		Preload("Items").
+		Preload("Items.Categories").
+		Preload("Items.Wallets").
+		Where("period IN ?", periods).
+		Order("id ASC").
+		Find(&budgets)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list budgets", result.Error)
+	}
+	return budgets, nil
+}
+
 // budgetItemRepository implements BudgetItemRepository using GORM.
 type budgetItemRepository struct {
 	*BaseRepository
@@ -143,6 +168,8 @@ func (r *budgetItemRepository) GetByID(ctx context.Context, id int32) (*models.B
 func (r *budgetItemRepository) GetByIDForBudget(ctx context.Context, itemID, budgetID int32) (*models.BudgetItem, error) {
 	var item models.BudgetItem
 	result := r.db.DB.WithContext(ctx).
+		Preload("Categories").
+		Preload("Wallets").
 		Where("id = ? AND budget_id = ?", itemID, budgetID).
 		First(&item)
 
@@ -156,6 +183,8 @@ func (r *budgetItemRepository) GetByIDForBudget(ctx context.Context, itemID, bud
 func (r *budgetItemRepository) ListByBudgetID(ctx context.Context, budgetID int32) ([]*models.BudgetItem, error) {
 	var items []*models.BudgetItem
 	result := r.db.DB.WithContext(ctx).
+		Preload("Categories").
+		Preload("Wallets").
 		Where("budget_id = ?", budgetID).
 		Find(&items)
 
@@ -166,9 +195,56 @@ func (r *budgetItemRepository) ListByBudgetID(ctx context.Context, budgetID int3
 	return items, nil
 }
 
-// Update updates a budget item.
+// Update updates a budget item. Its categories and wallets are replaced with
+// ReplaceCategories and ReplaceWallets.
 func (r *budgetItemRepository) Update(ctx context.Context, item *models.BudgetItem) error {
-	return r.executeUpdate(ctx, item, "budget item")
+	result := r.db.DB.WithContext(ctx).Omit(clause.Associations).Save(item)
+	if result.Error != nil {
+		return apperrors.NewInternalErrorWithCause("failed to update budget item", result.Error)
+	}
+	return nil
+}
+
+// ReplaceCategories atomically replaces the categories a budget item tracks.
+func (r *budgetItemRepository) ReplaceCategories(ctx context.Context, itemID int32, categoryIDs []int32) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("budget_item_id = ?", itemID).Delete(&models.BudgetItemCategory{}).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete budget item categories", err)
+		}
+
+		rows := BudgetItemCategoryRows(categoryIDs)
+		if len(rows) == 0 {
+			return nil
+		}
+		for i := range rows {
+			rows[i].BudgetItemID = itemID
+		}
+		if err := tx.Create(&rows).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to create budget item categories", err)
+		}
+		return nil
+	})
+}
+
+// ReplaceWallets atomically replaces the wallets a budget item is restricted to.
+func (r *budgetItemRepository) ReplaceWallets(ctx context.Context, itemID int32, walletIDs []int32) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("budget_item_id = ?", itemID).Delete(&models.BudgetItemWallet{}).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete budget item wallets", err)
+		}
+
+		rows := BudgetItemWalletRows(walletIDs)
+		if len(rows) == 0 {
+			return nil
+		}
+		for i := range rows {
+			rows[i].BudgetItemID = itemID
+		}
+		if err := tx.Create(&rows).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to create budget item wallets", err)
+		}
+		return nil
+	})
 }
 
 // Delete soft deletes a budget item by ID.
@@ -198,3 +274,33 @@ func (r *budgetItemRepository) CountByBudgetID(ctx context.Context, budgetID int
 	}
 	return int(count), nil
 }
+
+// BudgetItemCategoryRows builds the deduplicated category bindings of a budget item.
+// The item ID is filled in when the item is created with them.
+func BudgetItemCategoryRows(categoryIDs []int32) []models.BudgetItemCategory {
+	rows := make([]models.BudgetItemCategory, 0, len(categoryIDs))
+	seen := make(map[int32]bool, len(categoryIDs))
+	for _, id := range categoryIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		rows = append(rows, models.BudgetItemCategory{CategoryID: id})
+	}
+	return rows
+}
+
+// BudgetItemWalletRows builds the deduplicated wallet bindings of a budget item.
+// The item ID is filled in when the item is created with them.
+func BudgetItemWalletRows(walletIDs []int32) []models.BudgetItemWallet {
+	rows := make([]models.BudgetItemWallet, 0, len(walletIDs))
+	seen := make(map[int32]bool, len(walletIDs))
+	for _, id := range walletIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		rows = append(rows, models.BudgetItemWallet{WalletID: id})
+	}
+	return rows
+}
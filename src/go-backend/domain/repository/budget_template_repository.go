@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+)
+
+// budgetTemplateRepository implements BudgetTemplateRepository using GORM.
+type budgetTemplateRepository struct {
+	*BaseRepository
+}
+
+// NewBudgetTemplateRepository creates a new BudgetTemplateRepository.
+func NewBudgetTemplateRepository(db *database.Database) BudgetTemplateRepository {
+	return &budgetTemplateRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create creates a new budget template.
+func (r *budgetTemplateRepository) Create(ctx context.Context, template *models.BudgetTemplate) error {
+	return r.executeCreate(ctx, template, "budget template")
+}
+
+// GetByIDForUser retrieves a budget template by ID, ensuring it belongs to the user.
+func (r *budgetTemplateRepository) GetByIDForUser(ctx context.Context, templateID, userID int32) (*models.BudgetTemplate, error) {
+	var template models.BudgetTemplate
+	result := r.db.DB.WithContext(ctx).Where("id = ? AND user_id = ?", templateID, userID).First(&template)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "budget template", "get budget template")
+	}
+	return &template, nil
+}
+
+// ListByUserID retrieves the budget templates of a user, ordered by name.
+func (r *budgetTemplateRepository) ListByUserID(ctx context.Context, userID int32) ([]*models.BudgetTemplate, error) {
+	var templates []*models.BudgetTemplate
+	result := r.db.DB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("name asc, id asc").
+		Find(&templates)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list budget templates", result.Error)
+	}
+	return templates, nil
+}
+
+// Delete deletes a budget template by ID.
+func (r *budgetTemplateRepository) Delete(ctx context.Context, id int32) error {
+	return r.executeDelete(ctx, &models.BudgetTemplate{}, id, "budget template")
+}
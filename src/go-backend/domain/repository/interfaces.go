@@ -89,15 +89,20 @@ type TransactionManager interface {
 
 // TransactionFilter defines filter options for listing transactions.
 type TransactionFilter struct {
-	WalletID   *int32
-	WalletIDs  []int32 // Support multiple wallet IDs
-	CategoryID *int32
-	Type       *v1.TransactionType
-	StartDate  *time.Time
-	EndDate    *time.Time
-	MinAmount  *int64
-	MaxAmount  *int64
-	SearchNote *string
+	WalletID    *int32
+	WalletIDs   []int32 // Support multiple wallet IDs
+	CategoryID  *int32
+	Type        *v1.TransactionType
+	StartDate   *time.Time
+	EndDate     *time.Time
+	MinAmount   *int64
+	MaxAmount   *int64
+	SearchNote  *string
+	Status      *v1.TransactionStatus
+	TagIDs      []int32
+	TagMatchAll bool // Require every tag in TagIDs instead of any of them
+
+	ExcludeTransfers bool // Leave out the legs of transfers between wallets
 }
 
 // TransactionRepository defines the interface for transaction data operations.
@@ -140,8 +145,21 @@ type TransactionRepository interface {
 	TransferToWallet(ctx context.Context, fromWalletID, toWalletID int32) error
 
 	// GetCategoryBreakdown retrieves category-wise transaction summary grouped by currency.
+	// Split transactions are attributed to each split's category.
 	GetCategoryBreakdown(ctx context.Context, userID int32, filter TransactionFilter) ([]*CategoryBreakdownByCurrency, error)
 
+	// GetTagBreakdown retrieves tag-wise transaction summary grouped by currency.
+	// A transaction with several tags counts towards each of them. TagIDs in the filter
+	// restrict the breakdown to those tags.
+	GetTagBreakdown(ctx context.Context, userID int32, filter TransactionFilter) ([]*TagBreakdownByCurrency, error)
+
+	// ReplaceSplits atomically replaces the split lines of a transaction.
+	ReplaceSplits(ctx context.Context, transactionID int32, splits []models.TransactionSplit) error
+
+	// ReplaceTags atomically replaces the tags of a transaction.
+	// Passing no tag IDs removes all tags.
+	ReplaceTags(ctx context.Context, transactionID int32, tagIDs []int32) error
+
 	// BulkCreate creates multiple transactions atomically with wallet balance updates.
 	BulkCreate(ctx context.Context, transactions []*models.Transaction) ([]int32, error)
 
@@ -152,6 +170,115 @@ type TransactionRepository interface {
 	// FindByExternalID retrieves a transaction by its external reference ID.
 	// This method benefits from the composite index (wallet_id, external_id).
 	FindByExternalID(ctx context.Context, walletID int32, externalID string) (*models.Transaction, error)
+
+	// ListUnreconciled retrieves the transactions of a wallet dated up to through that
+	// are not reconciled yet, oldest first.
+	ListUnreconciled(ctx context.Context, walletID int32, through time.Time) ([]*models.Transaction, error)
+
+	// GetReconciledSum returns the sum of the reconciled transaction amounts for a wallet.
+	GetReconciledSum(ctx context.Context, walletID int32) (int64, error)
+
+	// UpdateStatus sets the status of the wallet's transactions with the given IDs.
+	// Reconciled transactions are left unchanged. Returns the number of transactions updated.
+	UpdateStatus(ctx context.Context, walletID int32, transactionIDs []int32, status v1.TransactionStatus) (int, error)
+}
+
+// TransferRepository defines the interface for transfer data operations. Both legs of a
+// transfer and the balances of both wallets are written in one database transaction.
+type TransferRepository interface {
+	// Create creates the transfer, its legs and its fee transaction (if not nil) and applies
+	// them to the wallet balances. The legs get the transfer ID and the transfer gets the
+	// IDs of the legs and the fee transaction.
+	Create(ctx context.Context, transfer *models.Transfer, from, to, fee *models.Transaction) error
+
+	// GetByIDForUser retrieves a transfer by ID, ensuring it belongs to the user.
+	GetByIDForUser(ctx context.Context, id string, userID int32) (*models.Transfer, error)
+
+	// Update saves the transfer and the amount, date, note and status of its legs, moving
+	// each wallet balance by the change of its leg's amount.
+	Update(ctx context.Context, transfer *models.Transfer, from, to *models.Transaction) error
+
+	// Delete deletes the transfer, its legs and its fee transaction and reverts them from
+	// the wallet balances.
+	Delete(ctx context.Context, transfer *models.Transfer) error
+
+	// Link creates a transfer from two existing transactions of opposite sign, which keep
+	// their amounts, so no balance moves. Fails with a conflict if either already is a leg.
+	Link(ctx context.Context, transfer *models.Transfer, from, to *models.Transaction) error
+
+	// UnlinkWithTx deletes transfers within a database transaction, leaving their legs as
+	// plain transactions.
+	UnlinkWithTx(ctx context.Context, dbTx interface{}, transferIDs []string) error
+}
+
+// WalletReconciliationRepository defines the interface for wallet reconciliation data operations.
+type WalletReconciliationRepository interface {
+	// Create creates a new reconciliation.
+	Create(ctx context.Context, reconciliation *models.WalletReconciliation) error
+
+	// GetByIDForUser retrieves a reconciliation by ID, ensuring it belongs to the user.
+	GetByIDForUser(ctx context.Context, id, userID int32) (*models.WalletReconciliation, error)
+
+	// GetInProgress retrieves the open reconciliation of a wallet, or nil when there is none.
+	GetInProgress(ctx context.Context, walletID int32) (*models.WalletReconciliation, error)
+
+	// ListByWalletID retrieves the reconciliations of a wallet, latest statement first.
+	ListByWalletID(ctx context.Context, walletID int32, opts ListOptions) ([]*models.WalletReconciliation, int, error)
+
+	// Update updates a reconciliation.
+	Update(ctx context.Context, reconciliation *models.WalletReconciliation) error
+
+	// Complete marks the given cleared transactions reconciled and saves the completed
+	// reconciliation atomically. Fails with a conflict when any of the transactions is no
+	// longer cleared.
+	Complete(ctx context.Context, reconciliation *models.WalletReconciliation, transactionIDs []int32) error
+}
+
+// TagRepository defines the interface for tag data operations.
+type TagRepository interface {
+	// Create creates a new tag.
+	Create(ctx context.Context, tag *models.Tag) error
+
+	// GetByIDForUser retrieves a tag by ID, ensuring it belongs to the user.
+	GetByIDForUser(ctx context.Context, tagID, userID int32) (*models.Tag, error)
+
+	// GetByIDsForUser retrieves the user's tags with the given IDs.
+	// IDs of missing tags or tags of other users are left out.
+	GetByIDsForUser(ctx context.Context, userID int32, ids []int32) ([]*models.Tag, error)
+
+	// GetByName retrieves a user's tag by name, ignoring case.
+	GetByName(ctx context.Context, userID int32, name string) (*models.Tag, error)
+
+	// GetOrCreateByName retrieves a user's tag by name, ignoring case.
+	// Returns the tag if found, or creates it if it doesn't exist.
+	GetOrCreateByName(ctx context.Context, userID int32, name string) (*models.Tag, error)
+
+	// Update updates a tag.
+	Update(ctx context.Context, tag *models.Tag) error
+
+	// Delete deletes a tag by ID and removes it from all transactions.
+	Delete(ctx context.Context, id int32) error
+
+	// ListByUserID retrieves all tags for a user ordered by name.
+	ListByUserID(ctx context.Context, userID int32, opts ListOptions) ([]*models.Tag, int, error)
+}
+
+// AttachmentRepository defines the interface for attachment data operations.
+type AttachmentRepository interface {
+	// Create creates a new attachment.
+	Create(ctx context.Context, attachment *models.Attachment) error
+
+	// GetByIDForUser retrieves an attachment by ID, ensuring it belongs to the user.
+	GetByIDForUser(ctx context.Context, attachmentID, userID int32) (*models.Attachment, error)
+
+	// ListByTransactionID retrieves the attachments of a transaction, oldest first.
+	ListByTransactionID(ctx context.Context, transactionID int32) ([]*models.Attachment, error)
+
+	// ListByInvestmentTransactionID retrieves the attachments of an investment transaction, oldest first.
+	ListByInvestmentTransactionID(ctx context.Context, transactionID int32) ([]*models.Attachment, error)
+
+	// Delete deletes an attachment by ID.
+	Delete(ctx context.Context, id int32) error
 }
 
 // CategoryBreakdownItem represents category-wise transaction summary
@@ -173,6 +300,52 @@ type CategoryBreakdownByCurrency struct {
 	TransactionCount  int32
 }
 
+// TagBreakdownByCurrency represents tag-wise transaction summary grouped by currency
+type TagBreakdownByCurrency struct {
+	TagID             int32
+	TagName           string
+	IncomeByCurrency  map[string]int64 // Map of currency code to income
+	ExpenseByCurrency map[string]int64 // Map of currency code to expense, as a positive amount
+	TransactionCount  int32
+}
+
+// RecurringTransactionFilter represents filter options for listing recurring transactions.
+type RecurringTransactionFilter struct {
+	WalletID *int32
+	Status   *int32
+}
+
+// RecurringTransactionRepository defines the interface for recurring transaction data operations.
+type RecurringTransactionRepository interface {
+	// Create creates a new recurring transaction.
+	Create(ctx context.Context, recurring *models.RecurringTransaction) error
+
+	// GetByIDForUser retrieves a recurring transaction by ID, ensuring it belongs to the user.
+	GetByIDForUser(ctx context.Context, id, userID int32) (*models.RecurringTransaction, error)
+
+	// ListByUserID retrieves recurring transactions for a user with filtering and pagination.
+	ListByUserID(ctx context.Context, userID int32, filter RecurringTransactionFilter, opts ListOptions) ([]*models.RecurringTransaction, int, error)
+
+	// UpdateLocked locks the recurring transaction row, applies fn and saves the result.
+	// Guarantees the change does not race with the background scheduler.
+	UpdateLocked(ctx context.Context, id, userID int32, fn func(recurring *models.RecurringTransaction) error) (*models.RecurringTransaction, error)
+
+	// Delete soft deletes a recurring transaction by ID.
+	Delete(ctx context.Context, id int32) error
+
+	// ListDueIDs returns IDs of active recurring transactions whose next occurrence is at or before now.
+	ListDueIDs(ctx context.Context, now time.Time, limit int) ([]int32, error)
+
+	// ProcessNextOccurrence materializes the next occurrence if it is due, creating the
+	// transaction, updating the wallet balance and advancing the schedule atomically.
+	// Returns nil when nothing was due.
+	ProcessNextOccurrence(ctx context.Context, id int32, now time.Time) (*models.RecurringTransactionOccurrence, error)
+
+	// SkipOccurrence records a slot as skipped so it is never generated.
+	// Skipping the next occurrence advances the schedule immediately.
+	SkipOccurrence(ctx context.Context, id, userID int32, date time.Time) (*models.RecurringTransaction, error)
+}
+
 // CategoryRepository defines the interface for category data operations.
 type CategoryRepository interface {
 	// Create creates a new category.
@@ -235,6 +408,9 @@ type BudgetRepository interface {
 
 	// CountByUserID returns the number of budgets for a user.
 	CountByUserID(ctx context.Context, userID int32) (int, error)
+
+	// ListByPeriods retrieves the budgets of all users with one of the periods, with their items.
+	ListByPeriods(ctx context.Context, periods []int32) ([]*models.Budget, error)
 }
 
 // BudgetItemRepository defines the interface for budget item data operations.
@@ -254,6 +430,12 @@ type BudgetItemRepository interface {
 	// Update updates a budget item.
 	Update(ctx context.Context, item *models.BudgetItem) error
 
+	// ReplaceCategories atomically replaces the categories a budget item tracks.
+	ReplaceCategories(ctx context.Context, itemID int32, categoryIDs []int32) error
+
+	// ReplaceWallets atomically replaces the wallets a budget item is restricted to.
+	ReplaceWallets(ctx context.Context, itemID int32, walletIDs []int32) error
+
 	// Delete soft deletes a budget item by ID.
 	Delete(ctx context.Context, id int32) error
 
@@ -264,6 +446,90 @@ type BudgetItemRepository interface {
 	CountByBudgetID(ctx context.Context, budgetID int32) (int, error)
 }
 
+// BudgetEnvelopeRepository defines the interface for envelope moves and period snapshots.
+type BudgetEnvelopeRepository interface {
+	// CreateMove records money moved between two envelopes.
+	CreateMove(ctx context.Context, move *models.BudgetMove) error
+
+	// ListMoves returns the moves of a budget in the period starting at periodStart, oldest first.
+	ListMoves(ctx context.Context, budgetID int32, periodStart time.Time) ([]*models.BudgetMove, error)
+
+	// ListSnapshots returns the envelope snapshots of a budget for the period starting at periodStart.
+	ListSnapshots(ctx context.Context, budgetID int32, periodStart time.Time) ([]*models.BudgetEnvelopeSnapshot, error)
+
+	// LatestSnapshotEnd returns the end of the last closed period of a budget, or nil when
+	// no period was closed yet.
+	LatestSnapshotEnd(ctx context.Context, budgetID int32) (*time.Time, error)
+
+	// CreateSnapshots stores the snapshots of a closed period atomically, skipping
+	// envelopes already closed for the period.
+	CreateSnapshots(ctx context.Context, snapshots []*models.BudgetEnvelopeSnapshot) error
+}
+
+// BudgetAlertRepository defines the interface for budget alert rules and the alerts they fired.
+type BudgetAlertRepository interface {
+	// CreateRule creates a new alert rule.
+	CreateRule(ctx context.Context, rule *models.BudgetAlertRule) error
+
+	// GetRuleByIDForUser retrieves an alert rule by ID, ensuring it belongs to the user.
+	GetRuleByIDForUser(ctx context.Context, ruleID, userID int32) (*models.BudgetAlertRule, error)
+
+	// ListRulesByBudgetID retrieves the alert rules of a budget, oldest first.
+	ListRulesByBudgetID(ctx context.Context, budgetID int32) ([]*models.BudgetAlertRule, error)
+
+	// ListEnabledRulesByUserID retrieves the enabled alert rules of a user.
+	ListEnabledRulesByUserID(ctx context.Context, userID int32) ([]*models.BudgetAlertRule, error)
+
+	// UpdateRule updates an alert rule.
+	UpdateRule(ctx context.Context, rule *models.BudgetAlertRule) error
+
+	// DeleteRule deletes an alert rule by ID.
+	DeleteRule(ctx context.Context, id int32) error
+
+	// DeleteRulesByBudgetID deletes the alert rules of a budget.
+	DeleteRulesByBudgetID(ctx context.Context, budgetID int32) error
+
+	// DeleteRulesByBudgetItemID deletes the alert rules of a budget item.
+	DeleteRulesByBudgetItemID(ctx context.Context, itemID int32) error
+
+	// RecordAlert records a crossed threshold. It returns false when the threshold
+	// already fired for the rule in the same period.
+	RecordAlert(ctx context.Context, alert *models.BudgetAlert) (bool, error)
+}
+
+// BudgetTemplateRepository defines the interface for reusable budget plans.
+type BudgetTemplateRepository interface {
+	// Create creates a new budget template.
+	Create(ctx context.Context, template *models.BudgetTemplate) error
+
+	// GetByIDForUser retrieves a budget template by ID, ensuring it belongs to the user.
+	GetByIDForUser(ctx context.Context, templateID, userID int32) (*models.BudgetTemplate, error)
+
+	// ListByUserID retrieves the budget templates of a user, ordered by name.
+	ListByUserID(ctx context.Context, userID int32) ([]*models.BudgetTemplate, error)
+
+	// Delete deletes a budget template by ID.
+	Delete(ctx context.Context, id int32) error
+}
+
+// NotificationRepository defines the interface for the in-app notification inbox.
+type NotificationRepository interface {
+	// Create creates a new notification.
+	Create(ctx context.Context, notification *models.Notification) error
+
+	// ListByUserID retrieves the notifications of a user with pagination, newest first.
+	ListByUserID(ctx context.Context, userID int32, unreadOnly bool, opts ListOptions) ([]*models.Notification, int, error)
+
+	// CountUnread counts the unread notifications of a user.
+	CountUnread(ctx context.Context, userID int32) (int, error)
+
+	// MarkRead marks a notification of the user as read.
+	MarkRead(ctx context.Context, id, userID int32) (*models.Notification, error)
+
+	// MarkAllRead marks all unread notifications of a user as read and returns how many.
+	MarkAllRead(ctx context.Context, userID int32) (int, error)
+}
+
 // MarketDataRepository defines the interface for market data operations.
 type MarketDataRepository interface {
 	// GetBySymbolAndCurrency retrieves the latest market data for a symbol.
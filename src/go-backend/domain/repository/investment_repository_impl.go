@@ -149,24 +149,29 @@ func (r *investmentRepository) ListByWalletID(ctx context.Context, walletID int3
 	return investments, int(total), nil
 }
 
+// investmentUpdateColumns lists every updatable investment column except the Type enum.
+var investmentUpdateColumns = []string{
+	"symbol",
+	"name",
+	"quantity",
+	"average_cost",
+	"total_cost",
+	"currency",
+	"current_price",
+	"current_value",
+	"unrealized_pnl",
+	"unrealized_pnl_percent",
+	"realized_pnl",
+	"total_dividends",
+	"cost_basis_method",
+	"asset_class",
+}
+
 // Update updates an investment.
 func (r *investmentRepository) Update(ctx context.Context, investment *models.Investment) error {
 	// Use Select to specify which fields to update, avoiding enum serialization issues
 	// Include all updatable fields except the Type enum which causes serialization errors
-	result := r.db.DB.WithContext(ctx).Select(
-		"symbol",
-		"name",
-		"quantity",
-		"average_cost",
-		"total_cost",
-		"currency",
-		"current_price",
-		"current_value",
-		"unrealized_pnl",
-		"unrealized_pnl_percent",
-		"realized_pnl",
-		"total_dividends",
-	).Save(investment)
+	result := r.db.DB.WithContext(ctx).Select(investmentUpdateColumns).Save(investment)
 	if result.Error != nil {
 		return apperrors.NewInternalErrorWithCause("failed to update investment", result.Error)
 	}
@@ -2,11 +2,21 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"wealthjourney/domain/models"
 	investmentv1 "wealthjourney/protobuf/v1"
 )
 
+// SellTransactionFilter narrows the sells returned by ListSellsForUser.
+// Zero values mean no filter.
+type SellTransactionFilter struct {
+	From     time.Time // Inclusive
+	To       time.Time // Exclusive
+	WalletID int32
+	Type     investmentv1.InvestmentType
+}
+
 // InvestmentTransactionRepository defines the interface for investment transaction data operations.
 type InvestmentTransactionRepository interface {
 	// Create creates a new investment transaction.
@@ -16,6 +26,7 @@ type InvestmentTransactionRepository interface {
 	GetByID(ctx context.Context, id int32) (*models.InvestmentTransaction, error)
 
 	// GetByIDForUser retrieves an investment transaction by ID, ensuring it belongs to the user's investment.
+	// Sell lot allocations are preloaded.
 	GetByIDForUser(ctx context.Context, txID, userID int32) (*models.InvestmentTransaction, error)
 
 	// ListByInvestmentID retrieves all transactions for an investment with pagination.
@@ -47,4 +58,25 @@ type InvestmentTransactionRepository interface {
 
 	// DeleteLotsByInvestmentID soft deletes all lots for an investment.
 	DeleteLotsByInvestmentID(ctx context.Context, investmentID int32) error
+
+	// ApplySplit atomically records a split transaction with its adjustments and saves
+	// the rescaled lots and investment.
+	ApplySplit(ctx context.Context, tx *models.InvestmentTransaction, investment *models.Investment, lots []*models.InvestmentLot, adjustments []*models.InvestmentSplitAdjustment) error
+
+	// GetSplitAdjustments retrieves the values a split transaction overwrote.
+	GetSplitAdjustments(ctx context.Context, transactionID int32) ([]*models.InvestmentSplitAdjustment, error)
+
+	// RestoreSplit atomically saves the restored lots and investment and removes the split's adjustments.
+	RestoreSplit(ctx context.Context, transactionID int32, investment *models.Investment, lots []*models.InvestmentLot) error
+
+	// HasTransactionsAfter reports whether the investment has transactions of the given
+	// types recorded after the transaction with afterID.
+	HasTransactionsAfter(ctx context.Context, investmentID, afterID int32, types ...investmentv1.InvestmentTransactionType) (bool, error)
+
+	// ListByInvestmentIDs retrieves all transactions of the given investments ordered by transaction date.
+	ListByInvestmentIDs(ctx context.Context, investmentIDs []int32) ([]*models.InvestmentTransaction, error)
+
+	// ListSellsForUser retrieves the user's sell transactions ordered by transaction date,
+	// with their investment, lot and lot allocations (and each allocation's lot) preloaded.
+	ListSellsForUser(ctx context.Context, userID int32, filter SellTransactionFilter) ([]*models.InvestmentTransaction, error)
 }
@@ -7,6 +7,8 @@ import (
 	"wealthjourney/pkg/database"
 	apperrors "wealthjourney/pkg/errors"
 	investmentv1 "wealthjourney/protobuf/v1"
+
+	"gorm.io/gorm"
 )
 
 // investmentTransactionRepository implements InvestmentTransactionRepository using GORM.
@@ -44,6 +46,7 @@ func (r *investmentTransactionRepository) GetByID(ctx context.Context, id int32)
 func (r *investmentTransactionRepository) GetByIDForUser(ctx context.Context, txID, userID int32) (*models.InvestmentTransaction, error) {
 	var tx models.InvestmentTransaction
 	result := r.db.DB.WithContext(ctx).
+		Preload("Allocations").
 		Joins("JOIN investment ON investment_transaction.investment_id = investment.id").
 		Where("investment_transaction.id = ? AND investment.wallet_id IN (SELECT id FROM wallet WHERE user_id = ?)", txID, userID).
 		First(&tx)
@@ -177,3 +180,138 @@ func (r *investmentTransactionRepository) DeleteLotsByInvestmentID(ctx context.C
 	}
 	return nil
 }
+
+// ApplySplit atomically records a split transaction with its adjustments and saves
+// the rescaled lots and investment.
+func (r *investmentTransactionRepository) ApplySplit(ctx context.Context, tx *models.InvestmentTransaction, investment *models.Investment, lots []*models.InvestmentLot, adjustments []*models.InvestmentSplitAdjustment) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		if err := db.Create(tx).Error; err != nil {
+			return r.handleDBError(err, "investment transaction", "create split transaction")
+		}
+
+		for _, adjustment := range adjustments {
+			adjustment.TransactionID = tx.ID
+		}
+		if len(adjustments) > 0 {
+			if err := db.Create(&adjustments).Error; err != nil {
+				return apperrors.NewInternalErrorWithCause("failed to record split adjustments", err)
+			}
+		}
+
+		return r.saveSplitHoldings(db, investment, lots)
+	})
+}
+
+// GetSplitAdjustments retrieves the values a split transaction overwrote.
+func (r *investmentTransactionRepository) GetSplitAdjustments(ctx context.Context, transactionID int32) ([]*models.InvestmentSplitAdjustment, error) {
+	var adjustments []*models.InvestmentSplitAdjustment
+	result := r.db.DB.WithContext(ctx).
+		Where("transaction_id = ?", transactionID).
+		Order("id ASC").
+		Find(&adjustments)
+
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to get split adjustments", result.Error)
+	}
+	return adjustments, nil
+}
+
+// RestoreSplit atomically saves the restored lots and investment and removes the split's adjustments.
+func (r *investmentTransactionRepository) RestoreSplit(ctx context.Context, transactionID int32, investment *models.Investment, lots []*models.InvestmentLot) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		if err := r.saveSplitHoldings(db, investment, lots); err != nil {
+			return err
+		}
+
+		if err := db.Where("transaction_id = ?", transactionID).Delete(&models.InvestmentSplitAdjustment{}).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete split adjustments", err)
+		}
+		return nil
+	})
+}
+
+// saveSplitHoldings saves lots and the investment within db.
+func (r *investmentTransactionRepository) saveSplitHoldings(db *gorm.DB, investment *models.Investment, lots []*models.InvestmentLot) error {
+	for _, lot := range lots {
+		if err := db.Save(lot).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to update investment lot", err)
+		}
+	}
+
+	if err := db.Select(investmentUpdateColumns).Save(investment).Error; err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to update investment", err)
+	}
+	return nil
+}
+
+// HasTransactionsAfter reports whether the investment has transactions of the given
+// types recorded after the transaction with afterID.
+func (r *investmentTransactionRepository) HasTransactionsAfter(ctx context.Context, investmentID, afterID int32, types ...investmentv1.InvestmentTransactionType) (bool, error) {
+	query := r.db.DB.WithContext(ctx).
+		Model(&models.InvestmentTransaction{}).
+		Where("investment_id = ? AND id > ?", investmentID, afterID)
+
+	if len(types) > 0 {
+		typeValues := make([]int32, len(types))
+		for i, t := range types {
+			typeValues[i] = int32(t)
+		}
+		query = query.Where("type IN ?", typeValues)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, apperrors.NewInternalErrorWithCause("failed to check later investment transactions", err)
+	}
+	return count > 0, nil
+}
+
+// ListByInvestmentIDs retrieves all transactions of the given investments ordered by transaction date.
+func (r *investmentTransactionRepository) ListByInvestmentIDs(ctx context.Context, investmentIDs []int32) ([]*models.InvestmentTransaction, error) {
+	var transactions []*models.InvestmentTransaction
+	if len(investmentIDs) == 0 {
+		return transactions, nil
+	}
+
+	result := r.db.DB.WithContext(ctx).
+		Where("investment_id IN ?", investmentIDs).
+		Order("transaction_date ASC, id ASC").
+		Find(&transactions)
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list investment transactions", result.Error)
+	}
+	return transactions, nil
+}
+
+// ListSellsForUser retrieves the user's sell transactions ordered by transaction date.
+func (r *investmentTransactionRepository) ListSellsForUser(ctx context.Context, userID int32, filter SellTransactionFilter) ([]*models.InvestmentTransaction, error) {
+	query := r.db.DB.WithContext(ctx).
+		Preload("Investment").
+		Preload("Lot").
+		Preload("Allocations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("investment_sell_allocation.id ASC")
+		}).
+		Preload("Allocations.Lot").
+		Joins("JOIN investment ON investment_transaction.investment_id = investment.id").
+		Where("investment_transaction.type = ?", int32(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL)).
+		Where("investment.wallet_id IN (SELECT id FROM wallet WHERE user_id = ?)", userID)
+
+	if !filter.From.IsZero() {
+		query = query.Where("investment_transaction.transaction_date >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("investment_transaction.transaction_date < ?", filter.To)
+	}
+	if filter.WalletID > 0 {
+		query = query.Where("investment_transaction.wallet_id = ?", filter.WalletID)
+	}
+	if filter.Type != investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED {
+		query = query.Where("investment.type = ?", int32(filter.Type))
+	}
+
+	var transactions []*models.InvestmentTransaction
+	if err := query.Order("investment_transaction.transaction_date ASC, investment_transaction.id ASC").Find(&transactions).Error; err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list sell transactions", err)
+	}
+	return transactions, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// notificationRepository implements NotificationRepository using GORM.
+type notificationRepository struct {
+	*BaseRepository
+}
+
+// NewNotificationRepository creates a new NotificationRepository.
+func NewNotificationRepository(db *database.Database) NotificationRepository {
+	return &notificationRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create creates a new notification.
+func (r *notificationRepository) Create(ctx context.Context, notification *models.Notification) error {
+	return r.executeCreate(ctx, notification, "notification")
+}
+
+// ListByUserID retrieves the notifications of a user with pagination, newest first.
+func (r *notificationRepository) ListByUserID(ctx context.Context, userID int32, unreadOnly bool, opts ListOptions) ([]*models.Notification, int, error) {
+	var notifications []*models.Notification
+	var total int64
+
+	scope := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("user_id = ?", userID)
+		if unreadOnly {
+			db = db.Where("read_at IS NULL")
+		}
+		return db
+	}
+
+	if err := r.db.DB.WithContext(ctx).Model(&models.Notification{}).Scopes(scope).Count(&total).Error; err != nil {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to count notifications", err)
+	}
+
+	query := r.db.DB.WithContext(ctx).Model(&models.Notification{}).Scopes(scope).Order("created_at desc, id desc")
+	query = r.applyPagination(query, opts)
+	if err := query.Find(&notifications).Error; err != nil {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to list notifications", err)
+	}
+
+	return notifications, int(total), nil
+}
+
+// CountUnread counts the unread notifications of a user.
+func (r *notificationRepository) CountUnread(ctx context.Context, userID int32) (int, error) {
+	var count int64
+	result := r.db.DB.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count)
+	if result.Error != nil {
+		return 0, apperrors.NewInternalErrorWithCause("failed to count unread notifications", result.Error)
+	}
+	return int(count), nil
+}
+
+// MarkRead marks a notification of the user as read. Notifications already read keep
+// the time they were first read.
+func (r *notificationRepository) MarkRead(ctx context.Context, id, userID int32) (*models.Notification, error) {
+	var notification models.Notification
+	result := r.db.DB.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&notification)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "notification", "get notification")
+	}
+	if notification.ReadAt != nil {
+		return &notification, nil
+	}
+
+	now := time.Now()
+	if err := r.db.DB.WithContext(ctx).Model(&notification).Update("read_at", now).Error; err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to mark notification as read", err)
+	}
+	notification.ReadAt = &now
+	return &notification, nil
+}
+
+// MarkAllRead marks all unread notifications of a user as read and returns how many.
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID int32) (int, error) {
+	result := r.db.DB.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return 0, apperrors.NewInternalErrorWithCause("failed to mark notifications as read", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
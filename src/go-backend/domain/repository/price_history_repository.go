@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PriceHistoryRepository defines the interface for stored daily OHLC prices.
+type PriceHistoryRepository interface {
+	// ListBySymbol retrieves the bars of a symbol between from and to (inclusive), oldest first.
+	ListBySymbol(ctx context.Context, symbol, currency string, from, to time.Time) ([]*models.PriceHistory, error)
+
+	// GetLatest retrieves the most recent stored bar of a symbol.
+	GetLatest(ctx context.Context, symbol, currency string) (*models.PriceHistory, error)
+
+	// Upsert stores bars, replacing the bar already stored for the same symbol, currency and date.
+	Upsert(ctx context.Context, prices []*models.PriceHistory) error
+
+	// RecordQuote folds a live price into the bar of its day, creating the bar if needed.
+	RecordQuote(ctx context.Context, symbol, currency string, day time.Time, price int64) error
+}
+
+// priceHistoryRepository implements PriceHistoryRepository using GORM.
+type priceHistoryRepository struct {
+	*BaseRepository
+}
+
+// NewPriceHistoryRepository creates a new PriceHistoryRepository.
+func NewPriceHistoryRepository(db *database.Database) PriceHistoryRepository {
+	return &priceHistoryRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// ListBySymbol retrieves the bars of a symbol between from and to (inclusive), oldest first.
+func (r *priceHistoryRepository) ListBySymbol(ctx context.Context, symbol, currency string, from, to time.Time) ([]*models.PriceHistory, error) {
+	var prices []*models.PriceHistory
+	result := r.db.DB.WithContext(ctx).
+		Where("symbol = ? AND currency = ? AND price_date >= ? AND price_date <= ?", symbol, currency, from, to).
+		Order("price_date ASC").
+		Find(&prices)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "price history", "list price history")
+	}
+	return prices, nil
+}
+
+// GetLatest retrieves the most recent stored bar of a symbol.
+func (r *priceHistoryRepository) GetLatest(ctx context.Context, symbol, currency string) (*models.PriceHistory, error) {
+	var price models.PriceHistory
+	result := r.db.DB.WithContext(ctx).
+		Where("symbol = ? AND currency = ?", symbol, currency).
+		Order("price_date DESC").
+		First(&price)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "price history", "get latest price history")
+	}
+	return &price, nil
+}
+
+// Upsert stores bars, replacing the bar already stored for the same symbol, currency and date.
+func (r *priceHistoryRepository) Upsert(ctx context.Context, prices []*models.PriceHistory) error {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	result := r.db.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "symbol"}, {Name: "currency"}, {Name: "price_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"open", "high", "low", "close", "volume", "source", "updated_at"}),
+	}).CreateInBatches(prices, 500)
+	if result.Error != nil {
+		return r.handleDBError(result.Error, "price history", "store price history")
+	}
+	return nil
+}
+
+// RecordQuote folds a live price into the bar of its day, creating the bar if needed.
+func (r *priceHistoryRepository) RecordQuote(ctx context.Context, symbol, currency string, day time.Time, price int64) error {
+	err := r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		bar := models.PriceHistory{Symbol: symbol, Currency: currency, PriceDate: day, Source: models.PriceSourceQuote}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("symbol = ? AND currency = ? AND price_date = ?", symbol, currency, day).
+			Limit(1).
+			Find(&bar).Error; err != nil {
+			return err
+		}
+		bar.AddQuote(price)
+		return tx.Save(&bar).Error
+	})
+	if err != nil {
+		return r.handleDBError(err, "price history", "record price quote")
+	}
+	return nil
+}
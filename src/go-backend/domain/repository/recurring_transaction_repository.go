@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+
+	v1 "wealthjourney/protobuf/v1"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// recurringTransactionRepository implements RecurringTransactionRepository using GORM.
+type recurringTransactionRepository struct {
+	*BaseRepository
+}
+
+// NewRecurringTransactionRepository creates a new RecurringTransactionRepository.
+func NewRecurringTransactionRepository(db *database.Database) RecurringTransactionRepository {
+	return &recurringTransactionRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create creates a new recurring transaction.
+func (r *recurringTransactionRepository) Create(ctx context.Context, recurring *models.RecurringTransaction) error {
+	result := r.db.DB.WithContext(ctx).Create(recurring)
+	if result.Error != nil {
+		return r.handleDBError(result.Error, "recurring transaction", "create recurring transaction")
+	}
+	return nil
+}
+
+// GetByIDForUser retrieves a recurring transaction by ID, ensuring it belongs to the user.
+func (r *recurringTransactionRepository) GetByIDForUser(ctx context.Context, id, userID int32) (*models.RecurringTransaction, error) {
+	var recurring models.RecurringTransaction
+	result := r.db.DB.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&recurring)
+
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "recurring transaction", "get recurring transaction")
+	}
+	return &recurring, nil
+}
+
+// ListByUserID retrieves recurring transactions for a user with filtering and pagination.
+func (r *recurringTransactionRepository) ListByUserID(ctx context.Context, userID int32, filter RecurringTransactionFilter, opts ListOptions) ([]*models.RecurringTransaction, int, error) {
+	var recurring []*models.RecurringTransaction
+	var total int64
+
+	query := r.db.DB.WithContext(ctx).Model(&models.RecurringTransaction{}).Where("user_id = ?", userID)
+	if filter.WalletID != nil {
+		query = query.Where("wallet_id = ?", *filter.WalletID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to count recurring transactions", err)
+	}
+
+	query = r.applyPagination(query.Order(r.buildOrderClause(opts)), opts)
+	if err := query.Find(&recurring).Error; err != nil {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to list recurring transactions", err)
+	}
+
+	return recurring, int(total), nil
+}
+
+// UpdateLocked locks the recurring transaction row, applies fn and saves the result.
+func (r *recurringTransactionRepository) UpdateLocked(ctx context.Context, id, userID int32, fn func(recurring *models.RecurringTransaction) error) (*models.RecurringTransaction, error) {
+	var recurring models.RecurringTransaction
+	err := r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", id, userID).
+			First(&recurring).Error; err != nil {
+			return r.handleDBError(err, "recurring transaction", "get recurring transaction")
+		}
+
+		if err := fn(&recurring); err != nil {
+			return err
+		}
+
+		if err := tx.Save(&recurring).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to update recurring transaction", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &recurring, nil
+}
+
+// Delete soft deletes a recurring transaction by ID.
+func (r *recurringTransactionRepository) Delete(ctx context.Context, id int32) error {
+	return r.executeDelete(ctx, &models.RecurringTransaction{}, id, "recurring transaction")
+}
+
+// ListDueIDs returns IDs of active recurring transactions whose next occurrence is at or before now.
+func (r *recurringTransactionRepository) ListDueIDs(ctx context.Context, now time.Time, limit int) ([]int32, error) {
+	var ids []int32
+	query := r.db.DB.WithContext(ctx).
+		Model(&models.RecurringTransaction{}).
+		Where("status = ? AND next_occurrence IS NOT NULL AND next_occurrence <= ?",
+			int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE), now).
+		Order("next_occurrence asc")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Pluck("id", &ids).Error; err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list due recurring transactions", err)
+	}
+	return ids, nil
+}
+
+// ProcessNextOccurrence materializes the next occurrence if it is due.
+//
+// The schedule row is locked for the whole operation and every slot is recorded in
+// the occurrence ledger, so running the scheduler twice (or on two instances) for
+// the same slot never creates a duplicate transaction. A slot that already has a
+// ledger entry (e.g. it was skipped in advance) only advances the schedule.
+func (r *recurringTransactionRepository) ProcessNextOccurrence(ctx context.Context, id int32, now time.Time) (*models.RecurringTransactionOccurrence, error) {
+	var occurrence *models.RecurringTransactionOccurrence
+
+	err := r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var recurring models.RecurringTransaction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&recurring, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil // Deleted since it was listed
+			}
+			return apperrors.NewInternalErrorWithCause("failed to get recurring transaction", err)
+		}
+
+		if !recurring.IsDue(now) {
+			return nil
+		}
+		date := *recurring.NextOccurrence
+
+		var existing []models.RecurringTransactionOccurrence
+		if err := tx.Where("recurring_transaction_id = ? AND occurrence_date = ?", recurring.ID, date).
+			Limit(1).Find(&existing).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to check recurring occurrence", err)
+		}
+
+		if len(existing) > 0 {
+			occurrence = &existing[0]
+		} else {
+			created, err := r.generateOccurrence(tx, &recurring, date)
+			if err != nil {
+				return err
+			}
+			occurrence = created
+		}
+
+		recurring.Advance()
+		if err := tx.Save(&recurring).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to advance recurring transaction", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return occurrence, nil
+}
+
+// generateOccurrence creates the transaction for a slot, records it in the ledger
+// and applies the amount to the wallet balance within tx.
+func (r *recurringTransactionRepository) generateOccurrence(tx *gorm.DB, recurring *models.RecurringTransaction, date time.Time) (*models.RecurringTransactionOccurrence, error) {
+	// Lock the wallet row for update
+	var wallet models.Wallet
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&wallet, recurring.WalletID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NewNotFoundError("wallet")
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to get wallet", err)
+	}
+
+	newBalance := wallet.Balance + recurring.Amount
+	if newBalance < 0 {
+		return nil, apperrors.NewValidationError("Insufficient balance for this transaction")
+	}
+
+	transaction := &models.Transaction{
+		WalletID:   recurring.WalletID,
+		CategoryID: recurring.CategoryID,
+		Amount:     recurring.Amount,
+		Currency:   wallet.Currency,
+		Date:       date,
+		Note:       recurring.Note,
+	}
+	if err := tx.Create(transaction).Error; err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to create transaction", err)
+	}
+
+	occurrence := &models.RecurringTransactionOccurrence{
+		RecurringTransactionID: recurring.ID,
+		OccurrenceDate:         date,
+		TransactionID:          &transaction.ID,
+		Status:                 models.RecurringOccurrenceGenerated,
+	}
+	if err := tx.Create(occurrence).Error; err != nil {
+		return nil, r.handleDBError(err, "recurring occurrence", "record recurring occurrence")
+	}
+
+	if err := tx.Model(&wallet).Update("balance", newBalance).Error; err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to update wallet balance", err)
+	}
+
+	return occurrence, nil
+}
+
+// SkipOccurrence records a slot as skipped so it is never generated.
+func (r *recurringTransactionRepository) SkipOccurrence(ctx context.Context, id, userID int32, date time.Time) (*models.RecurringTransaction, error) {
+	var recurring models.RecurringTransaction
+	err := r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", id, userID).
+			First(&recurring).Error; err != nil {
+			return r.handleDBError(err, "recurring transaction", "get recurring transaction")
+		}
+
+		occurrence := &models.RecurringTransactionOccurrence{
+			RecurringTransactionID: recurring.ID,
+			OccurrenceDate:         date,
+			Status:                 models.RecurringOccurrenceSkipped,
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(occurrence).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to record skipped occurrence", err)
+		}
+
+		if recurring.NextOccurrence != nil && recurring.NextOccurrence.Equal(date) {
+			recurring.Advance()
+			if err := tx.Save(&recurring).Error; err != nil {
+				return apperrors.NewInternalErrorWithCause("failed to advance recurring transaction", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &recurring, nil
+}
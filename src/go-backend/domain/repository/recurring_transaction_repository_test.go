@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recurringTransactionRows(next time.Time, scheduleIndex int32) *sqlmock.Rows {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return sqlmock.NewRows([]string{"id", "user_id", "wallet_id", "amount", "currency", "frequency", "interval",
+		"start_date", "schedule_index", "occurrence_count", "next_occurrence", "status"}).
+		AddRow(1, 7, 3, -50000, "VND", int32(v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY), 1,
+			start, scheduleIndex, scheduleIndex, next, int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE))
+}
+
+func TestRecurringTransactionRepository_ProcessNextOccurrence_ConcurrentRuns(t *testing.T) {
+	db, mock, database := setupMockDB(t)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	// The schedule row lock makes the second run wait for the first to commit; a single
+	// connection serializes the two transactions the same way.
+	sqlDB.SetMaxOpenConns(1)
+
+	due := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	now := due.Add(time.Hour)
+
+	// The first run creates the transaction and advances the schedule
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `recurring_transaction`")).
+		WillReturnRows(recurringTransactionRows(due, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `recurring_transaction_occurrence`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `wallet`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "balance", "currency"}).AddRow(3, 7, 100000, "VND"))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `transaction`")).
+		WillReturnResult(sqlmock.NewResult(20, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `recurring_transaction_occurrence`")).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `wallet` SET `balance`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `recurring_transaction`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// The second run sees the advanced schedule once the lock is released
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `recurring_transaction`")).
+		WillReturnRows(recurringTransactionRows(due.AddDate(0, 1, 0), 2))
+	mock.ExpectCommit()
+
+	repo := NewRecurringTransactionRepository(database)
+	occurrences := make([]*models.RecurringTransactionOccurrence, 2)
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range occurrences {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			occurrences[i], errs[i] = repo.ProcessNextOccurrence(context.Background(), 1, now)
+		}(i)
+	}
+	wg.Wait()
+
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	var generated []*models.RecurringTransactionOccurrence
+	for _, occurrence := range occurrences {
+		if occurrence != nil {
+			generated = append(generated, occurrence)
+		}
+	}
+	require.Len(t, generated, 1, "exactly one run creates the occurrence")
+	require.NotNil(t, generated[0].TransactionID)
+	assert.Equal(t, int32(20), *generated[0].TransactionID)
+	assert.True(t, generated[0].OccurrenceDate.Equal(due))
+	assert.NoError(t, mock.ExpectationsWereMet(), "a single transaction is inserted")
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// tagRepository implements TagRepository using GORM.
+type tagRepository struct {
+	*BaseRepository
+}
+
+// NewTagRepository creates a new TagRepository.
+func NewTagRepository(db *database.Database) TagRepository {
+	return &tagRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create creates a new tag.
+func (r *tagRepository) Create(ctx context.Context, tag *models.Tag) error {
+	return r.executeCreate(ctx, tag, "tag")
+}
+
+// GetByIDForUser retrieves a tag by ID, ensuring it belongs to the user.
+func (r *tagRepository) GetByIDForUser(ctx context.Context, tagID, userID int32) (*models.Tag, error) {
+	var tag models.Tag
+	result := r.db.DB.WithContext(ctx).Where("id = ? AND user_id = ?", tagID, userID).First(&tag)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "tag", "get tag")
+	}
+	return &tag, nil
+}
+
+// GetByIDsForUser retrieves the user's tags with the given IDs.
+func (r *tagRepository) GetByIDsForUser(ctx context.Context, userID int32, ids []int32) ([]*models.Tag, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var tags []*models.Tag
+	result := r.db.DB.WithContext(ctx).
+		Where("id IN ? AND user_id = ?", ids, userID).
+		Order("name asc").
+		Find(&tags)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "tag", "get tags by ids")
+	}
+	return tags, nil
+}
+
+// GetByName retrieves a user's tag by name, ignoring case.
+func (r *tagRepository) GetByName(ctx context.Context, userID int32, name string) (*models.Tag, error) {
+	var tag models.Tag
+	result := r.db.DB.WithContext(ctx).
+		Where("user_id = ? AND LOWER(name) = LOWER(?)", userID, name).
+		First(&tag)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "tag", "get tag by name")
+	}
+	return &tag, nil
+}
+
+// GetOrCreateByName retrieves a user's tag by name, ignoring case, creating it if it doesn't exist.
+func (r *tagRepository) GetOrCreateByName(ctx context.Context, userID int32, name string) (*models.Tag, error) {
+	tag, err := r.GetByName(ctx, userID, name)
+	if err == nil {
+		return tag, nil
+	}
+	var notFound apperrors.NotFoundError
+	if !errors.As(err, &notFound) {
+		return nil, err
+	}
+
+	newTag := &models.Tag{
+		UserID: userID,
+		Name:   name,
+	}
+	if err := r.Create(ctx, newTag); err != nil {
+		return nil, err
+	}
+	return newTag, nil
+}
+
+// Update updates a tag.
+func (r *tagRepository) Update(ctx context.Context, tag *models.Tag) error {
+	result := r.db.DB.WithContext(ctx).Save(tag)
+	if result.Error != nil {
+		return r.handleDBError(result.Error, "tag", "update tag")
+	}
+	return nil
+}
+
+// Delete deletes a tag by ID and removes it from all transactions.
+func (r *tagRepository) Delete(ctx context.Context, id int32) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&models.TransactionTag{}).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to remove tag from transactions", err)
+		}
+
+		result := tx.Delete(&models.Tag{}, id)
+		if result.Error != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete tag", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.NewNotFoundError("tag")
+		}
+		return nil
+	})
+}
+
+// ListByUserID retrieves all tags for a user ordered by name.
+func (r *tagRepository) ListByUserID(ctx context.Context, userID int32, opts ListOptions) ([]*models.Tag, int, error) {
+	var tags []*models.Tag
+	var total int64
+
+	query := r.db.DB.WithContext(ctx).Model(&models.Tag{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to count tags", err)
+	}
+
+	query = r.applyPagination(query.Order("name asc"), opts)
+	if err := query.Find(&tags).Error; err != nil {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to list tags", err)
+	}
+
+	return tags, int(total), nil
+}
@@ -39,18 +39,56 @@ func (r *transactionRepository) Create(ctx context.Context, tx *models.Transacti
 // GetByID retrieves a transaction by ID.
 func (r *transactionRepository) GetByID(ctx context.Context, id int32) (*models.Transaction, error) {
 	var transaction models.Transaction
-	err := r.executeGetByID(ctx, &transaction, id, "transaction")
-	if err != nil {
-		return nil, err
+	result := r.db.DB.WithContext(ctx).
+		Preload("Splits", orderSplits).
+		Preload("Tags", orderTags).
+		First(&transaction, id)
+
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "transaction", "get transaction")
 	}
 	return &transaction, nil
 }
 
+// orderSplits keeps split lines in creation order when preloading.
+func orderSplits(db *gorm.DB) *gorm.DB {
+	return db.Order("transaction_split.id asc")
+}
+
+// orderTags lists tags by name when preloading.
+func orderTags(db *gorm.DB) *gorm.DB {
+	return db.Order("tag.name asc")
+}
+
+// applyTagFilter restricts a query on transaction (aliased as table) to the ones
+// carrying any, or with TagMatchAll every, tag of the filter.
+func applyTagFilter(query *gorm.DB, table string, filter TransactionFilter) *gorm.DB {
+	if len(filter.TagIDs) == 0 {
+		return query
+	}
+
+	if !filter.TagMatchAll {
+		return query.Where(
+			"EXISTS (SELECT 1 FROM transaction_tag tt WHERE tt.transaction_id = "+table+".id AND tt.tag_id IN ?)",
+			filter.TagIDs)
+	}
+
+	distinct := make(map[int32]bool, len(filter.TagIDs))
+	for _, id := range filter.TagIDs {
+		distinct[id] = true
+	}
+	return query.Where(
+		"(SELECT COUNT(*) FROM transaction_tag tt WHERE tt.transaction_id = "+table+".id AND tt.tag_id IN ?) = ?",
+		filter.TagIDs, len(distinct))
+}
+
 // GetByIDForUser retrieves a transaction by ID, ensuring it belongs to the user's wallet.
 func (r *transactionRepository) GetByIDForUser(ctx context.Context, txID, userID int32) (*models.Transaction, error) {
 	var transaction models.Transaction
 	result := r.db.DB.WithContext(ctx).
 		Joins("JOIN wallet ON wallet.id = transaction.wallet_id").
+		Preload("Splits", orderSplits).
+		Preload("Tags", orderTags).
 		Where("transaction.id = ? AND wallet.user_id = ?", txID, userID).
 		First(&transaction)
 
@@ -124,7 +162,10 @@ func (r *transactionRepository) List(ctx context.Context, userID int32, filter T
 	}
 
 	if filter.CategoryID != nil {
-		query = query.Where("transaction.category_id = ?", *filter.CategoryID)
+		// Match split transactions through any of their split lines
+		query = query.Where(
+			"(transaction.category_id = ? OR EXISTS (SELECT 1 FROM transaction_split ts WHERE ts.transaction_id = transaction.id AND ts.category_id = ? AND ts.deleted_at IS NULL))",
+			*filter.CategoryID, *filter.CategoryID)
 	}
 
 	if filter.Type != nil {
@@ -165,6 +206,16 @@ func (r *transactionRepository) List(ctx context.Context, userID int32, filter T
 		query = query.Where("transaction.note LIKE ?", "%"+*filter.SearchNote+"%")
 	}
 
+	if filter.Status != nil && *filter.Status != v1.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED {
+		query = query.Where("transaction.status = ?", int32(*filter.Status))
+	}
+
+	if filter.ExcludeTransfers {
+		query = query.Where("transaction.transfer_id IS NULL")
+	}
+
+	query = applyTagFilter(query, "transaction", filter)
+
 	// Get total count
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, apperrors.NewInternalErrorWithCause("failed to count transactions", err)
@@ -181,6 +232,8 @@ func (r *transactionRepository) List(ctx context.Context, userID int32, filter T
 	result := query.
 		Preload("Wallet").
 		Preload("Category").
+		Preload("Splits", orderSplits).
+		Preload("Tags", orderTags).
 		Find(&transactions)
 
 	if result.Error != nil {
@@ -287,19 +340,21 @@ func (r *transactionRepository) TransferToWallet(ctx context.Context, fromWallet
 	return nil
 }
 
-// GetCategoryBreakdown retrieves category-wise transaction summary grouped by currency
+// GetCategoryBreakdown retrieves category-wise transaction summary grouped by currency.
+// Split transactions contribute one line per split, attributed to the split's category.
 func (r *transactionRepository) GetCategoryBreakdown(ctx context.Context, userID int32, filter TransactionFilter) ([]*CategoryBreakdownByCurrency, error) {
 	query := r.db.DB.WithContext(ctx).Table("transaction t").
 		Select(
-			"t.category_id as category_id",
+			"c.id as category_id",
 			"c.name as category_name",
 			"c.type as type",
 			"w.currency as currency",
-			"COALESCE(SUM(CASE WHEN t.amount > 0 THEN t.amount ELSE 0 END), 0) as income",
-			"COALESCE(SUM(CASE WHEN t.amount < 0 THEN ABS(t.amount) ELSE 0 END), 0) as total",
-			"COUNT(t.id) as transaction_count",
+			"COALESCE(SUM(CASE WHEN COALESCE(s.amount, t.amount) > 0 THEN COALESCE(s.amount, t.amount) ELSE 0 END), 0) as income",
+			"COALESCE(SUM(CASE WHEN COALESCE(s.amount, t.amount) < 0 THEN ABS(COALESCE(s.amount, t.amount)) ELSE 0 END), 0) as total",
+			"COUNT(DISTINCT t.id) as transaction_count",
 		).
-		Joins("JOIN category c ON t.category_id = c.id").
+		Joins("LEFT JOIN transaction_split s ON s.transaction_id = t.id AND s.deleted_at IS NULL").
+		Joins("JOIN category c ON c.id = COALESCE(s.category_id, t.category_id)").
 		Joins("JOIN wallet w ON w.id = t.wallet_id").
 		Where("w.user_id = ? AND w.status = 1 AND t.deleted_at IS NULL", userID)
 
@@ -317,6 +372,9 @@ func (r *transactionRepository) GetCategoryBreakdown(ctx context.Context, userID
 	if filter.EndDate != nil {
 		query = query.Where("t.date <= ?", *filter.EndDate)
 	}
+	if filter.ExcludeTransfers {
+		query = query.Where("t.transfer_id IS NULL")
+	}
 
 	// Filter by category type if specified
 	if filter.Type != nil {
@@ -335,7 +393,7 @@ func (r *transactionRepository) GetCategoryBreakdown(ctx context.Context, userID
 	}
 
 	// Group by category and currency to aggregate per-currency amounts
-	query = query.Group("t.category_id, c.name, c.type, w.currency")
+	query = query.Group("c.id, c.name, c.type, w.currency")
 
 	rows, err := query.Rows()
 	if err != nil {
@@ -407,6 +465,137 @@ func (r *transactionRepository) GetCategoryBreakdown(ctx context.Context, userID
 	return results, nil
 }
 
+// GetTagBreakdown retrieves tag-wise transaction summary grouped by currency.
+// A transaction with several tags counts towards each of them.
+func (r *transactionRepository) GetTagBreakdown(ctx context.Context, userID int32, filter TransactionFilter) ([]*TagBreakdownByCurrency, error) {
+	query := r.db.DB.WithContext(ctx).Table("transaction t").
+		Select(
+			"g.id as tag_id",
+			"g.name as tag_name",
+			"w.currency as currency",
+			"COALESCE(SUM(CASE WHEN t.amount > 0 THEN t.amount ELSE 0 END), 0) as income",
+			"COALESCE(SUM(CASE WHEN t.amount < 0 THEN ABS(t.amount) ELSE 0 END), 0) as expense",
+			"COUNT(DISTINCT t.id) as transaction_count",
+		).
+		Joins("JOIN transaction_tag tt ON tt.transaction_id = t.id").
+		Joins("JOIN tag g ON g.id = tt.tag_id").
+		Joins("JOIN wallet w ON w.id = t.wallet_id").
+		Where("w.user_id = ? AND w.status = 1 AND t.deleted_at IS NULL", userID)
+
+	if len(filter.WalletIDs) > 0 {
+		query = query.Where("t.wallet_id IN ?", filter.WalletIDs)
+	} else if filter.WalletID != nil {
+		query = query.Where("t.wallet_id = ?", *filter.WalletID)
+	}
+	if filter.StartDate != nil {
+		query = query.Where("t.date >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		query = query.Where("t.date <= ?", *filter.EndDate)
+	}
+	if len(filter.TagIDs) > 0 {
+		query = query.Where("g.id IN ?", filter.TagIDs)
+	}
+	if filter.ExcludeTransfers {
+		query = query.Where("t.transfer_id IS NULL")
+	}
+
+	query = query.Group("g.id, g.name, w.currency")
+
+	rows, err := query.Rows()
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to get tag breakdown", err)
+	}
+	defer rows.Close()
+
+	tagMap := make(map[int32]*TagBreakdownByCurrency)
+	var results []*TagBreakdownByCurrency
+	for rows.Next() {
+		var (
+			tagID            int32
+			tagName          string
+			currency         string
+			income           int64
+			expense          int64
+			transactionCount int32
+		)
+		if err := rows.Scan(&tagID, &tagName, &currency, &income, &expense, &transactionCount); err != nil {
+			return nil, apperrors.NewInternalErrorWithCause("failed to scan tag breakdown", err)
+		}
+
+		item, ok := tagMap[tagID]
+		if !ok {
+			item = &TagBreakdownByCurrency{
+				TagID:             tagID,
+				TagName:           tagName,
+				IncomeByCurrency:  make(map[string]int64),
+				ExpenseByCurrency: make(map[string]int64),
+			}
+			tagMap[tagID] = item
+			results = append(results, item)
+		}
+		item.IncomeByCurrency[currency] += income
+		item.ExpenseByCurrency[currency] += expense
+		item.TransactionCount += transactionCount
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("error iterating tag breakdown", err)
+	}
+
+	return results, nil
+}
+
+// ReplaceSplits atomically replaces the split lines of a transaction.
+// Passing no splits removes the split and reverts to the parent category.
+func (r *transactionRepository) ReplaceSplits(ctx context.Context, transactionID int32, splits []models.TransactionSplit) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("transaction_id = ?", transactionID).Delete(&models.TransactionSplit{}).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete transaction splits", err)
+		}
+
+		if len(splits) == 0 {
+			return nil
+		}
+
+		for i := range splits {
+			splits[i].ID = 0
+			splits[i].TransactionID = transactionID
+		}
+		if err := tx.Create(&splits).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to create transaction splits", err)
+		}
+		return nil
+	})
+}
+
+// ReplaceTags atomically replaces the tags of a transaction.
+func (r *transactionRepository) ReplaceTags(ctx context.Context, transactionID int32, tagIDs []int32) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("transaction_id = ?", transactionID).Delete(&models.TransactionTag{}).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete transaction tags", err)
+		}
+
+		if len(tagIDs) == 0 {
+			return nil
+		}
+
+		rows := make([]models.TransactionTag, 0, len(tagIDs))
+		seen := make(map[int32]bool, len(tagIDs))
+		for _, tagID := range tagIDs {
+			if seen[tagID] {
+				continue
+			}
+			seen[tagID] = true
+			rows = append(rows, models.TransactionTag{TransactionID: transactionID, TagID: tagID})
+		}
+		if err := tx.Create(&rows).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to create transaction tags", err)
+		}
+		return nil
+	})
+}
+
 // BulkCreate creates multiple transactions atomically with wallet balance updates
 func (r *transactionRepository) BulkCreate(ctx context.Context, transactions []*models.Transaction) ([]int32, error) {
 	// Start database transaction
@@ -515,3 +704,58 @@ func (r *transactionRepository) FindByExternalID(ctx context.Context, walletID i
 
 	return &transaction, nil
 }
+
+// ListUnreconciled retrieves the transactions of a wallet dated up to through that are
+// not reconciled yet, oldest first.
+func (r *transactionRepository) ListUnreconciled(ctx context.Context, walletID int32, through time.Time) ([]*models.Transaction, error) {
+	var transactions []*models.Transaction
+
+	result := r.db.DB.WithContext(ctx).
+		Where("wallet_id = ? AND date <= ? AND status <> ? AND deleted_at IS NULL",
+			walletID, through, int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)).
+		Order("date asc, id asc").
+		Find(&transactions)
+
+	if result.Error != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to list unreconciled transactions", result.Error)
+	}
+
+	return transactions, nil
+}
+
+// GetReconciledSum returns the sum of the reconciled transaction amounts for a wallet.
+func (r *transactionRepository) GetReconciledSum(ctx context.Context, walletID int32) (int64, error) {
+	var sum int64
+	result := r.db.DB.WithContext(ctx).
+		Model(&models.Transaction{}).
+		Where("wallet_id = ? AND status = ? AND deleted_at IS NULL",
+			walletID, int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&sum)
+
+	if result.Error != nil {
+		return 0, apperrors.NewInternalErrorWithCause("failed to sum reconciled transactions", result.Error)
+	}
+
+	return sum, nil
+}
+
+// UpdateStatus sets the status of the wallet's transactions with the given IDs, leaving
+// reconciled transactions unchanged.
+func (r *transactionRepository) UpdateStatus(ctx context.Context, walletID int32, transactionIDs []int32, status v1.TransactionStatus) (int, error) {
+	if len(transactionIDs) == 0 {
+		return 0, nil
+	}
+
+	result := r.db.DB.WithContext(ctx).
+		Model(&models.Transaction{}).
+		Where("wallet_id = ? AND id IN ? AND status <> ?",
+			walletID, transactionIDs, int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)).
+		Update("status", int32(status))
+
+	if result.Error != nil {
+		return 0, apperrors.NewInternalErrorWithCause("failed to update transaction status", result.Error)
+	}
+
+	return int(result.RowsAffected), nil
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// transferRepository implements TransferRepository using GORM.
+type transferRepository struct {
+	*BaseRepository
+}
+
+// NewTransferRepository creates a new TransferRepository.
+func NewTransferRepository(db *database.Database) TransferRepository {
+	return &transferRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create creates the transfer, its legs and its fee transaction (if not nil) and applies
+// them to the wallet balances.
+func (r *transferRepository) Create(ctx context.Context, transfer *models.Transfer, from, to, fee *models.Transaction) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The outgoing leg goes first so an insufficient balance fails before anything is credited
+		for _, leg := range []*models.Transaction{from, to} {
+			leg.TransferID = &transfer.ID
+			if err := tx.Create(leg).Error; err != nil {
+				return r.handleDBError(err, "transaction", "create transfer leg")
+			}
+			if err := applyBalanceDelta(tx, leg.WalletID, leg.Amount); err != nil {
+				return err
+			}
+		}
+
+		// The fee stays a plain expense so reports that leave out transfers count it
+		if fee != nil {
+			if err := tx.Create(fee).Error; err != nil {
+				return r.handleDBError(err, "transaction", "create transfer fee")
+			}
+			if err := applyBalanceDelta(tx, fee.WalletID, fee.Amount); err != nil {
+				return err
+			}
+			transfer.FeeTransactionID = &fee.ID
+		}
+
+		transfer.FromTransactionID = from.ID
+		transfer.ToTransactionID = to.ID
+		if err := tx.Create(transfer).Error; err != nil {
+			return r.handleDBError(err, "transfer", "create transfer")
+		}
+		return nil
+	})
+}
+
+// GetByIDForUser retrieves a transfer by ID, ensuring it belongs to the user.
+func (r *transferRepository) GetByIDForUser(ctx context.Context, id string, userID int32) (*models.Transfer, error) {
+	var transfer models.Transfer
+	result := r.db.DB.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&transfer)
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "transfer", "get transfer")
+	}
+	return &transfer, nil
+}
+
+// Update saves the transfer and the amount, date, note and status of its legs, moving
+// each wallet balance by the change of its leg's amount.
+func (r *transferRepository) Update(ctx context.Context, transfer *models.Transfer, from, to *models.Transaction) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, leg := range []*models.Transaction{from, to} {
+			var stored models.Transaction
+			if err := tx.Select("id", "wallet_id", "amount").First(&stored, leg.ID).Error; err != nil {
+				return r.handleDBError(err, "transaction", "get transfer leg")
+			}
+			if err := applyBalanceDelta(tx, stored.WalletID, leg.Amount-stored.Amount); err != nil {
+				return err
+			}
+
+			result := tx.Model(&models.Transaction{ID: leg.ID}).
+				Select("amount", "date", "note", "status").
+				Updates(leg)
+			if result.Error != nil {
+				return apperrors.NewInternalErrorWithCause("failed to update transfer leg", result.Error)
+			}
+		}
+
+		if err := tx.Save(transfer).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to update transfer", err)
+		}
+		return nil
+	})
+}
+
+// Delete deletes the transfer, its legs and its fee transaction and reverts them from the
+// wallet balances. A fee transaction deleted on its own is skipped.
+func (r *transferRepository) Delete(ctx context.Context, transfer *models.Transfer) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var legs []*models.Transaction
+		if err := tx.Where("transfer_id = ?", transfer.ID).Find(&legs).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to get transfer legs", err)
+		}
+		if transfer.FeeTransactionID != nil {
+			var fees []*models.Transaction
+			if err := tx.Where("id = ?", *transfer.FeeTransactionID).Find(&fees).Error; err != nil {
+				return apperrors.NewInternalErrorWithCause("failed to get transfer fee", err)
+			}
+			legs = append(legs, fees...)
+		}
+
+		for _, leg := range legs {
+			if err := applyBalanceDelta(tx, leg.WalletID, -leg.Amount); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("transfer_id = ?", transfer.ID).Delete(&models.Transaction{}).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete transfer legs", err)
+		}
+		if transfer.FeeTransactionID != nil {
+			if err := tx.Delete(&models.Transaction{}, *transfer.FeeTransactionID).Error; err != nil {
+				return apperrors.NewInternalErrorWithCause("failed to delete transfer fee", err)
+			}
+		}
+		if err := tx.Delete(transfer).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete transfer", err)
+		}
+		return nil
+	})
+}
+
+// Link creates a transfer from two existing transactions of opposite sign, which keep
+// their amounts, so no balance moves. Fails with a conflict if either already is a leg.
+func (r *transferRepository) Link(ctx context.Context, transfer *models.Transfer, from, to *models.Transaction) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, leg := range []*models.Transaction{from, to} {
+			result := tx.Model(&models.Transaction{}).
+				Where("id = ? AND transfer_id IS NULL", leg.ID).
+				Update("transfer_id", transfer.ID)
+			if result.Error != nil {
+				return apperrors.NewInternalErrorWithCause("failed to link transfer leg", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return apperrors.NewConflictError("transaction is already part of a transfer")
+			}
+		}
+
+		transfer.FromTransactionID = from.ID
+		transfer.ToTransactionID = to.ID
+		if err := tx.Create(transfer).Error; err != nil {
+			return r.handleDBError(err, "transfer", "create transfer")
+		}
+
+		from.TransferID = &transfer.ID
+		to.TransferID = &transfer.ID
+		return nil
+	})
+}
+
+// UnlinkWithTx deletes transfers within a database transaction, leaving their legs as
+// plain transactions.
+func (r *transferRepository) UnlinkWithTx(ctx context.Context, dbTx interface{}, transferIDs []string) error {
+	if len(transferIDs) == 0 {
+		return nil
+	}
+	tx, ok := dbTx.(*gorm.DB)
+	if !ok {
+		return apperrors.NewInternalErrorWithCause("invalid transaction type", nil)
+	}
+
+	// Unscoped so legs deleted in the same transaction let go of the transfer too
+	if err := tx.WithContext(ctx).Unscoped().
+		Model(&models.Transaction{}).
+		Where("transfer_id IN ?", transferIDs).
+		Update("transfer_id", nil).Error; err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to unlink transfer legs", err)
+	}
+	if err := tx.WithContext(ctx).Where("id IN ?", transferIDs).Delete(&models.Transfer{}).Error; err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to delete transfers", err)
+	}
+	return nil
+}
+
+// applyBalanceDelta moves a wallet balance by delta within tx, locking the wallet row.
+// Fails when the balance would become negative.
+func applyBalanceDelta(tx *gorm.DB, walletID int32, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+
+	var wallet models.Wallet
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&wallet, walletID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewNotFoundError("wallet")
+		}
+		return apperrors.NewInternalErrorWithCause("failed to get wallet", err)
+	}
+
+	if wallet.Balance+delta < 0 {
+		return apperrors.NewValidationError("Insufficient balance")
+	}
+
+	if err := tx.Model(&wallet).Update("balance", wallet.Balance+delta).Error; err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to update wallet balance", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/database"
+	apperrors "wealthjourney/pkg/errors"
+
+	v1 "wealthjourney/protobuf/v1"
+
+	"gorm.io/gorm"
+)
+
+// walletReconciliationRepository implements WalletReconciliationRepository using GORM.
+type walletReconciliationRepository struct {
+	*BaseRepository
+}
+
+// NewWalletReconciliationRepository creates a new WalletReconciliationRepository.
+func NewWalletReconciliationRepository(db *database.Database) WalletReconciliationRepository {
+	return &walletReconciliationRepository{
+		BaseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create creates a new reconciliation.
+func (r *walletReconciliationRepository) Create(ctx context.Context, reconciliation *models.WalletReconciliation) error {
+	return r.executeCreate(ctx, reconciliation, "reconciliation")
+}
+
+// GetByIDForUser retrieves a reconciliation by ID, ensuring it belongs to the user.
+func (r *walletReconciliationRepository) GetByIDForUser(ctx context.Context, id, userID int32) (*models.WalletReconciliation, error) {
+	var reconciliation models.WalletReconciliation
+	result := r.db.DB.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&reconciliation)
+
+	if result.Error != nil {
+		return nil, r.handleDBError(result.Error, "reconciliation", "get reconciliation")
+	}
+	return &reconciliation, nil
+}
+
+// GetInProgress retrieves the open reconciliation of a wallet, or nil when there is none.
+func (r *walletReconciliationRepository) GetInProgress(ctx context.Context, walletID int32) (*models.WalletReconciliation, error) {
+	var reconciliation models.WalletReconciliation
+	result := r.db.DB.WithContext(ctx).
+		Where("wallet_id = ? AND status = ?", walletID, int32(v1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_IN_PROGRESS)).
+		Order("created_at desc").
+		First(&reconciliation)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to get open reconciliation", result.Error)
+	}
+	return &reconciliation, nil
+}
+
+// ListByWalletID retrieves the reconciliations of a wallet, latest statement first.
+func (r *walletReconciliationRepository) ListByWalletID(ctx context.Context, walletID int32, opts ListOptions) ([]*models.WalletReconciliation, int, error) {
+	var reconciliations []*models.WalletReconciliation
+	var total int64
+
+	query := r.db.DB.WithContext(ctx).Model(&models.WalletReconciliation{}).Where("wallet_id = ?", walletID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to count reconciliations", err)
+	}
+
+	query = r.applyPagination(query.Order("statement_date desc, id desc"), opts)
+	if err := query.Find(&reconciliations).Error; err != nil {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to list reconciliations", err)
+	}
+
+	return reconciliations, int(total), nil
+}
+
+// Update updates a reconciliation.
+func (r *walletReconciliationRepository) Update(ctx context.Context, reconciliation *models.WalletReconciliation) error {
+	return r.executeUpdate(ctx, reconciliation, "reconciliation")
+}
+
+// Complete marks the given cleared transactions reconciled and saves the completed
+// reconciliation in one database transaction.
+func (r *walletReconciliationRepository) Complete(ctx context.Context, reconciliation *models.WalletReconciliation, transactionIDs []int32) error {
+	return r.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(transactionIDs) > 0 {
+			result := tx.Model(&models.Transaction{}).
+				Where("wallet_id = ? AND id IN ? AND status = ? AND deleted_at IS NULL",
+					reconciliation.WalletID, transactionIDs, int32(v1.TransactionStatus_TRANSACTION_STATUS_CLEARED)).
+				Updates(map[string]interface{}{
+					"status":            int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED),
+					"reconciliation_id": reconciliation.ID,
+					"updated_at":        time.Now(),
+				})
+			if result.Error != nil {
+				return apperrors.NewInternalErrorWithCause("failed to reconcile transactions", result.Error)
+			}
+			// A transaction edited or unticked since the difference was computed
+			if int(result.RowsAffected) != len(transactionIDs) {
+				return apperrors.NewConflictError("transactions changed during reconciliation; reload and try again")
+			}
+		}
+
+		if err := tx.Save(reconciliation).Error; err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to complete reconciliation", err)
+		}
+		return nil
+	})
+}
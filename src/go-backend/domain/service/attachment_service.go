@@ -0,0 +1,293 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/fileupload"
+	"wealthjourney/pkg/storage"
+
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// attachmentService implements AttachmentService.
+type attachmentService struct {
+	attachmentRepo   repository.AttachmentRepository
+	txRepo           repository.TransactionRepository
+	investmentTxRepo repository.InvestmentTransactionRepository
+	storage          storage.StorageProvider
+}
+
+// NewAttachmentService creates a new AttachmentService storing files in the given provider.
+func NewAttachmentService(
+	attachmentRepo repository.AttachmentRepository,
+	txRepo repository.TransactionRepository,
+	investmentTxRepo repository.InvestmentTransactionRepository,
+	storageProvider storage.StorageProvider,
+) AttachmentService {
+	return &attachmentService{
+		attachmentRepo:   attachmentRepo,
+		txRepo:           txRepo,
+		investmentTxRepo: investmentTxRepo,
+		storage:          storageProvider,
+	}
+}
+
+// SetAttachmentCleaner makes the transaction and investment services delete the attachments
+// of the transactions they delete. Without it, attachments outlive their transactions.
+func SetAttachmentCleaner(services *Services, cleaner AttachmentCleaner) {
+	if ts, ok := services.Transaction.(*transactionService); ok {
+		ts.attachmentCleaner = cleaner
+	}
+	if is, ok := services.Investment.(*investmentService); ok {
+		is.attachmentCleaner = cleaner
+	}
+}
+
+// UploadTransactionAttachment stores a file and attaches it to a transaction of the user.
+func (s *attachmentService) UploadTransactionAttachment(ctx context.Context, transactionID int32, userID int32, req *v1.UploadTransactionAttachmentRequest) (*v1.UploadAttachmentResponse, error) {
+	// Verify ownership
+	if _, err := s.txRepo.GetByIDForUser(ctx, transactionID, userID); err != nil {
+		return nil, err
+	}
+
+	attachment := &models.Attachment{
+		UserID:        userID,
+		TransactionID: &transactionID,
+	}
+	if err := s.store(ctx, attachment, req.FileData, req.FileName, req.FileSize); err != nil {
+		return nil, err
+	}
+
+	return &v1.UploadAttachmentResponse{
+		Success:   true,
+		Message:   "Attachment uploaded successfully",
+		Data:      attachmentToProto(attachment),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListTransactionAttachments retrieves the attachments of a transaction of the user.
+func (s *attachmentService) ListTransactionAttachments(ctx context.Context, transactionID int32, userID int32) (*v1.ListAttachmentsResponse, error) {
+	// Verify ownership
+	if _, err := s.txRepo.GetByIDForUser(ctx, transactionID, userID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepo.ListByTransactionID(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListAttachmentsResponse{
+		Success:     true,
+		Message:     "Attachments retrieved successfully",
+		Attachments: attachmentsToProto(attachments),
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// UploadInvestmentTransactionAttachment stores a file and attaches it to an investment transaction of the user.
+func (s *attachmentService) UploadInvestmentTransactionAttachment(ctx context.Context, transactionID int32, userID int32, req *v1.UploadInvestmentTransactionAttachmentRequest) (*v1.UploadAttachmentResponse, error) {
+	// Verify ownership
+	if _, err := s.investmentTxRepo.GetByIDForUser(ctx, transactionID, userID); err != nil {
+		return nil, err
+	}
+
+	attachment := &models.Attachment{
+		UserID:                  userID,
+		InvestmentTransactionID: &transactionID,
+	}
+	if err := s.store(ctx, attachment, req.FileData, req.FileName, req.FileSize); err != nil {
+		return nil, err
+	}
+
+	return &v1.UploadAttachmentResponse{
+		Success:   true,
+		Message:   "Attachment uploaded successfully",
+		Data:      attachmentToProto(attachment),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListInvestmentTransactionAttachments retrieves the attachments of an investment transaction of the user.
+func (s *attachmentService) ListInvestmentTransactionAttachments(ctx context.Context, transactionID int32, userID int32) (*v1.ListAttachmentsResponse, error) {
+	// Verify ownership
+	if _, err := s.investmentTxRepo.GetByIDForUser(ctx, transactionID, userID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepo.ListByInvestmentTransactionID(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListAttachmentsResponse{
+		Success:     true,
+		Message:     "Attachments retrieved successfully",
+		Attachments: attachmentsToProto(attachments),
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// GetAttachment retrieves an attachment with a signed URL to download it.
+func (s *attachmentService) GetAttachment(ctx context.Context, attachmentID int32, userID int32) (*v1.GetAttachmentResponse, error) {
+	attachment, err := s.attachmentRepo.GetByIDForUser(ctx, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if s.storage == nil {
+		return nil, apperrors.NewServiceUnavailableError("attachment storage is not configured")
+	}
+
+	url, err := s.storage.GetURL(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to get attachment URL", err)
+	}
+
+	data := attachmentToProto(attachment)
+	data.Url = url
+	return &v1.GetAttachmentResponse{
+		Success:   true,
+		Message:   "Attachment retrieved successfully",
+		Data:      data,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// DeleteAttachment deletes an attachment and its stored file.
+func (s *attachmentService) DeleteAttachment(ctx context.Context, attachmentID int32, userID int32) (*v1.DeleteAttachmentResponse, error) {
+	attachment, err := s.attachmentRepo.GetByIDForUser(ctx, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if s.storage == nil {
+		return nil, apperrors.NewServiceUnavailableError("attachment storage is not configured")
+	}
+
+	if err := s.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to delete attachment file", err)
+	}
+	if err := s.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteAttachmentResponse{
+		Success:   true,
+		Message:   "Attachment deleted successfully",
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// DeleteTransactionAttachments deletes the attachments and stored files of a transaction.
+func (s *attachmentService) DeleteTransactionAttachments(ctx context.Context, transactionID int32) error {
+	attachments, err := s.attachmentRepo.ListByTransactionID(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+	return s.deleteAll(ctx, attachments)
+}
+
+// DeleteInvestmentTransactionAttachments deletes the attachments and stored files of an investment transaction.
+func (s *attachmentService) DeleteInvestmentTransactionAttachments(ctx context.Context, transactionID int32) error {
+	attachments, err := s.attachmentRepo.ListByInvestmentTransactionID(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+	return s.deleteAll(ctx, attachments)
+}
+
+// store validates a file, uploads it and creates the attachment row. The stored file is
+// removed again if the row cannot be created.
+func (s *attachmentService) store(ctx context.Context, attachment *models.Attachment, fileData []byte, fileName string, fileSize int64) error {
+	if s.storage == nil {
+		return apperrors.NewServiceUnavailableError("attachment storage is not configured")
+	}
+	if fileName == "" {
+		return apperrors.NewValidationError("fileName is required")
+	}
+	if err := fileupload.ValidateFileSizeMatch(fileSize, fileData); err != nil {
+		return apperrors.NewValidationError(err.Error())
+	}
+
+	sanitizedName, contentType, err := fileupload.ValidateAttachment(fileData, fileName)
+	if err != nil {
+		return apperrors.NewValidationError(err.Error())
+	}
+
+	key := fmt.Sprintf("attachments/%d/%s%s", attachment.UserID, uuid.New().String(), strings.ToLower(filepath.Ext(sanitizedName)))
+	if _, err := s.storage.Upload(ctx, bytes.NewReader(fileData), key, contentType); err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to store attachment", err)
+	}
+
+	attachment.FileName = sanitizedName
+	attachment.ContentType = contentType
+	attachment.Size = int64(len(fileData))
+	attachment.StorageKey = key
+	if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+		if deleteErr := s.storage.Delete(ctx, key); deleteErr != nil {
+			slog.Warn("Failed to remove stored attachment after create failed",
+				"storage_key", key,
+				"error", deleteErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// deleteAll deletes attachments and their stored files. A file that cannot be deleted is
+// logged and left behind so the rest are still removed.
+func (s *attachmentService) deleteAll(ctx context.Context, attachments []*models.Attachment) error {
+	for _, attachment := range attachments {
+		if s.storage != nil {
+			if err := s.storage.Delete(ctx, attachment.StorageKey); err != nil {
+				slog.Warn("Failed to delete attachment file",
+					"attachment_id", attachment.ID,
+					"storage_key", attachment.StorageKey,
+					"error", err)
+			}
+		}
+		if err := s.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachmentToProto converts a model Attachment to protobuf, without a URL.
+func attachmentToProto(attachment *models.Attachment) *v1.Attachment {
+	result := &v1.Attachment{
+		Id:          attachment.ID,
+		UserId:      attachment.UserID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt.Unix(),
+	}
+	if attachment.TransactionID != nil {
+		result.TransactionId = *attachment.TransactionID
+	}
+	if attachment.InvestmentTransactionID != nil {
+		result.InvestmentTransactionId = *attachment.InvestmentTransactionID
+	}
+	return result
+}
+
+// attachmentsToProto converts model attachments to protobuf.
+func attachmentsToProto(attachments []*models.Attachment) []*v1.Attachment {
+	result := make([]*v1.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = attachmentToProto(attachment)
+	}
+	return result
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/storage"
+
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAttachmentRepository keeps attachments in memory.
+type stubAttachmentRepository struct {
+	repository.AttachmentRepository
+	attachments []*models.Attachment
+}
+
+func (r *stubAttachmentRepository) Create(ctx context.Context, attachment *models.Attachment) error {
+	attachment.ID = int32(len(r.attachments) + 1)
+	attachment.CreatedAt = time.Now()
+	r.attachments = append(r.attachments, attachment)
+	return nil
+}
+
+func (r *stubAttachmentRepository) GetByIDForUser(ctx context.Context, attachmentID, userID int32) (*models.Attachment, error) {
+	for _, attachment := range r.attachments {
+		if attachment.ID == attachmentID && attachment.UserID == userID {
+			return attachment, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("attachment")
+}
+
+func (r *stubAttachmentRepository) ListByTransactionID(ctx context.Context, transactionID int32) ([]*models.Attachment, error) {
+	var result []*models.Attachment
+	for _, attachment := range r.attachments {
+		if attachment.TransactionID != nil && *attachment.TransactionID == transactionID {
+			result = append(result, attachment)
+		}
+	}
+	return result, nil
+}
+
+func (r *stubAttachmentRepository) ListByInvestmentTransactionID(ctx context.Context, transactionID int32) ([]*models.Attachment, error) {
+	var result []*models.Attachment
+	for _, attachment := range r.attachments {
+		if attachment.InvestmentTransactionID != nil && *attachment.InvestmentTransactionID == transactionID {
+			result = append(result, attachment)
+		}
+	}
+	return result, nil
+}
+
+func (r *stubAttachmentRepository) Delete(ctx context.Context, id int32) error {
+	for i, attachment := range r.attachments {
+		if attachment.ID == id {
+			r.attachments = append(r.attachments[:i], r.attachments[i+1:]...)
+			return nil
+		}
+	}
+	return apperrors.NewNotFoundError("attachment")
+}
+
+// stubAttachmentInvestmentTxRepository only answers ownership checks.
+type stubAttachmentInvestmentTxRepository struct {
+	repository.InvestmentTransactionRepository
+	owner map[int32]int32
+}
+
+func (r *stubAttachmentInvestmentTxRepository) GetByIDForUser(ctx context.Context, txID, userID int32) (*models.InvestmentTransaction, error) {
+	if r.owner[txID] != userID {
+		return nil, apperrors.NewNotFoundError("investment transaction")
+	}
+	return &models.InvestmentTransaction{ID: txID}, nil
+}
+
+var receiptPNG = []byte("\x89PNG\r\n\x1a\nreceipt")
+
+func TestAttachmentService_TransactionAttachments(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	repo := &stubAttachmentRepository{}
+	txRepo := &stubReconcileTransactionRepository{transactions: []*models.Transaction{{ID: 1, WalletID: 1}}}
+	svc := NewAttachmentService(repo, txRepo, nil, storage.NewLocalStorage(rootDir, "", ""))
+
+	uploaded, err := svc.UploadTransactionAttachment(ctx, 1, 7, &v1.UploadTransactionAttachmentRequest{
+		FileData: receiptPNG,
+		FileName: "Lunch Receipt.PNG",
+		FileSize: int64(len(receiptPNG)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", uploaded.Data.ContentType)
+	assert.Equal(t, int32(1), uploaded.Data.TransactionId)
+	assert.Empty(t, uploaded.Data.Url, "URLs are only signed on request")
+
+	storageKey := repo.attachments[0].StorageKey
+	assert.True(t, strings.HasPrefix(storageKey, "attachments/7/"))
+	assert.FileExists(t, filepath.Join(rootDir, filepath.FromSlash(storageKey)))
+
+	listed, err := svc.ListTransactionAttachments(ctx, 1, 7)
+	require.NoError(t, err)
+	assert.Len(t, listed.Attachments, 1)
+
+	got, err := svc.GetAttachment(ctx, uploaded.Data.Id, 7)
+	require.NoError(t, err)
+	assert.NotEmpty(t, got.Data.Url)
+
+	_, err = svc.GetAttachment(ctx, uploaded.Data.Id, 8)
+	assert.IsType(t, apperrors.NotFoundError{}, err, "other users cannot see the attachment")
+
+	_, err = svc.UploadTransactionAttachment(ctx, 1, 7, &v1.UploadTransactionAttachmentRequest{
+		FileData: []byte("%PDF-1.4"),
+		FileName: "receipt.png",
+		FileSize: 8,
+	})
+	assert.IsType(t, apperrors.ValidationError{}, err, "content must match the extension")
+
+	_, err = svc.UploadTransactionAttachment(ctx, 1, 7, &v1.UploadTransactionAttachmentRequest{
+		FileData: receiptPNG,
+		FileName: "receipt.png",
+		FileSize: 1,
+	})
+	assert.IsType(t, apperrors.ValidationError{}, err, "declared size must match the data")
+	assert.Len(t, repo.attachments, 1)
+
+	require.NoError(t, svc.DeleteTransactionAttachments(ctx, 1))
+	assert.Empty(t, repo.attachments)
+	_, err = os.Stat(filepath.Join(rootDir, filepath.FromSlash(storageKey)))
+	assert.True(t, os.IsNotExist(err), "the stored file is removed with the transaction")
+}
+
+func TestAttachmentService_InvestmentTransactionAttachments(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubAttachmentRepository{}
+	investmentTxRepo := &stubAttachmentInvestmentTxRepository{owner: map[int32]int32{3: 7}}
+	svc := NewAttachmentService(repo, nil, investmentTxRepo, storage.NewLocalStorage(t.TempDir(), "", ""))
+
+	_, err := svc.UploadInvestmentTransactionAttachment(ctx, 3, 8, &v1.UploadInvestmentTransactionAttachmentRequest{
+		FileData: receiptPNG,
+		FileName: "contract-note.png",
+		FileSize: int64(len(receiptPNG)),
+	})
+	assert.IsType(t, apperrors.NotFoundError{}, err, "cannot attach to another user's transaction")
+
+	uploaded, err := svc.UploadInvestmentTransactionAttachment(ctx, 3, 7, &v1.UploadInvestmentTransactionAttachmentRequest{
+		FileData: receiptPNG,
+		FileName: "contract-note.png",
+		FileSize: int64(len(receiptPNG)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), uploaded.Data.InvestmentTransactionId)
+	assert.Zero(t, uploaded.Data.TransactionId)
+
+	_, err = svc.DeleteAttachment(ctx, uploaded.Data.Id, 7)
+	require.NoError(t, err)
+	listed, err := svc.ListInvestmentTransactionAttachments(ctx, 3, 7)
+	require.NoError(t, err)
+	assert.Empty(t, listed.Attachments)
+}
+
+func TestSetAttachmentCleaner(t *testing.T) {
+	ts := &transactionService{}
+	is := &investmentService{}
+	cleaner := NewAttachmentService(&stubAttachmentRepository{}, nil, nil, nil)
+
+	SetAttachmentCleaner(&Services{Transaction: ts, Investment: is}, cleaner)
+	assert.Equal(t, cleaner, ts.attachmentCleaner)
+	assert.Equal(t, cleaner, is.attachmentCleaner)
+}
@@ -0,0 +1,460 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/notification"
+	"wealthjourney/pkg/validator"
+	budgetv1 "wealthjourney/protobuf/v1"
+)
+
+// budgetAlertMaxThreshold bounds alert thresholds, in percent of the item total.
+const budgetAlertMaxThreshold = 1000
+
+// defaultAlertThresholds fire when an item has used half, most and all of its total.
+var defaultAlertThresholds = []int32{50, 80, 100}
+
+// budgetAlertChannels maps alert channels to the notification channels delivering them.
+var budgetAlertChannels = map[budgetv1.BudgetAlertChannel]string{
+	budgetv1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_IN_APP:  notification.ChannelInApp,
+	budgetv1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_EMAIL:   notification.ChannelEmail,
+	budgetv1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_WEBHOOK: notification.ChannelWebhook,
+}
+
+// SetAlertRepository enables alert rules on the items of budgets with a period.
+func (s *budgetService) SetAlertRepository(alertRepo repository.BudgetAlertRepository) {
+	s.alertRepo = alertRepo
+}
+
+// SetNotifier sets the dispatcher delivering budget alerts. Rules can only use the
+// channels registered with it.
+func (s *budgetService) SetNotifier(notifier *notification.Dispatcher) {
+	s.notifier = notifier
+}
+
+// SetImportBudgetAlertEvaluator makes ExecuteImport check the user's budget alerts once
+// the rows are imported.
+func SetImportBudgetAlertEvaluator(svc ImportService, evaluator BudgetAlertEvaluator) {
+	if s, ok := svc.(*importService); ok {
+		s.budgetAlerts = evaluator
+	}
+}
+
+// evaluateBudgetAlertsAsync checks the user's budget alerts in the background, so slow
+// notification channels don't hold up the request that changed the transactions.
+func evaluateBudgetAlertsAsync(evaluator BudgetAlertEvaluator, userID int32) {
+	if evaluator == nil {
+		return
+	}
+	go func() {
+		if err := evaluator.EvaluateBudgetAlerts(context.Background(), userID); err != nil {
+			slog.Warn("Failed to evaluate budget alerts",
+				"user_id", userID,
+				"error", err)
+		}
+	}()
+}
+
+// CreateBudgetAlertRule creates an alert rule on a category-linked item of a budget.
+func (s *budgetService) CreateBudgetAlertRule(ctx context.Context, budgetID int32, userID int32, req *budgetv1.CreateBudgetAlertRuleRequest) (*budgetv1.CreateBudgetAlertRuleResponse, error) {
+	budget, err := s.loadAlertBudget(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAlertItem(budget, req.BudgetItemId); err != nil {
+		return nil, err
+	}
+
+	thresholds := req.Thresholds
+	if len(thresholds) == 0 {
+		thresholds = defaultAlertThresholds
+	}
+	channels := req.Channels
+	if len(channels) == 0 {
+		channels = []budgetv1.BudgetAlertChannel{budgetv1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_IN_APP}
+	}
+
+	rule := &models.BudgetAlertRule{
+		UserID:       userID,
+		BudgetID:     budget.ID,
+		BudgetItemID: req.BudgetItemId,
+		WebhookURL:   req.WebhookUrl,
+		Enabled:      true,
+	}
+	if err := s.applyAlertRuleSettings(ctx, rule, thresholds, channels); err != nil {
+		return nil, err
+	}
+
+	if err := s.alertRepo.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.CreateBudgetAlertRuleResponse{
+		Success:   true,
+		Message:   "Budget alert rule created successfully",
+		Data:      budgetAlertRuleToProto(rule),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListBudgetAlertRules lists the alert rules of a budget.
+func (s *budgetService) ListBudgetAlertRules(ctx context.Context, budgetID int32, userID int32) (*budgetv1.ListBudgetAlertRulesResponse, error) {
+	budget, err := s.loadAlertBudget(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.alertRepo.ListRulesByBudgetID(ctx, budget.ID)
+	if err != nil {
+		return nil, err
+	}
+	protoRules := make([]*budgetv1.BudgetAlertRule, 0, len(rules))
+	for _, rule := range rules {
+		protoRules = append(protoRules, budgetAlertRuleToProto(rule))
+	}
+
+	return &budgetv1.ListBudgetAlertRulesResponse{
+		Success:   true,
+		Message:   "Budget alert rules retrieved successfully",
+		Rules:     protoRules,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// UpdateBudgetAlertRule updates the thresholds, channels, webhook or state of a rule.
+func (s *budgetService) UpdateBudgetAlertRule(ctx context.Context, budgetID int32, ruleID int32, userID int32, req *budgetv1.UpdateBudgetAlertRuleRequest) (*budgetv1.UpdateBudgetAlertRuleResponse, error) {
+	rule, err := s.loadAlertRule(ctx, budgetID, ruleID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds := req.Thresholds
+	if len(thresholds) == 0 {
+		thresholds = rule.Thresholds
+	}
+	channels := req.Channels
+	if len(channels) == 0 {
+		channels = alertChannelsToProto(rule.Channels)
+	}
+	if req.WebhookUrl != nil {
+		rule.WebhookURL = *req.WebhookUrl
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if err := s.applyAlertRuleSettings(ctx, rule, thresholds, channels); err != nil {
+		return nil, err
+	}
+
+	if err := s.alertRepo.UpdateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.UpdateBudgetAlertRuleResponse{
+		Success:   true,
+		Message:   "Budget alert rule updated successfully",
+		Data:      budgetAlertRuleToProto(rule),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// DeleteBudgetAlertRule deletes an alert rule.
+func (s *budgetService) DeleteBudgetAlertRule(ctx context.Context, budgetID int32, ruleID int32, userID int32) (*budgetv1.DeleteBudgetAlertRuleResponse, error) {
+	rule, err := s.loadAlertRule(ctx, budgetID, ruleID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.alertRepo.DeleteRule(ctx, rule.ID); err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.DeleteBudgetAlertRuleResponse{
+		Success:   true,
+		Message:   "Budget alert rule deleted successfully",
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// EvaluateBudgetAlerts checks the enabled alert rules of the user against the spending
+// of the current period and notifies the thresholds crossed for the first time.
+func (s *budgetService) EvaluateBudgetAlerts(ctx context.Context, userID int32) error {
+	return s.evaluateBudgetAlerts(ctx, userID, time.Now())
+}
+
+// evaluateBudgetAlerts records every threshold an item has reached in the period that
+// contains now. A threshold fires once per rule and period; when several are reached
+// at once, only the highest is notified. Delivery failures are logged, not retried.
+func (s *budgetService) evaluateBudgetAlerts(ctx context.Context, userID int32, now time.Time) error {
+	if s.alertRepo == nil || s.notifier == nil || s.txRepo == nil {
+		return nil
+	}
+
+	rules, err := s.alertRepo.ListEnabledRulesByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	rulesByBudget := make(map[int32][]*models.BudgetAlertRule)
+	var budgetIDs []int32
+	for _, rule := range rules {
+		if _, ok := rulesByBudget[rule.BudgetID]; !ok {
+			budgetIDs = append(budgetIDs, rule.BudgetID)
+		}
+		rulesByBudget[rule.BudgetID] = append(rulesByBudget[rule.BudgetID], rule)
+	}
+
+	var email string
+	for _, budgetID := range budgetIDs {
+		budget, err := s.budgetRepo.GetByIDForUser(ctx, budgetID, userID)
+		if err != nil {
+			var notFound apperrors.NotFoundError
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return err
+		}
+		items := budgetItemPointers(budget)
+		spending, err := s.computeSpending(ctx, userID, budget, items, now)
+		if err != nil {
+			return err
+		}
+		if spending == nil {
+			continue
+		}
+
+		itemsByID := make(map[int32]*models.BudgetItem, len(items))
+		for _, item := range items {
+			itemsByID[item.ID] = item
+		}
+
+		for _, rule := range rulesByBudget[budgetID] {
+			item, ok := itemsByID[rule.BudgetItemID]
+			if !ok || item.Total <= 0 {
+				continue
+			}
+			spent, ok := spending.byItem[item.ID]
+			if !ok {
+				continue
+			}
+
+			var crossed int32
+			for _, threshold := range rule.Thresholds {
+				// Integer comparison, so 99.996% does not round up to 100%
+				if spent*100 < int64(threshold)*item.Total {
+					break
+				}
+				fired, err := s.alertRepo.RecordAlert(ctx, &models.BudgetAlert{
+					RuleID:      rule.ID,
+					Threshold:   threshold,
+					PeriodStart: spending.start,
+					Spent:       spent,
+					Currency:    spending.currency,
+				})
+				if err != nil {
+					return err
+				}
+				if fired {
+					crossed = threshold
+				}
+			}
+			if crossed == 0 {
+				continue
+			}
+
+			if email == "" && hasAlertChannel(rule, notification.ChannelEmail) {
+				if user, err := s.userRepo.GetByID(ctx, userID); err == nil {
+					email = user.Email
+				}
+			}
+			msg := budgetAlertMessage(budget, item, rule, crossed, spent, spending)
+			msg.UserID = userID
+			msg.Email = email
+			if err := s.notifier.Send(ctx, rule.Channels, msg); err != nil {
+				slog.Warn("Failed to deliver budget alert",
+					"rule_id", rule.ID,
+					"threshold", crossed,
+					"user_id", userID,
+					"error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// loadAlertBudget loads a budget of the user once alerts are available.
+func (s *budgetService) loadAlertBudget(ctx context.Context, budgetID, userID int32) (*models.Budget, error) {
+	if err := validator.ID(budgetID); err != nil {
+		return nil, err
+	}
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if s.alertRepo == nil || s.notifier == nil {
+		return nil, apperrors.NewServiceUnavailableError("budget alerts are not configured")
+	}
+	return s.budgetRepo.GetByIDForUser(ctx, budgetID, userID)
+}
+
+// loadAlertRule loads a rule of the user and checks it belongs to the budget.
+func (s *budgetService) loadAlertRule(ctx context.Context, budgetID, ruleID, userID int32) (*models.BudgetAlertRule, error) {
+	if err := validator.ID(ruleID); err != nil {
+		return nil, err
+	}
+	budget, err := s.loadAlertBudget(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := s.alertRepo.GetRuleByIDForUser(ctx, ruleID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if rule.BudgetID != budget.ID {
+		return nil, apperrors.NewNotFoundError("budget alert rule")
+	}
+	return rule, nil
+}
+
+// validateAlertItem checks that the item belongs to the budget and tracks spending.
+func validateAlertItem(budget *models.Budget, itemID int32) error {
+	if err := validator.ID(itemID); err != nil {
+		return err
+	}
+	if budgetv1.BudgetPeriod(budget.Period) == budgetv1.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED {
+		return apperrors.NewValidationError("alerts need a budget with a period")
+	}
+	for i := range budget.Items {
+		if budget.Items[i].ID != itemID {
+			continue
+		}
+		if len(budget.Items[i].Categories) == 0 {
+			return apperrors.NewValidationError("alerts need a budget item linked to categories")
+		}
+		return nil
+	}
+	return apperrors.NewNotFoundError("budget item")
+}
+
+// applyAlertRuleSettings validates thresholds and channels and sets them on the rule,
+// thresholds deduplicated in ascending order. A webhook URL is resolved to make sure it
+// points to a public address.
+func (s *budgetService) applyAlertRuleSettings(ctx context.Context, rule *models.BudgetAlertRule, thresholds []int32, channels []budgetv1.BudgetAlertChannel) error {
+	seenThresholds := make(map[int32]bool, len(thresholds))
+	sorted := make([]int32, 0, len(thresholds))
+	for _, threshold := range thresholds {
+		if threshold < 1 || threshold > budgetAlertMaxThreshold {
+			return apperrors.NewValidationError(fmt.Sprintf("alert thresholds must be between 1 and %d percent", budgetAlertMaxThreshold))
+		}
+		if !seenThresholds[threshold] {
+			seenThresholds[threshold] = true
+			sorted = append(sorted, threshold)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	seenChannels := make(map[string]bool, len(channels))
+	names := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		name, ok := budgetAlertChannels[channel]
+		if !ok {
+			return apperrors.NewValidationError("invalid alert channel")
+		}
+		if !s.notifier.Has(name) {
+			return apperrors.NewServiceUnavailableError(fmt.Sprintf("%s notifications are not configured", name))
+		}
+		if name == notification.ChannelWebhook {
+			if err := notification.ValidateWebhookURL(rule.WebhookURL); err != nil {
+				return apperrors.NewValidationError(err.Error())
+			}
+			if err := s.notifier.Validate(ctx, name, &notification.Message{WebhookURL: rule.WebhookURL}); err != nil {
+				return apperrors.NewValidationError(err.Error())
+			}
+		}
+		if !seenChannels[name] {
+			seenChannels[name] = true
+			names = append(names, name)
+		}
+	}
+
+	rule.Thresholds = sorted
+	rule.Channels = names
+	return nil
+}
+
+// hasAlertChannel reports whether the rule delivers over the named channel.
+func hasAlertChannel(rule *models.BudgetAlertRule, name string) bool {
+	for _, channel := range rule.Channels {
+		if channel == name {
+			return true
+		}
+	}
+	return false
+}
+
+// budgetAlertMessage describes a crossed threshold. Amounts are left to clients, in
+// the message data, in the smallest currency unit.
+func budgetAlertMessage(budget *models.Budget, item *models.BudgetItem, rule *models.BudgetAlertRule, threshold int32, spent int64, spending *budgetSpending) *notification.Message {
+	title := fmt.Sprintf("%s reached %d%% of its budget", item.Name, threshold)
+	if threshold >= 100 {
+		title = fmt.Sprintf("%s is over budget", item.Name)
+		if spent*100 == int64(threshold)*item.Total {
+			title = fmt.Sprintf("%s has used its whole budget", item.Name)
+		}
+	}
+
+	return &notification.Message{
+		Kind:  models.NotificationKindBudgetAlert,
+		Title: title,
+		Body: fmt.Sprintf("%s in %s has used %.0f%% of its budget for the period from %s to %s.",
+			item.Name, budget.Name, percentUsed(spent, item.Total),
+			spending.start.Format("2006-01-02"), spending.end.Format("2006-01-02")),
+		Data: map[string]string{
+			"budgetId":     strconv.Itoa(int(budget.ID)),
+			"budgetItemId": strconv.Itoa(int(item.ID)),
+			"ruleId":       strconv.Itoa(int(rule.ID)),
+			"threshold":    strconv.Itoa(int(threshold)),
+			"spent":        strconv.FormatInt(spent, 10),
+			"total":        strconv.FormatInt(item.Total, 10),
+			"currency":     spending.currency,
+			"periodStart":  strconv.FormatInt(spending.start.Unix(), 10),
+		},
+		WebhookURL: rule.WebhookURL,
+	}
+}
+
+// alertChannelsToProto maps stored channel names back to alert channels.
+func alertChannelsToProto(names []string) []budgetv1.BudgetAlertChannel {
+	channels := make([]budgetv1.BudgetAlertChannel, 0, len(names))
+	for _, name := range names {
+		for channel, channelName := range budgetAlertChannels {
+			if channelName == name {
+				channels = append(channels, channel)
+				break
+			}
+		}
+	}
+	return channels
+}
+
+// budgetAlertRuleToProto converts an alert rule to its protobuf representation.
+func budgetAlertRuleToProto(rule *models.BudgetAlertRule) *budgetv1.BudgetAlertRule {
+	return &budgetv1.BudgetAlertRule{
+		Id:           rule.ID,
+		BudgetId:     rule.BudgetID,
+		BudgetItemId: rule.BudgetItemID,
+		Thresholds:   rule.Thresholds,
+		Channels:     alertChannelsToProto(rule.Channels),
+		WebhookUrl:   rule.WebhookURL,
+		Enabled:      rule.Enabled,
+		CreatedAt:    rule.CreatedAt.Unix(),
+		UpdatedAt:    rule.UpdatedAt.Unix(),
+	}
+}
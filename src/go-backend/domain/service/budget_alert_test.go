@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/notification"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBudgetAlertRepository keeps rules and fired alerts in memory.
+type stubBudgetAlertRepository struct {
+	repository.BudgetAlertRepository
+	rules  []*models.BudgetAlertRule
+	fired  map[string]bool
+	nextID int32
+}
+
+func (r *stubBudgetAlertRepository) CreateRule(ctx context.Context, rule *models.BudgetAlertRule) error {
+	r.nextID++
+	rule.ID = r.nextID
+	r.rules = append(r.rules, rule)
+	return nil
+}
+
+func (r *stubBudgetAlertRepository) GetRuleByIDForUser(ctx context.Context, ruleID, userID int32) (*models.BudgetAlertRule, error) {
+	for _, rule := range r.rules {
+		if rule.ID == ruleID && rule.UserID == userID {
+			return rule, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("budget alert rule")
+}
+
+func (r *stubBudgetAlertRepository) UpdateRule(ctx context.Context, rule *models.BudgetAlertRule) error {
+	return nil
+}
+
+func (r *stubBudgetAlertRepository) ListEnabledRulesByUserID(ctx context.Context, userID int32) ([]*models.BudgetAlertRule, error) {
+	var rules []*models.BudgetAlertRule
+	for _, rule := range r.rules {
+		if rule.UserID == userID && rule.Enabled {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+func (r *stubBudgetAlertRepository) RecordAlert(ctx context.Context, alert *models.BudgetAlert) (bool, error) {
+	if r.fired == nil {
+		r.fired = make(map[string]bool)
+	}
+	key := fmt.Sprintf("%d/%d/%d", alert.RuleID, alert.Threshold, alert.PeriodStart.Unix())
+	if r.fired[key] {
+		return false, nil
+	}
+	r.fired[key] = true
+	return true, nil
+}
+
+// recordingChannel records the messages sent over it and fails with err when set.
+type recordingChannel struct {
+	sent []*notification.Message
+	err  error
+}
+
+func (c *recordingChannel) Send(ctx context.Context, msg *notification.Message) error {
+	c.sent = append(c.sent, msg)
+	return c.err
+}
+
+// stubEmailUserRepository serves a user with an email address.
+type stubEmailUserRepository struct {
+	repository.UserRepository
+	email string
+}
+
+func (r *stubEmailUserRepository) GetByID(ctx context.Context, id int32) (*models.User, error) {
+	return &models.User{ID: id, Email: r.email}, nil
+}
+
+// newAlertTestService returns a budget service over a monthly budget of user 7 with a
+// category-linked item 1 of 100000 VND and an unlinked item 2.
+func newAlertTestService(channels map[string]notification.Channel) (*budgetService, *stubBudgetAlertRepository, *stubBreakdownRepository) {
+	budget := &models.Budget{
+		ID:       1,
+		UserID:   7,
+		Name:     "Household",
+		Total:    200000,
+		Currency: "VND",
+		Period:   int32(v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY),
+	}
+	groceries := boundItem(1, 100000, []int32{10}, nil)
+	groceries.Name = "Groceries"
+	budget.Items = []models.BudgetItem{*groceries, *boundItem(2, 100000, nil, nil)}
+
+	svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{}, &stubEmailUserRepository{email: "owner@example.com"}, nil, nil).(*budgetService)
+	breakdowns := &stubBreakdownRepository{byWallets: map[string][]*repository.CategoryBreakdownByCurrency{}}
+	svc.SetSpendingRepositories(breakdowns, nil, nil)
+
+	alertRepo := &stubBudgetAlertRepository{}
+	dispatcher := notification.NewDispatcher()
+	for name, channel := range channels {
+		dispatcher.Register(name, channel)
+	}
+	svc.SetAlertRepository(alertRepo)
+	svc.SetNotifier(dispatcher)
+	return svc, alertRepo, breakdowns
+}
+
+func spendOnGroceries(breakdowns *stubBreakdownRepository, amount int64) {
+	breakdowns.byWallets["[]"] = []*repository.CategoryBreakdownByCurrency{
+		{CategoryID: 10, AmountsByCurrency: map[string]int64{"VND": -amount}},
+	}
+}
+
+func TestBudgetService_CreateBudgetAlertRule(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("defaults to 50, 80 and 100 percent in the inbox", func(t *testing.T) {
+		svc, alertRepo, _ := newAlertTestService(map[string]notification.Channel{
+			notification.ChannelInApp: &recordingChannel{},
+		})
+
+		resp, err := svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{BudgetItemId: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []int32{50, 80, 100}, resp.Data.Thresholds)
+		assert.Equal(t, []v1.BudgetAlertChannel{v1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_IN_APP}, resp.Data.Channels)
+		assert.True(t, resp.Data.Enabled)
+		require.Len(t, alertRepo.rules, 1)
+		assert.Equal(t, int32(7), alertRepo.rules[0].UserID)
+	})
+
+	t.Run("sorts and deduplicates thresholds", func(t *testing.T) {
+		svc, _, _ := newAlertTestService(map[string]notification.Channel{
+			notification.ChannelInApp: &recordingChannel{},
+		})
+
+		resp, err := svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{
+			BudgetItemId: 1,
+			Thresholds:   []int32{120, 90, 90},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int32{90, 120}, resp.Data.Thresholds)
+	})
+
+	t.Run("rejects invalid rules", func(t *testing.T) {
+		svc, _, _ := newAlertTestService(map[string]notification.Channel{
+			notification.ChannelInApp:   &recordingChannel{},
+			notification.ChannelWebhook: &recordingChannel{},
+		})
+
+		_, err := svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{BudgetItemId: 2})
+		assert.IsType(t, apperrors.ValidationError{}, err, "item without categories")
+
+		_, err = svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{BudgetItemId: 3})
+		assert.IsType(t, apperrors.NotFoundError{}, err, "item of another budget")
+
+		_, err = svc.CreateBudgetAlertRule(ctx, 1, 8, &v1.CreateBudgetAlertRuleRequest{BudgetItemId: 1})
+		assert.IsType(t, apperrors.NotFoundError{}, err, "budget of another user")
+
+		_, err = svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{BudgetItemId: 1, Thresholds: []int32{0}})
+		assert.IsType(t, apperrors.ValidationError{}, err, "zero threshold")
+
+		_, err = svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{
+			BudgetItemId: 1,
+			Channels:     []v1.BudgetAlertChannel{v1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_WEBHOOK},
+		})
+		assert.IsType(t, apperrors.ValidationError{}, err, "webhook without URL")
+
+		_, err = svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{
+			BudgetItemId: 1,
+			Channels:     []v1.BudgetAlertChannel{v1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_EMAIL},
+		})
+		assert.IsType(t, apperrors.ServiceUnavailableError{}, err, "email is not configured")
+	})
+
+	t.Run("budgets without a period", func(t *testing.T) {
+		svc, _, _ := newAlertTestService(map[string]notification.Channel{
+			notification.ChannelInApp: &recordingChannel{},
+		})
+		svc.budgetRepo.(*stubBudgetRepository).budget.Period = int32(v1.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED)
+
+		_, err := svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{BudgetItemId: 1})
+		assert.IsType(t, apperrors.ValidationError{}, err)
+	})
+
+	t.Run("alerts not configured", func(t *testing.T) {
+		svc := NewBudgetService(&stubBudgetRepository{}, &stubBudgetItemRepository{}, &stubNoUserRepository{}, nil, nil)
+
+		_, err := svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{BudgetItemId: 1})
+		assert.IsType(t, apperrors.ServiceUnavailableError{}, err)
+	})
+}
+
+func TestBudgetService_UpdateBudgetAlertRule(t *testing.T) {
+	ctx := context.Background()
+	svc, alertRepo, _ := newAlertTestService(map[string]notification.Channel{
+		notification.ChannelInApp:   &recordingChannel{},
+		notification.ChannelWebhook: &recordingChannel{},
+	})
+	created, err := svc.CreateBudgetAlertRule(ctx, 1, 7, &v1.CreateBudgetAlertRuleRequest{BudgetItemId: 1})
+	require.NoError(t, err)
+
+	disabled := false
+	url := "https://hooks.example.com/budget"
+	resp, err := svc.UpdateBudgetAlertRule(ctx, 1, created.Data.Id, 7, &v1.UpdateBudgetAlertRuleRequest{
+		Channels:   []v1.BudgetAlertChannel{v1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_WEBHOOK},
+		WebhookUrl: &url,
+		Enabled:    &disabled,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{50, 80, 100}, resp.Data.Thresholds, "thresholds are kept")
+	assert.Equal(t, []v1.BudgetAlertChannel{v1.BudgetAlertChannel_BUDGET_ALERT_CHANNEL_WEBHOOK}, resp.Data.Channels)
+	assert.Equal(t, url, resp.Data.WebhookUrl)
+	assert.False(t, alertRepo.rules[0].Enabled)
+
+	alertRepo.rules[0].BudgetID = 2
+	_, err = svc.UpdateBudgetAlertRule(ctx, 1, created.Data.Id, 7, &v1.UpdateBudgetAlertRuleRequest{})
+	assert.IsType(t, apperrors.NotFoundError{}, err, "rule of another budget")
+}
+
+func TestBudgetService_EvaluateBudgetAlerts(t *testing.T) {
+	ctx := context.Background()
+	inbox := &recordingChannel{}
+	email := &recordingChannel{}
+	webhook := &recordingChannel{err: errors.New("connection refused")}
+	svc, alertRepo, breakdowns := newAlertTestService(map[string]notification.Channel{
+		notification.ChannelInApp:   inbox,
+		notification.ChannelEmail:   email,
+		notification.ChannelWebhook: webhook,
+	})
+	require.NoError(t, alertRepo.CreateRule(ctx, &models.BudgetAlertRule{
+		UserID:       7,
+		BudgetID:     1,
+		BudgetItemID: 1,
+		Thresholds:   []int32{50, 80, 100},
+		Channels:     []string{notification.ChannelInApp, notification.ChannelEmail, notification.ChannelWebhook},
+		WebhookURL:   "https://hooks.example.com/budget",
+		Enabled:      true,
+	}))
+	may := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+
+	spendOnGroceries(breakdowns, 45000)
+	require.NoError(t, svc.evaluateBudgetAlerts(ctx, 7, may))
+	assert.Empty(t, inbox.sent, "below the first threshold")
+
+	spendOnGroceries(breakdowns, 85000)
+	require.NoError(t, svc.evaluateBudgetAlerts(ctx, 7, may), "delivery failures are not returned")
+	require.Len(t, inbox.sent, 1, "only the highest threshold is notified")
+	msg := inbox.sent[0]
+	assert.Equal(t, models.NotificationKindBudgetAlert, msg.Kind)
+	assert.Equal(t, "Groceries reached 80% of its budget", msg.Title)
+	assert.Equal(t, "80", msg.Data["threshold"])
+	assert.Equal(t, "85000", msg.Data["spent"])
+	assert.Equal(t, int32(7), msg.UserID)
+	assert.Equal(t, "owner@example.com", msg.Email)
+	assert.Equal(t, "https://hooks.example.com/budget", msg.WebhookURL)
+	assert.Len(t, email.sent, 1)
+	assert.Len(t, webhook.sent, 1)
+	assert.Len(t, alertRepo.fired, 2, "the skipped 50% threshold is recorded too")
+
+	require.NoError(t, svc.evaluateBudgetAlerts(ctx, 7, may))
+	assert.Len(t, inbox.sent, 1, "thresholds fire once per period")
+
+	spendOnGroceries(breakdowns, 100000)
+	require.NoError(t, svc.evaluateBudgetAlerts(ctx, 7, may))
+	require.Len(t, inbox.sent, 2)
+	assert.Equal(t, "Groceries has used its whole budget", inbox.sent[1].Title)
+
+	spendOnGroceries(breakdowns, 60000)
+	june := time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, svc.evaluateBudgetAlerts(ctx, 7, june))
+	require.Len(t, inbox.sent, 3, "a new period fires again")
+	assert.Equal(t, "50", inbox.sent[2].Data["threshold"])
+
+	alertRepo.rules[0].Enabled = false
+	spendOnGroceries(breakdowns, 90000)
+	require.NoError(t, svc.evaluateBudgetAlerts(ctx, 7, june))
+	assert.Len(t, inbox.sent, 3, "disabled rules do not fire")
+}
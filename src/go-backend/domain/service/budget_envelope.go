@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/validator"
+	budgetv1 "wealthjourney/protobuf/v1"
+)
+
+// budgetMaxClosePeriods bounds how many ended periods of one budget a single run closes.
+const budgetMaxClosePeriods = 60
+
+// SetEnvelopeRepository enables envelopes: money moved between the items of a monthly
+// or weekly budget, and balances rolled over from one period to the next.
+func (s *budgetService) SetEnvelopeRepository(envelopeRepo repository.BudgetEnvelopeRepository) {
+	s.envelopeRepo = envelopeRepo
+}
+
+// isEnvelopeBudget reports whether the budget's period repeats, so its items can act as
+// envelopes that roll over into the next period.
+func isEnvelopeBudget(budget *models.Budget) bool {
+	period := budgetv1.BudgetPeriod(budget.Period)
+	return period == budgetv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY || period == budgetv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY
+}
+
+// validateRolloverPolicy validates an item's rollover policy and cap.
+func validateRolloverPolicy(policy budgetv1.BudgetRolloverPolicy, rolloverCap *budgetv1.Money) error {
+	if _, ok := budgetv1.BudgetRolloverPolicy_name[int32(policy)]; !ok {
+		return apperrors.NewValidationError("invalid rollover policy")
+	}
+	if rolloverCap != nil && rolloverCap.Amount < 0 {
+		return apperrors.NewValidationError("rollover cap cannot be negative")
+	}
+	return nil
+}
+
+// rolloverAmount returns how much of an envelope's balance at the end of a period is
+// carried into the next one.
+func rolloverAmount(item *models.BudgetItem, available int64) int64 {
+	switch budgetv1.BudgetRolloverPolicy(item.RolloverPolicy) {
+	case budgetv1.BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_ALL:
+		return available
+	case budgetv1.BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_CAPPED:
+		if available > item.RolloverCap {
+			return item.RolloverCap
+		}
+		if available < -item.RolloverCap {
+			return -item.RolloverCap
+		}
+		return available
+	default:
+		return 0
+	}
+}
+
+// loadEnvelopeBudget loads a budget of the user and checks it can hold envelopes.
+func (s *budgetService) loadEnvelopeBudget(ctx context.Context, budgetID, userID int32) (*models.Budget, error) {
+	if err := validator.ID(budgetID); err != nil {
+		return nil, err
+	}
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if s.envelopeRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("budget envelopes are not configured")
+	}
+
+	budget, err := s.budgetRepo.GetByIDForUser(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isEnvelopeBudget(budget) {
+		return nil, apperrors.NewValidationError("envelopes need a monthly or weekly budget")
+	}
+	return budget, nil
+}
+
+// liveEnvelopes computes the envelopes of the period from start to end: the allocation,
+// the amount carried from the closed previous period, the money moved and the spending.
+// Items created after the period are left out.
+func (s *budgetService) liveEnvelopes(ctx context.Context, budget *models.Budget, start, end time.Time) ([]*models.BudgetEnvelopeSnapshot, error) {
+	var items []*models.BudgetItem
+	for _, item := range budgetItemPointers(budget) {
+		if !item.CreatedAt.After(end) {
+			items = append(items, item)
+		}
+	}
+
+	spending, err := s.spendingBetween(ctx, budget.UserID, budget, items, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	moves, err := s.envelopeRepo.ListMoves(ctx, budget.ID, start)
+	if err != nil {
+		return nil, err
+	}
+	movedIn := make(map[int32]int64)
+	for _, move := range moves {
+		movedIn[move.FromItemID] -= move.Amount
+		movedIn[move.ToItemID] += move.Amount
+	}
+
+	previousStart, _, _ := budgetPeriodWindow(budget, start.Add(-time.Second))
+	previous, err := s.envelopeRepo.ListSnapshots(ctx, budget.ID, previousStart)
+	if err != nil {
+		return nil, err
+	}
+	carriedIn := make(map[int32]int64, len(previous))
+	for _, snapshot := range previous {
+		carriedIn[snapshot.BudgetItemID] = snapshot.CarriedOut
+	}
+
+	envelopes := make([]*models.BudgetEnvelopeSnapshot, 0, len(items))
+	for _, item := range items {
+		envelope := &models.BudgetEnvelopeSnapshot{
+			BudgetID:     budget.ID,
+			BudgetItemID: item.ID,
+			PeriodStart:  start,
+			PeriodEnd:    end,
+			Currency:     budget.Currency,
+			Allocated:    item.Total,
+			CarriedIn:    carriedIn[item.ID],
+			MovedIn:      movedIn[item.ID],
+			Spent:        spending.byItem[item.ID],
+		}
+		envelope.CarriedOut = rolloverAmount(item, envelope.Available())
+		envelopes = append(envelopes, envelope)
+	}
+	return envelopes, nil
+}
+
+// GetBudgetEnvelopes returns the envelopes of a budget for the period containing the
+// requested date. Closed periods are served from their snapshots.
+func (s *budgetService) GetBudgetEnvelopes(ctx context.Context, budgetID int32, userID int32, req *budgetv1.GetBudgetEnvelopesRequest) (*budgetv1.GetBudgetEnvelopesResponse, error) {
+	budget, err := s.loadEnvelopeBudget(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now()
+	if req.Date != 0 {
+		date = time.Unix(req.Date, 0)
+	}
+	start, end, _ := budgetPeriodWindow(budget, date)
+
+	envelopes, err := s.envelopeRepo.ListSnapshots(ctx, budget.ID, start)
+	if err != nil {
+		return nil, err
+	}
+	closed := len(envelopes) > 0
+	if !closed {
+		if envelopes, err = s.liveEnvelopes(ctx, budget, start, end); err != nil {
+			return nil, err
+		}
+	}
+
+	items := make(map[int32]*models.BudgetItem, len(budget.Items))
+	for _, item := range budgetItemPointers(budget) {
+		items[item.ID] = item
+	}
+	protoEnvelopes := make([]*budgetv1.BudgetEnvelope, 0, len(envelopes))
+	for _, envelope := range envelopes {
+		protoEnvelopes = append(protoEnvelopes, envelopeToProto(envelope, items[envelope.BudgetItemID], closed))
+	}
+
+	return &budgetv1.GetBudgetEnvelopesResponse{
+		Success:     true,
+		Message:     "Budget envelopes retrieved successfully",
+		PeriodStart: start.Unix(),
+		PeriodEnd:   end.Unix(),
+		Closed:      closed,
+		Envelopes:   protoEnvelopes,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// MoveBudgetMoney moves money from one envelope to another in the current period and
+// logs the move. An envelope may go negative.
+func (s *budgetService) MoveBudgetMoney(ctx context.Context, budgetID int32, userID int32, req *budgetv1.MoveBudgetMoneyRequest) (*budgetv1.MoveBudgetMoneyResponse, error) {
+	if req.Amount == nil || req.Amount.Amount <= 0 {
+		return nil, apperrors.NewValidationError("amount must be positive")
+	}
+	if req.FromItemId == req.ToItemId {
+		return nil, apperrors.NewValidationError("cannot move money to the same envelope")
+	}
+	if len(req.Note) > 255 {
+		return nil, apperrors.NewValidationError("note cannot exceed 255 characters")
+	}
+
+	budget, err := s.loadEnvelopeBudget(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Amount.Currency != "" && req.Amount.Currency != budget.Currency {
+		return nil, apperrors.NewValidationError("amount must be in the budget currency")
+	}
+
+	found := make(map[int32]bool, len(budget.Items))
+	for _, item := range budget.Items {
+		found[item.ID] = true
+	}
+	if !found[req.FromItemId] || !found[req.ToItemId] {
+		return nil, apperrors.NewNotFoundError("budget item")
+	}
+
+	start, _, _ := budgetPeriodWindow(budget, time.Now())
+	move := &models.BudgetMove{
+		BudgetID:    budget.ID,
+		UserID:      userID,
+		FromItemID:  req.FromItemId,
+		ToItemID:    req.ToItemId,
+		Amount:      req.Amount.Amount,
+		Currency:    budget.Currency,
+		PeriodStart: start,
+		Note:        req.Note,
+	}
+	if err := s.envelopeRepo.CreateMove(ctx, move); err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.MoveBudgetMoneyResponse{
+		Success:   true,
+		Message:   "Money moved successfully",
+		Data:      budgetMoveToProto(move),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListBudgetMoves returns the money moved between the envelopes of a budget in the
+// period containing the requested date.
+func (s *budgetService) ListBudgetMoves(ctx context.Context, budgetID int32, userID int32, req *budgetv1.ListBudgetMovesRequest) (*budgetv1.ListBudgetMovesResponse, error) {
+	budget, err := s.loadEnvelopeBudget(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now()
+	if req.Date != 0 {
+		date = time.Unix(req.Date, 0)
+	}
+	start, _, _ := budgetPeriodWindow(budget, date)
+
+	moves, err := s.envelopeRepo.ListMoves(ctx, budget.ID, start)
+	if err != nil {
+		return nil, err
+	}
+	protoMoves := make([]*budgetv1.BudgetMove, 0, len(moves))
+	for _, move := range moves {
+		protoMoves = append(protoMoves, budgetMoveToProto(move))
+	}
+
+	return &budgetv1.ListBudgetMovesResponse{
+		Success:   true,
+		Message:   "Budget moves retrieved successfully",
+		Moves:     protoMoves,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// CloseEndedPeriods snapshots the envelopes of every period that ended before now
+// across all monthly and weekly budgets, oldest first, and returns how many periods
+// were closed. A failing budget is logged and retried on the next run without
+// blocking the others.
+func (s *budgetService) CloseEndedPeriods(ctx context.Context, now time.Time) (int, error) {
+	if s.envelopeRepo == nil {
+		return 0, nil
+	}
+
+	budgets, err := s.budgetRepo.ListByPeriods(ctx, []int32{
+		int32(budgetv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY),
+		int32(budgetv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, budget := range budgets {
+		if ctx.Err() != nil {
+			return total, ctx.Err()
+		}
+
+		closed, err := s.closeBudgetPeriods(ctx, budget, now)
+		total += closed
+		if err != nil {
+			slog.Warn("Failed to close budget periods",
+				"budget_id", budget.ID,
+				"error", err)
+		}
+	}
+	return total, nil
+}
+
+// closeBudgetPeriods closes the ended periods of one budget following the last closed
+// one, or starting with the period its first item was created in.
+func (s *budgetService) closeBudgetPeriods(ctx context.Context, budget *models.Budget, now time.Time) (int, error) {
+	if len(budget.Items) == 0 {
+		return 0, nil
+	}
+
+	latest, err := s.envelopeRepo.LatestSnapshotEnd(ctx, budget.ID)
+	if err != nil {
+		return 0, err
+	}
+	var next time.Time
+	if latest != nil {
+		next = latest.Add(time.Second)
+	} else {
+		next = budget.Items[0].CreatedAt
+		for _, item := range budget.Items {
+			if item.CreatedAt.Before(next) {
+				next = item.CreatedAt
+			}
+		}
+	}
+	next = next.In(now.Location())
+
+	closed := 0
+	for i := 0; i < budgetMaxClosePeriods; i++ {
+		start, end, _ := budgetPeriodWindow(budget, next)
+		if !end.Before(now) {
+			break
+		}
+
+		envelopes, err := s.liveEnvelopes(ctx, budget, start, end)
+		if err != nil {
+			return closed, err
+		}
+		if err := s.envelopeRepo.CreateSnapshots(ctx, envelopes); err != nil {
+			return closed, err
+		}
+		closed++
+		next = end.Add(time.Second)
+	}
+	return closed, nil
+}
+
+// envelopeToProto converts an envelope to protobuf. Carried out is only final once the
+// period is closed.
+func envelopeToProto(envelope *models.BudgetEnvelopeSnapshot, item *models.BudgetItem, closed bool) *budgetv1.BudgetEnvelope {
+	money := func(amount int64) *budgetv1.Money {
+		return &budgetv1.Money{Amount: amount, Currency: envelope.Currency}
+	}
+
+	result := &budgetv1.BudgetEnvelope{
+		BudgetItemId: envelope.BudgetItemID,
+		Allocated:    money(envelope.Allocated),
+		CarriedIn:    money(envelope.CarriedIn),
+		MovedIn:      money(envelope.MovedIn),
+		Spent:        money(envelope.Spent),
+		Available:    money(envelope.Available()),
+	}
+	if item != nil {
+		result.Name = item.Name
+		result.RolloverPolicy = budgetv1.BudgetRolloverPolicy(item.RolloverPolicy)
+	}
+	if closed {
+		result.CarriedOut = money(envelope.CarriedOut)
+	}
+	return result
+}
+
+// budgetMoveToProto converts a budget move to protobuf.
+func budgetMoveToProto(move *models.BudgetMove) *budgetv1.BudgetMove {
+	return &budgetv1.BudgetMove{
+		Id:          move.ID,
+		BudgetId:    move.BudgetID,
+		FromItemId:  move.FromItemID,
+		ToItemId:    move.ToItemID,
+		Amount:      &budgetv1.Money{Amount: move.Amount, Currency: move.Currency},
+		PeriodStart: move.PeriodStart.Unix(),
+		Note:        move.Note,
+		CreatedAt:   move.CreatedAt.Unix(),
+	}
+}
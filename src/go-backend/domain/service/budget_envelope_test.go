@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (r *stubBudgetRepository) ListByPeriods(ctx context.Context, periods []int32) ([]*models.Budget, error) {
+	for _, period := range periods {
+		if r.budget != nil && r.budget.Period == period {
+			return []*models.Budget{r.budget}, nil
+		}
+	}
+	return nil, nil
+}
+
+// stubEnvelopeRepository keeps moves and snapshots in memory.
+type stubEnvelopeRepository struct {
+	moves     []*models.BudgetMove
+	snapshots []*models.BudgetEnvelopeSnapshot
+}
+
+func (r *stubEnvelopeRepository) CreateMove(ctx context.Context, move *models.BudgetMove) error {
+	move.ID = int32(len(r.moves) + 1)
+	r.moves = append(r.moves, move)
+	return nil
+}
+
+func (r *stubEnvelopeRepository) ListMoves(ctx context.Context, budgetID int32, periodStart time.Time) ([]*models.BudgetMove, error) {
+	var moves []*models.BudgetMove
+	for _, move := range r.moves {
+		if move.BudgetID == budgetID && move.PeriodStart.Equal(periodStart) {
+			moves = append(moves, move)
+		}
+	}
+	return moves, nil
+}
+
+func (r *stubEnvelopeRepository) ListSnapshots(ctx context.Context, budgetID int32, periodStart time.Time) ([]*models.BudgetEnvelopeSnapshot, error) {
+	var snapshots []*models.BudgetEnvelopeSnapshot
+	for _, snapshot := range r.snapshots {
+		if snapshot.BudgetID == budgetID && snapshot.PeriodStart.Equal(periodStart) {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots, nil
+}
+
+func (r *stubEnvelopeRepository) LatestSnapshotEnd(ctx context.Context, budgetID int32) (*time.Time, error) {
+	var latest *time.Time
+	for _, snapshot := range r.snapshots {
+		if snapshot.BudgetID == budgetID && (latest == nil || snapshot.PeriodEnd.After(*latest)) {
+			end := snapshot.PeriodEnd
+			latest = &end
+		}
+	}
+	return latest, nil
+}
+
+func (r *stubEnvelopeRepository) CreateSnapshots(ctx context.Context, snapshots []*models.BudgetEnvelopeSnapshot) error {
+	r.snapshots = append(r.snapshots, snapshots...)
+	return nil
+}
+
+// stubMonthlyBreakdownRepository returns a category breakdown per month.
+type stubMonthlyBreakdownRepository struct {
+	repository.TransactionRepository
+	byMonth map[time.Month][]*repository.CategoryBreakdownByCurrency
+}
+
+func (r *stubMonthlyBreakdownRepository) GetCategoryBreakdown(ctx context.Context, userID int32, filter repository.TransactionFilter) ([]*repository.CategoryBreakdownByCurrency, error) {
+	return r.byMonth[filter.StartDate.Month()], nil
+}
+
+func spentVND(categoryID int32, amount int64) *repository.CategoryBreakdownByCurrency {
+	return &repository.CategoryBreakdownByCurrency{CategoryID: categoryID, AmountsByCurrency: map[string]int64{"VND": amount}}
+}
+
+func TestRolloverAmount(t *testing.T) {
+	carryAll := &models.BudgetItem{RolloverPolicy: int32(v1.BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_ALL)}
+	capped := &models.BudgetItem{RolloverPolicy: int32(v1.BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_CAPPED), RolloverCap: 20000}
+
+	assert.Equal(t, int64(0), rolloverAmount(&models.BudgetItem{}, 50000))
+	assert.Equal(t, int64(-40000), rolloverAmount(carryAll, -40000))
+	assert.Equal(t, int64(20000), rolloverAmount(capped, 30000))
+	assert.Equal(t, int64(15000), rolloverAmount(capped, 15000))
+	assert.Equal(t, int64(-20000), rolloverAmount(capped, -90000), "overspending is capped too")
+}
+
+func TestBudgetService_EnvelopeRollover(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, 3, 10, 12, 0, 0, 0, time.Local)
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.Local)
+	march := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+
+	groceries := *boundItem(1, 100000, []int32{10}, nil)
+	groceries.RolloverPolicy = int32(v1.BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_ALL)
+	groceries.CreatedAt = created
+	dining := *boundItem(2, 50000, []int32{11}, nil)
+	dining.RolloverPolicy = int32(v1.BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_CAPPED)
+	dining.RolloverCap = 20000
+	dining.CreatedAt = created
+	budget := &models.Budget{
+		ID: 1, UserID: 7, Name: "Household", Total: 150000, Currency: "VND",
+		Period: int32(v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY),
+		Items:  []models.BudgetItem{groceries, dining},
+	}
+
+	txRepo := &stubMonthlyBreakdownRepository{byMonth: map[time.Month][]*repository.CategoryBreakdownByCurrency{
+		time.March: {spentVND(10, 150000), spentVND(11, 10000)},
+		time.April: {spentVND(10, 30000)},
+		time.May:   {spentVND(10, 5000)},
+	}}
+	envelopes := &stubEnvelopeRepository{moves: []*models.BudgetMove{
+		{BudgetID: 1, FromItemID: 2, ToItemID: 1, Amount: 10000, Currency: "VND", PeriodStart: march},
+	}}
+
+	svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{}, nil, nil, nil).(*budgetService)
+	svc.SetSpendingRepositories(txRepo, nil, nil)
+	svc.SetEnvelopeRepository(envelopes)
+
+	closed, err := svc.CloseEndedPeriods(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, closed, "March and April ended, May is still open")
+
+	closed, err = svc.CloseEndedPeriods(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, closed, "closed periods are not closed again")
+
+	// April comes from its snapshot, which carried March's balances in
+	april, err := svc.GetBudgetEnvelopes(ctx, 1, 7, &v1.GetBudgetEnvelopesRequest{Date: time.Date(2024, 4, 20, 0, 0, 0, 0, time.Local).Unix()})
+	require.NoError(t, err)
+	assert.True(t, april.Closed)
+	require.Len(t, april.Envelopes, 2)
+	// Groceries: 100000 + 10000 moved in - 150000 spent in March carries -40000
+	assert.Equal(t, int64(-40000), april.Envelopes[0].CarriedIn.Amount)
+	assert.Equal(t, int64(30000), april.Envelopes[0].Available.Amount)
+	assert.Equal(t, int64(30000), april.Envelopes[0].CarriedOut.Amount)
+	// Dining: 50000 - 10000 moved out - 10000 spent in March carries the 20000 cap
+	assert.Equal(t, int64(20000), april.Envelopes[1].CarriedIn.Amount)
+	assert.Equal(t, int64(70000), april.Envelopes[1].Available.Amount)
+	assert.Equal(t, int64(20000), april.Envelopes[1].CarriedOut.Amount)
+
+	// Editing an old transaction does not change a closed period
+	txRepo.byMonth[time.April] = []*repository.CategoryBreakdownByCurrency{spentVND(10, 999999)}
+	april, err = svc.GetBudgetEnvelopes(ctx, 1, 7, &v1.GetBudgetEnvelopesRequest{Date: time.Date(2024, 4, 20, 0, 0, 0, 0, time.Local).Unix()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(30000), april.Envelopes[0].Spent.Amount)
+
+	// May is live and starts from April's carry-over
+	may, err := svc.GetBudgetEnvelopes(ctx, 1, 7, &v1.GetBudgetEnvelopesRequest{Date: now.Unix()})
+	require.NoError(t, err)
+	assert.False(t, may.Closed)
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local).Unix(), may.PeriodStart)
+	assert.Equal(t, int64(30000), may.Envelopes[0].CarriedIn.Amount)
+	assert.Equal(t, int64(125000), may.Envelopes[0].Available.Amount)
+	assert.Nil(t, may.Envelopes[0].CarriedOut, "carry-over is only final once closed")
+	assert.Equal(t, "Item 1", may.Envelopes[0].Name)
+}
+
+func TestBudgetService_MoveBudgetMoney(t *testing.T) {
+	ctx := context.Background()
+	budget := &models.Budget{
+		ID: 1, UserID: 7, Name: "Household", Currency: "VND",
+		Period: int32(v1.BudgetPeriod_BUDGET_PERIOD_WEEKLY),
+		Items:  []models.BudgetItem{{ID: 1, BudgetID: 1}, {ID: 2, BudgetID: 1}},
+	}
+	envelopes := &stubEnvelopeRepository{}
+	svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{}, nil, nil, nil).(*budgetService)
+	svc.SetEnvelopeRepository(envelopes)
+
+	resp, err := svc.MoveBudgetMoney(ctx, 1, 7, &v1.MoveBudgetMoneyRequest{
+		FromItemId: 1, ToItemId: 2, Amount: &v1.Money{Amount: 25000, Currency: "VND"}, Note: "Dinner out",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Data.Id)
+	require.Len(t, envelopes.moves, 1)
+	weekStart, _, _ := budgetPeriodWindow(budget, time.Now())
+	assert.Equal(t, weekStart, envelopes.moves[0].PeriodStart, "moves land in the current period")
+
+	moves, err := svc.ListBudgetMoves(ctx, 1, 7, &v1.ListBudgetMovesRequest{})
+	require.NoError(t, err)
+	require.Len(t, moves.Moves, 1)
+	assert.Equal(t, "Dinner out", moves.Moves[0].Note)
+
+	invalid := []struct {
+		name    string
+		req     *v1.MoveBudgetMoneyRequest
+		errType interface{}
+	}{
+		{name: "same envelope", req: &v1.MoveBudgetMoneyRequest{FromItemId: 1, ToItemId: 1, Amount: &v1.Money{Amount: 1}}, errType: apperrors.ValidationError{}},
+		{name: "no amount", req: &v1.MoveBudgetMoneyRequest{FromItemId: 1, ToItemId: 2}, errType: apperrors.ValidationError{}},
+		{name: "other currency", req: &v1.MoveBudgetMoneyRequest{FromItemId: 1, ToItemId: 2, Amount: &v1.Money{Amount: 1, Currency: "USD"}}, errType: apperrors.ValidationError{}},
+		{name: "unknown envelope", req: &v1.MoveBudgetMoneyRequest{FromItemId: 1, ToItemId: 9, Amount: &v1.Money{Amount: 1}}, errType: apperrors.NotFoundError{}},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.MoveBudgetMoney(ctx, 1, 7, tc.req)
+			assert.IsType(t, tc.errType, err)
+		})
+	}
+	assert.Len(t, envelopes.moves, 1)
+
+	t.Run("checklist budget", func(t *testing.T) {
+		checklist := &models.Budget{ID: 2, UserID: 7, Items: budget.Items}
+		svc := NewBudgetService(&stubBudgetRepository{budget: checklist}, &stubBudgetItemRepository{}, nil, nil, nil).(*budgetService)
+		svc.SetEnvelopeRepository(envelopes)
+		_, err := svc.MoveBudgetMoney(ctx, 2, 7, &v1.MoveBudgetMoneyRequest{FromItemId: 1, ToItemId: 2, Amount: &v1.Money{Amount: 1}})
+		assert.IsType(t, apperrors.ValidationError{}, err)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{}, nil, nil, nil)
+		_, err := svc.GetBudgetEnvelopes(ctx, 1, 7, &v1.GetBudgetEnvelopesRequest{})
+		assert.IsType(t, apperrors.ServiceUnavailableError{}, err)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"wealthjourney/domain/repository"
 	apperrors "wealthjourney/pkg/errors"
 	"wealthjourney/pkg/cache"
+	"wealthjourney/pkg/notification"
 	"wealthjourney/pkg/types"
 	"wealthjourney/pkg/validator"
 	budgetv1 "wealthjourney/protobuf/v1"
@@ -22,6 +23,15 @@ type budgetService struct {
 	fxRateSvc      FXRateService
 	currencyCache  *cache.CurrencyCache
 	mapper         *BudgetMapper
+
+	// Optional, for items bound to categories and wallets
+	txRepo       repository.TransactionRepository
+	categoryRepo repository.CategoryRepository
+	walletRepo   repository.WalletRepository
+	envelopeRepo repository.BudgetEnvelopeRepository
+	alertRepo    repository.BudgetAlertRepository
+	notifier     *notification.Dispatcher
+	templateRepo repository.BudgetTemplateRepository
 }
 
 // NewBudgetService creates a new BudgetService.
@@ -62,6 +72,15 @@ func (s *budgetService) GetBudget(ctx context.Context, budgetID int32, userID in
 	// Enrich with conversion fields
 	s.enrichBudgetProto(ctx, userID, budgetProto, budget)
 
+	// Add the spending of the current period
+	spending, err := s.computeSpending(ctx, userID, budget, budgetItemPointers(budget), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if spending != nil {
+		spending.applyToBudget(budgetProto, budget)
+	}
+
 	return &budgetv1.GetBudgetResponse{
 		Success:   true,
 		Message:   "Budget retrieved successfully",
@@ -132,16 +151,33 @@ func (s *budgetService) CreateBudget(ctx context.Context, userID int32, req *bud
 		return nil, apperrors.NewNotFoundError("user")
 	}
 
+	// Validate period
+	startDate, endDate, err := budgetPeriodFields(req.Period, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create budget model
 	total := int64(0)
+	currency := types.VND
 	if req.Total != nil {
 		total = req.Total.Amount
+		if req.Total.Currency != "" {
+			currency = req.Total.Currency
+		}
+	}
+	if err := validator.Currency(currency); err != nil {
+		return nil, err
 	}
 
 	budget := &models.Budget{
-		UserID: userID,
-		Name:   req.Name,
-		Total:  total,
+		UserID:    userID,
+		Name:      req.Name,
+		Total:     total,
+		Currency:  currency,
+		Period:    int32(req.Period),
+		StartDate: startDate,
+		EndDate:   endDate,
 	}
 
 	if err := s.budgetRepo.Create(ctx, budget); err != nil {
@@ -161,15 +197,30 @@ func (s *budgetService) CreateBudget(ctx context.Context, userID int32, req *bud
 				return nil, err
 			}
 
+			// Validate item categories, wallets and rollover
+			if err := s.validateItemBindings(ctx, userID, itemReq.CategoryIds, itemReq.WalletIds); err != nil {
+				return nil, err
+			}
+			if err := validateRolloverPolicy(itemReq.RolloverPolicy, itemReq.RolloverCap); err != nil {
+				return nil, err
+			}
+
 			itemTotal := int64(0)
 			if itemReq.Total != nil {
 				itemTotal = itemReq.Total.Amount
 			}
 
 			item := &models.BudgetItem{
-				BudgetID: budget.ID,
-				Name:     itemReq.Name,
-				Total:    itemTotal,
+				BudgetID:   budget.ID,
+				Name:       itemReq.Name,
+				Total:      itemTotal,
+				Currency:   budget.Currency,
+				Categories: repository.BudgetItemCategoryRows(itemReq.CategoryIds),
+				Wallets:    repository.BudgetItemWalletRows(itemReq.WalletIds),
+			}
+			item.RolloverPolicy = int32(itemReq.RolloverPolicy)
+			if itemReq.RolloverCap != nil {
+				item.RolloverCap = itemReq.RolloverCap.Amount
 			}
 
 			if err := s.budgetItemRepo.Create(ctx, item); err != nil {
@@ -224,6 +275,33 @@ func (s *budgetService) UpdateBudget(ctx context.Context, budgetID int32, userID
 		return nil, err
 	}
 
+	// Update period if any of its fields is provided
+	if req.Period != nil || req.StartDate != nil || req.EndDate != nil {
+		period := budgetv1.BudgetPeriod(budget.Period)
+		if req.Period != nil {
+			period = *req.Period
+		}
+		var startDate, endDate int64
+		if budget.StartDate != nil {
+			startDate = budget.StartDate.Unix()
+		}
+		if budget.EndDate != nil {
+			endDate = budget.EndDate.Unix()
+		}
+		if req.StartDate != nil {
+			startDate = *req.StartDate
+		}
+		if req.EndDate != nil {
+			endDate = *req.EndDate
+		}
+
+		budget.StartDate, budget.EndDate, err = budgetPeriodFields(period, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		budget.Period = int32(period)
+	}
+
 	// Update fields
 	if req.Total != nil {
 		budget.Total = req.Total.Amount
@@ -276,6 +354,13 @@ func (s *budgetService) DeleteBudget(ctx context.Context, budgetID int32, userID
 		return nil, err
 	}
 
+	// Alert rules are useless without the budget; a failure only leaves them unevaluated
+	if s.alertRepo != nil {
+		if err := s.alertRepo.DeleteRulesByBudgetID(ctx, budgetID); err != nil {
+			fmt.Printf("Warning: failed to delete alert rules of budget %d: %v\n", budgetID, err)
+		}
+	}
+
 	// Invalidate currency cache
 	if err := s.invalidateBudgetCache(ctx, userID, budgetID); err != nil {
 		fmt.Printf("Warning: failed to invalidate currency cache for budget %d: %v\n", budgetID, err)
@@ -314,6 +399,17 @@ func (s *budgetService) GetBudgetItems(ctx context.Context, budgetID int32, user
 	// Enrich with conversion fields
 	s.enrichBudgetItemSliceProto(ctx, userID, protoItems, items, budget.Currency)
 
+	// Add the spending of the budget's current period
+	spending, err := s.computeSpending(ctx, userID, budget, items, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if spending != nil {
+		for i, itemProto := range protoItems {
+			spending.applyToItem(itemProto, items[i])
+		}
+	}
+
 	return &budgetv1.GetBudgetItemsResponse{
 		Success:   true,
 		Message:   "Budget items retrieved successfully",
@@ -343,11 +439,19 @@ func (s *budgetService) CreateBudgetItem(ctx context.Context, budgetID int32, us
 	}
 
 	// Verify budget ownership
-	_, err := s.budgetRepo.GetByIDForUser(ctx, budgetID, userID)
+	budget, err := s.budgetRepo.GetByIDForUser(ctx, budgetID, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Validate item categories, wallets and rollover
+	if err := s.validateItemBindings(ctx, userID, req.CategoryIds, req.WalletIds); err != nil {
+		return nil, err
+	}
+	if err := validateRolloverPolicy(req.RolloverPolicy, req.RolloverCap); err != nil {
+		return nil, err
+	}
+
 	// Create budget item
 	total := int64(0)
 	if req.Total != nil {
@@ -355,24 +459,26 @@ func (s *budgetService) CreateBudgetItem(ctx context.Context, budgetID int32, us
 	}
 
 	item := &models.BudgetItem{
-		BudgetID: budgetID,
-		Name:     req.Name,
-		Total:    total,
+		BudgetID:   budgetID,
+		Name:       req.Name,
+		Total:      total,
+		Currency:   budget.Currency,
+		Categories: repository.BudgetItemCategoryRows(req.CategoryIds),
+		Wallets:    repository.BudgetItemWalletRows(req.WalletIds),
+	}
+	item.RolloverPolicy = int32(req.RolloverPolicy)
+	if req.RolloverCap != nil {
+		item.RolloverCap = req.RolloverCap.Amount
 	}
 
 	if err := s.budgetItemRepo.Create(ctx, item); err != nil {
 		return nil, err
 	}
 
-	// Get budget to determine currency
-	budget, _ := s.budgetRepo.GetByID(ctx, budgetID)
-
 	// Populate currency cache
-	if budget != nil {
-		if err := s.populateBudgetItemCache(ctx, userID, item, budget.Currency); err != nil {
-			// Log error but don't fail - cache population is not critical
-			fmt.Printf("Warning: failed to populate currency cache for budget item %d: %v\n", item.ID, err)
-		}
+	if err := s.populateBudgetItemCache(ctx, userID, item, budget.Currency); err != nil {
+		// Log error but don't fail - cache population is not critical
+		fmt.Printf("Warning: failed to populate currency cache for budget item %d: %v\n", item.ID, err)
 	}
 
 	return &budgetv1.CreateBudgetItemResponse{
@@ -432,9 +538,45 @@ func (s *budgetService) UpdateBudgetItem(ctx context.Context, budgetID int32, it
 	// Handle checked field - protobuf provides default false for bool
 	item.Checked = req.Checked
 
+	// Update rollover if provided
+	if req.RolloverPolicy != nil {
+		if err := validateRolloverPolicy(*req.RolloverPolicy, req.RolloverCap); err != nil {
+			return nil, err
+		}
+		item.RolloverPolicy = int32(*req.RolloverPolicy)
+	}
+	if req.RolloverCap != nil {
+		if req.RolloverCap.Amount < 0 {
+			return nil, apperrors.NewValidationError("rollover cap cannot be negative")
+		}
+		item.RolloverCap = req.RolloverCap.Amount
+	}
+
+	// Validate replacement categories and wallets before writing anything
+	replaceCategories := req.ClearCategories || len(req.CategoryIds) > 0
+	replaceWallets := req.ClearWallets || len(req.WalletIds) > 0
+	if err := s.validateItemBindings(ctx, userID, req.CategoryIds, req.WalletIds); err != nil {
+		return nil, err
+	}
+
 	if err := s.budgetItemRepo.Update(ctx, item); err != nil {
 		return nil, err
 	}
+	if replaceCategories {
+		if err := s.budgetItemRepo.ReplaceCategories(ctx, itemID, req.CategoryIds); err != nil {
+			return nil, err
+		}
+	}
+	if replaceWallets {
+		if err := s.budgetItemRepo.ReplaceWallets(ctx, itemID, req.WalletIds); err != nil {
+			return nil, err
+		}
+	}
+	if replaceCategories || replaceWallets {
+		if item, err = s.budgetItemRepo.GetByIDForBudget(ctx, itemID, budgetID); err != nil {
+			return nil, err
+		}
+	}
 
 	// Get budget to determine currency
 	budget, _ := s.budgetRepo.GetByID(ctx, budgetID)
@@ -487,6 +629,12 @@ func (s *budgetService) DeleteBudgetItem(ctx context.Context, budgetID int32, it
 		return nil, err
 	}
 
+	if s.alertRepo != nil {
+		if err := s.alertRepo.DeleteRulesByBudgetItemID(ctx, itemID); err != nil {
+			fmt.Printf("Warning: failed to delete alert rules of budget item %d: %v\n", itemID, err)
+		}
+	}
+
 	// Invalidate currency cache
 	if err := s.invalidateBudgetItemCache(ctx, userID, itemID); err != nil {
 		fmt.Printf("Warning: failed to invalidate currency cache for budget item %d: %v\n", itemID, err)
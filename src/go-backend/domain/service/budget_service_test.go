@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	apperrors "wealthjourney/pkg/errors"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetService_CreateBudget_Currency(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTemplateTestService(&stubMonthlyBreakdownRepository{})
+
+	created, err := svc.CreateBudget(ctx, 7, &v1.CreateBudgetRequest{
+		Name:   "April",
+		Total:  &v1.Money{Amount: 400000, Currency: "VND"},
+		Period: v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "VND", created.Data.Total.Currency)
+
+	for _, currency := range []string{"usd", "DOLLARS", "U$D"} {
+		_, err = svc.CreateBudget(ctx, 7, &v1.CreateBudgetRequest{
+			Name:   "April",
+			Total:  &v1.Money{Amount: 400000, Currency: currency},
+			Period: v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+		})
+		assert.IsType(t, apperrors.ValidationError{}, err, currency)
+	}
+}
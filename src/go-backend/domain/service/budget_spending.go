@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	budgetv1 "wealthjourney/protobuf/v1"
+)
+
+// SetSpendingRepositories lets budgets bind items to categories and wallets and track
+// the spending of their period. Without them, budgets are plain checklists.
+func (s *budgetService) SetSpendingRepositories(txRepo repository.TransactionRepository, categoryRepo repository.CategoryRepository, walletRepo repository.WalletRepository) {
+	s.txRepo = txRepo
+	s.categoryRepo = categoryRepo
+	s.walletRepo = walletRepo
+}
+
+// budgetPeriodFields validates a period and returns the dates to store with it. Only
+// custom periods keep dates; the end date covers the whole day it falls on.
+func budgetPeriodFields(period budgetv1.BudgetPeriod, startDate, endDate int64) (*time.Time, *time.Time, error) {
+	switch period {
+	case budgetv1.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED,
+		budgetv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+		budgetv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY:
+		return nil, nil, nil
+	case budgetv1.BudgetPeriod_BUDGET_PERIOD_CUSTOM:
+		if startDate == 0 || endDate == 0 {
+			return nil, nil, apperrors.NewValidationError("startDate and endDate are required for a custom period")
+		}
+		start := time.Unix(startDate, 0)
+		end := endOfDay(time.Unix(endDate, 0))
+		if end.Before(start) {
+			return nil, nil, apperrors.NewValidationError("endDate cannot be before startDate")
+		}
+		return &start, &end, nil
+	default:
+		return nil, nil, apperrors.NewValidationError("invalid budget period")
+	}
+}
+
+// budgetPeriodWindow returns the window of the budget's period that contains now, both
+// ends inclusive. Weeks start on Monday. ok is false for budgets without a period.
+func budgetPeriodWindow(budget *models.Budget, now time.Time) (start, end time.Time, ok bool) {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch budgetv1.BudgetPeriod(budget.Period) {
+	case budgetv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY:
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 1, 0).Add(-time.Second), true
+	case budgetv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY:
+		start = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7).Add(-time.Second), true
+	case budgetv1.BudgetPeriod_BUDGET_PERIOD_CUSTOM:
+		if budget.StartDate == nil || budget.EndDate == nil {
+			return time.Time{}, time.Time{}, false
+		}
+		return *budget.StartDate, *budget.EndDate, true
+	default:
+		return time.Time{}, time.Time{}, false
+	}
+}
+
+// endOfDay returns the last second of the day t falls on.
+func endOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1).Add(-time.Second)
+}
+
+// validateItemBindings checks that the categories are expense categories of the user
+// and the wallets are the user's.
+func (s *budgetService) validateItemBindings(ctx context.Context, userID int32, categoryIDs, walletIDs []int32) error {
+	if len(categoryIDs) == 0 && len(walletIDs) == 0 {
+		return nil
+	}
+	if s.categoryRepo == nil || s.walletRepo == nil {
+		return apperrors.NewServiceUnavailableError("budget spending tracking is not configured")
+	}
+
+	for _, categoryID := range categoryIDs {
+		category, err := s.categoryRepo.GetByIDForUser(ctx, categoryID, userID)
+		if err != nil {
+			return err
+		}
+		if category.GetCategoryType() != budgetv1.CategoryType_CATEGORY_TYPE_EXPENSE {
+			return apperrors.NewValidationError(fmt.Sprintf("category %d is not an expense category", categoryID))
+		}
+	}
+	for _, walletID := range walletIDs {
+		if _, err := s.walletRepo.GetByIDForUser(ctx, walletID, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// budgetSpending is the spending of a budget over a period, in the budget currency.
+type budgetSpending struct {
+	currency string
+	start    time.Time
+	end      time.Time
+	byItem   map[int32]int64
+	total    int64
+}
+
+// computeSpending sums the spending of the items over the period of the budget that
+// contains now. Returns nil for budgets without a period or when spending tracking is
+// not configured.
+func (s *budgetService) computeSpending(ctx context.Context, userID int32, budget *models.Budget, items []*models.BudgetItem, now time.Time) (*budgetSpending, error) {
+	if s.txRepo == nil {
+		return nil, nil
+	}
+	start, end, ok := budgetPeriodWindow(budget, now)
+	if !ok {
+		return nil, nil
+	}
+	return s.spendingBetween(ctx, userID, budget, items, start, end)
+}
+
+// spendingBetween sums the spending of the items bound to categories from start to end.
+// Amounts in other currencies are converted to the budget currency; those that cannot
+// be converted are left out. Without spending tracking nothing is spent.
+func (s *budgetService) spendingBetween(ctx context.Context, userID int32, budget *models.Budget, items []*models.BudgetItem, start, end time.Time) (*budgetSpending, error) {
+	spending := &budgetSpending{
+		currency: budget.Currency,
+		start:    start,
+		end:      end,
+		byItem:   make(map[int32]int64),
+	}
+
+	// Items restricted to the same wallets share one breakdown
+	breakdowns := make(map[string]map[int32]map[string]int64)
+	expense := budgetv1.TransactionType_TRANSACTION_TYPE_EXPENSE
+	for _, item := range items {
+		categoryIDs := item.CategoryIDs()
+		if len(categoryIDs) == 0 || s.txRepo == nil {
+			continue
+		}
+
+		walletIDs := item.WalletIDs()
+		sort.Slice(walletIDs, func(i, j int) bool { return walletIDs[i] < walletIDs[j] })
+		key := fmt.Sprint(walletIDs)
+		byCategory, cached := breakdowns[key]
+		if !cached {
+			rows, err := s.txRepo.GetCategoryBreakdown(ctx, userID, repository.TransactionFilter{
+				WalletIDs:        walletIDs,
+				StartDate:        &start,
+				EndDate:          &end,
+				Type:             &expense,
+				ExcludeTransfers: true,
+			})
+			if err != nil {
+				return nil, err
+			}
+			byCategory = make(map[int32]map[string]int64, len(rows))
+			for _, row := range rows {
+				byCategory[row.CategoryID] = row.AmountsByCurrency
+			}
+			breakdowns[key] = byCategory
+		}
+
+		var spent int64
+		for _, categoryID := range categoryIDs {
+			for currency, amount := range byCategory[categoryID] {
+				spent += s.convertSpent(ctx, amount, currency, budget.Currency)
+			}
+		}
+		spending.byItem[item.ID] = spent
+		spending.total += spent
+	}
+
+	return spending, nil
+}
+
+// convertSpent converts a spent amount to the budget currency, or returns 0 when no
+// rate is available.
+func (s *budgetService) convertSpent(ctx context.Context, amount int64, from, to string) int64 {
+	if amount < 0 {
+		amount = -amount
+	}
+	if from == to {
+		return amount
+	}
+	if s.fxRateSvc == nil {
+		return 0
+	}
+	converted, err := s.fxRateSvc.ConvertAmount(ctx, amount, from, to)
+	if err != nil {
+		fmt.Printf("Warning: failed to convert budget spending from %s to %s: %v\n", from, to, err)
+		return 0
+	}
+	return converted
+}
+
+// applyToBudget sets the period window and the spending of the budget.
+func (sp *budgetSpending) applyToBudget(budgetProto *budgetv1.Budget, budget *models.Budget) {
+	budgetProto.PeriodStart = sp.start.Unix()
+	budgetProto.PeriodEnd = sp.end.Unix()
+	budgetProto.Spent = &budgetv1.Money{Amount: sp.total, Currency: sp.currency}
+	budgetProto.Remaining = &budgetv1.Money{Amount: budget.Total - sp.total, Currency: sp.currency}
+	budgetProto.PercentUsed = percentUsed(sp.total, budget.Total)
+}
+
+// applyToItem sets the spending of an item bound to categories.
+func (sp *budgetSpending) applyToItem(itemProto *budgetv1.BudgetItem, item *models.BudgetItem) {
+	spent, ok := sp.byItem[item.ID]
+	if !ok {
+		return
+	}
+	itemProto.Spent = &budgetv1.Money{Amount: spent, Currency: sp.currency}
+	itemProto.Remaining = &budgetv1.Money{Amount: item.Total - spent, Currency: sp.currency}
+	itemProto.PercentUsed = percentUsed(spent, item.Total)
+}
+
+// percentUsed returns spent as a percentage of total, rounded to two decimals, or 0
+// for a zero total.
+func percentUsed(spent, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(spent)/float64(total)*10000) / 100
+}
+
+// budgetItemPointers returns pointers to the preloaded items of a budget.
+func budgetItemPointers(budget *models.Budget) []*models.BudgetItem {
+	items := make([]*models.BudgetItem, len(budget.Items))
+	for i := range budget.Items {
+		items[i] = &budget.Items[i]
+	}
+	return items
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBudgetRepository serves one budget.
+type stubBudgetRepository struct {
+	repository.BudgetRepository
+	budget *models.Budget
+}
+
+func (r *stubBudgetRepository) GetByIDForUser(ctx context.Context, budgetID, userID int32) (*models.Budget, error) {
+	if r.budget == nil || r.budget.ID != budgetID || r.budget.UserID != userID {
+		return nil, apperrors.NewNotFoundError("budget")
+	}
+	return r.budget, nil
+}
+
+// stubBudgetItemRepository serves the items of the budget and records created items.
+type stubBudgetItemRepository struct {
+	repository.BudgetItemRepository
+	items   []*models.BudgetItem
+	created []*models.BudgetItem
+}
+
+func (r *stubBudgetItemRepository) ListByBudgetID(ctx context.Context, budgetID int32) ([]*models.BudgetItem, error) {
+	return r.items, nil
+}
+
+func (r *stubBudgetItemRepository) Create(ctx context.Context, item *models.BudgetItem) error {
+	r.created = append(r.created, item)
+	return nil
+}
+
+// stubBudgetCategoryRepository serves categories of one user by ID.
+type stubBudgetCategoryRepository struct {
+	repository.CategoryRepository
+	categories map[int32]*models.Category
+}
+
+func (r *stubBudgetCategoryRepository) GetByIDForUser(ctx context.Context, categoryID, userID int32) (*models.Category, error) {
+	category, ok := r.categories[categoryID]
+	if !ok || category.UserID != userID {
+		return nil, apperrors.NewNotFoundError("category")
+	}
+	return category, nil
+}
+
+// stubBreakdownRepository returns a category breakdown per wallet selection and records
+// the filters it was asked for.
+type stubBreakdownRepository struct {
+	repository.TransactionRepository
+	byWallets map[string][]*repository.CategoryBreakdownByCurrency
+	filters   []repository.TransactionFilter
+}
+
+func (r *stubBreakdownRepository) GetCategoryBreakdown(ctx context.Context, userID int32, filter repository.TransactionFilter) ([]*repository.CategoryBreakdownByCurrency, error) {
+	r.filters = append(r.filters, filter)
+	return r.byWallets[fmt.Sprint(filter.WalletIDs)], nil
+}
+
+func boundItem(id int32, total int64, categoryIDs []int32, walletIDs []int32) *models.BudgetItem {
+	item := &models.BudgetItem{ID: id, BudgetID: 1, Name: fmt.Sprintf("Item %d", id), Total: total, Currency: "VND"}
+	item.Categories = repository.BudgetItemCategoryRows(categoryIDs)
+	item.Wallets = repository.BudgetItemWalletRows(walletIDs)
+	return item
+}
+
+func TestBudgetPeriodWindow(t *testing.T) {
+	wednesday := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+
+	start, end, ok := budgetPeriodWindow(&models.Budget{Period: int32(v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY)}, wednesday)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 5, 31, 23, 59, 59, 0, time.UTC), end)
+
+	start, end, ok = budgetPeriodWindow(&models.Budget{Period: int32(v1.BudgetPeriod_BUDGET_PERIOD_WEEKLY)}, wednesday)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), start, "weeks start on Monday")
+	assert.Equal(t, time.Date(2024, 5, 19, 23, 59, 59, 0, time.UTC), end)
+
+	sunday := time.Date(2024, 5, 19, 8, 0, 0, 0, time.UTC)
+	start, _, _ = budgetPeriodWindow(&models.Budget{Period: int32(v1.BudgetPeriod_BUDGET_PERIOD_WEEKLY)}, sunday)
+	assert.Equal(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), start, "Sunday ends the week")
+
+	from, to := time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 10, 23, 59, 59, 0, time.UTC)
+	start, end, ok = budgetPeriodWindow(&models.Budget{Period: int32(v1.BudgetPeriod_BUDGET_PERIOD_CUSTOM), StartDate: &from, EndDate: &to}, wednesday)
+	require.True(t, ok)
+	assert.Equal(t, from, start)
+	assert.Equal(t, to, end)
+
+	_, _, ok = budgetPeriodWindow(&models.Budget{}, wednesday)
+	assert.False(t, ok, "checklists have no period")
+}
+
+func TestBudgetPeriodFields(t *testing.T) {
+	start := time.Date(2024, 4, 20, 0, 0, 0, 0, time.Local)
+	end := time.Date(2024, 6, 10, 9, 0, 0, 0, time.Local)
+
+	from, to, err := budgetPeriodFields(v1.BudgetPeriod_BUDGET_PERIOD_CUSTOM, start.Unix(), end.Unix())
+	require.NoError(t, err)
+	assert.Equal(t, start.Unix(), from.Unix())
+	assert.Equal(t, time.Date(2024, 6, 10, 23, 59, 59, 0, time.Local).Unix(), to.Unix(), "the end date covers its whole day")
+
+	from, to, err = budgetPeriodFields(v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY, start.Unix(), end.Unix())
+	require.NoError(t, err)
+	assert.Nil(t, from, "only custom periods keep dates")
+	assert.Nil(t, to)
+
+	_, _, err = budgetPeriodFields(v1.BudgetPeriod_BUDGET_PERIOD_CUSTOM, start.Unix(), 0)
+	assert.IsType(t, apperrors.ValidationError{}, err)
+	_, _, err = budgetPeriodFields(v1.BudgetPeriod_BUDGET_PERIOD_CUSTOM, end.AddDate(0, 0, 2).Unix(), end.Unix())
+	assert.IsType(t, apperrors.ValidationError{}, err)
+	_, _, err = budgetPeriodFields(v1.BudgetPeriod(42), 0, 0)
+	assert.IsType(t, apperrors.ValidationError{}, err)
+}
+
+func TestBudgetService_GetBudgetItemsSpending(t *testing.T) {
+	ctx := context.Background()
+	budget := &models.Budget{ID: 1, UserID: 7, Name: "Household", Total: 1000000, Currency: "VND", Period: int32(v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY)}
+	items := []*models.BudgetItem{
+		boundItem(1, 600000, []int32{10}, nil),
+		boundItem(2, 400000, []int32{11, 12}, []int32{5}),
+		boundItem(3, 200000, nil, nil),
+	}
+	for _, item := range items {
+		budget.Items = append(budget.Items, *item)
+	}
+
+	txRepo := &stubBreakdownRepository{byWallets: map[string][]*repository.CategoryBreakdownByCurrency{
+		"[]": {
+			{CategoryID: 10, AmountsByCurrency: map[string]int64{"VND": 300000, "USD": 1000}},
+			{CategoryID: 11, AmountsByCurrency: map[string]int64{"VND": 999999}},
+		},
+		"[5]": {
+			{CategoryID: 11, AmountsByCurrency: map[string]int64{"VND": 350000}},
+			{CategoryID: 12, AmountsByCurrency: map[string]int64{"VND": 150000, "EUR": 500}},
+		},
+	}}
+	fxRateSvc := new(MockFXRateService)
+	fxRateSvc.On("ConvertAmount", mock.Anything, int64(1000), "USD", "VND").Return(int64(250000), nil)
+	fxRateSvc.On("ConvertAmount", mock.Anything, int64(500), "EUR", "VND").Return(int64(0), fmt.Errorf("no rate"))
+
+	svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{items: items}, nil, fxRateSvc, nil).(*budgetService)
+	svc.SetSpendingRepositories(txRepo, nil, nil)
+
+	resp, err := svc.GetBudgetItems(ctx, 1, 7)
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 3)
+
+	// USD converted; EUR without a rate left out
+	assert.Equal(t, int64(550000), resp.Items[0].Spent.Amount)
+	assert.Equal(t, int64(50000), resp.Items[0].Remaining.Amount)
+	assert.Equal(t, 91.67, resp.Items[0].PercentUsed)
+	assert.Equal(t, []int32{10}, resp.Items[0].CategoryIds)
+
+	assert.Equal(t, int64(500000), resp.Items[1].Spent.Amount, "only spending from the item's wallets")
+	assert.Equal(t, int64(-100000), resp.Items[1].Remaining.Amount)
+	assert.Equal(t, 125.0, resp.Items[1].PercentUsed)
+	assert.Equal(t, []int32{5}, resp.Items[1].WalletIds)
+
+	assert.Nil(t, resp.Items[2].Spent, "items without categories track nothing")
+
+	require.Len(t, txRepo.filters, 2, "one breakdown per wallet selection")
+	filter := txRepo.filters[0]
+	assert.True(t, filter.ExcludeTransfers)
+	assert.Equal(t, v1.TransactionType_TRANSACTION_TYPE_EXPENSE, *filter.Type)
+	assert.Equal(t, 1, filter.StartDate.Day())
+
+	budgetResp, err := svc.GetBudget(ctx, 1, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1050000), budgetResp.Data.Spent.Amount)
+	assert.Equal(t, int64(-50000), budgetResp.Data.Remaining.Amount)
+	assert.Equal(t, 105.0, budgetResp.Data.PercentUsed)
+	assert.Equal(t, filter.StartDate.Unix(), budgetResp.Data.PeriodStart)
+	assert.Equal(t, filter.EndDate.Unix(), budgetResp.Data.PeriodEnd)
+}
+
+func TestBudgetService_ChecklistTracksNoSpending(t *testing.T) {
+	budget := &models.Budget{ID: 1, UserID: 7, Name: "Trip", Total: 500000, Currency: "VND"}
+	items := []*models.BudgetItem{boundItem(1, 500000, []int32{10}, nil)}
+	txRepo := &stubBreakdownRepository{}
+
+	svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{items: items}, nil, nil, nil).(*budgetService)
+	svc.SetSpendingRepositories(txRepo, nil, nil)
+
+	resp, err := svc.GetBudgetItems(context.Background(), 1, 7)
+	require.NoError(t, err)
+	assert.Nil(t, resp.Items[0].Spent)
+	assert.Empty(t, txRepo.filters)
+}
+
+func TestBudgetService_CreateBudgetItemBindings(t *testing.T) {
+	ctx := context.Background()
+	budget := &models.Budget{ID: 1, UserID: 7, Name: "Household", Currency: "USD", Period: int32(v1.BudgetPeriod_BUDGET_PERIOD_WEEKLY)}
+	categories := &stubBudgetCategoryRepository{categories: map[int32]*models.Category{
+		10: {ID: 10, UserID: 7, Type: int32(v1.CategoryType_CATEGORY_TYPE_EXPENSE)},
+		20: {ID: 20, UserID: 7, Type: int32(v1.CategoryType_CATEGORY_TYPE_INCOME)},
+	}}
+	wallets := &stubWalletRepository{wallets: map[int32]*models.Wallet{
+		5: {ID: 5, UserID: 7},
+		6: {ID: 6, UserID: 8},
+	}}
+
+	newService := func(itemRepo *stubBudgetItemRepository) *budgetService {
+		svc := NewBudgetService(&stubBudgetRepository{budget: budget}, itemRepo, &stubNoUserRepository{}, nil, nil).(*budgetService)
+		svc.SetSpendingRepositories(&stubBreakdownRepository{}, categories, wallets)
+		return svc
+	}
+
+	t.Run("expense categories and own wallets", func(t *testing.T) {
+		itemRepo := &stubBudgetItemRepository{}
+		resp, err := newService(itemRepo).CreateBudgetItem(ctx, 1, 7, &v1.CreateBudgetItemRequest{
+			Name:        "Groceries",
+			Total:       &v1.Money{Amount: 20000, Currency: "USD"},
+			CategoryIds: []int32{10, 10},
+			WalletIds:   []int32{5},
+		})
+		require.NoError(t, err)
+		require.Len(t, itemRepo.created, 1)
+		assert.Equal(t, "USD", itemRepo.created[0].Currency, "items are in the budget currency")
+		assert.Equal(t, []int32{10}, resp.Data.CategoryIds)
+		assert.Equal(t, []int32{5}, resp.Data.WalletIds)
+	})
+
+	invalid := []struct {
+		name    string
+		req     *v1.CreateBudgetItemRequest
+		errType interface{}
+	}{
+		{name: "income category", req: &v1.CreateBudgetItemRequest{Name: "Salary", CategoryIds: []int32{20}}, errType: apperrors.ValidationError{}},
+		{name: "unknown category", req: &v1.CreateBudgetItemRequest{Name: "Other", CategoryIds: []int32{99}}, errType: apperrors.NotFoundError{}},
+		{name: "another user's wallet", req: &v1.CreateBudgetItemRequest{Name: "Other", CategoryIds: []int32{10}, WalletIds: []int32{6}}, errType: apperrors.NotFoundError{}},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			itemRepo := &stubBudgetItemRepository{}
+			_, err := newService(itemRepo).CreateBudgetItem(ctx, 1, 7, tc.req)
+			require.Error(t, err)
+			assert.IsType(t, tc.errType, err)
+			assert.Empty(t, itemRepo.created)
+		})
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{}, nil, nil, nil)
+		_, err := svc.CreateBudgetItem(ctx, 1, 7, &v1.CreateBudgetItemRequest{Name: "Groceries", CategoryIds: []int32{10}})
+		assert.IsType(t, apperrors.ServiceUnavailableError{}, err)
+	})
+}
@@ -0,0 +1,450 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/types"
+	"wealthjourney/pkg/validator"
+	budgetv1 "wealthjourney/protobuf/v1"
+)
+
+const (
+	// budgetSeedMonths is the number of months before a new period whose average
+	// spending seeds allocations.
+	budgetSeedMonths = 3
+
+	// budgetAdjustMaxPercent bounds the increase of a bulk allocation adjustment.
+	budgetAdjustMaxPercent = 1000
+)
+
+// SetTemplateRepository enables budget templates.
+func (s *budgetService) SetTemplateRepository(templateRepo repository.BudgetTemplateRepository) {
+	s.templateRepo = templateRepo
+}
+
+// CopyBudgetToPeriod creates a new budget with the total and items of a budget. Item
+// categories, wallets and rollover settings are copied; checked states are not. Without
+// dates the copy is pinned to the period after the budget's current one, so a copy of a
+// monthly or weekly budget does not track the same rolling period as its source.
+func (s *budgetService) CopyBudgetToPeriod(ctx context.Context, budgetID int32, userID int32, req *budgetv1.CopyBudgetToPeriodRequest) (*budgetv1.CopyBudgetToPeriodResponse, error) {
+	if err := validator.ID(budgetID); err != nil {
+		return nil, err
+	}
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+
+	budget, err := s.budgetRepo.GetByIDForUser(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq := &budgetv1.CreateBudgetRequest{
+		Name:   req.Name,
+		Total:  &budgetv1.Money{Amount: budget.Total, Currency: budget.Currency},
+		Period: budgetv1.BudgetPeriod(budget.Period),
+	}
+	if createReq.Name == "" {
+		createReq.Name = budget.Name
+	}
+	for _, item := range budgetItemPointers(budget) {
+		createReq.Items = append(createReq.Items, &budgetv1.CreateBudgetItemRequest{
+			Name:           item.Name,
+			Total:          &budgetv1.Money{Amount: item.Total, Currency: budget.Currency},
+			CategoryIds:    item.CategoryIDs(),
+			WalletIds:      item.WalletIDs(),
+			RolloverPolicy: budgetv1.BudgetRolloverPolicy(item.RolloverPolicy),
+			RolloverCap:    &budgetv1.Money{Amount: item.RolloverCap, Currency: budget.Currency},
+		})
+	}
+
+	switch {
+	case req.StartDate != 0 || req.EndDate != 0:
+		createReq.Period = budgetv1.BudgetPeriod_BUDGET_PERIOD_CUSTOM
+		createReq.StartDate = req.StartDate
+		createReq.EndDate = req.EndDate
+	default:
+		if start, end, ok := budgetPeriodWindow(budget, time.Now()); ok {
+			start, end = nextCustomPeriod(start, end)
+			createReq.Period = budgetv1.BudgetPeriod_BUDGET_PERIOD_CUSTOM
+			createReq.StartDate = start.Unix()
+			createReq.EndDate = end.Unix()
+		}
+	}
+
+	created, err := s.createBudgetWithAllocation(ctx, userID, createReq, req.Allocation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.CopyBudgetToPeriodResponse{
+		Success:   true,
+		Message:   "Budget copied successfully",
+		Data:      created.Data,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// CreateBudgetTemplate saves a budget template, from an existing budget or from the
+// items of the request.
+func (s *budgetService) CreateBudgetTemplate(ctx context.Context, userID int32, req *budgetv1.CreateBudgetTemplateRequest) (*budgetv1.CreateBudgetTemplateResponse, error) {
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if s.templateRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("budget templates are not configured")
+	}
+
+	template := &models.BudgetTemplate{
+		UserID: userID,
+		Name:   req.Name,
+		Items:  []models.BudgetTemplateItem{},
+	}
+	if req.SourceBudgetId != 0 {
+		if len(req.Items) > 0 {
+			return nil, apperrors.NewValidationError("items cannot be set with a source budget")
+		}
+		budget, err := s.budgetRepo.GetByIDForUser(ctx, req.SourceBudgetId, userID)
+		if err != nil {
+			return nil, err
+		}
+		if template.Name == "" {
+			template.Name = budget.Name
+		}
+		template.Total = budget.Total
+		template.Currency = budget.Currency
+		template.Period = budget.Period
+		for _, item := range budgetItemPointers(budget) {
+			template.Items = append(template.Items, models.BudgetTemplateItem{
+				Name:           item.Name,
+				Total:          item.Total,
+				CategoryIDs:    item.CategoryIDs(),
+				WalletIDs:      item.WalletIDs(),
+				RolloverPolicy: item.RolloverPolicy,
+				RolloverCap:    item.RolloverCap,
+			})
+		}
+	} else {
+		if err := validateMoneyAmount(req.Total); err != nil {
+			return nil, err
+		}
+		if _, ok := budgetv1.BudgetPeriod_name[int32(req.Period)]; !ok {
+			return nil, apperrors.NewValidationError("invalid budget period")
+		}
+
+		template.Currency = types.VND
+		if req.Total != nil {
+			template.Total = req.Total.Amount
+			if req.Total.Currency != "" {
+				template.Currency = req.Total.Currency
+			}
+		}
+		if err := validator.Currency(template.Currency); err != nil {
+			return nil, err
+		}
+		template.Period = int32(req.Period)
+
+		for _, itemReq := range req.Items {
+			if err := validateBudgetItemName(itemReq.Name); err != nil {
+				return nil, err
+			}
+			if err := validateMoneyAmount(itemReq.Total); err != nil {
+				return nil, err
+			}
+			if err := s.validateItemBindings(ctx, userID, itemReq.CategoryIds, itemReq.WalletIds); err != nil {
+				return nil, err
+			}
+			if err := validateRolloverPolicy(itemReq.RolloverPolicy, itemReq.RolloverCap); err != nil {
+				return nil, err
+			}
+
+			item := models.BudgetTemplateItem{
+				Name:           itemReq.Name,
+				CategoryIDs:    itemReq.CategoryIds,
+				WalletIDs:      itemReq.WalletIds,
+				RolloverPolicy: int32(itemReq.RolloverPolicy),
+			}
+			if itemReq.Total != nil {
+				item.Total = itemReq.Total.Amount
+			}
+			if itemReq.RolloverCap != nil {
+				item.RolloverCap = itemReq.RolloverCap.Amount
+			}
+			template.Items = append(template.Items, item)
+		}
+	}
+	if err := validateBudgetName(template.Name); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.CreateBudgetTemplateResponse{
+		Success:   true,
+		Message:   "Budget template created successfully",
+		Data:      budgetTemplateToProto(template),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListBudgetTemplates lists the budget templates of a user.
+func (s *budgetService) ListBudgetTemplates(ctx context.Context, userID int32) (*budgetv1.ListBudgetTemplatesResponse, error) {
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if s.templateRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("budget templates are not configured")
+	}
+
+	templates, err := s.templateRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	protoTemplates := make([]*budgetv1.BudgetTemplate, 0, len(templates))
+	for _, template := range templates {
+		protoTemplates = append(protoTemplates, budgetTemplateToProto(template))
+	}
+
+	return &budgetv1.ListBudgetTemplatesResponse{
+		Success:   true,
+		Message:   "Budget templates retrieved successfully",
+		Templates: protoTemplates,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// GetBudgetTemplate retrieves a budget template of a user.
+func (s *budgetService) GetBudgetTemplate(ctx context.Context, templateID int32, userID int32) (*budgetv1.GetBudgetTemplateResponse, error) {
+	template, err := s.loadBudgetTemplate(ctx, templateID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.GetBudgetTemplateResponse{
+		Success:   true,
+		Message:   "Budget template retrieved successfully",
+		Data:      budgetTemplateToProto(template),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// DeleteBudgetTemplate deletes a budget template. Budgets created from it are kept.
+func (s *budgetService) DeleteBudgetTemplate(ctx context.Context, templateID int32, userID int32) (*budgetv1.DeleteBudgetTemplateResponse, error) {
+	template, err := s.loadBudgetTemplate(ctx, templateID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Delete(ctx, template.ID); err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.DeleteBudgetTemplateResponse{
+		Success:   true,
+		Message:   "Budget template deleted successfully",
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ApplyBudgetTemplate creates a new budget from a template.
+func (s *budgetService) ApplyBudgetTemplate(ctx context.Context, templateID int32, userID int32, req *budgetv1.ApplyBudgetTemplateRequest) (*budgetv1.ApplyBudgetTemplateResponse, error) {
+	template, err := s.loadBudgetTemplate(ctx, templateID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq := &budgetv1.CreateBudgetRequest{
+		Name:      req.Name,
+		Total:     &budgetv1.Money{Amount: template.Total, Currency: template.Currency},
+		Period:    budgetv1.BudgetPeriod(template.Period),
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+	if createReq.Name == "" {
+		createReq.Name = template.Name
+	}
+	if req.StartDate != 0 || req.EndDate != 0 {
+		createReq.Period = budgetv1.BudgetPeriod_BUDGET_PERIOD_CUSTOM
+	}
+	for _, item := range template.Items {
+		createReq.Items = append(createReq.Items, &budgetv1.CreateBudgetItemRequest{
+			Name:           item.Name,
+			Total:          &budgetv1.Money{Amount: item.Total, Currency: template.Currency},
+			CategoryIds:    item.CategoryIDs,
+			WalletIds:      item.WalletIDs,
+			RolloverPolicy: budgetv1.BudgetRolloverPolicy(item.RolloverPolicy),
+			RolloverCap:    &budgetv1.Money{Amount: item.RolloverCap, Currency: template.Currency},
+		})
+	}
+
+	created, err := s.createBudgetWithAllocation(ctx, userID, createReq, req.Allocation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &budgetv1.ApplyBudgetTemplateResponse{
+		Success:   true,
+		Message:   "Budget created from template successfully",
+		Data:      created.Data,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// loadBudgetTemplate loads a template of the user once templates are available.
+func (s *budgetService) loadBudgetTemplate(ctx context.Context, templateID, userID int32) (*models.BudgetTemplate, error) {
+	if err := validator.ID(templateID); err != nil {
+		return nil, err
+	}
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if s.templateRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("budget templates are not configured")
+	}
+	return s.templateRepo.GetByIDForUser(ctx, templateID, userID)
+}
+
+// createBudgetWithAllocation sets the item allocations of req as asked and creates the
+// budget. The budget total changes by as much as the item totals do.
+func (s *budgetService) createBudgetWithAllocation(ctx context.Context, userID int32, req *budgetv1.CreateBudgetRequest, opts *budgetv1.BudgetAllocationOptions) (*budgetv1.CreateBudgetResponse, error) {
+	if opts != nil && (opts.SeedFromSpending || opts.AdjustPercent != 0) {
+		if math.IsNaN(opts.AdjustPercent) || opts.AdjustPercent <= -100 || opts.AdjustPercent > budgetAdjustMaxPercent {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("adjustPercent must be greater than -100 and at most %d", budgetAdjustMaxPercent))
+		}
+
+		before := createItemsTotal(req.Items)
+		if opts.SeedFromSpending {
+			if err := s.seedAllocations(ctx, userID, req); err != nil {
+				return nil, err
+			}
+		}
+		if opts.AdjustPercent != 0 {
+			for _, item := range req.Items {
+				if item.Total != nil {
+					item.Total.Amount = adjustAllocation(item.Total.Amount, opts.AdjustPercent)
+				}
+			}
+		}
+
+		if req.Total == nil {
+			req.Total = &budgetv1.Money{}
+		}
+		req.Total.Amount = max(req.Total.Amount+createItemsTotal(req.Items)-before, 0)
+	}
+
+	return s.CreateBudget(ctx, userID, req)
+}
+
+// seedAllocations sets the total of each category-linked item to its average monthly
+// spending over the budgetSeedMonths months before the month the new budget starts in.
+// Items that spent nothing keep their total.
+func (s *budgetService) seedAllocations(ctx context.Context, userID int32, req *budgetv1.CreateBudgetRequest) error {
+	if s.txRepo == nil {
+		return apperrors.NewServiceUnavailableError("budget spending tracking is not configured")
+	}
+
+	currency := types.VND
+	if req.Total != nil && req.Total.Currency != "" {
+		currency = req.Total.Currency
+	}
+	reference := time.Now()
+	if req.Period == budgetv1.BudgetPeriod_BUDGET_PERIOD_CUSTOM && req.StartDate != 0 {
+		reference = time.Unix(req.StartDate, 0)
+	}
+	monthStart := time.Date(reference.Year(), reference.Month(), 1, 0, 0, 0, 0, reference.Location())
+	start := monthStart.AddDate(0, -budgetSeedMonths, 0)
+	end := monthStart.Add(-time.Second)
+
+	// Items are numbered by position, as they are not created yet
+	items := make([]*models.BudgetItem, len(req.Items))
+	for i, itemReq := range req.Items {
+		items[i] = &models.BudgetItem{
+			ID:         int32(i + 1),
+			Categories: repository.BudgetItemCategoryRows(itemReq.CategoryIds),
+			Wallets:    repository.BudgetItemWalletRows(itemReq.WalletIds),
+		}
+	}
+	spending, err := s.spendingBetween(ctx, userID, &models.Budget{Currency: currency}, items, start, end)
+	if err != nil {
+		return err
+	}
+
+	for i, itemReq := range req.Items {
+		spent := spending.byItem[int32(i+1)]
+		if spent <= 0 {
+			continue
+		}
+		itemReq.Total = &budgetv1.Money{
+			Amount:   int64(math.Round(float64(spent) / budgetSeedMonths)),
+			Currency: currency,
+		}
+	}
+	return nil
+}
+
+// adjustAllocation changes an amount by a percentage, rounded to the smallest unit.
+func adjustAllocation(amount int64, percent float64) int64 {
+	return int64(math.Round(float64(amount) * (100 + percent) / 100))
+}
+
+// createItemsTotal sums the totals of the items of a create request.
+func createItemsTotal(items []*budgetv1.CreateBudgetItemRequest) int64 {
+	var total int64
+	for _, item := range items {
+		if item.Total != nil {
+			total += item.Total.Amount
+		}
+	}
+	return total
+}
+
+// nextCustomPeriod returns the period of the same length that follows a custom period.
+// Periods spanning whole calendar months move by that many months, others by their
+// number of days.
+func nextCustomPeriod(start, end time.Time) (time.Time, time.Time) {
+	startDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+	nextDay := endDay.AddDate(0, 0, 1)
+
+	if startDay.Day() == 1 && nextDay.Day() == 1 {
+		months := (nextDay.Year()-startDay.Year())*12 + int(nextDay.Month()-startDay.Month())
+		return nextDay, endOfDay(nextDay.AddDate(0, months, -1))
+	}
+
+	// Rounded, so a daylight saving change does not shorten the period
+	days := int(math.Round(nextDay.Sub(startDay).Hours() / 24))
+	return nextDay, endOfDay(nextDay.AddDate(0, 0, days-1))
+}
+
+// budgetTemplateToProto converts a budget template to its protobuf representation.
+func budgetTemplateToProto(template *models.BudgetTemplate) *budgetv1.BudgetTemplate {
+	items := make([]*budgetv1.BudgetTemplateItem, 0, len(template.Items))
+	for _, item := range template.Items {
+		items = append(items, &budgetv1.BudgetTemplateItem{
+			Name:           item.Name,
+			Total:          &budgetv1.Money{Amount: item.Total, Currency: template.Currency},
+			CategoryIds:    item.CategoryIDs,
+			WalletIds:      item.WalletIDs,
+			RolloverPolicy: budgetv1.BudgetRolloverPolicy(item.RolloverPolicy),
+			RolloverCap:    &budgetv1.Money{Amount: item.RolloverCap, Currency: template.Currency},
+		})
+	}
+
+	return &budgetv1.BudgetTemplate{
+		Id:        template.ID,
+		Name:      template.Name,
+		Total:     &budgetv1.Money{Amount: template.Total, Currency: template.Currency},
+		Period:    budgetv1.BudgetPeriod(template.Period),
+		Items:     items,
+		CreatedAt: template.CreatedAt.Unix(),
+		UpdatedAt: template.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBudgetStore serves a source budget and records the budgets created after it.
+type stubBudgetStore struct {
+	repository.BudgetRepository
+	budgets []*models.Budget
+}
+
+func (r *stubBudgetStore) Create(ctx context.Context, budget *models.Budget) error {
+	budget.ID = int32(len(r.budgets) + 1)
+	r.budgets = append(r.budgets, budget)
+	return nil
+}
+
+func (r *stubBudgetStore) GetByIDForUser(ctx context.Context, budgetID, userID int32) (*models.Budget, error) {
+	for _, budget := range r.budgets {
+		if budget.ID == budgetID && budget.UserID == userID {
+			return budget, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("budget")
+}
+
+// stubBudgetTemplateRepository keeps templates in memory.
+type stubBudgetTemplateRepository struct {
+	repository.BudgetTemplateRepository
+	templates []*models.BudgetTemplate
+}
+
+func (r *stubBudgetTemplateRepository) Create(ctx context.Context, template *models.BudgetTemplate) error {
+	template.ID = int32(len(r.templates) + 1)
+	r.templates = append(r.templates, template)
+	return nil
+}
+
+func (r *stubBudgetTemplateRepository) GetByIDForUser(ctx context.Context, templateID, userID int32) (*models.BudgetTemplate, error) {
+	for _, template := range r.templates {
+		if template.ID == templateID && template.UserID == userID {
+			return template, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("budget template")
+}
+
+func (r *stubBudgetTemplateRepository) ListByUserID(ctx context.Context, userID int32) ([]*models.BudgetTemplate, error) {
+	var templates []*models.BudgetTemplate
+	for _, template := range r.templates {
+		if template.UserID == userID {
+			templates = append(templates, template)
+		}
+	}
+	return templates, nil
+}
+
+func (r *stubBudgetTemplateRepository) Delete(ctx context.Context, id int32) error {
+	for i, template := range r.templates {
+		if template.ID == id {
+			r.templates = append(r.templates[:i], r.templates[i+1:]...)
+			return nil
+		}
+	}
+	return apperrors.NewNotFoundError("budget template")
+}
+
+// newTemplateTestService returns a budget service over a custom March 2024 budget of
+// user 7 with a category-linked groceries item and a checked rent item.
+func newTemplateTestService(txRepo repository.TransactionRepository) (*budgetService, *stubBudgetItemRepository, *stubBudgetTemplateRepository) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	end := endOfDay(time.Date(2024, 3, 31, 0, 0, 0, 0, time.Local))
+	groceries := boundItem(1, 200000, []int32{10}, nil)
+	groceries.Name = "Groceries"
+	groceries.RolloverPolicy = int32(v1.BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_ALL)
+	rent := boundItem(2, 250000, nil, nil)
+	rent.Name = "Rent"
+	rent.Checked = true
+	source := &models.Budget{
+		ID:        1,
+		UserID:    7,
+		Name:      "March",
+		Total:     500000,
+		Currency:  "VND",
+		Period:    int32(v1.BudgetPeriod_BUDGET_PERIOD_CUSTOM),
+		StartDate: &start,
+		EndDate:   &end,
+		Items:     []models.BudgetItem{*groceries, *rent},
+	}
+
+	itemRepo := &stubBudgetItemRepository{}
+	templateRepo := &stubBudgetTemplateRepository{}
+	svc := NewBudgetService(&stubBudgetStore{budgets: []*models.Budget{source}}, itemRepo,
+		&stubPreferredCurrencyUserRepository{currency: "VND"}, nil, nil).(*budgetService)
+	categories := &stubBudgetCategoryRepository{categories: map[int32]*models.Category{
+		10: {ID: 10, UserID: 7, Type: int32(v1.CategoryType_CATEGORY_TYPE_EXPENSE)},
+	}}
+	svc.SetSpendingRepositories(txRepo, categories, &stubWalletRepository{})
+	svc.SetTemplateRepository(templateRepo)
+	return svc, itemRepo, templateRepo
+}
+
+func TestNextCustomPeriod(t *testing.T) {
+	day := func(month time.Month, d int) time.Time { return time.Date(2024, month, d, 0, 0, 0, 0, time.UTC) }
+
+	start, end := nextCustomPeriod(day(3, 1), endOfDay(day(3, 31)))
+	assert.Equal(t, day(4, 1), start, "whole months move by months")
+	assert.Equal(t, endOfDay(day(4, 30)), end)
+
+	start, end = nextCustomPeriod(day(1, 1), endOfDay(day(2, 29)))
+	assert.Equal(t, day(3, 1), start)
+	assert.Equal(t, endOfDay(day(4, 30)), end)
+
+	start, end = nextCustomPeriod(day(3, 10), endOfDay(day(3, 23)))
+	assert.Equal(t, day(3, 24), start, "other periods move by days")
+	assert.Equal(t, endOfDay(day(4, 6)), end)
+}
+
+func TestBudgetService_CopyBudgetToPeriod(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("copies a custom budget to the next period", func(t *testing.T) {
+		svc, itemRepo, _ := newTemplateTestService(&stubMonthlyBreakdownRepository{})
+
+		resp, err := svc.CopyBudgetToPeriod(ctx, 1, 7, &v1.CopyBudgetToPeriodRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), resp.Data.Id)
+		assert.Equal(t, "March", resp.Data.Name)
+		assert.Equal(t, int64(500000), resp.Data.Total.Amount)
+		assert.Equal(t, v1.BudgetPeriod_BUDGET_PERIOD_CUSTOM, resp.Data.Period)
+		assert.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local).Unix(), resp.Data.StartDate)
+		assert.Equal(t, endOfDay(time.Date(2024, 4, 30, 0, 0, 0, 0, time.Local)).Unix(), resp.Data.EndDate)
+
+		require.Len(t, itemRepo.created, 2)
+		assert.Equal(t, int32(2), itemRepo.created[0].BudgetID)
+		assert.Equal(t, int64(200000), itemRepo.created[0].Total)
+		assert.Equal(t, []int32{10}, itemRepo.created[0].CategoryIDs())
+		assert.Equal(t, int32(v1.BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_ALL), itemRepo.created[0].RolloverPolicy)
+		assert.False(t, itemRepo.created[1].Checked, "checked states are not copied")
+	})
+
+	t.Run("seeds from spending and adjusts allocations", func(t *testing.T) {
+		// One query covers January to March, the three months before April
+		svc, itemRepo, _ := newTemplateTestService(&stubMonthlyBreakdownRepository{byMonth: map[time.Month][]*repository.CategoryBreakdownByCurrency{
+			time.January: {spentVND(10, 300000)},
+		}})
+
+		resp, err := svc.CopyBudgetToPeriod(ctx, 1, 7, &v1.CopyBudgetToPeriodRequest{
+			Name:       "April",
+			Allocation: &v1.BudgetAllocationOptions{SeedFromSpending: true, AdjustPercent: 10},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "April", resp.Data.Name)
+		require.Len(t, itemRepo.created, 2)
+		assert.Equal(t, int64(110000), itemRepo.created[0].Total, "monthly average plus 10%")
+		assert.Equal(t, int64(275000), itemRepo.created[1].Total, "items without categories are only adjusted")
+		assert.Equal(t, int64(435000), resp.Data.Total.Amount, "the total follows the items")
+	})
+
+	t.Run("pins a monthly budget to next month", func(t *testing.T) {
+		svc, _, _ := newTemplateTestService(&stubMonthlyBreakdownRepository{})
+		source := svc.budgetRepo.(*stubBudgetStore).budgets[0]
+		source.Period = int32(v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY)
+		source.StartDate, source.EndDate = nil, nil
+
+		resp, err := svc.CopyBudgetToPeriod(ctx, 1, 7, &v1.CopyBudgetToPeriodRequest{})
+		require.NoError(t, err)
+		now := time.Now()
+		nextMonth := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.Local)
+		assert.Equal(t, v1.BudgetPeriod_BUDGET_PERIOD_CUSTOM, resp.Data.Period, "not another rolling monthly budget")
+		assert.Equal(t, nextMonth.Unix(), resp.Data.StartDate)
+		assert.Equal(t, nextMonth.AddDate(0, 1, 0).Add(-time.Second).Unix(), resp.Data.EndDate)
+	})
+
+	t.Run("dates make a custom copy", func(t *testing.T) {
+		svc, _, _ := newTemplateTestService(&stubMonthlyBreakdownRepository{})
+		start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local)
+
+		resp, err := svc.CopyBudgetToPeriod(ctx, 1, 7, &v1.CopyBudgetToPeriodRequest{
+			StartDate: start.Unix(),
+			EndDate:   time.Date(2024, 6, 15, 0, 0, 0, 0, time.Local).Unix(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, start.Unix(), resp.Data.StartDate)
+		assert.Equal(t, endOfDay(time.Date(2024, 6, 15, 0, 0, 0, 0, time.Local)).Unix(), resp.Data.EndDate)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		svc, _, _ := newTemplateTestService(&stubMonthlyBreakdownRepository{})
+
+		_, err := svc.CopyBudgetToPeriod(ctx, 1, 7, &v1.CopyBudgetToPeriodRequest{
+			Allocation: &v1.BudgetAllocationOptions{AdjustPercent: -100},
+		})
+		assert.IsType(t, apperrors.ValidationError{}, err)
+
+		_, err = svc.CopyBudgetToPeriod(ctx, 1, 8, &v1.CopyBudgetToPeriodRequest{})
+		assert.IsType(t, apperrors.NotFoundError{}, err, "budget of another user")
+
+		svc.txRepo = nil
+		_, err = svc.CopyBudgetToPeriod(ctx, 1, 7, &v1.CopyBudgetToPeriodRequest{
+			Allocation: &v1.BudgetAllocationOptions{SeedFromSpending: true},
+		})
+		assert.IsType(t, apperrors.ServiceUnavailableError{}, err)
+	})
+}
+
+func TestBudgetService_BudgetTemplates(t *testing.T) {
+	ctx := context.Background()
+	svc, itemRepo, templateRepo := newTemplateTestService(&stubMonthlyBreakdownRepository{})
+
+	fromBudget, err := svc.CreateBudgetTemplate(ctx, 7, &v1.CreateBudgetTemplateRequest{SourceBudgetId: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "March", fromBudget.Data.Name)
+	assert.Equal(t, v1.BudgetPeriod_BUDGET_PERIOD_CUSTOM, fromBudget.Data.Period)
+	require.Len(t, fromBudget.Data.Items, 2)
+	assert.Equal(t, []int32{10}, fromBudget.Data.Items[0].CategoryIds)
+	assert.Equal(t, &v1.Money{Amount: 250000, Currency: "VND"}, fromBudget.Data.Items[1].Total)
+
+	fromItems, err := svc.CreateBudgetTemplate(ctx, 7, &v1.CreateBudgetTemplateRequest{
+		Name:   "Monthly basics",
+		Total:  &v1.Money{Amount: 300000, Currency: "VND"},
+		Period: v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+		Items: []*v1.BudgetTemplateItem{
+			{Name: "Groceries", Total: &v1.Money{Amount: 150000}, CategoryIds: []int32{10}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), fromItems.Data.Id)
+
+	_, err = svc.CreateBudgetTemplate(ctx, 7, &v1.CreateBudgetTemplateRequest{
+		Name:  "Broken",
+		Items: []*v1.BudgetTemplateItem{{Name: "Unknown", CategoryIds: []int32{99}}},
+	})
+	assert.IsType(t, apperrors.NotFoundError{}, err, "unknown category")
+
+	_, err = svc.CreateBudgetTemplate(ctx, 7, &v1.CreateBudgetTemplateRequest{})
+	assert.IsType(t, apperrors.ValidationError{}, err, "name is required")
+
+	_, err = svc.CreateBudgetTemplate(ctx, 7, &v1.CreateBudgetTemplateRequest{
+		Name:  "Lowercase",
+		Total: &v1.Money{Amount: 300000, Currency: "usd"},
+	})
+	assert.IsType(t, apperrors.ValidationError{}, err, "invalid currency")
+
+	_, err = svc.CreateBudgetTemplate(ctx, 7, &v1.CreateBudgetTemplateRequest{
+		SourceBudgetId: 1,
+		Items:          []*v1.BudgetTemplateItem{{Name: "Extra", Total: &v1.Money{Amount: 10000}}},
+	})
+	assert.IsType(t, apperrors.ValidationError{}, err, "items come from the source budget")
+
+	list, err := svc.ListBudgetTemplates(ctx, 7)
+	require.NoError(t, err)
+	assert.Len(t, list.Templates, 2)
+
+	applied, err := svc.ApplyBudgetTemplate(ctx, 2, 7, &v1.ApplyBudgetTemplateRequest{
+		Allocation: &v1.BudgetAllocationOptions{AdjustPercent: -10},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Monthly basics", applied.Data.Name)
+	assert.Equal(t, v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY, applied.Data.Period)
+	assert.Equal(t, int64(285000), applied.Data.Total.Amount)
+	require.Len(t, itemRepo.created, 1)
+	assert.Equal(t, int64(135000), itemRepo.created[0].Total)
+
+	_, err = svc.ApplyBudgetTemplate(ctx, 1, 7, &v1.ApplyBudgetTemplateRequest{})
+	assert.IsType(t, apperrors.ValidationError{}, err, "custom templates need dates")
+
+	_, err = svc.GetBudgetTemplate(ctx, 1, 8)
+	assert.IsType(t, apperrors.NotFoundError{}, err, "template of another user")
+
+	_, err = svc.DeleteBudgetTemplate(ctx, 1, 7)
+	require.NoError(t, err)
+	assert.Len(t, templateRepo.templates, 1)
+
+	svc.templateRepo = nil
+	_, err = svc.ListBudgetTemplates(ctx, 7)
+	assert.IsType(t, apperrors.ServiceUnavailableError{}, err)
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/validator"
+	budgetv1 "wealthjourney/protobuf/v1"
+)
+
+// budgetVarianceMaxMonths bounds the months a variance report covers.
+const budgetVarianceMaxMonths = 24
+
+// GetBudgetVarianceReport compares the allocation of each item of a monthly budget with
+// its spending, month by month, and lists the spending on categories no item tracks.
+// Items without categories track no spending and are left out.
+func (s *budgetService) GetBudgetVarianceReport(ctx context.Context, budgetID int32, userID int32, req *budgetv1.GetBudgetVarianceReportRequest) (*budgetv1.GetBudgetVarianceReportResponse, error) {
+	if err := validator.ID(budgetID); err != nil {
+		return nil, err
+	}
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if s.txRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("budget spending tracking is not configured")
+	}
+
+	budget, err := s.budgetRepo.GetByIDForUser(ctx, budgetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if budgetv1.BudgetPeriod(budget.Period) != budgetv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY {
+		return nil, apperrors.NewValidationError("variance reports need a monthly budget")
+	}
+	months, err := varianceMonths(req.StartMonth, req.EndMonth, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	currency := budget.Currency
+	if req.PreferredCurrency {
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user.PreferredCurrency != "" && user.PreferredCurrency != budget.Currency {
+			if s.fxRateSvc == nil || s.currencyCache == nil {
+				return nil, apperrors.NewServiceUnavailableError("currency conversion is not configured")
+			}
+			currency = user.PreferredCurrency
+		}
+	}
+
+	var items []*models.BudgetItem
+	allocations := make(map[int32]int64)
+	// The wallets each category is budgeted in; nil when it is budgeted in all wallets
+	boundCategories := make(map[int32]map[int32]bool)
+	for _, item := range budgetItemPointers(budget) {
+		categoryIDs := item.CategoryIDs()
+		if len(categoryIDs) == 0 {
+			continue
+		}
+		items = append(items, item)
+		walletIDs := item.WalletIDs()
+		for _, categoryID := range categoryIDs {
+			wallets, seen := boundCategories[categoryID]
+			if len(walletIDs) == 0 || (seen && wallets == nil) {
+				boundCategories[categoryID] = nil
+				continue
+			}
+			if wallets == nil {
+				wallets = make(map[int32]bool, len(walletIDs))
+				boundCategories[categoryID] = wallets
+			}
+			for _, walletID := range walletIDs {
+				wallets[walletID] = true
+			}
+		}
+
+		allocations[item.ID] = item.Total
+		if currency != budget.Currency {
+			converted, err := s.convertBudgetItemTotal(ctx, userID, item, budget.Currency)
+			if err != nil {
+				return nil, err
+			}
+			allocations[item.ID] = converted
+		}
+	}
+
+	// Spending is summed straight in the report currency rather than converted twice
+	reportBudget := *budget
+	reportBudget.Currency = currency
+
+	resp := &budgetv1.GetBudgetVarianceReportResponse{
+		Success:    true,
+		Message:    "Budget variance report retrieved successfully",
+		Currency:   currency,
+		Rows:       []*budgetv1.BudgetVarianceRow{},
+		Unbudgeted: []*budgetv1.UnbudgetedSpending{},
+		Months:     make([]*budgetv1.BudgetVarianceMonth, 0, len(months)),
+	}
+	for _, start := range months {
+		end := start.AddDate(0, 1, 0).Add(-time.Second)
+		spending, err := s.spendingBetween(ctx, userID, &reportBudget, items, start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		var allocatedTotal int64
+		for _, item := range items {
+			allocated := allocations[item.ID]
+			actual := spending.byItem[item.ID]
+			allocatedTotal += allocated
+			resp.Rows = append(resp.Rows, &budgetv1.BudgetVarianceRow{
+				BudgetItemId: item.ID,
+				Name:         item.Name,
+				MonthStart:   start.Unix(),
+				Allocated:    &budgetv1.Money{Amount: allocated, Currency: currency},
+				Actual:       &budgetv1.Money{Amount: actual, Currency: currency},
+				Variance:     &budgetv1.Money{Amount: allocated - actual, Currency: currency},
+				PercentUsed:  percentUsed(actual, allocated),
+			})
+		}
+
+		unbudgeted, err := s.unbudgetedSpending(ctx, userID, currency, boundCategories, start, end)
+		if err != nil {
+			return nil, err
+		}
+		var unbudgetedTotal int64
+		for _, row := range unbudgeted {
+			unbudgetedTotal += row.Actual.Amount
+		}
+		resp.Unbudgeted = append(resp.Unbudgeted, unbudgeted...)
+
+		resp.Months = append(resp.Months, &budgetv1.BudgetVarianceMonth{
+			MonthStart: start.Unix(),
+			Allocated:  &budgetv1.Money{Amount: allocatedTotal, Currency: currency},
+			Actual:     &budgetv1.Money{Amount: spending.total, Currency: currency},
+			Variance:   &budgetv1.Money{Amount: allocatedTotal - spending.total, Currency: currency},
+			Unbudgeted: &budgetv1.Money{Amount: unbudgetedTotal, Currency: currency},
+		})
+	}
+
+	resp.Timestamp = time.Now().Format(time.RFC3339)
+	return resp, nil
+}
+
+// unbudgetedSpending returns the spending from start to end on expense categories that
+// are not bound, largest first. Categories bound only in some wallets count the spending
+// of the other wallets. Amounts that cannot be converted are left out.
+func (s *budgetService) unbudgetedSpending(ctx context.Context, userID int32, currency string, bound map[int32]map[int32]bool, start, end time.Time) ([]*budgetv1.UnbudgetedSpending, error) {
+	expense := budgetv1.TransactionType_TRANSACTION_TYPE_EXPENSE
+	filter := repository.TransactionFilter{
+		StartDate:        &start,
+		EndDate:          &end,
+		Type:             &expense,
+		ExcludeTransfers: true,
+	}
+	rows, err := s.txRepo.GetCategoryBreakdown(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Categories bound in the same wallets share one breakdown
+	breakdowns := make(map[string]map[int32]map[string]int64)
+	budgeted := make(map[int32]map[string]int64)
+	for categoryID, wallets := range bound {
+		if wallets == nil {
+			continue
+		}
+		walletIDs := make([]int32, 0, len(wallets))
+		for walletID := range wallets {
+			walletIDs = append(walletIDs, walletID)
+		}
+		sort.Slice(walletIDs, func(i, j int) bool { return walletIDs[i] < walletIDs[j] })
+		key := fmt.Sprint(walletIDs)
+		byCategory, cached := breakdowns[key]
+		if !cached {
+			walletFilter := filter
+			walletFilter.WalletIDs = walletIDs
+			walletRows, err := s.txRepo.GetCategoryBreakdown(ctx, userID, walletFilter)
+			if err != nil {
+				return nil, err
+			}
+			byCategory = make(map[int32]map[string]int64, len(walletRows))
+			for _, row := range walletRows {
+				byCategory[row.CategoryID] = row.AmountsByCurrency
+			}
+			breakdowns[key] = byCategory
+		}
+		budgeted[categoryID] = byCategory[categoryID]
+	}
+
+	var unbudgeted []*budgetv1.UnbudgetedSpending
+	for _, row := range rows {
+		if wallets, ok := bound[row.CategoryID]; ok && wallets == nil {
+			continue
+		}
+		var actual int64
+		for from, amount := range row.AmountsByCurrency {
+			actual += s.convertSpent(ctx, amount-budgeted[row.CategoryID][from], from, currency)
+		}
+		if actual == 0 {
+			continue
+		}
+		unbudgeted = append(unbudgeted, &budgetv1.UnbudgetedSpending{
+			MonthStart:   start.Unix(),
+			CategoryId:   row.CategoryID,
+			CategoryName: row.CategoryName,
+			Actual:       &budgetv1.Money{Amount: actual, Currency: currency},
+		})
+	}
+	sort.Slice(unbudgeted, func(i, j int) bool {
+		if unbudgeted[i].Actual.Amount != unbudgeted[j].Actual.Amount {
+			return unbudgeted[i].Actual.Amount > unbudgeted[j].Actual.Amount
+		}
+		return unbudgeted[i].CategoryId < unbudgeted[j].CategoryId
+	})
+	return unbudgeted, nil
+}
+
+// varianceMonths returns the first day of each month from the month of startMonth to
+// the month of endMonth. endMonth defaults to now and startMonth to five months before.
+func varianceMonths(startMonth, endMonth int64, now time.Time) ([]time.Time, error) {
+	end := now
+	if endMonth != 0 {
+		end = time.Unix(endMonth, 0).In(now.Location())
+	}
+	last := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, end.Location())
+
+	first := last.AddDate(0, -5, 0)
+	if startMonth != 0 {
+		start := time.Unix(startMonth, 0).In(now.Location())
+		first = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location())
+	}
+	if first.After(last) {
+		return nil, apperrors.NewValidationError("startMonth cannot be after endMonth")
+	}
+
+	var months []time.Time
+	for month := first; !month.After(last); month = month.AddDate(0, 1, 0) {
+		if len(months) == budgetVarianceMaxMonths {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("variance reports cover at most %d months", budgetVarianceMaxMonths))
+		}
+		months = append(months, month)
+	}
+	return months, nil
+}
+
+// budgetVarianceCSVHeader is the column layout of the budget variance CSV export.
+var budgetVarianceCSVHeader = []string{
+	"Month", "Type", "Budget Item", "Category", "Allocated", "Actual", "Variance", "Currency",
+}
+
+// WriteBudgetVarianceCSV writes a variance report as CSV, the item rows of each month
+// followed by its unbudgeted spending, with amounts in major currency units.
+func WriteBudgetVarianceCSV(w io.Writer, report *budgetv1.GetBudgetVarianceReportResponse) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(budgetVarianceCSVHeader); err != nil {
+		return err
+	}
+
+	currency := report.Currency
+	for _, month := range report.Months {
+		monthLabel := time.Unix(month.MonthStart, 0).Format("2006-01")
+		for _, row := range report.Rows {
+			if row.MonthStart != month.MonthStart {
+				continue
+			}
+			record := []string{
+				monthLabel,
+				"Budgeted",
+				row.Name,
+				"",
+				formatMinorUnits(row.Allocated.Amount, currency),
+				formatMinorUnits(row.Actual.Amount, currency),
+				formatMinorUnits(row.Variance.Amount, currency),
+				currency,
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		for _, spending := range report.Unbudgeted {
+			if spending.MonthStart != month.MonthStart {
+				continue
+			}
+			record := []string{
+				monthLabel,
+				"Unbudgeted",
+				"",
+				spending.CategoryName,
+				formatMinorUnits(0, currency),
+				formatMinorUnits(spending.Actual.Amount, currency),
+				formatMinorUnits(-spending.Actual.Amount, currency),
+				currency,
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	"wealthjourney/pkg/cache"
+	apperrors "wealthjourney/pkg/errors"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPreferredCurrencyUserRepository serves a user with a preferred currency.
+type stubPreferredCurrencyUserRepository struct {
+	repository.UserRepository
+	currency string
+}
+
+func (r *stubPreferredCurrencyUserRepository) GetByID(ctx context.Context, id int32) (*models.User, error) {
+	return &models.User{ID: id, PreferredCurrency: r.currency}, nil
+}
+
+func namedSpentVND(categoryID int32, name string, amount int64) *repository.CategoryBreakdownByCurrency {
+	row := spentVND(categoryID, amount)
+	row.CategoryName = name
+	return row
+}
+
+// newVarianceTestBudget returns a monthly VND budget of user 7 with two tracked items
+// and a checklist item.
+func newVarianceTestBudget() *models.Budget {
+	groceries := boundItem(1, 100000, []int32{10}, nil)
+	groceries.Name = "Groceries"
+	transport := boundItem(2, 50000, []int32{11}, nil)
+	transport.Name = "Transport"
+	return &models.Budget{
+		ID:       1,
+		UserID:   7,
+		Name:     "Household",
+		Total:    150000,
+		Currency: "VND",
+		Period:   int32(v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY),
+		Items:    []models.BudgetItem{*groceries, *transport, *boundItem(3, 20000, nil, nil)},
+	}
+}
+
+func TestVarianceMonths(t *testing.T) {
+	now := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+
+	months, err := varianceMonths(0, 0, now)
+	require.NoError(t, err)
+	require.Len(t, months, 6)
+	assert.Equal(t, time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), months[0])
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), months[5])
+
+	months, err = varianceMonths(time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC).Unix(), time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC).Unix(), now)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}, months)
+
+	_, err = varianceMonths(now.Unix(), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC).Unix(), now)
+	assert.IsType(t, apperrors.ValidationError{}, err, "start after end")
+
+	_, err = varianceMonths(time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC).Unix(), 0, now)
+	assert.IsType(t, apperrors.ValidationError{}, err, "more than 24 months")
+}
+
+func TestBudgetService_GetBudgetVarianceReport(t *testing.T) {
+	ctx := context.Background()
+	txRepo := &stubMonthlyBreakdownRepository{byMonth: map[time.Month][]*repository.CategoryBreakdownByCurrency{
+		time.April: {
+			spentVND(10, 120000),
+			spentVND(11, 20000),
+			namedSpentVND(12, "Travel", 30000),
+			namedSpentVND(13, "Gifts", 5000),
+		},
+		time.May: {spentVND(10, 40000)},
+	}}
+	svc := NewBudgetService(&stubBudgetRepository{budget: newVarianceTestBudget()}, &stubBudgetItemRepository{}, &stubNoUserRepository{}, nil, nil).(*budgetService)
+	svc.SetSpendingRepositories(txRepo, nil, nil)
+
+	resp, err := svc.GetBudgetVarianceReport(ctx, 1, 7, &v1.GetBudgetVarianceReportRequest{
+		StartMonth: time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local).Unix(),
+		EndMonth:   time.Date(2024, 5, 31, 0, 0, 0, 0, time.Local).Unix(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "VND", resp.Currency)
+
+	require.Len(t, resp.Rows, 4, "checklist items are left out")
+	april := time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local).Unix()
+	assert.Equal(t, april, resp.Rows[0].MonthStart)
+	assert.Equal(t, "Groceries", resp.Rows[0].Name)
+	assert.Equal(t, int64(100000), resp.Rows[0].Allocated.Amount)
+	assert.Equal(t, int64(120000), resp.Rows[0].Actual.Amount)
+	assert.Equal(t, int64(-20000), resp.Rows[0].Variance.Amount)
+	assert.Equal(t, 120.0, resp.Rows[0].PercentUsed)
+	assert.Equal(t, int64(30000), resp.Rows[1].Variance.Amount)
+	assert.Equal(t, int64(0), resp.Rows[3].Actual.Amount, "no transport spending in May")
+
+	require.Len(t, resp.Unbudgeted, 2)
+	assert.Equal(t, "Travel", resp.Unbudgeted[0].CategoryName, "largest first")
+	assert.Equal(t, int64(30000), resp.Unbudgeted[0].Actual.Amount)
+	assert.Equal(t, int32(13), resp.Unbudgeted[1].CategoryId)
+
+	require.Len(t, resp.Months, 2)
+	assert.Equal(t, int64(150000), resp.Months[0].Allocated.Amount)
+	assert.Equal(t, int64(140000), resp.Months[0].Actual.Amount)
+	assert.Equal(t, int64(10000), resp.Months[0].Variance.Amount)
+	assert.Equal(t, int64(35000), resp.Months[0].Unbudgeted.Amount)
+	assert.Equal(t, int64(0), resp.Months[1].Unbudgeted.Amount)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteBudgetVarianceCSV(&buf, resp))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 7)
+	assert.Equal(t, "Month,Type,Budget Item,Category,Allocated,Actual,Variance,Currency", lines[0])
+	assert.Equal(t, "2024-04,Budgeted,Groceries,,100000,120000,-20000,VND", lines[1])
+	assert.Equal(t, "2024-04,Unbudgeted,,Travel,0,30000,-30000,VND", lines[3])
+	assert.Equal(t, "2024-05,Budgeted,Transport,,50000,0,50000,VND", lines[6])
+}
+
+func TestBudgetService_GetBudgetVarianceReport_PreferredCurrency(t *testing.T) {
+	ctx := context.Background()
+	txRepo := &stubMonthlyBreakdownRepository{byMonth: map[time.Month][]*repository.CategoryBreakdownByCurrency{
+		time.May: {spentVND(10, 250000), namedSpentVND(12, "Travel", 500000)},
+	}}
+	fxRateSvc := new(MockFXRateService)
+	fxRateSvc.On("ConvertAmount", mock.Anything, int64(100000), "VND", "USD").Return(int64(400), nil)
+	fxRateSvc.On("ConvertAmount", mock.Anything, int64(50000), "VND", "USD").Return(int64(200), nil)
+	fxRateSvc.On("ConvertAmount", mock.Anything, int64(250000), "VND", "USD").Return(int64(1000), nil)
+	fxRateSvc.On("ConvertAmount", mock.Anything, int64(500000), "VND", "USD").Return(int64(2000), nil)
+
+	// Nothing listens there, so every cache lookup misses
+	redisClient := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	defer redisClient.Close()
+
+	svc := NewBudgetService(&stubBudgetRepository{budget: newVarianceTestBudget()}, &stubBudgetItemRepository{},
+		&stubPreferredCurrencyUserRepository{currency: "USD"}, fxRateSvc, cache.NewCurrencyCache(redisClient)).(*budgetService)
+	svc.SetSpendingRepositories(txRepo, nil, nil)
+
+	may := time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local).Unix()
+	req := &v1.GetBudgetVarianceReportRequest{StartMonth: may, EndMonth: may}
+
+	resp, err := svc.GetBudgetVarianceReport(ctx, 1, 7, req)
+	require.NoError(t, err)
+	assert.Equal(t, "VND", resp.Currency, "budget currency unless asked")
+
+	req.PreferredCurrency = true
+	resp, err = svc.GetBudgetVarianceReport(ctx, 1, 7, req)
+	require.NoError(t, err)
+	assert.Equal(t, "USD", resp.Currency)
+	require.Len(t, resp.Rows, 2)
+	assert.Equal(t, &v1.Money{Amount: 400, Currency: "USD"}, resp.Rows[0].Allocated)
+	assert.Equal(t, &v1.Money{Amount: 1000, Currency: "USD"}, resp.Rows[0].Actual)
+	assert.Equal(t, int64(-600), resp.Rows[0].Variance.Amount)
+	assert.Equal(t, int64(200), resp.Rows[1].Allocated.Amount)
+	require.Len(t, resp.Unbudgeted, 1)
+	assert.Equal(t, &v1.Money{Amount: 2000, Currency: "USD"}, resp.Unbudgeted[0].Actual)
+}
+
+// stubWalletBreakdownRepository serves the spending of each wallet, summed over the
+// wallets of the filter or over all wallets without one.
+type stubWalletBreakdownRepository struct {
+	repository.TransactionRepository
+	byWallet map[int32][]*repository.CategoryBreakdownByCurrency
+}
+
+func (r *stubWalletBreakdownRepository) GetCategoryBreakdown(ctx context.Context, userID int32, filter repository.TransactionFilter) ([]*repository.CategoryBreakdownByCurrency, error) {
+	byCategory := make(map[int32]*repository.CategoryBreakdownByCurrency)
+	var rows []*repository.CategoryBreakdownByCurrency
+	for walletID, walletRows := range r.byWallet {
+		if len(filter.WalletIDs) > 0 && !slices.Contains(filter.WalletIDs, walletID) {
+			continue
+		}
+		for _, row := range walletRows {
+			total, ok := byCategory[row.CategoryID]
+			if !ok {
+				total = &repository.CategoryBreakdownByCurrency{CategoryID: row.CategoryID, CategoryName: row.CategoryName, AmountsByCurrency: map[string]int64{}}
+				byCategory[row.CategoryID] = total
+				rows = append(rows, total)
+			}
+			for currency, amount := range row.AmountsByCurrency {
+				total.AmountsByCurrency[currency] += amount
+			}
+		}
+	}
+	return rows, nil
+}
+
+func TestBudgetService_GetBudgetVarianceReport_WalletRestrictedItem(t *testing.T) {
+	ctx := context.Background()
+	budget := newVarianceTestBudget()
+	budget.Items[0].Wallets = repository.BudgetItemWalletRows([]int32{1})
+	txRepo := &stubWalletBreakdownRepository{byWallet: map[int32][]*repository.CategoryBreakdownByCurrency{
+		1: {namedSpentVND(10, "Groceries", 80000)},
+		2: {namedSpentVND(10, "Groceries", 25000), spentVND(11, 10000)},
+	}}
+	svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{}, &stubNoUserRepository{}, nil, nil).(*budgetService)
+	svc.SetSpendingRepositories(txRepo, nil, nil)
+
+	may := time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local).Unix()
+	resp, err := svc.GetBudgetVarianceReport(ctx, 1, 7, &v1.GetBudgetVarianceReportRequest{StartMonth: may, EndMonth: may})
+	require.NoError(t, err)
+
+	require.Len(t, resp.Rows, 2)
+	assert.Equal(t, int64(80000), resp.Rows[0].Actual.Amount, "groceries in the item's wallet")
+	assert.Equal(t, int64(10000), resp.Rows[1].Actual.Amount, "transport in any wallet")
+
+	require.Len(t, resp.Unbudgeted, 1, "groceries in another wallet are not budgeted")
+	assert.Equal(t, int32(10), resp.Unbudgeted[0].CategoryId)
+	assert.Equal(t, int64(25000), resp.Unbudgeted[0].Actual.Amount)
+	assert.Equal(t, int64(25000), resp.Months[0].Unbudgeted.Amount)
+}
+
+func TestBudgetService_GetBudgetVarianceReport_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	budget := newVarianceTestBudget()
+	svc := NewBudgetService(&stubBudgetRepository{budget: budget}, &stubBudgetItemRepository{}, &stubNoUserRepository{}, nil, nil).(*budgetService)
+	_, err := svc.GetBudgetVarianceReport(ctx, 1, 7, &v1.GetBudgetVarianceReportRequest{})
+	assert.IsType(t, apperrors.ServiceUnavailableError{}, err, "spending tracking not configured")
+
+	svc.SetSpendingRepositories(&stubMonthlyBreakdownRepository{}, nil, nil)
+	_, err = svc.GetBudgetVarianceReport(ctx, 1, 8, &v1.GetBudgetVarianceReportRequest{})
+	assert.IsType(t, apperrors.NotFoundError{}, err, "budget of another user")
+
+	budget.Period = int32(v1.BudgetPeriod_BUDGET_PERIOD_WEEKLY)
+	_, err = svc.GetBudgetVarianceReport(ctx, 1, 7, &v1.GetBudgetVarianceReportRequest{})
+	assert.IsType(t, apperrors.ValidationError{}, err, "weekly budget")
+
+	budget.Period = int32(v1.BudgetPeriod_BUDGET_PERIOD_MONTHLY)
+	_, err = svc.GetBudgetVarianceReport(ctx, 1, 7, &v1.GetBudgetVarianceReportRequest{PreferredCurrency: true})
+	assert.IsType(t, apperrors.NotFoundError{}, err, "user lookup fails")
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"wealthjourney/domain/models"
+	apperrors "wealthjourney/pkg/errors"
+
+	investmentv1 "wealthjourney/protobuf/v1"
+)
+
+// lotAllocation is the quantity a sell takes from one lot and the per-unit cost it is booked at.
+type lotAllocation struct {
+	lot      *models.InvestmentLot
+	quantity int64
+	unitCost float64 // Cost per whole unit; the lot's average cost, or the pooled average for AVERAGE
+}
+
+// resolveCostBasisMethod returns the method an investment's sells use.
+// Investments created before cost-basis methods existed keep FIFO.
+func resolveCostBasisMethod(method int32) investmentv1.CostBasisMethod {
+	switch m := investmentv1.CostBasisMethod(method); m {
+	case investmentv1.CostBasisMethod_COST_BASIS_METHOD_FIFO,
+		investmentv1.CostBasisMethod_COST_BASIS_METHOD_LIFO,
+		investmentv1.CostBasisMethod_COST_BASIS_METHOD_HIFO,
+		investmentv1.CostBasisMethod_COST_BASIS_METHOD_AVERAGE,
+		investmentv1.CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT:
+		return m
+	default:
+		return investmentv1.CostBasisMethod_COST_BASIS_METHOD_FIFO
+	}
+}
+
+// validateCostBasisMethod rejects values outside the CostBasisMethod enum.
+func validateCostBasisMethod(method investmentv1.CostBasisMethod) error {
+	if _, ok := investmentv1.CostBasisMethod_name[int32(method)]; !ok {
+		return apperrors.NewValidationError(fmt.Sprintf("unsupported cost basis method: %d", method))
+	}
+	return nil
+}
+
+// allocateSell decides which open lots a sell of quantity consumes.
+//
+// Explicit lot selections take precedence for every method except AVERAGE, where
+// lots are not distinguished. SPECIFIC_LOT requires selections.
+func allocateSell(method investmentv1.CostBasisMethod, openLots []*models.InvestmentLot, quantity int64, selections []*investmentv1.LotSelection) ([]lotAllocation, error) {
+	if len(selections) > 0 {
+		if method == investmentv1.CostBasisMethod_COST_BASIS_METHOD_AVERAGE {
+			return nil, apperrors.NewValidationError("lot selections cannot be used with the average cost method")
+		}
+		return allocateSelectedLots(openLots, quantity, selections)
+	}
+
+	switch method {
+	case investmentv1.CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT:
+		return nil, apperrors.NewValidationError("lot selections are required for specific-lot investments")
+	case investmentv1.CostBasisMethod_COST_BASIS_METHOD_AVERAGE:
+		return allocateAverage(openLots, quantity), nil
+	}
+
+	ordered := make([]*models.InvestmentLot, len(openLots))
+	copy(ordered, openLots)
+
+	switch method {
+	case investmentv1.CostBasisMethod_COST_BASIS_METHOD_LIFO:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].PurchasedAt.After(ordered[j].PurchasedAt)
+		})
+	case investmentv1.CostBasisMethod_COST_BASIS_METHOD_HIFO:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			if ordered[i].AverageCost != ordered[j].AverageCost {
+				return ordered[i].AverageCost > ordered[j].AverageCost
+			}
+			return ordered[i].PurchasedAt.Before(ordered[j].PurchasedAt)
+		})
+	default: // FIFO
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].PurchasedAt.Before(ordered[j].PurchasedAt)
+		})
+	}
+
+	allocations := make([]lotAllocation, 0, len(ordered))
+	remaining := quantity
+	for _, lot := range ordered {
+		if remaining <= 0 {
+			break
+		}
+		if lot.RemainingQuantity <= 0 {
+			continue
+		}
+
+		take := remaining
+		if take > lot.RemainingQuantity {
+			take = lot.RemainingQuantity
+		}
+		allocations = append(allocations, lotAllocation{lot: lot, quantity: take, unitCost: float64(lot.AverageCost)})
+		remaining -= take
+	}
+	return allocations, nil
+}
+
+// allocateSelectedLots validates explicit lot selections against the open lots.
+func allocateSelectedLots(openLots []*models.InvestmentLot, quantity int64, selections []*investmentv1.LotSelection) ([]lotAllocation, error) {
+	lotsByID := make(map[int32]*models.InvestmentLot, len(openLots))
+	for _, lot := range openLots {
+		lotsByID[lot.ID] = lot
+	}
+
+	allocations := make([]lotAllocation, 0, len(selections))
+	seen := make(map[int32]bool, len(selections))
+	var total int64
+
+	for _, selection := range selections {
+		if selection == nil {
+			continue
+		}
+		lot, ok := lotsByID[selection.LotId]
+		if !ok {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("lot %d is not an open lot of this investment", selection.LotId))
+		}
+		if seen[selection.LotId] {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("lot %d is selected more than once", selection.LotId))
+		}
+		if selection.Quantity <= 0 {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("quantity for lot %d must be positive", selection.LotId))
+		}
+		if selection.Quantity > lot.RemainingQuantity {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("lot %d has only %d remaining, trying to sell %d", lot.ID, lot.RemainingQuantity, selection.Quantity))
+		}
+
+		seen[selection.LotId] = true
+		total += selection.Quantity
+		allocations = append(allocations, lotAllocation{lot: lot, quantity: selection.Quantity, unitCost: float64(lot.AverageCost)})
+	}
+
+	if total != quantity {
+		return nil, apperrors.NewValidationError(fmt.Sprintf("lot selections must add up to the sell quantity (got %d, expected %d)", total, quantity))
+	}
+	return allocations, nil
+}
+
+// allocateAverage spreads a sell across all open lots in proportion to their
+// remaining quantity and books every unit at the pooled weighted average cost, so
+// the average cost of what is left does not change.
+func allocateAverage(openLots []*models.InvestmentLot, quantity int64) []lotAllocation {
+	var totalRemaining int64
+	var totalCost float64
+	for _, lot := range openLots {
+		if lot.RemainingQuantity > 0 {
+			totalRemaining += lot.RemainingQuantity
+			totalCost += float64(lot.AverageCost) * float64(lot.RemainingQuantity)
+		}
+	}
+	if totalRemaining == 0 {
+		return nil
+	}
+	pooledCost := totalCost / float64(totalRemaining)
+
+	// Proportional shares rounded down; big ints avoid overflow on crypto quantities
+	shares := make([]int64, len(openLots))
+	var allocated int64
+	for i, lot := range openLots {
+		if lot.RemainingQuantity <= 0 {
+			continue
+		}
+		share := new(big.Int).Mul(big.NewInt(quantity), big.NewInt(lot.RemainingQuantity))
+		share.Quo(share, big.NewInt(totalRemaining))
+		shares[i] = share.Int64()
+		allocated += shares[i]
+	}
+
+	// Hand out the rounding remainder one unit at a time, oldest lots first
+	for i := 0; allocated < quantity; i = (i + 1) % len(openLots) {
+		if shares[i] < openLots[i].RemainingQuantity {
+			shares[i]++
+			allocated++
+		}
+	}
+
+	allocations := make([]lotAllocation, 0, len(openLots))
+	for i, lot := range openLots {
+		if shares[i] > 0 {
+			allocations = append(allocations, lotAllocation{lot: lot, quantity: shares[i], unitCost: pooledCost})
+		}
+	}
+	return allocations
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	apperrors "wealthjourney/pkg/errors"
+
+	investmentv1 "wealthjourney/protobuf/v1"
+	walletv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// costBasisLots returns three open lots, oldest first, with average costs
+// 1500000 (lot 1), 1700000 (lot 2) and 1600000 (lot 3)
+func costBasisLots() []*models.InvestmentLot {
+	now := time.Now()
+	return []*models.InvestmentLot{
+		{ID: 1, Quantity: 2000, RemainingQuantity: 2000, AverageCost: 1500000, PurchasedAt: now.Add(-72 * time.Hour)},
+		{ID: 2, Quantity: 3000, RemainingQuantity: 3000, AverageCost: 1700000, PurchasedAt: now.Add(-48 * time.Hour)},
+		{ID: 3, Quantity: 5000, RemainingQuantity: 5000, AverageCost: 1600000, PurchasedAt: now.Add(-24 * time.Hour)},
+	}
+}
+
+func allocatedQuantities(allocations []lotAllocation) map[int32]int64 {
+	result := make(map[int32]int64, len(allocations))
+	for _, a := range allocations {
+		result[a.lot.ID] = a.quantity
+	}
+	return result
+}
+
+func TestAllocateSell_Methods(t *testing.T) {
+	tests := []struct {
+		name   string
+		method investmentv1.CostBasisMethod
+		want   map[int32]int64
+	}{
+		{name: "unspecified falls back to FIFO", method: resolveCostBasisMethod(0), want: map[int32]int64{1: 2000, 2: 2000}},
+		{name: "FIFO", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_FIFO, want: map[int32]int64{1: 2000, 2: 2000}},
+		{name: "LIFO", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_LIFO, want: map[int32]int64{3: 4000}},
+		{name: "HIFO", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_HIFO, want: map[int32]int64{2: 3000, 3: 1000}},
+		{name: "average is pro rata", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_AVERAGE, want: map[int32]int64{1: 800, 2: 1200, 3: 2000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocations, err := allocateSell(tt.method, costBasisLots(), 4000, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, allocatedQuantities(allocations))
+		})
+	}
+}
+
+func TestAllocateSell_AverageUsesPooledCost(t *testing.T) {
+	allocations, err := allocateSell(investmentv1.CostBasisMethod_COST_BASIS_METHOD_AVERAGE, costBasisLots(), 3333, nil)
+	require.NoError(t, err)
+
+	var total int64
+	for _, a := range allocations {
+		total += a.quantity
+		// (2000×1500000 + 3000×1700000 + 5000×1600000) / 10000
+		assert.InDelta(t, 1610000, a.unitCost, 0.001)
+	}
+	assert.Equal(t, int64(3333), total, "rounding remainder must be allocated")
+}
+
+func TestAllocateSell_SpecificLots(t *testing.T) {
+	selections := []*investmentv1.LotSelection{{LotId: 3, Quantity: 1500}, {LotId: 1, Quantity: 500}}
+
+	allocations, err := allocateSell(investmentv1.CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT, costBasisLots(), 2000, selections)
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]int64{3: 1500, 1: 500}, allocatedQuantities(allocations))
+
+	// Selections override the order for FIFO investments too
+	allocations, err = allocateSell(investmentv1.CostBasisMethod_COST_BASIS_METHOD_FIFO, costBasisLots(), 2000, selections)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), allocations[0].lot.ID)
+
+	invalid := []struct {
+		name       string
+		method     investmentv1.CostBasisMethod
+		selections []*investmentv1.LotSelection
+	}{
+		{name: "missing for specific lot", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT},
+		{name: "not allowed for average", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_AVERAGE, selections: selections},
+		{name: "unknown lot", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT, selections: []*investmentv1.LotSelection{{LotId: 9, Quantity: 2000}}},
+		{name: "more than remaining", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT, selections: []*investmentv1.LotSelection{{LotId: 1, Quantity: 2000}, {LotId: 1, Quantity: 0}}},
+		{name: "exceeds lot", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT, selections: []*investmentv1.LotSelection{{LotId: 1, Quantity: 2500}}},
+		{name: "sum mismatch", method: investmentv1.CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT, selections: []*investmentv1.LotSelection{{LotId: 1, Quantity: 1000}}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := allocateSell(tt.method, costBasisLots(), 2000, tt.selections)
+			var validationErr apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+}
+
+// TestSellTransaction_HIFORecordsAllocationsAndReverses sells with HIFO, then deletes
+// the sell and checks the same lots and realized PNL are restored.
+func TestSellTransaction_HIFORecordsAllocationsAndReverses(t *testing.T) {
+	mockWalletRepo := new(MockWalletRepository)
+	mockInvestmentRepo := new(MockInvestmentRepository)
+	mockTxRepo := new(MockInvestmentTransactionRepository)
+
+	service := NewInvestmentService(
+		mockInvestmentRepo,
+		mockWalletRepo,
+		mockTxRepo,
+		new(MockMarketDataService),
+		new(MockUserRepository),
+		new(MockFXRateService),
+		nil,
+		new(MockWalletService),
+	).(*investmentService)
+
+	ctx := context.Background()
+	wallet := createTestWallet(1, 1, walletv1.WalletType_INVESTMENT)
+	lots := costBasisLots()
+	investment := &models.Investment{
+		ID:              1,
+		WalletID:        1,
+		Type:            int32(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK),
+		Quantity:        10000,
+		AverageCost:     1610000,
+		TotalCost:       16100000000,
+		Currency:        "USD",
+		CostBasisMethod: int32(investmentv1.CostBasisMethod_COST_BASIS_METHOD_HIFO),
+	}
+
+	mockWalletRepo.On("GetByID", ctx, int32(1)).Return(wallet, nil)
+	mockWalletRepo.On("UpdateBalance", ctx, int32(1), mock.AnythingOfType("int64")).Return(wallet, nil)
+	mockTxRepo.On("GetOpenLots", ctx, int32(1)).Return(lots, nil)
+	mockTxRepo.On("UpdateLot", ctx, mock.AnythingOfType("*models.InvestmentLot")).Return(nil)
+	mockInvestmentRepo.On("Update", ctx, investment).Return(nil)
+
+	var sellTx *models.InvestmentTransaction
+	mockTxRepo.On("Create", ctx, mock.AnythingOfType("*models.InvestmentTransaction")).
+		Run(func(args mock.Arguments) { sellTx = args.Get(1).(*models.InvestmentTransaction) }).
+		Return(nil)
+
+	// Sell 4000 units @ 1800000: HIFO takes 3000 from lot 2 (1700000) and 1000 from lot 3 (1600000)
+	_, err := service.processSellTransaction(ctx, investment, &investmentv1.AddTransactionRequest{
+		Quantity:        4000,
+		Price:           1800000,
+		Fees:            100,
+		TransactionDate: time.Now().Unix(),
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, sellTx)
+	require.Len(t, sellTx.Allocations, 2)
+	assert.Equal(t, int32(2), sellTx.Allocations[0].LotID)
+	assert.Equal(t, int64(3000), sellTx.Allocations[0].Quantity)
+	assert.Equal(t, int32(3), sellTx.Allocations[1].LotID)
+	assert.Equal(t, int64(1000), sellTx.Allocations[1].Quantity)
+	assert.Equal(t, int64(2000), lots[0].RemainingQuantity, "lot 1 is untouched by HIFO")
+
+	// 0.3 × 100000 + 0.1 × 200000 (precision 10000), minus the fee
+	assert.Equal(t, int64(50000-100), investment.RealizedPNL)
+
+	mockTxRepo.On("GetLotByID", ctx, int32(2)).Return(lots[1], nil)
+	mockTxRepo.On("GetLotByID", ctx, int32(3)).Return(lots[2], nil)
+
+	require.NoError(t, service.reverseSellTransaction(ctx, investment, sellTx))
+
+	assert.Equal(t, int64(10000), investment.Quantity)
+	assert.Equal(t, int64(0), investment.RealizedPNL)
+	assert.Equal(t, int64(16100000000), investment.TotalCost)
+	assert.Equal(t, int64(3000), lots[1].RemainingQuantity)
+	assert.Equal(t, int64(5000), lots[2].RemainingQuantity)
+}
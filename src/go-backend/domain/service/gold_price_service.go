@@ -10,12 +10,14 @@ import (
 
 	"wealthjourney/pkg/cache"
 	"wealthjourney/pkg/vang247"
+	"wealthjourney/pkg/yahoo"
 )
 
 // GoldPriceService handles fetching gold prices from vang247
 type GoldPriceService interface {
 	FetchPriceForSymbol(ctx context.Context, symbol string) (*CachedGoldPrice, error)
 	FetchAllPrices(ctx context.Context) ([]*CachedGoldPrice, error)
+	FetchHistoricalPrices(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error)
 }
 
 // CachedGoldPrice represents a cached gold price with metadata
@@ -170,3 +172,13 @@ func (s *goldPriceService) FetchAllPrices(ctx context.Context) ([]*CachedGoldPri
 
 	return prices, nil
 }
+
+// FetchHistoricalPrices fetches daily prices for a gold symbol (USD per ounce)
+// Only world gold has a history, from Yahoo Finance gold futures; vang247 only
+// publishes current prices, so VND gold history is built from recorded quotes
+func (s *goldPriceService) FetchHistoricalPrices(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error) {
+	if symbol != "XAUUSD" {
+		return nil, ErrPriceHistoryUnavailable
+	}
+	return yahoo.GetHistoricalPrices(ctx, "GC=F", from, to)
+}
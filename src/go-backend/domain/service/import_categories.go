@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	"wealthjourney/pkg/fx"
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// importCategoryResolver resolves the category names of an imported file to the user's
+// categories, creating the ones the user does not have yet. Lookups are cached for the
+// duration of one import.
+type importCategoryResolver struct {
+	categoryRepo repository.CategoryRepository
+	userID       int32
+	cache        map[string]int32
+}
+
+func newImportCategoryResolver(categoryRepo repository.CategoryRepository, userID int32) *importCategoryResolver {
+	return &importCategoryResolver{
+		categoryRepo: categoryRepo,
+		userID:       userID,
+		cache:        make(map[string]int32),
+	}
+}
+
+// resolve returns the ID of the named category, an income category for a positive
+// amount and an expense category otherwise.
+func (r *importCategoryResolver) resolve(ctx context.Context, name string, amount int64) (int32, error) {
+	categoryType := v1.CategoryType_CATEGORY_TYPE_EXPENSE
+	if amount > 0 {
+		categoryType = v1.CategoryType_CATEGORY_TYPE_INCOME
+	}
+
+	key := fmt.Sprintf("%d:%s", categoryType, name)
+	if id, ok := r.cache[key]; ok {
+		return id, nil
+	}
+
+	category, err := r.categoryRepo.GetByNameAndType(ctx, r.userID, name, categoryType)
+	if err != nil {
+		return 0, err
+	}
+	r.cache[key] = category.ID
+	return category.ID, nil
+}
+
+// splits builds the split lines of an imported transaction. parsedAmount is the parent
+// amount in the parser unit (×10000) and amount the stored one in currency; the last line absorbs
+// the rounding of the conversion so the lines sum to the parent. Returns nil when the
+// lines cannot be imported, leaving the transaction unsplit.
+func (r *importCategoryResolver) splits(ctx context.Context, lines []*v1.ParsedSplit, parsedAmount, amount int64, currency string) []models.TransactionSplit {
+	if len(lines) < 2 {
+		return nil
+	}
+
+	var parsedTotal int64
+	for _, line := range lines {
+		if line.Amount == nil || line.CategoryName == "" {
+			return nil
+		}
+		parsedTotal += line.Amount.Amount
+	}
+	if parsedTotal != parsedAmount {
+		return nil
+	}
+
+	splits := make([]models.TransactionSplit, 0, len(lines))
+	var total int64
+	for i, line := range lines {
+		categoryID, err := r.resolve(ctx, line.CategoryName, line.Amount.Amount)
+		if err != nil {
+			return nil
+		}
+
+		splitAmount := fx.ParsedToStorageAmount(line.Amount.Amount, currency)
+		if i == len(lines)-1 {
+			splitAmount = amount - total
+		}
+		if splitAmount == 0 {
+			return nil
+		}
+		total += splitAmount
+
+		splits = append(splits, models.TransactionSplit{
+			CategoryID: categoryID,
+			Amount:     splitAmount,
+			Note:       line.Memo,
+		})
+	}
+	return splits
+}
+
+// convertParsedSplits converts split lines at the exchange rate of their parent. The
+// last line absorbs the rounding so the lines still sum to the converted amount.
+func convertParsedSplits(lines []*v1.ParsedSplit, exchangeRate float64, convertedAmount int64, currency string) []*v1.ParsedSplit {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	converted := make([]*v1.ParsedSplit, 0, len(lines))
+	var total int64
+	for i, line := range lines {
+		var amount int64
+		if line.Amount != nil {
+			amount = int64(float64(line.Amount.Amount) * exchangeRate)
+		}
+		if i == len(lines)-1 {
+			amount = convertedAmount - total
+		}
+		total += amount
+
+		converted = append(converted, &v1.ParsedSplit{
+			CategoryName: line.CategoryName,
+			Amount:       &v1.Money{Amount: amount, Currency: currency},
+			Memo:         line.Memo,
+		})
+	}
+	return converted
+}
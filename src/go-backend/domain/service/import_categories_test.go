@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubNamedCategoryRepository looks categories up by ID or by name, creating missing ones
+// like the real repository does.
+type stubNamedCategoryRepository struct {
+	repository.CategoryRepository
+	byID    map[int32]*models.Category
+	lookups int
+}
+
+func newStubNamedCategoryRepository(categories ...*models.Category) *stubNamedCategoryRepository {
+	repo := &stubNamedCategoryRepository{byID: make(map[int32]*models.Category)}
+	for _, category := range categories {
+		repo.byID[category.ID] = category
+	}
+	return repo
+}
+
+func (r *stubNamedCategoryRepository) GetByID(ctx context.Context, id int32) (*models.Category, error) {
+	category, ok := r.byID[id]
+	if !ok {
+		return nil, apperrors.NewNotFoundError("category")
+	}
+	return category, nil
+}
+
+func (r *stubNamedCategoryRepository) GetByIDs(ctx context.Context, ids []int32) (map[int32]*models.Category, error) {
+	result := make(map[int32]*models.Category)
+	for _, id := range ids {
+		if category, ok := r.byID[id]; ok {
+			result[id] = category
+		}
+	}
+	return result, nil
+}
+
+func (r *stubNamedCategoryRepository) GetByNameAndType(ctx context.Context, userID int32, name string, categoryType v1.CategoryType) (*models.Category, error) {
+	r.lookups++
+	for _, category := range r.byID {
+		if category.UserID == userID && category.Name == name && category.Type == int32(categoryType) {
+			return category, nil
+		}
+	}
+	category := &models.Category{ID: int32(len(r.byID) + 1), UserID: userID, Name: name, Type: int32(categoryType)}
+	r.byID[category.ID] = category
+	return category, nil
+}
+
+func parsedSplit(category string, amount int64, memo string) *v1.ParsedSplit {
+	return &v1.ParsedSplit{CategoryName: category, Amount: &v1.Money{Amount: amount, Currency: "VND"}, Memo: memo}
+}
+
+func TestImportCategoryResolver_Resolve(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubNamedCategoryRepository(&models.Category{ID: 1, UserID: 7, Name: "Salary", Type: int32(v1.CategoryType_CATEGORY_TYPE_INCOME)})
+	resolver := newImportCategoryResolver(repo, 7)
+
+	id, err := resolver.resolve(ctx, "Salary", 100)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), id)
+
+	// A missing category is created, typed by the sign of the amount
+	id, err = resolver.resolve(ctx, "Groceries", -100)
+	require.NoError(t, err)
+	assert.Equal(t, int32(v1.CategoryType_CATEGORY_TYPE_EXPENSE), repo.byID[id].Type)
+
+	again, err := resolver.resolve(ctx, "Groceries", -500)
+	require.NoError(t, err)
+	assert.Equal(t, id, again)
+	assert.Equal(t, 2, repo.lookups, "repeated names are cached")
+}
+
+func TestImportCategoryResolver_Splits(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lines sum to the parent", func(t *testing.T) {
+		resolver := newImportCategoryResolver(newStubNamedCategoryRepository(), 7)
+		lines := []*v1.ParsedSplit{
+			parsedSplit("Groceries", -3000000000, "Food"),
+			parsedSplit("Household", -1500000000, ""),
+		}
+
+		splits := resolver.splits(ctx, lines, -4500000000, -450000, "VND")
+		require.Len(t, splits, 2)
+		assert.Equal(t, int64(-300000), splits[0].Amount)
+		assert.Equal(t, "Food", splits[0].Note)
+		assert.Equal(t, int64(-150000), splits[1].Amount)
+		assert.NotEqual(t, splits[0].CategoryID, splits[1].CategoryID)
+	})
+
+	t.Run("the last line absorbs rounding", func(t *testing.T) {
+		resolver := newImportCategoryResolver(newStubNamedCategoryRepository(), 7)
+		lines := []*v1.ParsedSplit{
+			parsedSplit("A", -15000, ""),
+			parsedSplit("B", -15000, ""),
+		}
+
+		splits := resolver.splits(ctx, lines, -30000, -3, "VND")
+		require.Len(t, splits, 2)
+		assert.Equal(t, int64(-1), splits[0].Amount)
+		assert.Equal(t, int64(-2), splits[1].Amount)
+		assert.Equal(t, int64(-3), models.SumSplitAmounts(splits))
+	})
+
+	t.Run("unusable lines leave the transaction unsplit", func(t *testing.T) {
+		resolver := newImportCategoryResolver(newStubNamedCategoryRepository(), 7)
+
+		assert.Nil(t, resolver.splits(ctx, []*v1.ParsedSplit{parsedSplit("A", -10000, "")}, -10000, -1, "VND"), "single line")
+		assert.Nil(t, resolver.splits(ctx, []*v1.ParsedSplit{
+			parsedSplit("A", -10000, ""),
+			parsedSplit("", -10000, ""),
+		}, -20000, -2, "VND"), "uncategorized line")
+		assert.Nil(t, resolver.splits(ctx, []*v1.ParsedSplit{
+			parsedSplit("A", -10000, ""),
+			parsedSplit("B", -10000, ""),
+		}, -30000, -3, "VND"), "lines not adding up")
+	})
+}
+
+func TestConvertParsedSplits(t *testing.T) {
+	lines := []*v1.ParsedSplit{
+		parsedSplit("A", 10000, "first"),
+		parsedSplit("B", 10000, ""),
+		parsedSplit("C", 10000, ""),
+	}
+
+	converted := convertParsedSplits(lines, 1.0/3, 10000, "USD")
+	require.Len(t, converted, 3)
+	assert.Equal(t, int64(3333), converted[0].Amount.Amount)
+	assert.Equal(t, int64(3334), converted[2].Amount.Amount)
+	assert.Equal(t, "USD", converted[1].Amount.Currency)
+	assert.Equal(t, "first", converted[0].Memo)
+
+	assert.Nil(t, convertParsedSplits(nil, 2, 100, "USD"))
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/fx"
+	"wealthjourney/pkg/logger"
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// ImportBalanceAdjuster posts balance adjustments for statement reconciliation.
+// WalletService satisfies it.
+type ImportBalanceAdjuster interface {
+	AdjustBalance(ctx context.Context, walletID int32, userID int32, req *v1.AdjustBalanceRequest) (*v1.AdjustBalanceResponse, error)
+}
+
+// SetImportBalanceAdjuster lets ExecuteImport post reconciliation differences through
+// the adjuster. Without it, imports still record the reconciliation but never adjust.
+func SetImportBalanceAdjuster(svc ImportService, adjuster ImportBalanceAdjuster) {
+	if s, ok := svc.(*importService); ok {
+		s.balanceAdjuster = adjuster
+	}
+}
+
+// ReconcileStatement checks that the opening balance plus the rows gives the closing
+// balance, in parser format (×10000).
+func (s *importService) ReconcileStatement(balance *v1.StatementBalance, transactions []*v1.ParsedTransaction) *v1.StatementReconciliation {
+	return reconcileStatement(balance, transactions, nil)
+}
+
+// reconcileStatement checks opening + rows = closing for the rows not excluded. Rows
+// are taken at the amount the statement reports, before any currency conversion.
+// Returns nil when the statement reported no balances.
+func reconcileStatement(balance *v1.StatementBalance, transactions []*v1.ParsedTransaction, excluded map[int32]bool) *v1.StatementReconciliation {
+	if balance == nil {
+		return nil
+	}
+	opening, closing := balance.GetOpeningBalance(), balance.GetClosingBalance()
+	if opening == nil || closing == nil {
+		return &v1.StatementReconciliation{
+			Status:  v1.ReconciliationStatus_RECONCILIATION_STATUS_UNAVAILABLE,
+			Message: "The statement does not report both an opening and a closing balance",
+		}
+	}
+
+	currency := closing.Currency
+	var rowsTotal int64
+	var kept []*v1.ParsedTransaction
+	for _, tx := range transactions {
+		if excluded[tx.RowNumber] {
+			continue
+		}
+		amount := statementAmount(tx)
+		if amount == nil {
+			continue
+		}
+		if amount.Currency != "" && currency != "" && amount.Currency != currency {
+			return &v1.StatementReconciliation{
+				Status:  v1.ReconciliationStatus_RECONCILIATION_STATUS_UNAVAILABLE,
+				Message: fmt.Sprintf("Row %d is in %s, the statement balances in %s", tx.RowNumber, amount.Currency, currency),
+			}
+		}
+		rowsTotal += amount.Amount
+		kept = append(kept, tx)
+	}
+
+	expectedClosing := opening.Amount + rowsTotal
+	difference := closing.Amount - expectedClosing
+	result := &v1.StatementReconciliation{
+		Status:          v1.ReconciliationStatus_RECONCILIATION_STATUS_BALANCED,
+		RowsTotal:       &v1.Money{Amount: rowsTotal, Currency: currency},
+		ExpectedClosing: &v1.Money{Amount: expectedClosing, Currency: currency},
+		Difference:      &v1.Money{Amount: difference, Currency: currency},
+		Message:         "Opening balance plus the rows matches the closing balance",
+	}
+	if difference == 0 {
+		return result
+	}
+
+	// A row whose amount is the negated difference balances the statement when removed,
+	// which is what a row parsed twice or a summary line read as a row looks like
+	for _, tx := range kept {
+		if statementAmount(tx).Amount == -difference {
+			result.SuspectRowNumbers = append(result.SuspectRowNumbers, tx.RowNumber)
+		}
+	}
+
+	result.Status = v1.ReconciliationStatus_RECONCILIATION_STATUS_MISMATCH
+	result.Message = reconciliationMismatchMessage(difference, result.SuspectRowNumbers)
+	return result
+}
+
+// statementAmount returns the amount of a row as the statement reports it
+func statementAmount(tx *v1.ParsedTransaction) *v1.Money {
+	if tx.OriginalAmount != nil && tx.OriginalAmount.Amount != 0 {
+		return tx.OriginalAmount
+	}
+	return tx.Amount
+}
+
+func reconciliationMismatchMessage(difference int64, suspectRows []int32) string {
+	message := "The rows add up to less than the closing balance: credits are missing or debits were read twice"
+	if difference < 0 {
+		message = "The rows add up to more than the closing balance: debits are missing or credits were read twice"
+	}
+	if len(suspectRows) > 0 {
+		rows := make([]string, len(suspectRows))
+		for i, row := range suspectRows {
+			rows[i] = strconv.Itoa(int(row))
+		}
+		message += fmt.Sprintf(". Removing row %s would balance the statement", strings.Join(rows, " or "))
+	}
+	return message
+}
+
+// applyReconciliation records the reconciliation on the batch in storage format, the
+// smallest unit of the wallet currency as for the imported amounts
+func applyReconciliation(batch *models.ImportBatch, balance *v1.StatementBalance, reconciliation *v1.StatementReconciliation, currency string) {
+	if reconciliation == nil {
+		return
+	}
+	if opening := balance.GetOpeningBalance(); opening != nil {
+		amount := fx.ParsedToStorageAmount(opening.Amount, currency)
+		batch.StatementOpeningBalance = &amount
+	}
+	if closing := balance.GetClosingBalance(); closing != nil {
+		amount := fx.ParsedToStorageAmount(closing.Amount, currency)
+		batch.StatementClosingBalance = &amount
+	}
+
+	switch reconciliation.Status {
+	case v1.ReconciliationStatus_RECONCILIATION_STATUS_BALANCED:
+		batch.ReconciliationStatus = models.ReconciliationStatusBalanced
+	case v1.ReconciliationStatus_RECONCILIATION_STATUS_MISMATCH:
+		batch.ReconciliationStatus = models.ReconciliationStatusMismatch
+		batch.ReconciliationDifference = fx.ParsedToStorageAmount(reconciliation.Difference.Amount, currency)
+	default:
+		batch.ReconciliationStatus = models.ReconciliationStatusUnavailable
+	}
+}
+
+// postReconciliationAdjustment posts the reconciliation difference of the batch through
+// the balance adjuster and links the adjustment to the batch, so undoing the import
+// removes it too. The batch and wallet are updated in place.
+func (s *importService) postReconciliationAdjustment(ctx context.Context, userID int32, wallet *models.Wallet, batch *models.ImportBatch, statementCurrency string) error {
+	if s.balanceAdjuster == nil {
+		return fmt.Errorf("balance adjustments are not available")
+	}
+	if statementCurrency != "" && statementCurrency != wallet.Currency {
+		return fmt.Errorf("statement is in %s, the wallet in %s", statementCurrency, wallet.Currency)
+	}
+	difference := batch.ReconciliationDifference
+	if difference == 0 {
+		return fmt.Errorf("difference is below the smallest currency unit")
+	}
+
+	adjustmentType := v1.AdjustmentType_ADJUSTMENT_TYPE_ADD
+	amount := difference
+	if difference < 0 {
+		adjustmentType = v1.AdjustmentType_ADJUSTMENT_TYPE_REMOVE
+		amount = -difference
+	}
+
+	resp, err := s.balanceAdjuster.AdjustBalance(ctx, wallet.ID, userID, &v1.AdjustBalanceRequest{
+		WalletId:       wallet.ID,
+		Amount:         &v1.Money{Amount: amount, Currency: wallet.Currency},
+		Reason:         fmt.Sprintf("Statement reconciliation for import %s", batch.ID),
+		AdjustmentType: adjustmentType,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.importRepo.LinkTransactionsToImport(ctx, batch.ID, []int32{resp.TransactionId}); err != nil {
+		// The adjustment stands; it just is not undone with the import
+		logger.LogImportError(ctx, userID, "execute:link_adjustment", err, map[string]interface{}{
+			"batch_id":       batch.ID,
+			"transaction_id": resp.TransactionId,
+		})
+	}
+
+	batch.BalanceAdjustment = difference
+	batch.ReconciliationStatus = models.ReconciliationStatusAdjusted
+	wallet.Balance += difference
+	return s.importRepo.UpdateImportBatch(ctx, batch)
+}
+
+// batchReconciliationToProto returns the reconciliation recorded on a batch, in
+// storage format, or nil when the import carried no statement balances
+func batchReconciliationToProto(batch *models.ImportBatch, currency string) *v1.StatementReconciliation {
+	result := &v1.StatementReconciliation{}
+	switch batch.ReconciliationStatus {
+	case models.ReconciliationStatusBalanced:
+		result.Status = v1.ReconciliationStatus_RECONCILIATION_STATUS_BALANCED
+		result.Message = "Opening balance plus the rows matches the closing balance"
+	case models.ReconciliationStatusMismatch:
+		result.Status = v1.ReconciliationStatus_RECONCILIATION_STATUS_MISMATCH
+		result.Message = reconciliationMismatchMessage(batch.ReconciliationDifference, nil)
+	case models.ReconciliationStatusAdjusted:
+		result.Status = v1.ReconciliationStatus_RECONCILIATION_STATUS_ADJUSTED
+		result.Message = "The difference to the closing balance was posted as a balance adjustment"
+		result.Adjustment = &v1.Money{Amount: batch.BalanceAdjustment, Currency: currency}
+	case models.ReconciliationStatusUnavailable:
+		result.Status = v1.ReconciliationStatus_RECONCILIATION_STATUS_UNAVAILABLE
+		result.Message = "The statement does not report both an opening and a closing balance"
+		return result
+	default:
+		return nil
+	}
+
+	if batch.StatementOpeningBalance != nil && batch.StatementClosingBalance != nil {
+		expectedClosing := *batch.StatementClosingBalance - batch.ReconciliationDifference
+		result.ExpectedClosing = &v1.Money{Amount: expectedClosing, Currency: currency}
+		result.RowsTotal = &v1.Money{Amount: expectedClosing - *batch.StatementOpeningBalance, Currency: currency}
+		result.Difference = &v1.Money{Amount: batch.ReconciliationDifference, Currency: currency}
+	}
+	return result
+}
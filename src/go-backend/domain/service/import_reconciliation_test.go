@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statementBalance(opening, closing int64) *v1.StatementBalance {
+	return &v1.StatementBalance{
+		OpeningBalance: &v1.Money{Amount: opening, Currency: "VND"},
+		ClosingBalance: &v1.Money{Amount: closing, Currency: "VND"},
+	}
+}
+
+func statementRow(row int32, amount int64) *v1.ParsedTransaction {
+	return &v1.ParsedTransaction{RowNumber: row, Amount: &v1.Money{Amount: amount, Currency: "VND"}, IsValid: true}
+}
+
+func TestReconcileStatement(t *testing.T) {
+	rows := []*v1.ParsedTransaction{
+		statementRow(1, -1500000000),
+		statementRow(2, 25000000000),
+		statementRow(3, -50000000),
+	}
+
+	t.Run("balanced", func(t *testing.T) {
+		result := reconcileStatement(statementBalance(100000000000, 123450000000), rows, nil)
+		require.NotNil(t, result)
+		assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_BALANCED, result.Status)
+		assert.Equal(t, int64(23450000000), result.RowsTotal.Amount)
+		assert.Equal(t, int64(0), result.Difference.Amount)
+	})
+
+	t.Run("extra row", func(t *testing.T) {
+		withDuplicate := append(rows, statementRow(4, -1500000000))
+		result := reconcileStatement(statementBalance(100000000000, 123450000000), withDuplicate, nil)
+		assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_MISMATCH, result.Status)
+		assert.Equal(t, int64(1500000000), result.Difference.Amount)
+		assert.Equal(t, int64(121950000000), result.ExpectedClosing.Amount)
+		assert.Equal(t, []int32{1, 4}, result.SuspectRowNumbers, "either copy of a row read twice")
+		assert.Contains(t, result.Message, "Removing row 1 or 4")
+	})
+
+	t.Run("missing row", func(t *testing.T) {
+		withoutSalary := []*v1.ParsedTransaction{rows[0], rows[2]}
+		result := reconcileStatement(statementBalance(100000000000, 123450000000), withoutSalary, nil)
+		assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_MISMATCH, result.Status)
+		assert.Equal(t, int64(25000000000), result.Difference.Amount)
+		assert.Empty(t, result.SuspectRowNumbers)
+		assert.Contains(t, result.Message, "credits are missing")
+	})
+
+	t.Run("excluded rows are left out", func(t *testing.T) {
+		withSummary := append(rows, statementRow(4, 100000000000))
+		result := reconcileStatement(statementBalance(100000000000, 123450000000), withSummary, map[int32]bool{4: true})
+		assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_BALANCED, result.Status)
+	})
+
+	t.Run("statement amounts before conversion", func(t *testing.T) {
+		converted := statementRow(1, -40000000)
+		converted.Amount.Currency = "USD"
+		converted.OriginalAmount = &v1.Money{Amount: -1000000000000, Currency: "VND"}
+		result := reconcileStatement(statementBalance(2000000000000, 1000000000000), []*v1.ParsedTransaction{converted}, nil)
+		assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_BALANCED, result.Status)
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+		assert.Nil(t, reconcileStatement(nil, rows, nil))
+
+		closingOnly := &v1.StatementBalance{ClosingBalance: &v1.Money{Amount: 1, Currency: "VND"}}
+		assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_UNAVAILABLE, reconcileStatement(closingOnly, rows, nil).Status)
+
+		foreign := statementRow(5, 10000)
+		foreign.Amount.Currency = "USD"
+		result := reconcileStatement(statementBalance(0, 10000), []*v1.ParsedTransaction{foreign}, nil)
+		assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_UNAVAILABLE, result.Status)
+	})
+}
+
+func TestApplyReconciliation(t *testing.T) {
+	balance := statementBalance(100000000000, 123450000000)
+	batch := &models.ImportBatch{}
+	applyReconciliation(batch, balance, reconcileStatement(balance, []*v1.ParsedTransaction{statementRow(1, 20000000000)}, nil), "VND")
+
+	assert.Equal(t, models.ReconciliationStatusMismatch, batch.ReconciliationStatus)
+	assert.Equal(t, int64(345000), batch.ReconciliationDifference)
+	require.NotNil(t, batch.StatementOpeningBalance)
+	assert.Equal(t, int64(10000000), *batch.StatementOpeningBalance)
+
+	proto := batchReconciliationToProto(batch, "VND")
+	assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_MISMATCH, proto.Status)
+	assert.Equal(t, int64(12000000), proto.ExpectedClosing.Amount)
+	assert.Equal(t, int64(2000000), proto.RowsTotal.Amount)
+
+	unreconciled := &models.ImportBatch{}
+	applyReconciliation(unreconciled, nil, nil, "VND")
+	assert.Empty(t, unreconciled.ReconciliationStatus)
+	assert.Nil(t, batchReconciliationToProto(unreconciled, "VND"))
+}
+
+// stubBalanceAdjuster records the adjustments it is asked to post.
+type stubBalanceAdjuster struct {
+	requests []*v1.AdjustBalanceRequest
+	err      error
+}
+
+func (a *stubBalanceAdjuster) AdjustBalance(ctx context.Context, walletID int32, userID int32, req *v1.AdjustBalanceRequest) (*v1.AdjustBalanceResponse, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	a.requests = append(a.requests, req)
+	return &v1.AdjustBalanceResponse{Success: true, TransactionId: 99}, nil
+}
+
+// stubBatchImportRepository records linked transactions and batch updates.
+type stubBatchImportRepository struct {
+	repository.ImportRepository
+	linked  map[string][]int32
+	updated []*models.ImportBatch
+}
+
+func (r *stubBatchImportRepository) LinkTransactionsToImport(ctx context.Context, importBatchID string, transactionIDs []int32) error {
+	if r.linked == nil {
+		r.linked = make(map[string][]int32)
+	}
+	r.linked[importBatchID] = append(r.linked[importBatchID], transactionIDs...)
+	return nil
+}
+
+func (r *stubBatchImportRepository) UpdateImportBatch(ctx context.Context, batch *models.ImportBatch) error {
+	r.updated = append(r.updated, batch)
+	return nil
+}
+
+func TestPostReconciliationAdjustment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing debits are removed", func(t *testing.T) {
+		adjuster := &stubBalanceAdjuster{}
+		repo := &stubBatchImportRepository{}
+		svc := &importService{importRepo: repo}
+		SetImportBalanceAdjuster(svc, adjuster)
+
+		wallet := &models.Wallet{ID: 3, Currency: "VND", Balance: 500000}
+		batch := &models.ImportBatch{ID: "batch-1", ReconciliationStatus: models.ReconciliationStatusMismatch, ReconciliationDifference: -150000}
+		require.NoError(t, svc.postReconciliationAdjustment(ctx, 7, wallet, batch, "VND"))
+
+		require.Len(t, adjuster.requests, 1)
+		assert.Equal(t, v1.AdjustmentType_ADJUSTMENT_TYPE_REMOVE, adjuster.requests[0].AdjustmentType)
+		assert.Equal(t, int64(150000), adjuster.requests[0].Amount.Amount)
+		assert.Equal(t, []int32{99}, repo.linked["batch-1"], "undoing the import removes the adjustment")
+		assert.Equal(t, models.ReconciliationStatusAdjusted, batch.ReconciliationStatus)
+		assert.Equal(t, int64(-150000), batch.BalanceAdjustment)
+		assert.Equal(t, int64(350000), wallet.Balance)
+		assert.Len(t, repo.updated, 1)
+
+		proto := batchReconciliationToProto(batch, "VND")
+		assert.Equal(t, v1.ReconciliationStatus_RECONCILIATION_STATUS_ADJUSTED, proto.Status)
+		assert.Equal(t, int64(-150000), proto.Adjustment.Amount)
+	})
+
+	t.Run("nothing is posted when it cannot be", func(t *testing.T) {
+		wallet := &models.Wallet{ID: 3, Currency: "VND"}
+		newBatch := func() *models.ImportBatch {
+			return &models.ImportBatch{ReconciliationStatus: models.ReconciliationStatusMismatch, ReconciliationDifference: 1000}
+		}
+
+		assert.Error(t, (&importService{}).postReconciliationAdjustment(ctx, 7, wallet, newBatch(), "VND"), "no adjuster")
+
+		adjuster := &stubBalanceAdjuster{}
+		svc := &importService{importRepo: &stubBatchImportRepository{}, balanceAdjuster: adjuster}
+		assert.Error(t, svc.postReconciliationAdjustment(ctx, 7, wallet, newBatch(), "USD"), "foreign statement")
+		assert.Empty(t, adjuster.requests)
+
+		adjuster.err = apperrors.NewValidationError("Insufficient balance for this adjustment")
+		batch := newBatch()
+		assert.Error(t, svc.postReconciliationAdjustment(ctx, 7, wallet, batch, "VND"))
+		assert.Equal(t, models.ReconciliationStatusMismatch, batch.ReconciliationStatus)
+	})
+}
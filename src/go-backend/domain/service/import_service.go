@@ -15,6 +15,7 @@ import (
 	"wealthjourney/pkg/duplicate"
 	apperrors "wealthjourney/pkg/errors"
 	"wealthjourney/pkg/fileupload"
+	"wealthjourney/pkg/fx"
 	"wealthjourney/pkg/logger"
 	"wealthjourney/pkg/metrics"
 	"wealthjourney/pkg/parser"
@@ -41,6 +42,9 @@ type ImportService interface {
 	// GetImportHistory retrieves import history for a user.
 	GetImportHistory(ctx context.Context, userID int32, req *v1.GetImportHistoryRequest) (*v1.GetImportHistoryResponse, error)
 
+	// ReconcileStatement checks parsed rows against the opening and closing balances of their statement.
+	ReconcileStatement(balance *v1.StatementBalance, transactions []*v1.ParsedTransaction) *v1.StatementReconciliation
+
 	// ListBankTemplates retrieves available bank templates.
 	ListBankTemplates(ctx context.Context) (*v1.ListBankTemplatesResponse, error)
 
@@ -75,8 +79,12 @@ type importService struct {
 	categoryRepo      repository.CategoryRepository
 	duplicateDetector *duplicate.Detector
 	categorizer       *categorization.Categorizer
-	fxService         ImportFXService // For currency conversion
-	jobQueue          ImportJobQueue  // For background processing
+	fxService         ImportFXService               // For currency conversion
+	jobQueue          ImportJobQueue                // For background processing
+	balanceAdjuster   ImportBalanceAdjuster         // Optional, posts statement reconciliation differences
+	tagRepo           repository.TagRepository      // Optional, resolves the tags column
+	transferRepo      repository.TransferRepository // Optional, links rows mirrored in another wallet as transfers
+	budgetAlerts      BudgetAlertEvaluator          // Optional, checks budget alerts after an import
 }
 
 // FXService defines the interface for exchange rate operations
@@ -191,7 +199,7 @@ func (s *importService) DetectDuplicates(ctx context.Context, userID int32, req
 	}()
 
 	// Validate wallet ownership
-	_, err := s.walletRepo.GetByIDForUser(ctx, req.WalletId, userID)
+	wallet, err := s.walletRepo.GetByIDForUser(ctx, req.WalletId, userID)
 	if err != nil {
 		return nil, apperrors.WrapWithUserMessage(err)
 	}
@@ -235,17 +243,39 @@ func (s *importService) DetectDuplicates(ctx context.Context, userID int32, req
 		pbMatches = append(pbMatches, pbMatch)
 	}
 
+	// Rows that are not duplicates may be one side of a transfer between the user's wallets
+	duplicateRows := make(map[*v1.ParsedTransaction]bool, len(duplicateMatches))
+	for _, match := range duplicateMatches {
+		duplicateRows[match.ImportedTransaction] = true
+	}
+	var candidates []*v1.ParsedTransaction
+	for _, tx := range req.Transactions {
+		if !duplicateRows[tx] {
+			candidates = append(candidates, tx)
+		}
+	}
+	transferMatches, err := s.detectTransfers(ctx, userID, wallet, candidates)
+	if err != nil {
+		return nil, apperrors.WrapWithUserMessage(err)
+	}
+
+	message := fmt.Sprintf("Found %d potential duplicate(s)", len(pbMatches))
+	if len(transferMatches) > 0 {
+		message += fmt.Sprintf(" and %d possible transfer(s)", len(transferMatches))
+	}
+
 	return &v1.DetectDuplicatesResponse{
-		Success:   true,
-		Message:   fmt.Sprintf("Found %d potential duplicate(s)", len(pbMatches)),
-		Matches:   pbMatches,
-		Timestamp: time.Now().Format(time.RFC3339),
+		Success:         true,
+		Message:         message,
+		Matches:         pbMatches,
+		TransferMatches: transferMatchesToProto(transferMatches),
+		Timestamp:       time.Now().Format(time.RFC3339),
 	}, nil
 }
 
 // updateTransactionFromParsed updates an existing transaction with data from a parsed transaction
 func (s *importService) updateTransactionFromParsed(ctx context.Context, existingTx *models.Transaction, parsedTx *v1.ParsedTransaction, userID int32) {
-	existingTx.Amount = parsedTx.Amount.Amount
+	existingTx.Amount = fx.ParsedToStorageAmount(parsedTx.Amount.Amount, existingTx.Currency)
 	existingTx.Date = time.Unix(parsedTx.Date, 0)
 	existingTx.Note = parsedTx.Description
 
@@ -403,6 +433,16 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 		}
 	}
 
+	// Rows the user confirmed as the other side of a transaction in another wallet
+	transferLinks, err := s.confirmedTransferLinks(ctx, userID, wallet, req, validTransactions)
+	if err != nil {
+		logger.LogImportError(ctx, userID, "execute:detect_transfers", err, map[string]interface{}{
+			"wallet_id": req.WalletId,
+			"file_id":   req.FileId,
+		})
+		return nil, err
+	}
+
 	// Build user action map for REVIEW_EACH strategy
 	duplicateActionMap := make(map[int32]*v1.DuplicateAction) // row number -> action
 	if req.Strategy == v1.DuplicateHandlingStrategy_DUPLICATE_STRATEGY_REVIEW_EACH {
@@ -414,9 +454,12 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 	// Convert parsed transactions to models
 	var transactionsToCreate []*models.Transaction
 	var transactionsToUpdate []*models.Transaction
+	var pendingTransferLinks []importTransferLink
 	var totalIncome, totalExpenses int64
 	var minDate, maxDate time.Time
 	var duplicatesMerged, duplicatesSkipped int32
+	categories := newImportCategoryResolver(s.categoryRepo, userID)
+	tags := newImportTagResolver(s.tagRepo, userID)
 
 	for _, parsedTx := range validTransactions {
 		// Convert Unix timestamp to time.Time
@@ -538,12 +581,23 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 
 		// Get or create category
 		var categoryID *int32
-		if parsedTx.SuggestedCategoryId > 0 {
+		counterpart, isTransfer := transferLinks[parsedTx.RowNumber]
+		if isTransfer {
+			// Imported as the other leg of a transfer
+			if id, err := s.transferCategoryID(ctx, userID, parsedTx.Amount.Amount); err == nil {
+				categoryID = id
+			}
+		} else if parsedTx.SuggestedCategoryId > 0 {
 			// Verify category exists and belongs to user
 			_, err := s.categoryRepo.GetByIDForUser(ctx, parsedTx.SuggestedCategoryId, userID)
 			if err == nil {
 				categoryID = &parsedTx.SuggestedCategoryId
 			}
+		} else if parsedTx.CategoryName != "" {
+			// Category named by the file, e.g. when migrating from Quicken
+			if id, err := categories.resolve(ctx, parsedTx.CategoryName, parsedTx.Amount.Amount); err == nil {
+				categoryID = &id
+			}
 		} else if s.categorizer != nil && parsedTx.Description != "" {
 			// Try to suggest a category using the categorizer
 			suggestion, err := s.categorizer.SuggestCategory(ctx, userID, parsedTx.Description)
@@ -558,10 +612,10 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 		}
 
 		// Convert amount from parser format (×10000) to regular storage format (smallest currency unit)
-		// Parser stores amounts as ×10000 for precision, but regular transactions store in smallest unit
+		// Parser stores amounts as ×10000 of the major unit, but regular transactions store in smallest unit
 		// For VND: parser=-990000000 (×10000) → regular=-99000 VND
-		// For USD: parser=12345000 (×10000) → regular=12345 cents ($123.45)
-		amount := parsedTx.Amount.Amount / 10000
+		// For USD: parser=1234500 (×10000) → regular=12345 cents ($123.45)
+		amount := fx.ParsedToStorageAmount(parsedTx.Amount.Amount, wallet.Currency)
 
 		// Validate amount is not zero
 		if amount == 0 {
@@ -598,13 +652,30 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 			Currency:   parsedTx.Amount.Currency,
 			Date:       txDate,
 			Note:       sanitizedDescription,
+			Status:     int32(v1.TransactionStatus_TRANSACTION_STATUS_CLEARED), // Already on a bank statement
+		}
+
+		if len(parsedTx.Tags) > 0 {
+			transaction.Tags = tags.resolve(ctx, parsedTx.Tags)
+		}
+
+		if len(parsedTx.Splits) > 0 {
+			transaction.Splits = categories.splits(ctx, parsedTx.Splits, parsedTx.Amount.Amount, amount, wallet.Currency)
+			if transaction.Splits == nil {
+				logger.LogImportError(ctx, userID, "execute:splits",
+					fmt.Errorf("split lines could not be imported"),
+					map[string]interface{}{
+						"row_number":  parsedTx.RowNumber,
+						"split_count": len(parsedTx.Splits),
+					})
+			}
 		}
 
 		// Store currency conversion metadata if present
 		if parsedTx.OriginalAmount != nil && parsedTx.OriginalAmount.Amount != 0 {
 			// Convert original amount from parser format (×10000) to regular format
-			originalAmount := parsedTx.OriginalAmount.Amount / 10000
 			originalCurrency := parsedTx.OriginalAmount.Currency
+			originalAmount := fx.ParsedToStorageAmount(parsedTx.OriginalAmount.Amount, originalCurrency)
 			exchangeRate := parsedTx.ExchangeRate
 			exchangeRateSource := parsedTx.ExchangeRateSource
 			exchangeRateDate := time.Unix(parsedTx.ExchangeRateDate, 0)
@@ -617,6 +688,9 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 		}
 
 		transactionsToCreate = append(transactionsToCreate, transaction)
+		if isTransfer {
+			pendingTransferLinks = append(pendingTransferLinks, importTransferLink{leg: transaction, counterpart: counterpart})
+		}
 	}
 
 	// Create import batch record
@@ -645,6 +719,10 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 		UndoExpiresAt:     undoExpiresAt,
 	}
 
+	// Record how the statement rows the user kept reconcile with the statement balances
+	reconciliation := reconcileStatement(req.StatementBalance, req.Transactions, excludedMap)
+	applyReconciliation(importBatch, req.StatementBalance, reconciliation, wallet.Currency)
+
 	// Create import batch
 	if err := s.importRepo.CreateImportBatch(ctx, importBatch); err != nil {
 		logger.LogImportError(ctx, userID, "execute:create_batch", err, map[string]interface{}{
@@ -695,6 +773,17 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 		}
 	}
 
+	// Link confirmed transfer rows with their counterparts; balances are already applied
+	if len(pendingTransferLinks) > 0 {
+		importBatch.TransfersLinked = s.linkImportedTransfers(ctx, userID, pendingTransferLinks)
+		if err := s.importRepo.UpdateImportBatch(ctx, importBatch); err != nil {
+			logger.LogImportError(ctx, userID, "execute:update_batch", err, map[string]interface{}{
+				"batch_id":         batchID,
+				"transfers_linked": importBatch.TransfersLinked,
+			})
+		}
+	}
+
 	// Learn from user's category selections (if they differ from suggestions)
 	if s.categorizer != nil {
 		corrections := make(map[string]int32)
@@ -746,9 +835,31 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 	// Use the verified wallet for response
 	wallet = walletAfter
 
+	// Settle a reconciliation difference through a balance adjustment if the user asked for it.
+	// The import itself has succeeded, so a failed adjustment only leaves the mismatch recorded.
+	if req.PostBalanceAdjustment && importBatch.ReconciliationStatus == models.ReconciliationStatusMismatch {
+		statementCurrency := req.StatementBalance.GetClosingBalance().GetCurrency()
+		if err := s.postReconciliationAdjustment(ctx, userID, wallet, importBatch, statementCurrency); err != nil {
+			logger.LogImportError(ctx, userID, "execute:balance_adjustment", err, map[string]interface{}{
+				"batch_id":   batchID,
+				"wallet_id":  req.WalletId,
+				"difference": importBatch.ReconciliationDifference,
+			})
+		}
+	}
+
+	summaryReconciliation := batchReconciliationToProto(importBatch, wallet.Currency)
+	if summaryReconciliation != nil && importBatch.ReconciliationStatus != models.ReconciliationStatusAdjusted {
+		// The check at import knows the rows, the batch only the totals
+		summaryReconciliation.Message = reconciliation.Message
+		summaryReconciliation.SuspectRowNumbers = reconciliation.SuspectRowNumbers
+	}
+
 	// Mark import as successful
 	importSuccess = true
 
+	evaluateBudgetAlertsAsync(s.budgetAlerts, userID)
+
 	// Log successful import
 	logger.LogImportSuccess(ctx, userID, "execute", map[string]interface{}{
 		"batch_id":          batchID,
@@ -769,6 +880,7 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 			TotalSkipped:      importBatch.SkippedRows,
 			DuplicatesMerged:  importBatch.DuplicatesMerged,
 			DuplicatesSkipped: importBatch.DuplicatesSkipped,
+			TransfersLinked:   importBatch.TransfersLinked,
 			TotalIncome: &v1.Money{
 				Amount:   totalIncome,
 				Currency: wallet.Currency,
@@ -785,11 +897,27 @@ func (s *importService) ExecuteImport(ctx context.Context, userID int32, req *v1
 				Amount:   wallet.Balance,
 				Currency: wallet.Currency,
 			},
+			OpeningBalance: statementSummaryBalance(req.StatementBalance.GetOpeningBalance(), wallet.Currency),
+			ClosingBalance: statementSummaryBalance(req.StatementBalance.GetClosingBalance(), wallet.Currency),
+			Reconciliation: summaryReconciliation,
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
 	}, nil
 }
 
+// statementSummaryBalance converts a balance reported by the statement from parser format
+// (×10000) to the storage format the summary uses. A balance in another currency than the
+// wallet is left out, since the summary amounts are all in the wallet currency.
+func statementSummaryBalance(balance *v1.Money, walletCurrency string) *v1.Money {
+	if balance == nil || (balance.Currency != "" && balance.Currency != walletCurrency) {
+		return nil
+	}
+	return &v1.Money{
+		Amount:   fx.ParsedToStorageAmount(balance.Amount, walletCurrency),
+		Currency: walletCurrency,
+	}
+}
+
 // UndoImport undoes an import within 24 hours of creation.
 func (s *importService) UndoImport(ctx context.Context, userID int32, importID string) (*v1.UndoImportResponse, error) {
 	// Get import batch
@@ -824,6 +952,17 @@ func (s *importService) UndoImport(ctx context.Context, userID int32, importID s
 		return nil, err
 	}
 
+	// Reconciled transactions are locked, so the import cannot be undone in part
+	reconciled := 0
+	for _, tx := range transactions {
+		if tx.IsReconciled() {
+			reconciled++
+		}
+	}
+	if reconciled > 0 {
+		return nil, apperrors.NewConflictError(fmt.Sprintf("%d transactions of this import are reconciled; the import cannot be undone", reconciled))
+	}
+
 	// Calculate total reversal amount by wallet
 	walletReversals := make(map[int32]int64)
 	for _, tx := range transactions {
@@ -875,7 +1014,19 @@ func (s *importService) UndoImport(ctx context.Context, userID int32, importID s
 		}
 	}
 
-	// 3. Mark import batch as undone
+	// 3. Turn transfers linked at import back into plain transactions on the other wallet
+	if transferIDs := transferIDsOf(transactions); len(transferIDs) > 0 && s.transferRepo != nil {
+		if err := s.transferRepo.UnlinkWithTx(ctx, dbTx, transferIDs); err != nil {
+			dbTx.Rollback()
+			logger.LogImportError(ctx, userID, "undo:unlink_transfers", err, map[string]interface{}{
+				"batch_id":       batch.ID,
+				"transfer_count": len(transferIDs),
+			})
+			return nil, fmt.Errorf("failed to unlink transfers: %w", err)
+		}
+	}
+
+	// 4. Mark import batch as undone
 	now := time.Now()
 	batch.UndoneAt = &now
 	batch.CanUndo = false
@@ -941,6 +1092,7 @@ func (s *importService) GetImportHistory(ctx context.Context, userID int32, req
 				TotalSkipped:      batch.SkippedRows,
 				DuplicatesMerged:  batch.DuplicatesMerged,
 				DuplicatesSkipped: batch.DuplicatesSkipped,
+				TransfersLinked:   batch.TransfersLinked,
 				TotalIncome: &v1.Money{
 					Amount:   batch.TotalIncome,
 					Currency: "VND", // Default currency, should get from wallet
@@ -953,6 +1105,7 @@ func (s *importService) GetImportHistory(ctx context.Context, userID int32, req
 					Amount:   batch.NetChange,
 					Currency: "VND",
 				},
+				Reconciliation: batchReconciliationToProto(batch, "VND"),
 			},
 			CanUndo:       batch.CanUndo && batch.UndoneAt == nil && time.Now().Before(batch.UndoExpiresAt),
 			UndoExpiresAt: batch.UndoExpiresAt.Unix(),
@@ -1097,6 +1250,9 @@ func (s *importService) ConvertCurrency(ctx context.Context, userID int32, req *
 				ReferenceNumber:     tx.ReferenceNumber,
 				ValidationErrors:    tx.ValidationErrors,
 				IsValid:             tx.IsValid,
+				CategoryName:        tx.CategoryName,
+				Tags:                tx.Tags,
+				Splits:              convertParsedSplits(tx.Splits, exchangeRate, convertedAmount, walletCurrency),
 				// Populate conversion metadata
 				OriginalAmount:       &v1.Money{Amount: originalAmount, Currency: originalCurrency},
 				ExchangeRate:         exchangeRate,
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	"wealthjourney/pkg/logger"
+)
+
+// SetImportTagRepository lets ExecuteImport attach the tags of an imported tags column.
+// Without it, the column is parsed but the tags are dropped.
+func SetImportTagRepository(svc ImportService, tagRepo repository.TagRepository) {
+	if s, ok := svc.(*importService); ok {
+		s.tagRepo = tagRepo
+	}
+}
+
+// importTagResolver resolves the tag names of an imported file to the user's tags,
+// creating the ones the user does not have yet. Lookups are cached for the duration
+// of one import.
+type importTagResolver struct {
+	tagRepo repository.TagRepository
+	userID  int32
+	cache   map[string]models.Tag
+}
+
+func newImportTagResolver(tagRepo repository.TagRepository, userID int32) *importTagResolver {
+	return &importTagResolver{
+		tagRepo: tagRepo,
+		userID:  userID,
+		cache:   make(map[string]models.Tag),
+	}
+}
+
+// resolve returns the tags with the given names. Names that are not valid tag names or
+// cannot be resolved are skipped, so a bad cell never fails the import.
+func (r *importTagResolver) resolve(ctx context.Context, names []string) []models.Tag {
+	if r.tagRepo == nil {
+		return nil
+	}
+
+	var tags []models.Tag
+	seen := make(map[int32]bool)
+	for _, name := range names {
+		name, err := validateTagName(name)
+		if err != nil {
+			continue
+		}
+
+		key := strings.ToLower(name)
+		tag, ok := r.cache[key]
+		if !ok {
+			resolved, err := r.tagRepo.GetOrCreateByName(ctx, r.userID, name)
+			if err != nil {
+				logger.LogImportError(ctx, r.userID, "execute:tags", err, map[string]interface{}{
+					"tag": name,
+				})
+				continue
+			}
+			tag = *resolved
+			r.cache[key] = tag
+		}
+
+		if !seen[tag.ID] {
+			seen[tag.ID] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	"wealthjourney/pkg/duplicate"
+	"wealthjourney/pkg/logger"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/google/uuid"
+)
+
+// SetImportTransferRepository lets imports detect rows mirrored in another of the user's
+// wallets and link the confirmed ones as transfers. Without it, such rows are imported
+// as plain income and expenses.
+func SetImportTransferRepository(svc ImportService, transferRepo repository.TransferRepository) {
+	if s, ok := svc.(*importService); ok {
+		s.transferRepo = transferRepo
+	}
+}
+
+// importTransferLink is an imported row to link as a transfer with an existing
+// transaction of another wallet once the row is created.
+type importTransferLink struct {
+	leg         *models.Transaction
+	counterpart *models.Transaction
+}
+
+// detectTransfers finds the rows mirrored by a transaction of opposite sign in another
+// wallet of the user with the same currency. Returns nothing when transfers cannot be
+// linked.
+func (s *importService) detectTransfers(ctx context.Context, userID int32, wallet *models.Wallet, transactions []*v1.ParsedTransaction) ([]*duplicate.TransferMatch, error) {
+	if s.transferRepo == nil || len(transactions) == 0 {
+		return nil, nil
+	}
+
+	wallets, _, err := s.walletRepo.ListByUserID(ctx, userID, repository.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var walletIDs []int32
+	for _, other := range wallets {
+		if other.ID != wallet.ID && other.Currency == wallet.Currency {
+			walletIDs = append(walletIDs, other.ID)
+		}
+	}
+
+	return s.duplicateDetector.DetectTransfers(ctx, walletIDs, transactions)
+}
+
+// confirmedTransferLinks returns the counterparts of the transfer links of the request
+// by row number. A link is only kept when the detector still proposes it, so a stale
+// or forged link imports the row as a plain transaction.
+func (s *importService) confirmedTransferLinks(ctx context.Context, userID int32, wallet *models.Wallet, req *v1.ExecuteImportRequest, transactions []*v1.ParsedTransaction) (map[int32]*models.Transaction, error) {
+	confirmed := make(map[int32]*models.Transaction)
+	if len(req.TransferLinks) == 0 {
+		return confirmed, nil
+	}
+
+	matches, err := s.detectTransfers(ctx, userID, wallet, transactions)
+	if err != nil {
+		return nil, err
+	}
+	detected := make(map[int32]*models.Transaction, len(matches))
+	for _, match := range matches {
+		detected[match.ImportedTransaction.RowNumber] = match.CounterpartTransaction
+	}
+
+	for _, link := range req.TransferLinks {
+		counterpart, ok := detected[link.ImportedRowNumber]
+		if !ok || counterpart.ID != link.CounterpartTransactionId {
+			logger.LogImportError(ctx, userID, "execute:transfer_link_mismatch",
+				fmt.Errorf("row %d is not mirrored by transaction %d", link.ImportedRowNumber, link.CounterpartTransactionId),
+				map[string]interface{}{
+					"row_number":     link.ImportedRowNumber,
+					"counterpart_id": link.CounterpartTransactionId,
+				})
+			continue
+		}
+		confirmed[link.ImportedRowNumber] = counterpart
+	}
+	return confirmed, nil
+}
+
+// transferCategoryID returns the transfer category for a leg of the given amount,
+// the same "Outgoing Transfer" and "Incoming Transfer" categories TransferFunds uses.
+func (s *importService) transferCategoryID(ctx context.Context, userID int32, amount int64) (*int32, error) {
+	name, categoryType := "Incoming Transfer", v1.CategoryType_CATEGORY_TYPE_INCOME
+	if amount < 0 {
+		name, categoryType = "Outgoing Transfer", v1.CategoryType_CATEGORY_TYPE_EXPENSE
+	}
+	category, err := s.categoryRepo.GetByNameAndType(ctx, userID, name, categoryType)
+	if err != nil {
+		return nil, err
+	}
+	return &category.ID, nil
+}
+
+// linkImportedTransfers links the created rows with their counterparts and returns how
+// many were linked. The rows are already imported, so a failed link only leaves them
+// as plain transactions.
+func (s *importService) linkImportedTransfers(ctx context.Context, userID int32, links []importTransferLink) int32 {
+	var linked int32
+	for _, link := range links {
+		from, to := link.counterpart, link.leg
+		if link.leg.Amount < 0 {
+			from, to = link.leg, link.counterpart
+		}
+
+		transfer := &models.Transfer{
+			ID:           uuid.New().String(),
+			UserID:       userID,
+			FromWalletID: from.WalletID,
+			ToWalletID:   to.WalletID,
+			ExchangeRate: 1,
+		}
+		if err := s.transferRepo.Link(ctx, transfer, from, to); err != nil {
+			logger.LogImportError(ctx, userID, "execute:link_transfer", err, map[string]interface{}{
+				"transaction_id": link.leg.ID,
+				"counterpart_id": link.counterpart.ID,
+			})
+			continue
+		}
+		linked++
+	}
+	return linked
+}
+
+// transferMatchesToProto converts transfer matches to protobuf.
+func transferMatchesToProto(matches []*duplicate.TransferMatch) []*v1.TransferMatch {
+	result := make([]*v1.TransferMatch, 0, len(matches))
+	for _, match := range matches {
+		counterpart := &v1.Transaction{
+			Id:       match.CounterpartTransaction.ID,
+			WalletId: match.CounterpartTransaction.WalletID,
+			Amount: &v1.Money{
+				Amount:   match.CounterpartTransaction.Amount,
+				Currency: match.CounterpartTransaction.Currency,
+			},
+			Date: match.CounterpartTransaction.Date.Unix(),
+			Note: match.CounterpartTransaction.Note,
+		}
+		if match.CounterpartTransaction.CategoryID != nil {
+			counterpart.CategoryId = *match.CounterpartTransaction.CategoryID
+		}
+
+		result = append(result, &v1.TransferMatch{
+			ImportedTransaction:    match.ImportedTransaction,
+			CounterpartTransaction: counterpart,
+			Confidence:             match.Confidence,
+			MatchReason:            match.MatchReason,
+		})
+	}
+	return result
+}
+
+// transferIDsOf returns the IDs of the transfers the transactions are legs of.
+func transferIDsOf(transactions []*models.Transaction) []string {
+	var ids []string
+	for _, tx := range transactions {
+		if tx.TransferID != nil {
+			ids = append(ids, *tx.TransferID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/duplicate"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTransferImportService returns an import service into the checking wallet 1, with
+// the savings wallet 2 holding a 500,000 VND credit from the day before.
+func newTransferImportService(t *testing.T) (*importService, *stubTransferRepository, time.Time) {
+	t.Helper()
+	day := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	repo := newStubTransferRepository(
+		&models.Wallet{ID: 1, UserID: 7, WalletName: "Checking", Currency: "VND"},
+		&models.Wallet{ID: 2, UserID: 7, WalletName: "Savings", Currency: "VND", Balance: 500000},
+		&models.Wallet{ID: 3, UserID: 7, WalletName: "Travel", Currency: "USD"},
+	)
+	repo.legs.transactions[100] = &models.Transaction{ID: 100, WalletID: 2, Amount: 500000, Currency: "VND", Date: day.AddDate(0, 0, -1), Note: "Incoming"}
+
+	svc := &importService{
+		walletRepo:        repo.wallets,
+		transactionRepo:   repo.legs,
+		categoryRepo:      newStubNamedCategoryRepository(),
+		duplicateDetector: duplicate.NewDetector(repo.legs),
+		transferRepo:      repo,
+	}
+	return svc, repo, day
+}
+
+func TestImportService_DetectDuplicates_ProposesTransfers(t *testing.T) {
+	ctx := context.Background()
+	svc, _, day := newTransferImportService(t)
+
+	resp, err := svc.DetectDuplicates(ctx, 7, &v1.DetectDuplicatesRequest{
+		WalletId: 1,
+		Transactions: []*v1.ParsedTransaction{
+			{RowNumber: 1, Date: day.Unix(), Amount: &v1.Money{Amount: -5000000000, Currency: "VND"}, Description: "Transfer to savings"},
+			{RowNumber: 2, Date: day.Unix(), Amount: &v1.Money{Amount: -250000000, Currency: "VND"}, Description: "Market"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Matches)
+	require.Len(t, resp.TransferMatches, 1)
+
+	match := resp.TransferMatches[0]
+	assert.Equal(t, int32(1), match.ImportedTransaction.RowNumber)
+	assert.Equal(t, int32(100), match.CounterpartTransaction.Id)
+	assert.Equal(t, int32(2), match.CounterpartTransaction.WalletId)
+	assert.Equal(t, int32(90), match.Confidence)
+	assert.Contains(t, resp.Message, "1 possible transfer(s)")
+
+	// Without a transfer repository transfers are not proposed
+	svc.transferRepo = nil
+	resp, err = svc.DetectDuplicates(ctx, 7, &v1.DetectDuplicatesRequest{
+		WalletId:     1,
+		Transactions: []*v1.ParsedTransaction{{RowNumber: 1, Date: day.Unix(), Amount: &v1.Money{Amount: -5000000000, Currency: "VND"}}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.TransferMatches)
+}
+
+func TestImportService_ConfirmedTransferLinks(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, day := newTransferImportService(t)
+
+	rows := []*v1.ParsedTransaction{
+		{RowNumber: 1, Date: day.Unix(), Amount: &v1.Money{Amount: -5000000000, Currency: "VND"}},
+		{RowNumber: 2, Date: day.Unix(), Amount: &v1.Money{Amount: -250000000, Currency: "VND"}},
+	}
+	links, err := svc.confirmedTransferLinks(ctx, 7, repo.wallets.wallets[1], &v1.ExecuteImportRequest{
+		TransferLinks: []*v1.TransferLink{
+			{ImportedRowNumber: 1, CounterpartTransactionId: 100},
+			{ImportedRowNumber: 2, CounterpartTransactionId: 100}, // Not what the detector proposes
+		},
+	}, rows)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, int32(100), links[1].ID)
+}
+
+func TestImportService_ConfirmedTransferLinks_CentCurrency(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, day := newTransferImportService(t)
+
+	// A $123.45 credit in a second USD wallet, stored in cents
+	repo.wallets.wallets[4] = &models.Wallet{ID: 4, UserID: 7, WalletName: "Brokerage", Currency: "USD"}
+	repo.legs.transactions[200] = &models.Transaction{ID: 200, WalletID: 4, Amount: 12345, Currency: "USD", Date: day}
+
+	rows := []*v1.ParsedTransaction{
+		{RowNumber: 1, Date: day.Unix(), Amount: &v1.Money{Amount: -1234500, Currency: "USD"}},
+	}
+	links, err := svc.confirmedTransferLinks(ctx, 7, repo.wallets.wallets[3], &v1.ExecuteImportRequest{
+		TransferLinks: []*v1.TransferLink{{ImportedRowNumber: 1, CounterpartTransactionId: 200}},
+	}, rows)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, int32(200), links[1].ID)
+}
+
+func TestImportService_LinkImportedTransfers(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, day := newTransferImportService(t)
+
+	leg := &models.Transaction{ID: 101, WalletID: 1, Amount: -500000, Currency: "VND", Date: day}
+	repo.legs.transactions[leg.ID] = leg
+	counterpart := repo.legs.transactions[100]
+
+	linked := svc.linkImportedTransfers(ctx, 7, []importTransferLink{{leg: leg, counterpart: counterpart}})
+	assert.Equal(t, int32(1), linked)
+	require.NotNil(t, leg.TransferID)
+
+	transfer := repo.transfers[*leg.TransferID]
+	assert.Equal(t, int32(101), transfer.FromTransactionID, "the imported debit is the outgoing leg")
+	assert.Equal(t, int32(100), transfer.ToTransactionID)
+	assert.Equal(t, int32(1), transfer.FromWalletID)
+	assert.Equal(t, int32(2), transfer.ToWalletID)
+	assert.Equal(t, int64(500000), repo.wallets.wallets[2].Balance, "linking moves no balance")
+
+	// A counterpart that already is a leg is left alone
+	again := &models.Transaction{ID: 102, WalletID: 1, Amount: -500000, Currency: "VND", Date: day}
+	repo.legs.transactions[again.ID] = again
+	assert.Equal(t, int32(0), svc.linkImportedTransfers(ctx, 7, []importTransferLink{{leg: again, counterpart: counterpart}}))
+
+	// Undoing the import unlinks the counterpart
+	transferIDs := transferIDsOf([]*models.Transaction{leg, again})
+	require.Equal(t, []string{transfer.ID}, transferIDs)
+	require.NoError(t, repo.UnlinkWithTx(ctx, nil, transferIDs))
+	assert.Nil(t, repo.legs.transactions[100].TransferID)
+	assert.Empty(t, repo.transfers)
+}
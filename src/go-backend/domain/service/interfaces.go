@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"wealthjourney/domain/models"
 	"wealthjourney/pkg/fx"
+	"wealthjourney/pkg/notification"
 	"wealthjourney/pkg/types"
 	budgetv1 "wealthjourney/protobuf/v1"
 	investmentv1 "wealthjourney/protobuf/v1"
+	notificationv1 "wealthjourney/protobuf/v1"
 	transactionv1 "wealthjourney/protobuf/v1"
 	v1 "wealthjourney/protobuf/v1"
 	walletv1 "wealthjourney/protobuf/v1"
@@ -50,6 +54,25 @@ type WalletService interface {
 
 	// GetMonthlyDominance retrieves monthly balance data for all wallets.
 	GetMonthlyDominance(ctx context.Context, userID int32, req *walletv1.GetMonthlyDominanceRequest) (*walletv1.GetMonthlyDominanceResponse, error)
+
+	// StartReconciliation starts reconciling a wallet against a bank statement, or resumes
+	// the open reconciliation with the new statement date and balance.
+	StartReconciliation(ctx context.Context, walletID int32, userID int32, req *walletv1.StartReconciliationRequest) (*walletv1.StartReconciliationResponse, error)
+
+	// ListReconciliations retrieves the reconciliation history of a wallet.
+	ListReconciliations(ctx context.Context, walletID int32, userID int32, req *walletv1.ListReconciliationsRequest) (*walletv1.ListReconciliationsResponse, error)
+
+	// GetReconciliation retrieves a reconciliation with the transactions that can be ticked.
+	GetReconciliation(ctx context.Context, walletID int32, reconciliationID int32, userID int32) (*walletv1.GetReconciliationResponse, error)
+
+	// UpdateReconciliationTransactions ticks (clears) or unticks transactions in an open reconciliation.
+	UpdateReconciliationTransactions(ctx context.Context, walletID int32, reconciliationID int32, userID int32, req *walletv1.UpdateReconciliationTransactionsRequest) (*walletv1.UpdateReconciliationTransactionsResponse, error)
+
+	// FinishReconciliation reconciles and locks the cleared transactions once the difference is zero.
+	FinishReconciliation(ctx context.Context, walletID int32, reconciliationID int32, userID int32) (*walletv1.FinishReconciliationResponse, error)
+
+	// CancelReconciliation abandons an open reconciliation.
+	CancelReconciliation(ctx context.Context, walletID int32, reconciliationID int32, userID int32) (*walletv1.CancelReconciliationResponse, error)
 }
 
 // UserService defines the interface for user business logic.
@@ -104,6 +127,43 @@ type TransactionService interface {
 
 	// GetCategoryBreakdown retrieves category-wise transaction summary for a date range.
 	GetCategoryBreakdown(ctx context.Context, userID int32, req *v1.GetCategoryBreakdownRequest) (*v1.GetCategoryBreakdownResponse, error)
+
+	// GetTagBreakdown retrieves tag-wise transaction summary for a date range.
+	GetTagBreakdown(ctx context.Context, userID int32, req *v1.GetTagBreakdownRequest) (*v1.GetTagBreakdownResponse, error)
+
+	// ExportQIF writes the transactions of a wallet as a QIF file with category names.
+	ExportQIF(ctx context.Context, userID, walletID int32, w io.Writer) error
+}
+
+// RecurringTransactionService defines the interface for recurring transaction business logic.
+type RecurringTransactionService interface {
+	// GetRecurringTransaction retrieves a recurring transaction by ID, ensuring it belongs to the user.
+	GetRecurringTransaction(ctx context.Context, recurringID int32, userID int32) (*transactionv1.GetRecurringTransactionResponse, error)
+
+	// ListRecurringTransactions retrieves recurring transactions for a user with optional filtering.
+	ListRecurringTransactions(ctx context.Context, userID int32, req *transactionv1.ListRecurringTransactionsRequest) (*transactionv1.ListRecurringTransactionsResponse, error)
+
+	// CreateRecurringTransaction creates a new schedule and generates any occurrences already due.
+	CreateRecurringTransaction(ctx context.Context, userID int32, req *transactionv1.CreateRecurringTransactionRequest) (*transactionv1.CreateRecurringTransactionResponse, error)
+
+	// UpdateRecurringTransaction updates a schedule. Changes apply to future occurrences only.
+	UpdateRecurringTransaction(ctx context.Context, recurringID int32, userID int32, req *transactionv1.UpdateRecurringTransactionRequest) (*transactionv1.UpdateRecurringTransactionResponse, error)
+
+	// DeleteRecurringTransaction deletes a schedule. Generated transactions are kept.
+	DeleteRecurringTransaction(ctx context.Context, recurringID int32, userID int32) (*transactionv1.DeleteRecurringTransactionResponse, error)
+
+	// PauseRecurringTransaction stops generating occurrences until resumed.
+	PauseRecurringTransaction(ctx context.Context, recurringID int32, userID int32) (*transactionv1.PauseRecurringTransactionResponse, error)
+
+	// ResumeRecurringTransaction resumes a paused schedule, skipping slots missed while paused.
+	ResumeRecurringTransaction(ctx context.Context, recurringID int32, userID int32) (*transactionv1.ResumeRecurringTransactionResponse, error)
+
+	// SkipRecurringOccurrence skips the next (or a specific upcoming) occurrence.
+	SkipRecurringOccurrence(ctx context.Context, recurringID int32, userID int32, req *transactionv1.SkipRecurringOccurrenceRequest) (*transactionv1.SkipRecurringOccurrenceResponse, error)
+
+	// ProcessDueOccurrences generates every occurrence due at now across all users.
+	// Called by the background scheduler; returns the number of transactions created.
+	ProcessDueOccurrences(ctx context.Context, now time.Time) (int, error)
 }
 
 // CategoryService defines the interface for category business logic.
@@ -134,8 +194,60 @@ type CategoryService interface {
 	GetOrCreateInitialBalanceCategory(ctx context.Context, userID int32) (*models.Category, error)
 }
 
+// TagService defines the interface for tag business logic.
+type TagService interface {
+	// CreateTag creates a new tag for a user.
+	CreateTag(ctx context.Context, userID int32, req *transactionv1.CreateTagRequest) (*transactionv1.CreateTagResponse, error)
+
+	// GetTag retrieves a tag by ID, ensuring it belongs to the user.
+	GetTag(ctx context.Context, tagID int32, userID int32) (*transactionv1.GetTagResponse, error)
+
+	// ListTags retrieves the tags of a user ordered by name.
+	ListTags(ctx context.Context, userID int32, req *transactionv1.ListTagsRequest) (*transactionv1.ListTagsResponse, error)
+
+	// UpdateTag renames a tag.
+	UpdateTag(ctx context.Context, tagID int32, userID int32, req *transactionv1.UpdateTagRequest) (*transactionv1.UpdateTagResponse, error)
+
+	// DeleteTag deletes a tag and removes it from all transactions.
+	DeleteTag(ctx context.Context, tagID int32, userID int32) (*transactionv1.DeleteTagResponse, error)
+}
+
+// AttachmentService defines the interface for receipts and documents attached to transactions.
+type AttachmentService interface {
+	AttachmentCleaner
+
+	// UploadTransactionAttachment stores a file and attaches it to a transaction of the user.
+	UploadTransactionAttachment(ctx context.Context, transactionID int32, userID int32, req *transactionv1.UploadTransactionAttachmentRequest) (*transactionv1.UploadAttachmentResponse, error)
+
+	// ListTransactionAttachments retrieves the attachments of a transaction of the user.
+	ListTransactionAttachments(ctx context.Context, transactionID int32, userID int32) (*transactionv1.ListAttachmentsResponse, error)
+
+	// UploadInvestmentTransactionAttachment stores a file and attaches it to an investment transaction of the user.
+	UploadInvestmentTransactionAttachment(ctx context.Context, transactionID int32, userID int32, req *transactionv1.UploadInvestmentTransactionAttachmentRequest) (*transactionv1.UploadAttachmentResponse, error)
+
+	// ListInvestmentTransactionAttachments retrieves the attachments of an investment transaction of the user.
+	ListInvestmentTransactionAttachments(ctx context.Context, transactionID int32, userID int32) (*transactionv1.ListAttachmentsResponse, error)
+
+	// GetAttachment retrieves an attachment with a signed URL to download it.
+	GetAttachment(ctx context.Context, attachmentID int32, userID int32) (*transactionv1.GetAttachmentResponse, error)
+
+	// DeleteAttachment deletes an attachment and its stored file.
+	DeleteAttachment(ctx context.Context, attachmentID int32, userID int32) (*transactionv1.DeleteAttachmentResponse, error)
+}
+
+// AttachmentCleaner removes the attachments of deleted transactions.
+type AttachmentCleaner interface {
+	// DeleteTransactionAttachments deletes the attachments and stored files of a transaction.
+	DeleteTransactionAttachments(ctx context.Context, transactionID int32) error
+
+	// DeleteInvestmentTransactionAttachments deletes the attachments and stored files of an investment transaction.
+	DeleteInvestmentTransactionAttachments(ctx context.Context, transactionID int32) error
+}
+
 // BudgetService defines the interface for budget business logic.
 type BudgetService interface {
+	BudgetAlertEvaluator
+
 	// GetBudget retrieves a budget by ID, ensuring it belongs to the user.
 	GetBudget(ctx context.Context, budgetID int32, userID int32) (*budgetv1.GetBudgetResponse, error)
 
@@ -162,6 +274,74 @@ type BudgetService interface {
 
 	// DeleteBudgetItem deletes a budget item.
 	DeleteBudgetItem(ctx context.Context, budgetID int32, itemID int32, userID int32) (*budgetv1.DeleteBudgetItemResponse, error)
+
+	// GetBudgetEnvelopes returns the envelopes of a monthly or weekly budget for a period.
+	GetBudgetEnvelopes(ctx context.Context, budgetID int32, userID int32, req *budgetv1.GetBudgetEnvelopesRequest) (*budgetv1.GetBudgetEnvelopesResponse, error)
+
+	// MoveBudgetMoney moves money between two envelopes of a budget in the current period.
+	MoveBudgetMoney(ctx context.Context, budgetID int32, userID int32, req *budgetv1.MoveBudgetMoneyRequest) (*budgetv1.MoveBudgetMoneyResponse, error)
+
+	// ListBudgetMoves lists the money moved between the envelopes of a budget in a period.
+	ListBudgetMoves(ctx context.Context, budgetID int32, userID int32, req *budgetv1.ListBudgetMovesRequest) (*budgetv1.ListBudgetMovesResponse, error)
+
+	// CloseEndedPeriods snapshots the envelopes of every period that ended before now
+	// and returns how many periods were closed.
+	CloseEndedPeriods(ctx context.Context, now time.Time) (int, error)
+
+	// CreateBudgetAlertRule creates an alert rule on a category-linked item of a budget.
+	CreateBudgetAlertRule(ctx context.Context, budgetID int32, userID int32, req *budgetv1.CreateBudgetAlertRuleRequest) (*budgetv1.CreateBudgetAlertRuleResponse, error)
+
+	// ListBudgetAlertRules lists the alert rules of a budget.
+	ListBudgetAlertRules(ctx context.Context, budgetID int32, userID int32) (*budgetv1.ListBudgetAlertRulesResponse, error)
+
+	// UpdateBudgetAlertRule updates the thresholds, channels, webhook or state of a rule.
+	UpdateBudgetAlertRule(ctx context.Context, budgetID int32, ruleID int32, userID int32, req *budgetv1.UpdateBudgetAlertRuleRequest) (*budgetv1.UpdateBudgetAlertRuleResponse, error)
+
+	// DeleteBudgetAlertRule deletes an alert rule.
+	DeleteBudgetAlertRule(ctx context.Context, budgetID int32, ruleID int32, userID int32) (*budgetv1.DeleteBudgetAlertRuleResponse, error)
+
+	// GetBudgetVarianceReport compares item allocations with spending, month by month.
+	GetBudgetVarianceReport(ctx context.Context, budgetID int32, userID int32, req *budgetv1.GetBudgetVarianceReportRequest) (*budgetv1.GetBudgetVarianceReportResponse, error)
+
+	// CopyBudgetToPeriod creates a new budget with the total and items of a budget.
+	CopyBudgetToPeriod(ctx context.Context, budgetID int32, userID int32, req *budgetv1.CopyBudgetToPeriodRequest) (*budgetv1.CopyBudgetToPeriodResponse, error)
+
+	// CreateBudgetTemplate saves a budget template, from a budget or from items.
+	CreateBudgetTemplate(ctx context.Context, userID int32, req *budgetv1.CreateBudgetTemplateRequest) (*budgetv1.CreateBudgetTemplateResponse, error)
+
+	// ListBudgetTemplates lists the budget templates of a user.
+	ListBudgetTemplates(ctx context.Context, userID int32) (*budgetv1.ListBudgetTemplatesResponse, error)
+
+	// GetBudgetTemplate retrieves a budget template of a user.
+	GetBudgetTemplate(ctx context.Context, templateID int32, userID int32) (*budgetv1.GetBudgetTemplateResponse, error)
+
+	// DeleteBudgetTemplate deletes a budget template.
+	DeleteBudgetTemplate(ctx context.Context, templateID int32, userID int32) (*budgetv1.DeleteBudgetTemplateResponse, error)
+
+	// ApplyBudgetTemplate creates a new budget from a template.
+	ApplyBudgetTemplate(ctx context.Context, templateID int32, userID int32, req *budgetv1.ApplyBudgetTemplateRequest) (*budgetv1.ApplyBudgetTemplateResponse, error)
+}
+
+// BudgetAlertEvaluator checks budget alert rules after the user's transactions change.
+type BudgetAlertEvaluator interface {
+	// EvaluateBudgetAlerts notifies the alert thresholds crossed for the first time in
+	// the current period.
+	EvaluateBudgetAlerts(ctx context.Context, userID int32) error
+}
+
+// NotificationService defines the interface for the in-app notification inbox. It is
+// also the in-app notification channel: messages sent through it land in the inbox.
+type NotificationService interface {
+	notification.Channel
+
+	// ListNotifications lists the notifications of a user with pagination, newest first.
+	ListNotifications(ctx context.Context, userID int32, req *notificationv1.ListNotificationsRequest) (*notificationv1.ListNotificationsResponse, error)
+
+	// MarkNotificationRead marks a notification of the user as read.
+	MarkNotificationRead(ctx context.Context, notificationID int32, userID int32) (*notificationv1.MarkNotificationReadResponse, error)
+
+	// MarkAllNotificationsRead marks all notifications of the user as read.
+	MarkAllNotificationsRead(ctx context.Context, userID int32) (*notificationv1.MarkAllNotificationsReadResponse, error)
 }
 
 // InvestmentService defines the interface for investment business logic.
@@ -210,6 +390,21 @@ type InvestmentService interface {
 
 	// ListInvestmentWallets retrieves all investment wallets for a user.
 	ListInvestmentWallets(ctx context.Context, userID int32) ([]*models.Wallet, error)
+
+	// GetPerformanceReturns computes XIRR and TWR for an investment, a wallet or all investment wallets.
+	GetPerformanceReturns(ctx context.Context, userID int32, req *investmentv1.GetPerformanceReturnsRequest) (*investmentv1.GetPerformanceReturnsResponse, error)
+
+	// GetRealizedGainsReport lists realized gains per disposal in the user's preferred currency.
+	GetRealizedGainsReport(ctx context.Context, userID int32, req *investmentv1.GetRealizedGainsReportRequest) (*investmentv1.GetRealizedGainsReportResponse, error)
+
+	// SetAllocationTargets replaces the allocation targets of a wallet (0 = all investment wallets).
+	SetAllocationTargets(ctx context.Context, userID int32, req *investmentv1.SetAllocationTargetsRequest) (*investmentv1.SetAllocationTargetsResponse, error)
+
+	// ListAllocationTargets returns the allocation targets of a wallet (0 = all investment wallets).
+	ListAllocationTargets(ctx context.Context, userID int32, req *investmentv1.ListAllocationTargetsRequest) (*investmentv1.ListAllocationTargetsResponse, error)
+
+	// GetRebalancingPlan returns the drift from the allocation targets and the trades that close it.
+	GetRebalancingPlan(ctx context.Context, userID int32, req *investmentv1.GetRebalancingPlanRequest) (*investmentv1.GetRebalancingPlanResponse, error)
 }
 
 // FXRateService defines the interface for foreign exchange rate business logic.
@@ -232,4 +427,7 @@ type PortfolioHistoryService interface {
 
 	// CreateAggregatedSnapshot creates snapshots for all investment wallets.
 	CreateAggregatedSnapshot(ctx context.Context, userID int32) error
+
+	// BackfillHistory rebuilds daily snapshots for the days before the first snapshot.
+	BackfillHistory(ctx context.Context, userID int32) (int, error)
 }
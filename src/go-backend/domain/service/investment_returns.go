@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/fx"
+	"wealthjourney/pkg/returns"
+	"wealthjourney/pkg/units"
+	investmentv1 "wealthjourney/protobuf/v1"
+)
+
+// snapshotLookback is how far before the period start a portfolio snapshot may be
+// taken as the starting value.
+const snapshotLookback = 7 * 24 * time.Hour
+
+// SetPortfolioHistoryRepository wires the portfolio value snapshots used as valuation
+// points for wallet and portfolio time-weighted returns.
+func (s *investmentService) SetPortfolioHistoryRepository(historyRepo repository.PortfolioHistoryRepository) {
+	s.historyRepo = historyRepo
+}
+
+// returnsPageSize is how many investments are listed at a time when collecting the
+// holdings of a wallet or of all investment wallets.
+const returnsPageSize = 1000
+
+// listAllInvestments pages through an investment listing until it is exhausted.
+func listAllInvestments(list func(opts repository.ListOptions) ([]*models.Investment, int, error)) ([]*models.Investment, error) {
+	var investments []*models.Investment
+	for offset := 0; ; offset += returnsPageSize {
+		page, _, err := list(repository.ListOptions{Limit: returnsPageSize, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		investments = append(investments, page...)
+		if len(page) < returnsPageSize {
+			return investments, nil
+		}
+	}
+}
+
+// holdingHistory is the cash flow history of a holding (or portfolio) within a period.
+type holdingHistory struct {
+	start            time.Time // Period start, or the first transaction when that is later; zero without transactions
+	startValue       float64
+	flows            []returns.CashFlow  // Investor's view: buys negative, sells and dividends positive
+	valuations       []returns.Valuation // Holding value just before each trade, at the traded price
+	netContributions float64
+}
+
+// GetPerformanceReturns computes XIRR and TWR for an investment, a wallet or all investment wallets.
+func (s *investmentService) GetPerformanceReturns(ctx context.Context, userID int32, req *investmentv1.GetPerformanceReturnsRequest) (*investmentv1.GetPerformanceReturnsResponse, error) {
+	period := req.Period
+	if period == investmentv1.ReturnPeriod_RETURN_PERIOD_UNSPECIFIED {
+		period = investmentv1.ReturnPeriod_RETURN_PERIOD_SINCE_INCEPTION
+	}
+	if _, ok := investmentv1.ReturnPeriod_name[int32(period)]; !ok {
+		return nil, apperrors.NewValidationError(fmt.Sprintf("unsupported return period: %d", req.Period))
+	}
+
+	var investments []*models.Investment
+	switch {
+	case req.InvestmentId > 0:
+		investment, err := s.investmentRepo.GetByIDForUser(ctx, req.InvestmentId, userID)
+		if err != nil {
+			return nil, err
+		}
+		investments = []*models.Investment{investment}
+	case req.WalletId > 0:
+		if _, err := s.walletRepo.GetByIDForUser(ctx, req.WalletId, userID); err != nil {
+			return nil, err
+		}
+		list, err := listAllInvestments(func(opts repository.ListOptions) ([]*models.Investment, int, error) {
+			return s.investmentRepo.ListByWalletID(ctx, req.WalletId, opts, req.TypeFilter)
+		})
+		if err != nil {
+			return nil, err
+		}
+		investments = list
+	default:
+		list, err := listAllInvestments(func(opts repository.ListOptions) ([]*models.Investment, int, error) {
+			return s.investmentRepo.ListByUserID(ctx, userID, opts, req.TypeFilter)
+		})
+		if err != nil {
+			return nil, err
+		}
+		investments = list
+	}
+
+	investmentIDs := make([]int32, len(investments))
+	for i, inv := range investments {
+		investmentIDs[i] = inv.ID
+	}
+	transactions, err := s.txRepo.ListByInvestmentIDs(ctx, investmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	byInvestment := make(map[int32][]*models.InvestmentTransaction, len(investments))
+	for _, tx := range transactions {
+		byInvestment[tx.InvestmentID] = append(byInvestment[tx.InvestmentID], tx)
+	}
+
+	now := time.Now().UTC()
+	periodStart := returnPeriodStart(period, now)
+
+	histories := make([]*holdingHistory, len(investments))
+	breakdown := make([]*investmentv1.InvestmentReturns, len(investments))
+	for i, inv := range investments {
+		histories[i] = buildHoldingHistory(inv, byInvestment[inv.ID], periodStart)
+		breakdown[i] = &investmentv1.InvestmentReturns{
+			InvestmentId: inv.ID,
+			WalletId:     inv.WalletID,
+			Symbol:       inv.Symbol,
+			Name:         inv.Name,
+			Returns:      histories[i].performance(period, now, float64(inv.CurrentValue), inv.Currency),
+		}
+	}
+
+	resp := &investmentv1.GetPerformanceReturnsResponse{
+		Success:     true,
+		Message:     "Performance returns calculated successfully",
+		Investments: breakdown,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+
+	if req.InvestmentId > 0 {
+		resp.Data = breakdown[0].Returns
+		return resp, nil
+	}
+
+	// Snapshots hold whole wallets, so they cannot value a subset of investment types
+	useSnapshots := req.TypeFilter == investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+	data, err := s.portfolioReturns(ctx, userID, req.WalletId, useSnapshots, period, periodStart, now, investments, histories)
+	if err != nil {
+		return nil, err
+	}
+	resp.Data = data
+	return resp, nil
+}
+
+// portfolioReturns combines holding histories into one in the user's preferred currency.
+// Portfolio snapshots provide the valuation points for TWR and, when one exists at the
+// period start, the starting value.
+func (s *investmentService) portfolioReturns(ctx context.Context, userID, walletID int32, useSnapshots bool, period investmentv1.ReturnPeriod, periodStart, now time.Time, investments []*models.Investment, histories []*holdingHistory) (*investmentv1.PerformanceReturns, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	preferredCurrency := user.PreferredCurrency
+	if preferredCurrency == "" {
+		preferredCurrency = "USD" // Default fallback
+	}
+
+	factors := make(map[string]float64)
+	combined := &holdingHistory{}
+	var endValue float64
+
+	for i, inv := range investments {
+		factor, err := s.conversionFactor(ctx, factors, inv.Currency, preferredCurrency)
+		if err != nil {
+			return nil, err
+		}
+		endValue += float64(inv.CurrentValue) * factor
+
+		h := histories[i]
+		if h.start.IsZero() {
+			continue
+		}
+		if combined.start.IsZero() || h.start.Before(combined.start) {
+			combined.start = h.start
+		}
+		combined.startValue += h.startValue * factor
+		combined.netContributions += h.netContributions * factor
+		for _, flow := range h.flows {
+			combined.flows = append(combined.flows, returns.CashFlow{Date: flow.Date, Amount: flow.Amount * factor})
+		}
+	}
+
+	if useSnapshots && s.historyRepo != nil && !combined.start.IsZero() {
+		var snapshots []*models.PortfolioHistory
+		from := combined.start.Add(-snapshotLookback)
+		if walletID > 0 {
+			snapshots, err = s.historyRepo.GetHistoryByWallet(ctx, userID, walletID, from, now, 0)
+		} else {
+			snapshots, err = s.historyRepo.GetAggregatedHistory(ctx, userID, from, now, 0)
+		}
+		if err != nil {
+			return nil, apperrors.NewInternalErrorWithCause("failed to fetch portfolio history", err)
+		}
+
+		for _, valuation := range dailyPortfolioValues(snapshots, func(currency string) float64 {
+			factor, err := s.conversionFactor(ctx, factors, currency, preferredCurrency)
+			if err != nil {
+				return 1
+			}
+			return factor
+		}) {
+			switch {
+			case !valuation.Date.After(combined.start):
+				// A snapshot at the period start is more accurate than last traded prices,
+				// unless the period starts with the very first transaction
+				if combined.start.Equal(periodStart) {
+					combined.startValue = valuation.Value
+				}
+			case valuation.Date.Before(now):
+				combined.valuations = append(combined.valuations, valuation)
+			}
+		}
+	}
+
+	return combined.performance(period, now, endValue, preferredCurrency), nil
+}
+
+// conversionFactor returns the factor converting an amount in from's smallest unit
+// into to's smallest unit at the current rate.
+func (s *investmentService) conversionFactor(ctx context.Context, factors map[string]float64, from, to string) (float64, error) {
+	if from == "" || from == to {
+		return 1, nil
+	}
+	if factor, ok := factors[from]; ok {
+		return factor, nil
+	}
+
+	rate, err := s.fxRateSvc.GetRate(ctx, from, to)
+	if err != nil {
+		return 0, apperrors.NewInternalErrorWithCause(fmt.Sprintf("failed to get %s to %s rate", from, to), err)
+	}
+	factor := rate * float64(fx.GetDecimalMultiplier(to)) / float64(fx.GetDecimalMultiplier(from))
+	factors[from] = factor
+	return factor, nil
+}
+
+// returnPeriodStart returns the start of period ending at now; zero means since inception.
+func returnPeriodStart(period investmentv1.ReturnPeriod, now time.Time) time.Time {
+	switch period {
+	case investmentv1.ReturnPeriod_RETURN_PERIOD_YTD:
+		return time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	case investmentv1.ReturnPeriod_RETURN_PERIOD_ONE_YEAR:
+		return now.AddDate(-1, 0, 0)
+	case investmentv1.ReturnPeriod_RETURN_PERIOD_THREE_YEARS:
+		return now.AddDate(-3, 0, 0)
+	default:
+		return time.Time{}
+	}
+}
+
+// buildHoldingHistory replays an investment's transactions (oldest first) and keeps
+// the cash flows inside the period. Without price history, the holding is valued at
+// its last traded price: the starting value is the quantity held at the period start
+// times the last price paid or received before it.
+func buildHoldingHistory(inv *models.Investment, txs []*models.InvestmentTransaction, periodStart time.Time) *holdingHistory {
+	h := &holdingHistory{}
+	if len(txs) == 0 {
+		return h
+	}
+
+	investmentType := investmentv1.InvestmentType(inv.Type)
+	value := func(quantity, price int64) float64 {
+		return float64(units.CalculateTransactionCost(quantity, price, investmentType))
+	}
+
+	h.start = periodStart
+	if periodStart.IsZero() || txs[0].TransactionDate.After(periodStart) {
+		h.start = txs[0].TransactionDate
+	}
+
+	var quantity, lastPrice int64
+	started := false
+	for _, tx := range txs {
+		if !started && !tx.TransactionDate.Before(h.start) {
+			h.startValue = value(quantity, lastPrice)
+			started = true
+		}
+
+		txType := investmentv1.InvestmentTransactionType(tx.Type)
+
+		// Value the holding just before the trade; trades at the same instant share one point
+		if started && tx.TransactionDate.After(h.start) &&
+			(len(h.valuations) == 0 || tx.TransactionDate.After(h.valuations[len(h.valuations)-1].Date)) {
+			switch txType {
+			case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY,
+				investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL:
+				h.valuations = append(h.valuations, returns.Valuation{Date: tx.TransactionDate, Value: value(quantity, tx.Price)})
+			case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_DIVIDEND:
+				if lastPrice > 0 {
+					h.valuations = append(h.valuations, returns.Valuation{Date: tx.TransactionDate, Value: value(quantity, lastPrice)})
+				}
+			}
+		}
+
+		var flow float64
+		switch txType {
+		case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY:
+			quantity += tx.Quantity
+			lastPrice = tx.Price
+			flow = -float64(tx.Cost + tx.Fees)
+		case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL:
+			quantity -= tx.Quantity
+			lastPrice = tx.Price
+			flow = float64(tx.Cost - tx.Fees)
+		case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_DIVIDEND:
+			flow = float64(tx.Cost)
+		case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT:
+			// The split transaction records the quantity after the split
+			quantity = tx.Quantity
+			if tx.SplitNumerator > 0 && tx.SplitDenominator > 0 {
+				lastPrice = models.ScaleByRatio(lastPrice, tx.SplitDenominator, tx.SplitNumerator)
+			}
+		}
+
+		if started && flow != 0 {
+			h.flows = append(h.flows, returns.CashFlow{Date: tx.TransactionDate, Amount: flow})
+			h.netContributions -= flow
+		}
+	}
+
+	if !started {
+		// No transaction inside the period
+		h.startValue = value(quantity, lastPrice)
+	}
+	return h
+}
+
+// performance computes the returns of the history up to end, when the holding is worth endValue.
+func (h *holdingHistory) performance(period investmentv1.ReturnPeriod, end time.Time, endValue float64, currency string) *investmentv1.PerformanceReturns {
+	result := &investmentv1.PerformanceReturns{
+		Period:           period,
+		EndDate:          end.Unix(),
+		StartValue:       int64(h.startValue),
+		EndValue:         int64(endValue),
+		NetContributions: int64(h.netContributions),
+		Currency:         currency,
+	}
+	if h.start.IsZero() {
+		return result
+	}
+	result.StartDate = h.start.Unix()
+
+	xirrFlows := make([]returns.CashFlow, 0, len(h.flows)+2)
+	if h.startValue > 0 {
+		xirrFlows = append(xirrFlows, returns.CashFlow{Date: h.start, Amount: -h.startValue})
+	}
+	xirrFlows = append(xirrFlows, h.flows...)
+	xirrFlows = append(xirrFlows, returns.CashFlow{Date: end, Amount: endValue})
+	if rate, err := returns.XIRR(xirrFlows); err == nil {
+		result.Xirr = &rate
+	}
+
+	valuations := make([]returns.Valuation, 0, len(h.valuations)+2)
+	valuations = append(valuations, returns.Valuation{Date: h.start, Value: h.startValue})
+	for _, valuation := range h.valuations {
+		if valuation.Date.After(h.start) && valuation.Date.Before(end) {
+			valuations = append(valuations, valuation)
+		}
+	}
+	valuations = append(valuations, returns.Valuation{Date: end, Value: endValue})
+	if twr, err := returns.TimeWeighted(valuations, h.flows); err == nil {
+		annualized := returns.Annualize(twr, end.Sub(h.start).Hours()/24)
+		result.Twr = &twr
+		result.AnnualizedTwr = &annualized
+	}
+
+	return result
+}
+
+// dailyPortfolioValues sums wallet snapshots into one portfolio value per day, at the
+// time of that day's last snapshot. A wallet without a snapshot on a day keeps its
+// last known value. factor converts a snapshot currency to the report currency.
+func dailyPortfolioValues(snapshots []*models.PortfolioHistory, factor func(currency string) float64) []returns.Valuation {
+	sorted := make([]*models.PortfolioHistory, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Timestamp.Before(sorted[j].Timestamp) })
+
+	walletValues := make(map[int32]float64)
+	var valuations []returns.Valuation
+	for i, snapshot := range sorted {
+		walletValues[snapshot.WalletID] = float64(snapshot.TotalValue) * factor(snapshot.Currency)
+
+		last := i == len(sorted)-1
+		if !last && sameDay(sorted[i+1].Timestamp, snapshot.Timestamp) {
+			continue
+		}
+
+		var total float64
+		for _, value := range walletValues {
+			total += value
+		}
+		valuations = append(valuations, returns.Valuation{Date: snapshot.Timestamp, Value: total})
+	}
+	return valuations
+}
+
+// sameDay reports whether two times fall on the same UTC calendar day.
+func sameDay(a, b time.Time) bool {
+	a, b = a.UTC(), b.UTC()
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/returns"
+	investmentv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func returnsTestTx(txType investmentv1.InvestmentTransactionType, date time.Time, quantity, price, fees int64) *models.InvestmentTransaction {
+	return &models.InvestmentTransaction{
+		InvestmentID:    1,
+		Type:            int32(txType),
+		Quantity:        quantity,
+		Price:           price,
+		Cost:            quantity * price / 10000,
+		Fees:            fees,
+		TransactionDate: date,
+	}
+}
+
+func TestBuildHoldingHistory(t *testing.T) {
+	inv := &models.Investment{ID: 1, Type: int32(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK), Currency: "USD"}
+	jan := time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)
+	apr := time.Date(2023, time.April, 3, 0, 0, 0, 0, time.UTC)
+	jul := time.Date(2023, time.July, 3, 0, 0, 0, 0, time.UTC)
+	oct := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+
+	split := returnsTestTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT, jul, 200000, 0, 0)
+	split.Cost = 0
+	split.SplitNumerator, split.SplitDenominator = 2, 1
+
+	txs := []*models.InvestmentTransaction{
+		returnsTestTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY, jan, 100000, 10000, 500),  // 10 @ $100
+		returnsTestTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_DIVIDEND, apr, 100000, 100, 0), // $1 per share
+		split, // 20 shares, last price now $50
+		returnsTestTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL, oct, 50000, 6000, 200), // 5 @ $60
+	}
+
+	t.Run("since inception", func(t *testing.T) {
+		h := buildHoldingHistory(inv, txs, time.Time{})
+
+		assert.Equal(t, jan, h.start)
+		assert.Equal(t, 0.0, h.startValue)
+		assert.Equal(t, []returns.CashFlow{
+			{Date: jan, Amount: -100500},
+			{Date: apr, Amount: 1000},
+			{Date: oct, Amount: 29800},
+		}, h.flows)
+		assert.Equal(t, []returns.Valuation{
+			{Date: apr, Value: 100000}, // 10 shares at the last price of $100
+			{Date: oct, Value: 120000}, // 20 shares at the sell price of $60
+		}, h.valuations)
+		assert.Equal(t, 100500.0-1000-29800, h.netContributions)
+	})
+
+	t.Run("period starting after the split", func(t *testing.T) {
+		h := buildHoldingHistory(inv, txs, time.Date(2023, time.August, 1, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 100000.0, h.startValue, "20 shares at the split-adjusted $50")
+		assert.Equal(t, []returns.CashFlow{{Date: oct, Amount: 29800}}, h.flows)
+	})
+
+	t.Run("no transactions in period", func(t *testing.T) {
+		h := buildHoldingHistory(inv, txs, time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 90000.0, h.startValue, "15 shares at the last sell price")
+		assert.Empty(t, h.flows)
+	})
+}
+
+func TestHoldingHistoryPerformance(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	h := &holdingHistory{
+		start: start,
+		flows: []returns.CashFlow{{Date: start, Amount: -1000}},
+	}
+
+	result := h.performance(investmentv1.ReturnPeriod_RETURN_PERIOD_SINCE_INCEPTION, end, 1100, "USD")
+
+	require.NotNil(t, result.Xirr)
+	require.NotNil(t, result.Twr)
+	require.NotNil(t, result.AnnualizedTwr)
+	assert.InDelta(t, 0.1, *result.Xirr, 1e-6)
+	assert.InDelta(t, 0.1, *result.Twr, 1e-9)
+	assert.InDelta(t, 0.1, *result.AnnualizedTwr, 1e-9)
+	assert.Equal(t, start.Unix(), result.StartDate)
+	assert.Equal(t, int64(1100), result.EndValue)
+
+	empty := (&holdingHistory{}).performance(investmentv1.ReturnPeriod_RETURN_PERIOD_YTD, end, 0, "USD")
+	assert.Nil(t, empty.Xirr)
+	assert.Nil(t, empty.Twr)
+	assert.Equal(t, int64(0), empty.StartDate)
+}
+
+func TestDailyPortfolioValues(t *testing.T) {
+	day1 := time.Date(2024, time.March, 1, 8, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, time.March, 2, 8, 0, 0, 0, time.UTC)
+	snapshots := []*models.PortfolioHistory{
+		{WalletID: 1, TotalValue: 1000, Currency: "USD", Timestamp: day1},
+		{WalletID: 2, TotalValue: 500, Currency: "USD", Timestamp: day1.Add(time.Minute)},
+		{WalletID: 1, TotalValue: 1200, Currency: "USD", Timestamp: day2}, // Wallet 2 keeps 500
+	}
+
+	got := dailyPortfolioValues(snapshots, func(string) float64 { return 1 })
+
+	assert.Equal(t, []returns.Valuation{
+		{Date: day1.Add(time.Minute), Value: 1500},
+		{Date: day2, Value: 1700},
+	}, got)
+}
+
+func TestInvestmentService_GetPerformanceReturns_SingleInvestment(t *testing.T) {
+	ctx := context.Background()
+	mockInvRepo := new(MockInvestmentRepository)
+	mockTxRepo := new(MockInvestmentTransactionRepository)
+	svc := NewInvestmentService(mockInvRepo, nil, mockTxRepo, nil, nil, nil, nil, nil)
+
+	firstBuy := time.Now().UTC().AddDate(-2, 0, 0)
+	inv := &models.Investment{
+		ID:           1,
+		Symbol:       "VNM",
+		Type:         int32(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK),
+		Currency:     "VND",
+		CurrentValue: 1210000,
+	}
+	buy := returnsTestTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY, firstBuy, 100000, 100000, 0)
+
+	mockInvRepo.On("GetByIDForUser", ctx, int32(1), int32(7)).Return(inv, nil)
+	mockTxRepo.On("ListByInvestmentIDs", ctx, []int32{1}).Return([]*models.InvestmentTransaction{buy}, nil)
+
+	resp, err := svc.GetPerformanceReturns(ctx, 7, &investmentv1.GetPerformanceReturnsRequest{InvestmentId: 1})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, investmentv1.ReturnPeriod_RETURN_PERIOD_SINCE_INCEPTION, resp.Data.Period)
+	assert.Equal(t, "VND", resp.Data.Currency)
+	require.NotNil(t, resp.Data.Xirr)
+	require.NotNil(t, resp.Data.AnnualizedTwr)
+	// 1,000,000 grew to 1,210,000 over two years
+	assert.InDelta(t, 0.1, *resp.Data.Xirr, 1e-3)
+	assert.InDelta(t, 0.21, *resp.Data.Twr, 1e-9)
+	assert.InDelta(t, 0.1, *resp.Data.AnnualizedTwr, 1e-3)
+	require.Len(t, resp.Investments, 1)
+	assert.Equal(t, "VNM", resp.Investments[0].Symbol)
+}
+
+func TestListAllInvestments(t *testing.T) {
+	all := make([]*models.Investment, returnsPageSize*2+5)
+	for i := range all {
+		all[i] = &models.Investment{ID: int32(i + 1)}
+	}
+	var calls []repository.ListOptions
+	list := func(opts repository.ListOptions) ([]*models.Investment, int, error) {
+		calls = append(calls, opts)
+		end := min(opts.Offset+opts.Limit, len(all))
+		return all[opts.Offset:end], len(all), nil
+	}
+
+	got, err := listAllInvestments(list)
+	require.NoError(t, err)
+	assert.Equal(t, all, got)
+	assert.Len(t, calls, 3)
+	assert.Equal(t, returnsPageSize*2, calls[2].Offset)
+
+	_, err = listAllInvestments(func(opts repository.ListOptions) ([]*models.Investment, int, error) {
+		return nil, 0, apperrors.NewInternalErrorWithCause("failed to list investments", assert.AnError)
+	})
+	assert.Error(t, err)
+}
+
+func TestInvestmentService_GetPerformanceReturns_InvalidPeriod(t *testing.T) {
+	svc := NewInvestmentService(nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := svc.GetPerformanceReturns(context.Background(), 1, &investmentv1.GetPerformanceReturnsRequest{Period: 99})
+
+	var validationErr apperrors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func TestReturnPeriodStart(t *testing.T) {
+	now := time.Date(2024, time.May, 20, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), returnPeriodStart(investmentv1.ReturnPeriod_RETURN_PERIOD_YTD, now))
+	assert.Equal(t, now.AddDate(-1, 0, 0), returnPeriodStart(investmentv1.ReturnPeriod_RETURN_PERIOD_ONE_YEAR, now))
+	assert.Equal(t, now.AddDate(-3, 0, 0), returnPeriodStart(investmentv1.ReturnPeriod_RETURN_PERIOD_THREE_YEARS, now))
+	assert.True(t, returnPeriodStart(investmentv1.ReturnPeriod_RETURN_PERIOD_SINCE_INCEPTION, now).IsZero())
+}
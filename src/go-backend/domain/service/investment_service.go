@@ -23,17 +23,21 @@ import (
 
 // investmentService implements InvestmentService.
 type investmentService struct {
-	investmentRepo    repository.InvestmentRepository
-	walletRepo        repository.WalletRepository
-	txRepo            repository.InvestmentTransactionRepository
-	marketDataService MarketDataService
-	userRepo          repository.UserRepository
-	fxRateSvc         FXRateService
-	currencyCache     *cache.CurrencyCache
-	walletService     WalletService
-	mapper            *InvestmentMapper
-	goldConverter     *gold.Converter
-	silverConverter   *silver.Converter
+	investmentRepo       repository.InvestmentRepository
+	walletRepo           repository.WalletRepository
+	txRepo               repository.InvestmentTransactionRepository
+	marketDataService    MarketDataService
+	userRepo             repository.UserRepository
+	fxRateSvc            FXRateService
+	exchangeRateRepo     repository.ExchangeRateRepository     // Optional; see SetExchangeRateRepository
+	historyRepo          repository.PortfolioHistoryRepository // Optional; see SetPortfolioHistoryRepository
+	allocationTargetRepo repository.AllocationTargetRepository // Optional; see SetAllocationTargetRepository
+	attachmentCleaner    AttachmentCleaner                     // Optional; see SetAttachmentCleaner
+	currencyCache        *cache.CurrencyCache
+	walletService        WalletService
+	mapper               *InvestmentMapper
+	goldConverter        *gold.Converter
+	silverConverter      *silver.Converter
 }
 
 // NewInvestmentService creates a new InvestmentService.
@@ -104,6 +108,9 @@ func (s *investmentService) CreateInvestment(ctx context.Context, userID int32,
 	if err := validator.Currency(req.Currency); err != nil {
 		return nil, err
 	}
+	if err := validateCostBasisMethod(req.CostBasisMethod); err != nil {
+		return nil, err
+	}
 
 	// Convert decimal inputs to integer format if provided
 	// initialQuantityDecimal and initialCostDecimal take precedence over initialQuantity/initialCost
@@ -161,6 +168,16 @@ func (s *investmentService) CreateInvestment(ctx context.Context, userID int32,
 		return nil, apperrors.NewConflictError(fmt.Sprintf("investment with symbol %s already exists in this wallet", req.Symbol))
 	}
 
+	// 4.5. Resolve cost-basis method: explicit choice, else the user's default
+	costBasisMethod := req.CostBasisMethod
+	if costBasisMethod == investmentv1.CostBasisMethod_COST_BASIS_METHOD_UNSPECIFIED {
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		costBasisMethod = investmentv1.CostBasisMethod(user.DefaultCostBasisMethod)
+	}
+
 	// 5. Calculate initial average cost using utility function
 	// Average cost is stored as "cents per whole unit" (e.g., cents per BTC, cents per share)
 	var averageCost int64 = 0
@@ -187,18 +204,19 @@ func (s *investmentService) CreateInvestment(ctx context.Context, userID int32,
 
 	// 6. Create investment model
 	investment := &models.Investment{
-		WalletID:     req.WalletId,
-		Symbol:       req.Symbol,
-		Name:         req.Name,
-		Type:         int32(req.Type), // Convert enum to int32 for database storage
-		Quantity:     initialQuantity,
-		AverageCost:  averageCost,
-		TotalCost:    initialCost,
-		Currency:     req.Currency,
-		CurrentPrice: currentPrice, // 0 for custom investments, averageCost for market-based
-		RealizedPNL:  0,
-		PurchaseUnit: req.PurchaseUnit, // Store user's purchase unit for display
-		IsCustom:     req.IsCustom,     // Store custom flag for filtering in auto-updates
+		WalletID:        req.WalletId,
+		Symbol:          req.Symbol,
+		Name:            req.Name,
+		Type:            int32(req.Type), // Convert enum to int32 for database storage
+		Quantity:        initialQuantity,
+		AverageCost:     averageCost,
+		TotalCost:       initialCost,
+		Currency:        req.Currency,
+		CurrentPrice:    currentPrice, // 0 for custom investments, averageCost for market-based
+		RealizedPNL:     0,
+		PurchaseUnit:    req.PurchaseUnit, // Store user's purchase unit for display
+		IsCustom:        req.IsCustom,     // Store custom flag for filtering in auto-updates
+		CostBasisMethod: int32(costBasisMethod),
 	}
 
 	// 7. Persist investment
@@ -424,6 +442,25 @@ func (s *investmentService) UpdateInvestment(ctx context.Context, investmentID i
 		needsUpdate = false // Price already updated via UpdatePrices
 	}
 
+	// Cost-basis method only affects future sells; past realized PNL is kept
+	if req.CostBasisMethod != investmentv1.CostBasisMethod_COST_BASIS_METHOD_UNSPECIFIED {
+		if err := validateCostBasisMethod(req.CostBasisMethod); err != nil {
+			return nil, err
+		}
+		investment.CostBasisMethod = int32(req.CostBasisMethod)
+		needsUpdate = true
+	}
+
+	// Asset class groups investments for allocation targets; empty clears it
+	if req.AssetClass != nil {
+		assetClass := strings.TrimSpace(*req.AssetClass)
+		if len(assetClass) > 50 {
+			return nil, apperrors.NewValidationError("asset class must be at most 50 characters")
+		}
+		investment.AssetClass = assetClass
+		needsUpdate = true
+	}
+
 	// Update other fields if changed
 	if needsUpdate {
 		if err := s.investmentRepo.Update(ctx, investment); err != nil {
@@ -531,11 +568,18 @@ func (s *investmentService) AddTransaction(ctx context.Context, userID int32, re
 	if err := validator.ID(req.InvestmentId); err != nil {
 		return nil, err
 	}
-	if req.Quantity <= 0 {
-		return nil, apperrors.NewValidationError("quantity must be positive")
-	}
-	if req.Price <= 0 {
-		return nil, apperrors.NewValidationError("price must be positive")
+	if req.Type == investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT {
+		// Splits carry a ratio instead of a quantity and price
+		if err := validateSplitRatio(req.SplitNumerator, req.SplitDenominator); err != nil {
+			return nil, err
+		}
+	} else {
+		if req.Quantity <= 0 {
+			return nil, apperrors.NewValidationError("quantity must be positive")
+		}
+		if req.Price <= 0 {
+			return nil, apperrors.NewValidationError("price must be positive")
+		}
 	}
 	if req.Type == investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_UNSPECIFIED {
 		return nil, apperrors.NewValidationError("transaction type must be specified")
@@ -601,6 +645,12 @@ func (s *investmentService) AddTransaction(ctx context.Context, userID int32, re
 			Timestamp:         time.Now().Format(time.RFC3339),
 		}, nil
 
+	case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT:
+		updatedInvestment, err = s.processSplitTransaction(ctx, investment, req)
+		if err != nil {
+			return nil, err
+		}
+
 	default:
 		return nil, apperrors.NewValidationError("unsupported transaction type")
 	}
@@ -781,7 +831,8 @@ func (s *investmentService) processBuyTransaction(ctx context.Context, investmen
 	return investment, nil
 }
 
-// processSellTransaction handles a sell transaction with FIFO lot consumption.
+// processSellTransaction handles a sell transaction, consuming lots according to the
+// investment's cost-basis method and recording what was taken from each lot.
 func (s *investmentService) processSellTransaction(ctx context.Context, investment *models.Investment, req *investmentv1.AddTransactionRequest) (*models.Investment, error) {
 	// Validate sufficient quantity
 	if investment.Quantity < req.Quantity {
@@ -794,7 +845,7 @@ func (s *investmentService) processSellTransaction(ctx context.Context, investme
 		return nil, apperrors.NewInternalErrorWithCause("failed to fetch wallet", err)
 	}
 
-	// Get open lots (FIFO order by purchased_at ASC)
+	// Get open lots (ordered by purchased_at ASC)
 	openLots, err := s.txRepo.GetOpenLots(ctx, investment.ID)
 	if err != nil {
 		return nil, apperrors.NewInternalErrorWithCause("failed to get open lots", err)
@@ -814,42 +865,41 @@ func (s *investmentService) processSellTransaction(ctx context.Context, investme
 		return nil, apperrors.NewValidationError(fmt.Sprintf("insufficient quantity in lots: available %d, trying to sell %d", totalAvailable, req.Quantity))
 	}
 
-	// FIFO: Consume from oldest lots first
-	quantityToSell := req.Quantity
+	// Pick lots according to the investment's cost-basis method (FIFO by default)
+	method := resolveCostBasisMethod(investment.CostBasisMethod)
+	lotAllocations, err := allocateSell(method, openLots, req.Quantity, req.LotSelections)
+	if err != nil {
+		return nil, err
+	}
+
 	realizedPNL := int64(0)
 	sellPrice := req.Price
+	precision := units.GetPrecisionForInvestmentType(investmentv1.InvestmentType(investment.Type))
+	allocations := make([]models.InvestmentSellAllocation, 0, len(lotAllocations))
 
-	for _, lot := range openLots {
-		if quantityToSell <= 0 {
-			break
-		}
-
-		if lot.RemainingQuantity == 0 {
-			continue
-		}
-
-		// Calculate quantity to consume from this lot
-		consumeFromLot := quantityToSell
-		if consumeFromLot > lot.RemainingQuantity {
-			consumeFromLot = lot.RemainingQuantity
-		}
+	for _, allocation := range lotAllocations {
+		lot := allocation.lot
 
 		// Calculate realized PNL for this lot using utility function
-		// Convert consumeFromLot from smallest units to whole units
-		precision := units.GetPrecisionForInvestmentType(investmentv1.InvestmentType(investment.Type))
-		consumeFromLotWholeUnits := float64(consumeFromLot) / float64(precision)
-		lotCostBasis := float64(lot.AverageCost) * consumeFromLotWholeUnits
+		// Convert the consumed quantity from smallest units to whole units
+		consumeFromLotWholeUnits := float64(allocation.quantity) / float64(precision)
+		lotCostBasis := allocation.unitCost * consumeFromLotWholeUnits
 		lotSellValue := float64(sellPrice) * consumeFromLotWholeUnits
 		lotPNL := units.CalculateRealizedPNL(int64(lotCostBasis), int64(lotSellValue))
 		realizedPNL += lotPNL
 
 		// Update lot
-		lot.RemainingQuantity -= consumeFromLot
+		lot.RemainingQuantity -= allocation.quantity
 		if err := s.txRepo.UpdateLot(ctx, lot); err != nil {
 			return nil, apperrors.NewInternalErrorWithCause("failed to update lot", err)
 		}
 
-		quantityToSell -= consumeFromLot
+		allocations = append(allocations, models.InvestmentSellAllocation{
+			LotID:       lot.ID,
+			Quantity:    allocation.quantity,
+			CostBasis:   int64(lotCostBasis),
+			RealizedPNL: lotPNL,
+		})
 	}
 
 	// Subtract fees from realized PNL
@@ -870,10 +920,11 @@ func (s *investmentService) processSellTransaction(ctx context.Context, investme
 		Fees:            req.Fees,
 		TransactionDate: time.Unix(req.TransactionDate, time.Now().Unix()%int64(time.Second)),
 		Notes:           req.Notes,
+		Allocations:     allocations,
 	}
 
-	if len(openLots) > 0 {
-		tx.LotID = &openLots[0].ID // Track the first lot consumed
+	if len(allocations) > 0 {
+		tx.LotID = &allocations[0].LotID // Track the first lot consumed
 	}
 
 	if err := s.txRepo.Create(ctx, tx); err != nil {
@@ -1004,6 +1055,84 @@ func (s *investmentService) processDividendTransaction(ctx context.Context, inve
 	return investment, tx, nil
 }
 
+// maxSplitRatioTerm bounds each side of a split ratio to catch typos like 2/100000.
+const maxSplitRatioTerm = 1000
+
+// validateSplitRatio validates a split of numerator new units per denominator old units.
+func validateSplitRatio(numerator, denominator int32) error {
+	if numerator <= 0 || denominator <= 0 {
+		return apperrors.NewValidationError("split ratio numerator and denominator must be positive")
+	}
+	if numerator > maxSplitRatioTerm || denominator > maxSplitRatioTerm {
+		return apperrors.NewValidationError(fmt.Sprintf("split ratio terms cannot exceed %d", maxSplitRatioTerm))
+	}
+	if numerator == denominator {
+		return apperrors.NewValidationError("split ratio must change the number of units")
+	}
+	return nil
+}
+
+// processSplitTransaction handles a stock split or reverse split.
+// Every open lot and the investment are rescaled by the ratio: quantities by
+// numerator/denominator and per-unit costs by the inverse. TotalCost and the wallet
+// balance are unchanged. The overwritten values are recorded so the split can be
+// reversed exactly by deleting the transaction.
+//
+// Example (2-for-1):
+//
+//	Before: Quantity=100, AverageCost=$150, TotalCost=$15,000
+//	After:  Quantity=200, AverageCost=$75,  TotalCost=$15,000
+func (s *investmentService) processSplitTransaction(ctx context.Context, investment *models.Investment, req *investmentv1.AddTransactionRequest) (*models.Investment, error) {
+	if investment.Quantity <= 0 {
+		return nil, apperrors.NewValidationError("no holdings to split")
+	}
+	if models.ScaleByRatio(investment.Quantity, req.SplitNumerator, req.SplitDenominator) <= 0 {
+		return nil, apperrors.NewValidationError("split ratio would reduce the holding to zero")
+	}
+
+	openLots, err := s.txRepo.GetOpenLots(ctx, investment.ID)
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to get open lots", err)
+	}
+
+	// Record the values being overwritten: the investment itself, then each open lot
+	adjustments := make([]*models.InvestmentSplitAdjustment, 0, len(openLots)+1)
+	adjustments = append(adjustments, &models.InvestmentSplitAdjustment{
+		QuantityBefore:    investment.Quantity,
+		AverageCostBefore: investment.AverageCost,
+	})
+	for _, lot := range openLots {
+		lotID := lot.ID
+		adjustments = append(adjustments, &models.InvestmentSplitAdjustment{
+			LotID:                   &lotID,
+			QuantityBefore:          lot.Quantity,
+			RemainingQuantityBefore: lot.RemainingQuantity,
+			AverageCostBefore:       lot.AverageCost,
+		})
+		lot.ApplySplit(req.SplitNumerator, req.SplitDenominator)
+	}
+
+	investment.ApplySplit(req.SplitNumerator, req.SplitDenominator)
+
+	// Quantity records the holding after the split; a split moves no money
+	tx := &models.InvestmentTransaction{
+		InvestmentID:     investment.ID,
+		WalletID:         investment.WalletID,
+		Type:             int32(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT),
+		Quantity:         investment.Quantity,
+		TransactionDate:  time.Unix(req.TransactionDate, 0),
+		Notes:            req.Notes,
+		SplitNumerator:   req.SplitNumerator,
+		SplitDenominator: req.SplitDenominator,
+	}
+
+	if err := s.txRepo.ApplySplit(ctx, tx, investment, openLots, adjustments); err != nil {
+		return nil, err
+	}
+
+	return investment, nil
+}
+
 // ListTransactions retrieves transactions for an investment.
 func (s *investmentService) ListTransactions(ctx context.Context, userID int32, req *investmentv1.ListInvestmentTransactionsRequest) (*investmentv1.ListInvestmentTransactionsResponse, error) {
 	if err := validator.ID(req.InvestmentId); err != nil {
@@ -1125,8 +1254,23 @@ func (s *investmentService) DeleteTransaction(ctx context.Context, transactionID
 		return nil, apperrors.NewInternalErrorWithCause("failed to get parent investment", err)
 	}
 
+	// Buys and sells recorded before a split are in pre-split units; reversing them
+	// against rescaled lots would corrupt the holding
+	txType := investmentv1.InvestmentTransactionType(tx.Type)
+	if txType == investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY ||
+		txType == investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL {
+		splitAfter, err := s.txRepo.HasTransactionsAfter(ctx, investment.ID, tx.ID,
+			investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT)
+		if err != nil {
+			return nil, err
+		}
+		if splitAfter {
+			return nil, apperrors.NewValidationError("cannot delete a transaction recorded before a split; delete the split first")
+		}
+	}
+
 	// Handle based on transaction type
-	switch investmentv1.InvestmentTransactionType(tx.Type) {
+	switch txType {
 	case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY:
 		if err := s.reverseBuyTransaction(ctx, investment, tx); err != nil {
 			return nil, err
@@ -1139,8 +1283,12 @@ func (s *investmentService) DeleteTransaction(ctx context.Context, transactionID
 		if err := s.reverseDividendTransaction(ctx, investment, tx); err != nil {
 			return nil, err
 		}
+	case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT:
+		if err := s.reverseSplitTransaction(ctx, investment, tx); err != nil {
+			return nil, err
+		}
 	default:
-		// For split and other types, just delete the transaction without recalculation
+		// For other types, just delete the transaction without recalculation
 	}
 
 	// Delete transaction
@@ -1148,6 +1296,13 @@ func (s *investmentService) DeleteTransaction(ctx context.Context, transactionID
 		return nil, err
 	}
 
+	// Remove receipts and documents; a failure leaves them behind but the transaction is gone
+	if s.attachmentCleaner != nil {
+		if err := s.attachmentCleaner.DeleteInvestmentTransactionAttachments(ctx, transactionID); err != nil {
+			fmt.Printf("Warning: failed to delete attachments of investment transaction %d: %v\n", transactionID, err)
+		}
+	}
+
 	// Invalidate currency cache
 	if err := s.invalidateInvestmentCache(ctx, userID, tx.InvestmentID); err != nil {
 		fmt.Printf("Warning: failed to invalidate currency cache for investment %d: %v\n", tx.InvestmentID, err)
@@ -1246,6 +1401,57 @@ func (s *investmentService) reverseSellTransaction(ctx context.Context, investme
 		return apperrors.NewInternalErrorWithCause("failed to fetch wallet", err)
 	}
 
+	if len(tx.Allocations) > 0 {
+		// Restore exactly what the sell took from each lot and reverse the PNL it booked
+		bookedPNL := -tx.Fees
+		for _, allocation := range tx.Allocations {
+			lot, err := s.txRepo.GetLotByID(ctx, allocation.LotID)
+			if err != nil {
+				return apperrors.NewInternalErrorWithCause("failed to get lot", err)
+			}
+
+			lot.RemainingQuantity += allocation.Quantity
+			if err := s.txRepo.UpdateLot(ctx, lot); err != nil {
+				return apperrors.NewInternalErrorWithCause("failed to update lot", err)
+			}
+			bookedPNL += allocation.RealizedPNL
+		}
+
+		// TotalCost and AverageCost were not changed by the sell
+		investment.Quantity += tx.Quantity
+		investment.RealizedPNL -= bookedPNL
+	} else if err := s.reverseLegacySell(ctx, investment, tx); err != nil {
+		return err
+	}
+
+	if err := s.investmentRepo.Update(ctx, investment); err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to update investment", err)
+	}
+
+	// Deduct the proceeds from wallet (undo the credit)
+	proceeds := tx.Cost - tx.Fees
+
+	// Convert proceeds from investment currency to wallet currency
+	proceedsInWalletCurrency := proceeds
+	if investment.Currency != wallet.Currency {
+		converted, err := s.fxRateSvc.ConvertAmount(ctx, proceeds, investment.Currency, wallet.Currency)
+		if err != nil {
+			return apperrors.NewInternalErrorWithCause(
+				fmt.Sprintf("failed to convert %s to %s for wallet deduction", investment.Currency, wallet.Currency), err)
+		}
+		proceedsInWalletCurrency = converted
+	}
+
+	_, err = s.walletRepo.UpdateBalance(ctx, investment.WalletID, -proceedsInWalletCurrency)
+	if err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to deduct from wallet balance", err)
+	}
+
+	return nil
+}
+
+// reverseLegacySell restores holdings for sells recorded before lot allocations were kept.
+func (s *investmentService) reverseLegacySell(ctx context.Context, investment *models.Investment, tx *models.InvestmentTransaction) error {
 	// If transaction has a LotID, restore quantity to that lot
 	if tx.LotID != nil {
 		lot, err := s.txRepo.GetLotByID(ctx, *tx.LotID)
@@ -1292,29 +1498,6 @@ func (s *investmentService) reverseSellTransaction(ctx context.Context, investme
 		}
 	}
 
-	if err := s.investmentRepo.Update(ctx, investment); err != nil {
-		return apperrors.NewInternalErrorWithCause("failed to update investment", err)
-	}
-
-	// Deduct the proceeds from wallet (undo the credit)
-	proceeds := tx.Cost - tx.Fees
-
-	// Convert proceeds from investment currency to wallet currency
-	proceedsInWalletCurrency := proceeds
-	if investment.Currency != wallet.Currency {
-		converted, err := s.fxRateSvc.ConvertAmount(ctx, proceeds, investment.Currency, wallet.Currency)
-		if err != nil {
-			return apperrors.NewInternalErrorWithCause(
-				fmt.Sprintf("failed to convert %s to %s for wallet deduction", investment.Currency, wallet.Currency), err)
-		}
-		proceedsInWalletCurrency = converted
-	}
-
-	_, err = s.walletRepo.UpdateBalance(ctx, investment.WalletID, -proceedsInWalletCurrency)
-	if err != nil {
-		return apperrors.NewInternalErrorWithCause("failed to deduct from wallet balance", err)
-	}
-
 	return nil
 }
 
@@ -1358,6 +1541,56 @@ func (s *investmentService) reverseDividendTransaction(ctx context.Context, inve
 	return nil
 }
 
+// reverseSplitTransaction restores the lots and investment a split rescaled.
+// Only a split with no buys, sells or splits recorded after it can be reversed, so
+// the recorded values are still the ones to restore.
+func (s *investmentService) reverseSplitTransaction(ctx context.Context, investment *models.Investment, tx *models.InvestmentTransaction) error {
+	later, err := s.txRepo.HasTransactionsAfter(ctx, investment.ID, tx.ID,
+		investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY,
+		investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL,
+		investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT)
+	if err != nil {
+		return err
+	}
+	if later {
+		return apperrors.NewValidationError("cannot delete a split while later buy, sell or split transactions exist; delete those first")
+	}
+
+	adjustments, err := s.txRepo.GetSplitAdjustments(ctx, tx.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(adjustments) == 0 {
+		// Split recorded before adjustments were kept: nothing was rescaled
+		return nil
+	}
+
+	lots := make([]*models.InvestmentLot, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		if adjustment.LotID == nil {
+			investment.Quantity = adjustment.QuantityBefore
+			investment.AverageCost = adjustment.AverageCostBefore
+			continue
+		}
+
+		lot, err := s.txRepo.GetLotByID(ctx, *adjustment.LotID)
+		if err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to get lot", err)
+		}
+		lot.Quantity = adjustment.QuantityBefore
+		lot.RemainingQuantity = adjustment.RemainingQuantityBefore
+		lot.AverageCost = adjustment.AverageCostBefore
+		lots = append(lots, lot)
+	}
+
+	// Price was rescaled with the split; undo it until the next price refresh
+	investment.CurrentPrice = models.ScaleByRatio(investment.CurrentPrice, tx.SplitNumerator, tx.SplitDenominator)
+	investment.Recalculate()
+
+	return s.txRepo.RestoreSplit(ctx, tx.ID, investment, lots)
+}
+
 // GetPortfolioSummary retrieves portfolio summary for a wallet.
 // For mixed-currency portfolios, all values are converted to user's preferred currency.
 func (s *investmentService) GetPortfolioSummary(ctx context.Context, walletID int32, userID int32) (*investmentv1.GetPortfolioSummaryResponse, error) {
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	apperrors "wealthjourney/pkg/errors"
+
+	investmentv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newSplitTestService(txRepo *MockInvestmentTransactionRepository) *investmentService {
+	return NewInvestmentService(
+		new(MockInvestmentRepository),
+		new(MockWalletRepository),
+		txRepo,
+		new(MockMarketDataService),
+		new(MockUserRepository),
+		new(MockFXRateService),
+		nil,
+		new(MockWalletService),
+	).(*investmentService)
+}
+
+func splitTestHolding() (*models.Investment, []*models.InvestmentLot) {
+	investment := &models.Investment{
+		ID:           1,
+		WalletID:     1,
+		Symbol:       "AAPL",
+		Type:         int32(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK),
+		Quantity:     1000000, // 100 shares
+		AverageCost:  15000,   // $150.00
+		TotalCost:    1500000, // $15,000.00
+		CurrentPrice: 20000,
+		Currency:     "USD",
+	}
+	lots := []*models.InvestmentLot{
+		{ID: 10, InvestmentID: 1, Quantity: 700000, RemainingQuantity: 400000, AverageCost: 14000, TotalCost: 980000, PurchasedAt: time.Now().AddDate(-1, 0, 0)},
+		{ID: 11, InvestmentID: 1, Quantity: 600000, RemainingQuantity: 600000, AverageCost: 16000, TotalCost: 960000, PurchasedAt: time.Now().AddDate(0, -1, 0)},
+	}
+	return investment, lots
+}
+
+func TestProcessSplitTransaction_ReverseSplitAndRestore(t *testing.T) {
+	ctx := context.Background()
+	txRepo := new(MockInvestmentTransactionRepository)
+	svc := newSplitTestService(txRepo)
+
+	investment, lots := splitTestHolding()
+	txRepo.On("GetOpenLots", ctx, int32(1)).Return(lots, nil)
+
+	var recorded []*models.InvestmentSplitAdjustment
+	txRepo.On("ApplySplit", ctx, mock.AnythingOfType("*models.InvestmentTransaction"), investment, lots, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*models.InvestmentTransaction).ID = 99
+			recorded = args.Get(4).([]*models.InvestmentSplitAdjustment)
+		}).Return(nil)
+
+	// 1-for-3 reverse split rounds quantities
+	req := &investmentv1.AddTransactionRequest{
+		InvestmentId:     1,
+		Type:             investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT,
+		TransactionDate:  time.Now().Unix(),
+		SplitNumerator:   1,
+		SplitDenominator: 3,
+	}
+	updated, err := svc.processSplitTransaction(ctx, investment, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(333333), updated.Quantity)
+	assert.Equal(t, int64(45000), updated.AverageCost)
+	assert.Equal(t, int64(1500000), updated.TotalCost, "split must not change total cost")
+	assert.Equal(t, int64(133333), lots[0].RemainingQuantity)
+	assert.Equal(t, int64(42000), lots[0].AverageCost)
+	assert.Equal(t, int64(980000), lots[0].TotalCost)
+	require.Len(t, recorded, 3)
+
+	// Deleting the split restores the exact pre-split values
+	splitTx := &models.InvestmentTransaction{
+		ID:               99,
+		InvestmentID:     1,
+		Type:             int32(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT),
+		SplitNumerator:   1,
+		SplitDenominator: 3,
+	}
+	txRepo.On("HasTransactionsAfter", ctx, int32(1), int32(99), mock.Anything).Return(false, nil)
+	txRepo.On("GetSplitAdjustments", ctx, int32(99)).Return(recorded, nil)
+	txRepo.On("GetLotByID", ctx, int32(10)).Return(lots[0], nil)
+	txRepo.On("GetLotByID", ctx, int32(11)).Return(lots[1], nil)
+	txRepo.On("RestoreSplit", ctx, int32(99), investment, mock.Anything).Return(nil)
+
+	require.NoError(t, svc.reverseSplitTransaction(ctx, investment, splitTx))
+
+	original, originalLots := splitTestHolding()
+	assert.Equal(t, original.Quantity, investment.Quantity)
+	assert.Equal(t, original.AverageCost, investment.AverageCost)
+	assert.Equal(t, original.CurrentPrice, investment.CurrentPrice)
+	for i := range lots {
+		assert.Equal(t, originalLots[i].Quantity, lots[i].Quantity)
+		assert.Equal(t, originalLots[i].RemainingQuantity, lots[i].RemainingQuantity)
+		assert.Equal(t, originalLots[i].AverageCost, lots[i].AverageCost)
+	}
+	txRepo.AssertExpectations(t)
+}
+
+func TestReverseSplitTransaction_RejectsWhenLaterTransactionsExist(t *testing.T) {
+	ctx := context.Background()
+	txRepo := new(MockInvestmentTransactionRepository)
+	svc := newSplitTestService(txRepo)
+
+	investment, _ := splitTestHolding()
+	splitTx := &models.InvestmentTransaction{ID: 5, InvestmentID: 1, SplitNumerator: 2, SplitDenominator: 1}
+	txRepo.On("HasTransactionsAfter", ctx, int32(1), int32(5), mock.Anything).Return(true, nil)
+
+	err := svc.reverseSplitTransaction(ctx, investment, splitTx)
+
+	var validationErr apperrors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	txRepo.AssertNotCalled(t, "RestoreSplit", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestValidateSplitRatio(t *testing.T) {
+	assert.NoError(t, validateSplitRatio(2, 1))
+	assert.NoError(t, validateSplitRatio(1, 10))
+	assert.Error(t, validateSplitRatio(0, 1))
+	assert.Error(t, validateSplitRatio(1, 0))
+	assert.Error(t, validateSplitRatio(3, 3))
+	assert.Error(t, validateSplitRatio(maxSplitRatioTerm+1, 1))
+}
+
+func TestProcessSplitTransaction_RejectsEmptyHolding(t *testing.T) {
+	svc := newSplitTestService(new(MockInvestmentTransactionRepository))
+	investment, _ := splitTestHolding()
+	investment.Quantity = 0
+
+	_, err := svc.processSplitTransaction(context.Background(), investment, &investmentv1.AddTransactionRequest{SplitNumerator: 2, SplitDenominator: 1})
+	assert.Error(t, err)
+}
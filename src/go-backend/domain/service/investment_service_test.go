@@ -8,6 +8,7 @@ import (
 	"wealthjourney/domain/models"
 	"wealthjourney/domain/repository"
 	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/pricing"
 	"wealthjourney/pkg/types"
 	"wealthjourney/pkg/yahoo"
 
@@ -262,6 +263,42 @@ func (m *MockInvestmentTransactionRepository) DeleteLotsByInvestmentID(ctx conte
 	return args.Error(0)
 }
 
+func (m *MockInvestmentTransactionRepository) ApplySplit(ctx context.Context, tx *models.InvestmentTransaction, investment *models.Investment, lots []*models.InvestmentLot, adjustments []*models.InvestmentSplitAdjustment) error {
+	args := m.Called(ctx, tx, investment, lots, adjustments)
+	return args.Error(0)
+}
+
+func (m *MockInvestmentTransactionRepository) GetSplitAdjustments(ctx context.Context, transactionID int32) ([]*models.InvestmentSplitAdjustment, error) {
+	args := m.Called(ctx, transactionID)
+	return args.Get(0).([]*models.InvestmentSplitAdjustment), args.Error(1)
+}
+
+func (m *MockInvestmentTransactionRepository) RestoreSplit(ctx context.Context, transactionID int32, investment *models.Investment, lots []*models.InvestmentLot) error {
+	args := m.Called(ctx, transactionID, investment, lots)
+	return args.Error(0)
+}
+
+func (m *MockInvestmentTransactionRepository) HasTransactionsAfter(ctx context.Context, investmentID, afterID int32, types ...investmentv1.InvestmentTransactionType) (bool, error) {
+	args := m.Called(ctx, investmentID, afterID, types)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockInvestmentTransactionRepository) ListByInvestmentIDs(ctx context.Context, investmentIDs []int32) ([]*models.InvestmentTransaction, error) {
+	args := m.Called(ctx, investmentIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.InvestmentTransaction), args.Error(1)
+}
+
+func (m *MockInvestmentTransactionRepository) ListSellsForUser(ctx context.Context, userID int32, filter repository.SellTransactionFilter) ([]*models.InvestmentTransaction, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.InvestmentTransaction), args.Error(1)
+}
+
 type MockMarketDataService struct {
 	mock.Mock
 }
@@ -289,6 +326,22 @@ func (m *MockMarketDataService) GetPriceBatch(ctx context.Context, symbols []str
 	return args.Get(0).(map[string]*models.MarketData), args.Error(1)
 }
 
+func (m *MockMarketDataService) GetPriceHistory(ctx context.Context, symbol, currency string, investmentType investmentv1.InvestmentType, from, to time.Time) ([]*models.PriceHistory, error) {
+	args := m.Called(ctx, symbol, currency, investmentType, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.PriceHistory), args.Error(1)
+}
+
+func (m *MockMarketDataService) ProviderHealth() []pricing.ProviderHealth {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]pricing.ProviderHealth)
+}
+
 type MockUserRepository struct {
 	mock.Mock
 }
@@ -473,6 +526,54 @@ func (m *MockWalletService) GetMonthlyDominance(ctx context.Context, userID int3
 	return args.Get(0).(*walletv1.GetMonthlyDominanceResponse), args.Error(1)
 }
 
+func (m *MockWalletService) StartReconciliation(ctx context.Context, walletID, userID int32, req *walletv1.StartReconciliationRequest) (*walletv1.StartReconciliationResponse, error) {
+	args := m.Called(ctx, walletID, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletv1.StartReconciliationResponse), args.Error(1)
+}
+
+func (m *MockWalletService) ListReconciliations(ctx context.Context, walletID, userID int32, req *walletv1.ListReconciliationsRequest) (*walletv1.ListReconciliationsResponse, error) {
+	args := m.Called(ctx, walletID, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletv1.ListReconciliationsResponse), args.Error(1)
+}
+
+func (m *MockWalletService) GetReconciliation(ctx context.Context, walletID, reconciliationID, userID int32) (*walletv1.GetReconciliationResponse, error) {
+	args := m.Called(ctx, walletID, reconciliationID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletv1.GetReconciliationResponse), args.Error(1)
+}
+
+func (m *MockWalletService) UpdateReconciliationTransactions(ctx context.Context, walletID, reconciliationID, userID int32, req *walletv1.UpdateReconciliationTransactionsRequest) (*walletv1.UpdateReconciliationTransactionsResponse, error) {
+	args := m.Called(ctx, walletID, reconciliationID, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletv1.UpdateReconciliationTransactionsResponse), args.Error(1)
+}
+
+func (m *MockWalletService) FinishReconciliation(ctx context.Context, walletID, reconciliationID, userID int32) (*walletv1.FinishReconciliationResponse, error) {
+	args := m.Called(ctx, walletID, reconciliationID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletv1.FinishReconciliationResponse), args.Error(1)
+}
+
+func (m *MockWalletService) CancelReconciliation(ctx context.Context, walletID, reconciliationID, userID int32) (*walletv1.CancelReconciliationResponse, error) {
+	args := m.Called(ctx, walletID, reconciliationID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletv1.CancelReconciliationResponse), args.Error(1)
+}
+
 // Test helper functions
 func createTestWallet(id int32, userID int32, walletType walletv1.WalletType) *models.Wallet {
 	return &models.Wallet{
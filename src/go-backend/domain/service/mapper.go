@@ -60,6 +60,47 @@ func (m *WalletMapper) PaginationResultToProto(result types.PaginationResult) *p
 	}
 }
 
+// ReconciliationToProto converts a WalletReconciliation model to proto. Open
+// reconciliations carry the balances computed when they were loaded.
+func (m *WalletMapper) ReconciliationToProto(reconciliation *models.WalletReconciliation, currency string) *protobufv1.WalletReconciliation {
+	if reconciliation == nil {
+		return nil
+	}
+
+	proto := &protobufv1.WalletReconciliation{
+		Id:               reconciliation.ID,
+		WalletId:         reconciliation.WalletID,
+		StatementDate:    reconciliation.StatementDate.Unix(),
+		StatementBalance: &protobufv1.Money{Amount: reconciliation.StatementBalance, Currency: currency},
+		OpeningBalance:   &protobufv1.Money{Amount: reconciliation.OpeningBalance, Currency: currency},
+		ClearedBalance:   &protobufv1.Money{Amount: reconciliation.ClearedBalance, Currency: currency},
+		Difference:       &protobufv1.Money{Amount: reconciliation.StatementBalance - reconciliation.ClearedBalance, Currency: currency},
+		Status:           protobufv1.WalletReconciliationStatus(reconciliation.Status),
+		TransactionCount: reconciliation.TransactionCount,
+		CreatedAt:        reconciliation.CreatedAt.Unix(),
+		UpdatedAt:        reconciliation.UpdatedAt.Unix(),
+	}
+	if reconciliation.CompletedAt != nil {
+		proto.CompletedAt = reconciliation.CompletedAt.Unix()
+	}
+	return proto
+}
+
+// ReconciliationTransactionsToProto converts the transactions of a reconciliation to proto.
+func (m *WalletMapper) ReconciliationTransactionsToProto(transactions []*models.Transaction, currency string) []*protobufv1.ReconciliationTransaction {
+	result := make([]*protobufv1.ReconciliationTransaction, len(transactions))
+	for i, tx := range transactions {
+		result[i] = &protobufv1.ReconciliationTransaction{
+			Id:     tx.ID,
+			Date:   tx.Date.Unix(),
+			Amount: &protobufv1.Money{Amount: tx.Amount, Currency: currency},
+			Note:   tx.Note,
+			Status: protobufv1.TransactionStatus(tx.Status),
+		}
+	}
+	return result
+}
+
 // UserMapper handles conversion between domain models and proto types.
 type UserMapper struct{}
 
@@ -81,6 +122,7 @@ func (m *UserMapper) ModelToProto(user *models.User) *protobufv1.User {
 		Picture:              user.Picture,
 		PreferredCurrency:    user.PreferredCurrency,
 		ConversionInProgress: user.ConversionInProgress,
+		DefaultCostBasisMethod: protobufv1.CostBasisMethod(user.DefaultCostBasisMethod),
 		CreatedAt:            user.CreatedAt.Unix(),
 		UpdatedAt:            user.UpdatedAt.Unix(),
 	}
@@ -129,7 +171,7 @@ func (m *BudgetMapper) ModelToProto(budget *models.Budget) *protobufv1.Budget {
 		currency = types.VND
 	}
 
-	return &protobufv1.Budget{
+	result := &protobufv1.Budget{
 		Id:        budget.ID,
 		UserId:    budget.UserID,
 		Name:      budget.Name,
@@ -140,7 +182,15 @@ func (m *BudgetMapper) ModelToProto(budget *models.Budget) *protobufv1.Budget {
 		CreatedAt: budget.CreatedAt.Unix(),
 		UpdatedAt: budget.UpdatedAt.Unix(),
 		Currency:  currency,
+		Period:    protobufv1.BudgetPeriod(budget.Period),
+	}
+	if budget.StartDate != nil {
+		result.StartDate = budget.StartDate.Unix()
 	}
+	if budget.EndDate != nil {
+		result.EndDate = budget.EndDate.Unix()
+	}
+	return result
 }
 
 // ModelSliceToProto converts a slice of Budget models to proto Budgets.
@@ -176,10 +226,17 @@ func (m *BudgetMapper) ModelItemToProto(item *models.BudgetItem) *protobufv1.Bud
 			Amount:   item.Total,
 			Currency: currency,
 		},
-		Checked:   item.Checked,
-		CreatedAt: item.CreatedAt.Unix(),
-		UpdatedAt: item.UpdatedAt.Unix(),
-		Currency:  currency,
+		Checked:        item.Checked,
+		CreatedAt:      item.CreatedAt.Unix(),
+		UpdatedAt:      item.UpdatedAt.Unix(),
+		Currency:       currency,
+		CategoryIds:    item.CategoryIDs(),
+		WalletIds:      item.WalletIDs(),
+		RolloverPolicy: protobufv1.BudgetRolloverPolicy(item.RolloverPolicy),
+		RolloverCap: &protobufv1.Money{
+			Amount:   item.RolloverCap,
+			Currency: currency,
+		},
 	}
 }
 
@@ -238,6 +295,8 @@ func (m *InvestmentMapper) ModelToProto(investment *models.Investment) *investme
 		TotalDividends:       investment.TotalDividends,
 		PurchaseUnit:         investment.PurchaseUnit,
 		IsCustom:             investment.IsCustom,
+		CostBasisMethod:      investmentv1.CostBasisMethod(investment.CostBasisMethod),
+		AssetClass:           investment.AssetClass,
 		CreatedAt:            investment.CreatedAt.Unix(),
 		UpdatedAt:            investment.UpdatedAt.Unix(),
 	}
@@ -280,6 +339,8 @@ func (m *InvestmentMapper) TransactionToProto(tx *models.InvestmentTransaction)
 		Notes:             tx.Notes,
 		LotId:             lotID,
 		RemainingQuantity: int32(tx.RemainingQuantity),
+		SplitNumerator:    tx.SplitNumerator,
+		SplitDenominator:  tx.SplitDenominator,
 		CreatedAt:         tx.CreatedAt.Unix(),
 		UpdatedAt:         tx.UpdatedAt.Unix(),
 	}
@@ -307,3 +368,76 @@ func (m *InvestmentMapper) PaginationResultToProto(result types.PaginationResult
 		TotalPages: int32(result.TotalPages),
 	}
 }
+
+// recurringUpcomingPreview is the number of upcoming occurrences included in responses
+const recurringUpcomingPreview = 5
+
+// RecurringTransactionMapper handles conversion between recurring transaction models and proto types.
+type RecurringTransactionMapper struct{}
+
+// NewRecurringTransactionMapper creates a new RecurringTransactionMapper.
+func NewRecurringTransactionMapper() *RecurringTransactionMapper {
+	return &RecurringTransactionMapper{}
+}
+
+// ModelToProto converts a RecurringTransaction domain model to proto RecurringTransaction type.
+func (m *RecurringTransactionMapper) ModelToProto(recurring *models.RecurringTransaction) *protobufv1.RecurringTransaction {
+	if recurring == nil {
+		return nil
+	}
+
+	currency := recurring.Currency
+	if currency == "" {
+		currency = types.VND
+	}
+
+	rule := &protobufv1.RecurrenceRule{
+		Frequency: protobufv1.RecurrenceFrequency(recurring.Frequency),
+		Interval:  recurring.Interval,
+		StartDate: recurring.StartDate.Unix(),
+		Count:     recurring.MaxOccurrences,
+	}
+	if recurring.EndDate != nil {
+		endDate := recurring.EndDate.Unix()
+		rule.EndDate = &endDate
+	}
+
+	result := &protobufv1.RecurringTransaction{
+		Id:       recurring.ID,
+		WalletId: recurring.WalletID,
+		Amount: &protobufv1.Money{
+			Amount:   recurring.Amount,
+			Currency: currency,
+		},
+		Note:            recurring.Note,
+		Rule:            rule,
+		Status:          protobufv1.RecurringTransactionStatus(recurring.Status),
+		OccurrenceCount: recurring.OccurrenceCount,
+		CreatedAt:       recurring.CreatedAt.Unix(),
+		UpdatedAt:       recurring.UpdatedAt.Unix(),
+	}
+	if recurring.CategoryID != nil {
+		result.CategoryId = *recurring.CategoryID
+	}
+	if recurring.NextOccurrence != nil {
+		result.NextOccurrence = recurring.NextOccurrence.Unix()
+	}
+	for _, date := range recurring.UpcomingOccurrences(recurringUpcomingPreview) {
+		result.UpcomingOccurrences = append(result.UpcomingOccurrences, date.Unix())
+	}
+
+	return result
+}
+
+// ModelSliceToProto converts a slice of RecurringTransaction models to proto RecurringTransactions.
+func (m *RecurringTransactionMapper) ModelSliceToProto(recurring []*models.RecurringTransaction) []*protobufv1.RecurringTransaction {
+	if recurring == nil {
+		return nil
+	}
+
+	result := make([]*protobufv1.RecurringTransaction, len(recurring))
+	for i, r := range recurring {
+		result[i] = m.ModelToProto(r)
+	}
+	return result
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"wealthjourney/domain/models"
 	"wealthjourney/domain/repository"
 	apperrors "wealthjourney/pkg/errors"
 	"wealthjourney/pkg/gold"
+	"wealthjourney/pkg/pricing"
 	"wealthjourney/pkg/silver"
 	"wealthjourney/pkg/validator"
 	"wealthjourney/pkg/yahoo"
@@ -29,15 +31,24 @@ type MarketDataService interface {
 
 	// GetPriceBatch fetches prices for multiple symbols in a single API call.
 	GetPriceBatch(ctx context.Context, symbols []string) (map[string]*models.MarketData, error)
+
+	// GetPriceHistory retrieves daily prices between from and to, backfilling missing days first.
+	GetPriceHistory(ctx context.Context, symbol, currency string, investmentType investmentv1.InvestmentType, from, to time.Time) ([]*models.PriceHistory, error)
+
+	// ProviderHealth reports the health of each price provider.
+	ProviderHealth() []pricing.ProviderHealth
 }
 
 // marketDataService implements MarketDataService.
 type marketDataService struct {
 	marketDataRepo     repository.MarketDataRepository
+	priceHistoryRepo   repository.PriceHistoryRepository // Optional; see SetPriceHistoryRepository
 	goldPriceService   GoldPriceService
 	silverPriceService SilverPriceService
 	goldConverter      *gold.Converter
 	silverConverter    *silver.Converter
+	providers          *pricing.Registry
+	fetchHistory       historicalPriceFetcher
 }
 
 // NewMarketDataService creates a new MarketDataService.
@@ -48,11 +59,13 @@ func NewMarketDataService(marketDataRepo repository.MarketDataRepository, goldPr
 		silverPriceService: silverPriceService,
 		goldConverter:      gold.NewGoldConverter(nil),   // Will be injected later if needed
 		silverConverter:    silver.NewSilverConverter(nil), // Will be injected later if needed
+		providers:          newPriceProviderRegistry(goldPriceService, silverPriceService, nil),
+		fetchHistory:       yahoo.GetHistoricalPrices,
 	}
 }
 
 // GetPrice retrieves the current price for a symbol, using cache if fresh.
-// If cached data is older than maxAge or doesn't exist, fetches from external API.
+// If cached data is older than maxAge or doesn't exist, fetches from the price providers.
 func (s *marketDataService) GetPrice(ctx context.Context, symbol, currency string, investmentType investmentv1.InvestmentType, maxAge time.Duration) (*models.MarketData, error) {
 	// Validate inputs
 	if symbol == "" {
@@ -69,30 +82,26 @@ func (s *marketDataService) GetPrice(ctx context.Context, symbol, currency strin
 		return cached, nil
 	}
 
-	// Cache miss or expired data - fetch from API
-	var priceData *models.MarketData
-
-	// Check if this is a gold investment
-	if gold.IsGoldType(investmentType) {
-		priceData, err = s.fetchGoldPriceFromAPI(ctx, symbol, currency, investmentType)
-	} else if silver.IsSilverType(investmentType) {
-		priceData, err = s.fetchSilverPriceFromAPI(ctx, symbol, currency, investmentType)
-	} else {
-		priceData, err = s.fetchPriceFromAPI(ctx, symbol, currency)
-	}
-
+	// Cache miss or expired data - fetch from the provider chain
+	priceData, err := s.fetchPriceFromProviders(ctx, symbol, currency, investmentType)
 	if err != nil {
 		// If we have expired cached data, return it as fallback
 		if cached != nil {
-			log.Printf("Warning: API fetch failed for %s, using stale cache: %v", symbol, err)
+			log.Printf("Warning: all price providers failed for %s, using stale price from %s: %v", symbol, cached.Timestamp.Format(time.RFC3339), err)
 			return cached, nil
 		}
+		// Otherwise fall back to the last stored daily close, not cached as a live price
+		if lastClose := s.lastClosePrice(ctx, symbol, currency); lastClose != nil {
+			log.Printf("Warning: all price providers failed for %s, using last close from %s: %v", symbol, lastClose.Timestamp.Format("2006-01-02"), err)
+			return lastClose, nil
+		}
 		// Return a more user-friendly error for API failures
 		return nil, fmt.Errorf("unable to fetch current price for %s. Please try again later. Error: %w", symbol, err)
 	}
 
 	// Store in cache
 	priceData.Timestamp = time.Now()
+	s.recordQuote(ctx, priceData)
 	if cached != nil && cached.ID > 0 {
 		priceData.ID = cached.ID
 		if err := s.marketDataRepo.Update(ctx, priceData); err != nil {
@@ -170,6 +179,7 @@ func (s *marketDataService) UpdatePricesForInvestments(ctx context.Context, inve
 					// Update cache
 					cached, _ := s.marketDataRepo.GetBySymbolAndCurrency(ctx, inv.Symbol, inv.Currency)
 					priceData.Timestamp = time.Now()
+					s.recordQuote(ctx, priceData)
 					if cached != nil && cached.ID > 0 {
 						priceData.ID = cached.ID
 						_ = s.marketDataRepo.Update(ctx, priceData)
@@ -217,13 +227,12 @@ func (s *marketDataService) fetchAndUpdateSingle(ctx context.Context, investment
 	updates[investment.ID] = priceData.Price
 }
 
-// fetchPriceFromAPI fetches price data from Yahoo Finance API.
-// Uses the v8 chart API which provides comprehensive market data.
-func (s *marketDataService) fetchPriceFromAPI(ctx context.Context, symbol, currency string) (*models.MarketData, error) {
-	// Use GetQuote function for comprehensive data
-	quote, err := yahoo.GetQuote(ctx, symbol)
+// fetchPriceFromProviders fetches the latest price from the provider chain of the
+// investment type and converts it to the storage unit of that type.
+func (s *marketDataService) fetchPriceFromProviders(ctx context.Context, symbol, currency string, investmentType investmentv1.InvestmentType) (*models.MarketData, error) {
+	quote, err := s.providers.GetQuote(ctx, investmentType, symbol, currency)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch quote for %s: %w", symbol, err)
+		return nil, err
 	}
 
 	// Use quote's currency if available, otherwise fall back to investment's currency
@@ -231,70 +240,31 @@ func (s *marketDataService) fetchPriceFromAPI(ctx context.Context, symbol, curre
 	if fetchedCurrency == "" {
 		fetchedCurrency = currency
 	}
-
-	// Convert price from float to int64 (smallest currency unit)
-	// Uses currency-based decimal places: VND has 0, USD has 2, etc.
-	// For VND: 69800.00 -> 69800 (no decimal places)
-	// For USD: 150.25 -> 15025 (2 decimal places)
-	priceInSmallestUnit := yahoo.ToSmallestCurrencyUnitByCurrency(quote.RegularMarketPrice, fetchedCurrency)
-
-	return &models.MarketData{
-		Symbol:    quote.Symbol,
-		Currency:  fetchedCurrency,
-		Price:     priceInSmallestUnit,
-		Change24h: quote.RegularMarketChangePercent,
-		Volume24h: 0,
-		Timestamp: time.Now(),
-	}, nil
-}
-
-// fetchGoldPriceFromAPI fetches gold price from vang.today API.
-// Used for Vietnamese gold (GOLD_VND) and world gold (GOLD_USD) investments.
-func (s *marketDataService) fetchGoldPriceFromAPI(ctx context.Context, symbol, currency string, investmentType investmentv1.InvestmentType) (*models.MarketData, error) {
-	// Fetch price from vang.today API
-	price, err := s.goldPriceService.FetchPriceForSymbol(ctx, symbol)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch gold price from vang.today: %w", err)
+	if quote.Symbol != "" {
+		symbol = quote.Symbol
 	}
 
-	// Convert market price to storage format
-	// Market price for VND gold is per tael, need to convert to per gram for storage
-	// Market price for USD gold is already per ounce
-	normalizedPrice := s.goldConverter.ProcessMarketPrice(price.Buy, currency, investmentType)
-
-	return &models.MarketData{
-		Symbol:    symbol,
-		Currency:  currency,
-		Price:     normalizedPrice,
-		Change24h: 0, // vang.today provides change in absolute value, not percent
-		Volume24h: 0,
-		Timestamp: price.UpdateTime,
-	}, nil
-}
-
-// fetchSilverPriceFromAPI fetches silver price from ancarat API.
-// Used for Vietnamese silver (SILVER_VND) and world silver (SILVER_USD) investments.
-func (s *marketDataService) fetchSilverPriceFromAPI(ctx context.Context, symbol, currency string, investmentType investmentv1.InvestmentType) (*models.MarketData, error) {
-	// Fetch price from ancarat API
-	price, err := s.silverPriceService.FetchPriceForSymbol(ctx, symbol)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch silver price from ancarat: %w", err)
+	price := quote.Price
+	switch {
+	case gold.IsGoldType(investmentType):
+		// Market price for VND gold is per tael, need to convert to per gram for storage
+		// Market price for USD gold is already per ounce
+		price = s.goldConverter.ProcessMarketPrice(price, fetchedCurrency, investmentType)
+	case silver.IsSilverType(investmentType):
+		// Market prices depend on symbol:
+		// - AG_VND_Tael: price per tael, need to convert to per gram for storage
+		// - AG_VND_Kg: price per kg, need to convert to per gram for storage
+		// - XAG: price per ounce, already in storage format
+		price = s.silverConverter.ProcessMarketPrice(price, fetchedCurrency, investmentType, symbol)
 	}
 
-	// Convert market price to storage format
-	// Market prices depend on symbol:
-	// - AG_VND_Tael: price per tael, need to convert to per gram for storage
-	// - AG_VND_Kg: price per kg, need to convert to per gram for storage
-	// - XAG: price per ounce, already in storage format
-	normalizedPrice := s.silverConverter.ProcessMarketPrice(price.Buy, currency, investmentType, symbol)
-
 	return &models.MarketData{
 		Symbol:    symbol,
-		Currency:  currency,
-		Price:     normalizedPrice,
-		Change24h: 0, // ancarat API doesn't provide change percent
+		Currency:  fetchedCurrency,
+		Price:     price,
+		Change24h: quote.ChangePercent,
 		Volume24h: 0,
-		Timestamp: price.UpdateTime,
+		Timestamp: quote.Timestamp,
 	}, nil
 }
 
@@ -310,24 +280,24 @@ func (s *marketDataService) GetPriceBatch(ctx context.Context, symbols []string)
 		return nil, apperrors.NewValidationError("symbols list cannot be empty")
 	}
 
-	// Use batch quote API
-	quotes, err := yahoo.GetQuoteBatch(ctx, symbols)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch batch quotes: %w", err)
-	}
-
-	// Convert to MarketData
+	// Symbols are routed by exchange only, as their investment type is not known
 	results := make(map[string]*models.MarketData)
-	for _, quote := range quotes {
-		priceInSmallestUnit := yahoo.ToSmallestCurrencyUnitByCurrency(quote.RegularMarketPrice, quote.Currency)
-		results[quote.Symbol] = &models.MarketData{
-			Symbol:    quote.Symbol,
-			Currency:  quote.Currency,
-			Price:     priceInSmallestUnit,
-			Change24h: quote.RegularMarketChangePercent,
-			Volume24h: 0,
-			Timestamp: time.Now(),
+	for _, symbol := range symbols {
+		symbol = strings.TrimSpace(symbol)
+		if symbol == "" {
+			continue
+		}
+		priceData, err := s.fetchPriceFromProviders(ctx, symbol, "", investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("failed to fetch batch quotes: %w", ctx.Err())
+			}
+			// Skip failed symbols but continue with others
+			log.Printf("Warning: failed to fetch price for %s: %v", symbol, err)
+			continue
 		}
+		priceData.Timestamp = time.Now()
+		results[priceData.Symbol] = priceData
 	}
 
 	return results, nil
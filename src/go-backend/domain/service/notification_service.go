@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/notification"
+	"wealthjourney/pkg/types"
+	"wealthjourney/pkg/validator"
+
+	"gorm.io/datatypes"
+
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// notificationService implements NotificationService.
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+}
+
+// NewNotificationService creates a new NotificationService.
+func NewNotificationService(notificationRepo repository.NotificationRepository) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Send stores the message in the inbox of msg.UserID.
+func (s *notificationService) Send(ctx context.Context, msg *notification.Message) error {
+	if msg.UserID <= 0 {
+		return apperrors.NewValidationError("notification has no user")
+	}
+	return s.notificationRepo.Create(ctx, &models.Notification{
+		UserID: msg.UserID,
+		Kind:   msg.Kind,
+		Title:  msg.Title,
+		Body:   msg.Body,
+		Data:   datatypes.NewJSONType(msg.Data),
+	})
+}
+
+// ListNotifications lists the notifications of a user with pagination, newest first.
+func (s *notificationService) ListNotifications(ctx context.Context, userID int32, req *v1.ListNotificationsRequest) (*v1.ListNotificationsResponse, error) {
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+
+	params := types.NewPaginationParams()
+	if req.Pagination != nil {
+		params = types.PaginationParams{
+			Page:     int(req.Pagination.Page),
+			PageSize: int(req.Pagination.PageSize),
+		}
+	}
+	params = params.Validate()
+
+	notifications, total, err := s.notificationRepo.ListByUserID(ctx, userID, req.UnreadOnly, repository.ListOptions{
+		Limit:  params.Limit(),
+		Offset: params.Offset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	unread, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoNotifications := make([]*v1.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		protoNotifications = append(protoNotifications, notificationToProto(n))
+	}
+
+	paginationResult := types.NewPaginationResult(params.Page, params.PageSize, total)
+	return &v1.ListNotificationsResponse{
+		Success:       true,
+		Message:       "Notifications retrieved successfully",
+		Notifications: protoNotifications,
+		UnreadCount:   int32(unread),
+		Pagination: &v1.PaginationResult{
+			Page:       int32(paginationResult.Page),
+			PageSize:   int32(paginationResult.PageSize),
+			TotalCount: int32(paginationResult.TotalCount),
+			TotalPages: int32(paginationResult.TotalPages),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// MarkNotificationRead marks a notification of the user as read.
+func (s *notificationService) MarkNotificationRead(ctx context.Context, notificationID int32, userID int32) (*v1.MarkNotificationReadResponse, error) {
+	if err := validator.ID(notificationID); err != nil {
+		return nil, err
+	}
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+
+	n, err := s.notificationRepo.MarkRead(ctx, notificationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MarkNotificationReadResponse{
+		Success:   true,
+		Message:   "Notification marked as read",
+		Data:      notificationToProto(n),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// MarkAllNotificationsRead marks all notifications of the user as read.
+func (s *notificationService) MarkAllNotificationsRead(ctx context.Context, userID int32) (*v1.MarkAllNotificationsReadResponse, error) {
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.notificationRepo.MarkAllRead(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MarkAllNotificationsReadResponse{
+		Success:   true,
+		Message:   "Notifications marked as read",
+		Updated:   int32(updated),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// notificationToProto converts a notification to its protobuf representation.
+func notificationToProto(n *models.Notification) *v1.Notification {
+	proto := &v1.Notification{
+		Id:        n.ID,
+		Kind:      n.Kind,
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data.Data(),
+		CreatedAt: n.CreatedAt.Unix(),
+	}
+	if n.ReadAt != nil {
+		proto.ReadAt = n.ReadAt.Unix()
+	}
+	return proto
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/notification"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubNotificationRepository keeps notifications in memory.
+type stubNotificationRepository struct {
+	repository.NotificationRepository
+	notifications []*models.Notification
+}
+
+func (r *stubNotificationRepository) Create(ctx context.Context, n *models.Notification) error {
+	n.ID = int32(len(r.notifications) + 1)
+	r.notifications = append(r.notifications, n)
+	return nil
+}
+
+func (r *stubNotificationRepository) ListByUserID(ctx context.Context, userID int32, unreadOnly bool, opts repository.ListOptions) ([]*models.Notification, int, error) {
+	var matched []*models.Notification
+	for _, n := range r.notifications {
+		if n.UserID == userID && (!unreadOnly || n.ReadAt == nil) {
+			matched = append(matched, n)
+		}
+	}
+	return matched, len(matched), nil
+}
+
+func (r *stubNotificationRepository) CountUnread(ctx context.Context, userID int32) (int, error) {
+	unread, _, _ := r.ListByUserID(ctx, userID, true, repository.ListOptions{})
+	return len(unread), nil
+}
+
+func (r *stubNotificationRepository) MarkRead(ctx context.Context, id, userID int32) (*models.Notification, error) {
+	for _, n := range r.notifications {
+		if n.ID == id && n.UserID == userID {
+			if n.ReadAt == nil {
+				now := time.Now()
+				n.ReadAt = &now
+			}
+			return n, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("notification")
+}
+
+func TestNotificationService_Inbox(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubNotificationRepository{}
+	svc := NewNotificationService(repo)
+
+	err := svc.Send(ctx, &notification.Message{Title: "No user"})
+	assert.IsType(t, apperrors.ValidationError{}, err)
+
+	require.NoError(t, svc.Send(ctx, &notification.Message{
+		Kind:   models.NotificationKindBudgetAlert,
+		Title:  "Groceries reached 80% of its budget",
+		Data:   map[string]string{"threshold": "80"},
+		UserID: 7,
+	}))
+	require.NoError(t, svc.Send(ctx, &notification.Message{Title: "Someone else's", UserID: 8}))
+
+	list, err := svc.ListNotifications(ctx, 7, &v1.ListNotificationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Notifications, 1)
+	assert.Equal(t, int32(1), list.UnreadCount)
+	assert.Equal(t, "80", list.Notifications[0].Data["threshold"])
+	assert.Zero(t, list.Notifications[0].ReadAt)
+
+	_, err = svc.MarkNotificationRead(ctx, 2, 7)
+	assert.IsType(t, apperrors.NotFoundError{}, err, "notification of another user")
+
+	read, err := svc.MarkNotificationRead(ctx, 1, 7)
+	require.NoError(t, err)
+	assert.NotZero(t, read.Data.ReadAt)
+
+	list, err = svc.ListNotifications(ctx, 7, &v1.ListNotificationsRequest{UnreadOnly: true})
+	require.NoError(t, err)
+	assert.Empty(t, list.Notifications)
+	assert.Equal(t, int32(0), list.UnreadCount)
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/units"
+	investmentv1 "wealthjourney/protobuf/v1"
+)
+
+// portfolioBackfillMaxDays bounds how far back the history is rebuilt; charts never show
+// more than a year.
+const portfolioBackfillMaxDays = 365
+
+// backfillHolding is an investment with its transactions (oldest first) and the stored
+// daily prices covering the backfilled days.
+type backfillHolding struct {
+	investment   *models.Investment
+	transactions []*models.InvestmentTransaction
+	prices       []*models.PriceHistory
+}
+
+// holdingDayValue is the value and remaining cost of a holding at the end of a day,
+// in the investment currency.
+type holdingDayValue struct {
+	value int64
+	cost  int64
+}
+
+// SetBackfillSources wires the data used to rebuild portfolio history for the days
+// before the first snapshot.
+func (s *portfolioHistoryService) SetBackfillSources(investmentRepo repository.InvestmentRepository, txRepo repository.InvestmentTransactionRepository, marketDataSvc MarketDataService) {
+	s.investmentRepo = investmentRepo
+	s.txRepo = txRepo
+	s.marketDataSvc = marketDataSvc
+}
+
+// BackfillHistory rebuilds one snapshot per day for each investment wallet, from its
+// first transaction (at most a year ago) until its first real snapshot. Holdings are
+// replayed from their transactions and valued at the stored daily closes, or at the
+// last traded price where no close is known.
+func (s *portfolioHistoryService) BackfillHistory(ctx context.Context, userID int32) (int, error) {
+	if s.investmentRepo == nil || s.txRepo == nil || s.marketDataSvc == nil {
+		return 0, apperrors.NewInternalError("portfolio history backfill is not configured")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	currency := user.PreferredCurrency
+	if currency == "" {
+		currency = "USD" // Default fallback
+	}
+
+	wallets, err := s.investmentSvc.ListInvestmentWallets(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list investment wallets: %w", err)
+	}
+
+	today := startOfDayUTC(time.Now())
+	rates := make(map[string]float64)
+	created := 0
+	for _, wallet := range wallets {
+		n, err := s.backfillWallet(ctx, userID, wallet.ID, currency, today, rates)
+		created += n
+		if err != nil {
+			return created, fmt.Errorf("failed to backfill wallet %d: %w", wallet.ID, err)
+		}
+	}
+	return created, nil
+}
+
+// backfillWallet creates the missing daily snapshots of one wallet.
+func (s *portfolioHistoryService) backfillWallet(ctx context.Context, userID, walletID int32, currency string, today time.Time, rates map[string]float64) (int, error) {
+	end := today
+	first, err := s.historyRepo.GetHistoryByWallet(ctx, userID, walletID, time.Time{}, today.Add(24*time.Hour), 1)
+	if err != nil {
+		return 0, err
+	}
+	if len(first) > 0 {
+		end = startOfDayUTC(first[0].Timestamp)
+	}
+
+	investments, _, err := s.investmentRepo.ListByWalletID(ctx, walletID, repository.ListOptions{Limit: 1000}, investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED)
+	if err != nil {
+		return 0, err
+	}
+	investmentIDs := make([]int32, len(investments))
+	for i, inv := range investments {
+		investmentIDs[i] = inv.ID
+	}
+	transactions, err := s.txRepo.ListByInvestmentIDs(ctx, investmentIDs)
+	if err != nil {
+		return 0, err
+	}
+	if len(transactions) == 0 {
+		return 0, nil
+	}
+
+	start := startOfDayUTC(transactions[0].TransactionDate)
+	if earliest := today.AddDate(0, 0, -portfolioBackfillMaxDays); start.Before(earliest) {
+		start = earliest
+	}
+	if !start.Before(end) {
+		return 0, nil
+	}
+
+	byInvestment := make(map[int32][]*models.InvestmentTransaction, len(investments))
+	for _, tx := range transactions {
+		byInvestment[tx.InvestmentID] = append(byInvestment[tx.InvestmentID], tx)
+	}
+
+	var days []time.Time
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	totalValues := make([]int64, len(days))
+	totalCosts := make([]int64, len(days))
+
+	for _, inv := range investments {
+		txs := byInvestment[inv.ID]
+		if len(txs) == 0 {
+			continue
+		}
+
+		rate := 1.0
+		if inv.Currency != currency {
+			var ok bool
+			if rate, ok = rates[inv.Currency]; !ok {
+				rate, err = s.fxRateSvc.GetRate(ctx, inv.Currency, currency)
+				if err != nil {
+					return 0, apperrors.NewInternalErrorWithCause(fmt.Sprintf("failed to get %s to %s rate", inv.Currency, currency), err)
+				}
+				rates[inv.Currency] = rate
+			}
+		}
+
+		holding := &backfillHolding{investment: inv, transactions: txs}
+		if !inv.IsCustom {
+			holding.prices, err = s.marketDataSvc.GetPriceHistory(ctx, inv.Symbol, inv.Currency, investmentv1.InvestmentType(inv.Type), start.Add(-priceHistoryLookback), end)
+			if err != nil {
+				log.Printf("Warning: no price history for %s, using traded prices: %v", inv.Symbol, err)
+			}
+		}
+
+		for i, dayValue := range holding.dailyValues(days) {
+			value, cost := dayValue.value, dayValue.cost
+			if inv.Currency != currency {
+				value, _ = s.fxRateSvc.ConvertAmountWithRate(ctx, value, rate, inv.Currency, currency)
+				cost, _ = s.fxRateSvc.ConvertAmountWithRate(ctx, cost, rate, inv.Currency, currency)
+			}
+			totalValues[i] += value
+			totalCosts[i] += cost
+		}
+	}
+
+	created := 0
+	for i, day := range days {
+		history := &models.PortfolioHistory{
+			UserID:     userID,
+			WalletID:   walletID,
+			TotalValue: totalValues[i],
+			TotalCost:  totalCosts[i],
+			TotalPnl:   totalValues[i] - totalCosts[i],
+			Currency:   currency,
+			Timestamp:  day.Add(24*time.Hour - time.Second),
+		}
+		if err := s.historyRepo.Create(ctx, history); err != nil {
+			return created, err
+		}
+		created++
+	}
+	return created, nil
+}
+
+// dailyValues replays the transactions and values the holding at the end of each day.
+// Yahoo closes are split-adjusted, so they are scaled back by the splits that had not
+// happened yet on the day.
+func (h *backfillHolding) dailyValues(days []time.Time) []holdingDayValue {
+	investmentType := investmentv1.InvestmentType(h.investment.Type)
+	values := make([]holdingDayValue, len(days))
+
+	var quantity, cost, lastPrice int64
+	var lastTradeDay time.Time
+	next := 0
+	for i, day := range days {
+		dayEnd := day.Add(24 * time.Hour)
+		for ; next < len(h.transactions) && h.transactions[next].TransactionDate.Before(dayEnd); next++ {
+			tx := h.transactions[next]
+			switch investmentv1.InvestmentTransactionType(tx.Type) {
+			case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY:
+				quantity += tx.Quantity
+				cost += tx.Cost + tx.Fees
+				lastPrice, lastTradeDay = tx.Price, startOfDayUTC(tx.TransactionDate)
+			case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL:
+				if quantity > 0 {
+					cost -= int64(float64(cost) * float64(tx.Quantity) / float64(quantity))
+				}
+				quantity -= tx.Quantity
+				lastPrice, lastTradeDay = tx.Price, startOfDayUTC(tx.TransactionDate)
+			case investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT:
+				// The split transaction records the quantity after the split
+				quantity = tx.Quantity
+				if tx.SplitNumerator > 0 && tx.SplitDenominator > 0 {
+					lastPrice = models.ScaleByRatio(lastPrice, tx.SplitDenominator, tx.SplitNumerator)
+				}
+			}
+		}
+		if quantity <= 0 {
+			quantity, cost = 0, 0
+			continue
+		}
+
+		// A trade newer than the last close is the better price
+		price := lastPrice
+		if bar := priceBarAt(h.prices, day); bar != nil && !lastTradeDay.After(bar.PriceDate) {
+			price = bar.Close
+			if bar.Source == models.PriceSourceYahoo {
+				for _, tx := range h.transactions[next:] {
+					if investmentv1.InvestmentTransactionType(tx.Type) == investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT &&
+						tx.SplitNumerator > 0 && tx.SplitDenominator > 0 {
+						price = models.ScaleByRatio(price, tx.SplitNumerator, tx.SplitDenominator)
+					}
+				}
+			}
+		}
+
+		values[i] = holdingDayValue{
+			value: units.CalculateTransactionCost(quantity, price, investmentType),
+			cost:  cost,
+		}
+	}
+	return values
+}
+
+// priceBarAt returns the last bar on or before day; prices are sorted oldest first.
+func priceBarAt(prices []*models.PriceHistory, day time.Time) *models.PriceHistory {
+	var bar *models.PriceHistory
+	for _, price := range prices {
+		if price.PriceDate.After(day) {
+			break
+		}
+		bar = price
+	}
+	return bar
+}
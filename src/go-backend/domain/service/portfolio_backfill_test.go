@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	investmentv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBackfillHistoryRepository serves a wallet's snapshots and records created ones.
+type stubBackfillHistoryRepository struct {
+	repository.PortfolioHistoryRepository
+	existing []*models.PortfolioHistory
+	created  []*models.PortfolioHistory
+}
+
+func (s *stubBackfillHistoryRepository) GetHistoryByWallet(ctx context.Context, userID, walletID int32, from, to time.Time, limit int) ([]*models.PortfolioHistory, error) {
+	return s.existing, nil
+}
+
+func (s *stubBackfillHistoryRepository) Create(ctx context.Context, history *models.PortfolioHistory) error {
+	s.created = append(s.created, history)
+	return nil
+}
+
+func backfillTx(txType investmentv1.InvestmentTransactionType, date time.Time, quantity, price int64) *models.InvestmentTransaction {
+	return &models.InvestmentTransaction{
+		InvestmentID:    1,
+		Type:            int32(txType),
+		Quantity:        quantity,
+		Price:           price,
+		Cost:            quantity / 10000 * price,
+		TransactionDate: date,
+	}
+}
+
+func TestBackfillHolding_DailyValues(t *testing.T) {
+	buy := backfillTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY, priceDay(time.March, 4).Add(10*time.Hour), 100000, 10000) // 10 shares @ $100
+	split := backfillTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SPLIT, priceDay(time.March, 7).Add(9*time.Hour), 200000, 0)
+	split.SplitNumerator, split.SplitDenominator = 2, 1
+	sell := backfillTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL, priceDay(time.March, 8).Add(11*time.Hour), 50000, 6200) // 5 shares @ $62
+
+	holding := &backfillHolding{
+		investment:   &models.Investment{ID: 1, Symbol: "AAA", Type: int32(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK)},
+		transactions: []*models.InvestmentTransaction{buy, split, sell},
+		prices: []*models.PriceHistory{
+			{PriceDate: priceDay(time.March, 1), Close: 4900, Source: models.PriceSourceYahoo},
+			{PriceDate: priceDay(time.March, 5), Close: 5500, Source: models.PriceSourceYahoo}, // Split-adjusted: $110 before the split
+			{PriceDate: priceDay(time.March, 7), Close: 6000, Source: models.PriceSourceYahoo},
+		},
+	}
+	days := []time.Time{priceDay(time.March, 3), priceDay(time.March, 4), priceDay(time.March, 5), priceDay(time.March, 6), priceDay(time.March, 7), priceDay(time.March, 8)}
+
+	values := holding.dailyValues(days)
+
+	require.Len(t, values, len(days))
+	assert.Equal(t, holdingDayValue{}, values[0], "nothing held before the first buy")
+	assert.Equal(t, holdingDayValue{value: 100000, cost: 100000}, values[1], "the trade is newer than the last close")
+	assert.Equal(t, holdingDayValue{value: 110000, cost: 100000}, values[2], "adjusted close scaled back by the later split")
+	assert.Equal(t, holdingDayValue{value: 110000, cost: 100000}, values[3], "last close carried over")
+	assert.Equal(t, holdingDayValue{value: 120000, cost: 100000}, values[4], "20 shares @ $60 after the split")
+	assert.Equal(t, holdingDayValue{value: 93000, cost: 75000}, values[5], "15 shares @ the $62 sell price")
+}
+
+func TestPortfolioHistoryService_BackfillWallet(t *testing.T) {
+	ctx := context.Background()
+	today := priceDay(time.March, 10)
+	historyRepo := &stubBackfillHistoryRepository{existing: []*models.PortfolioHistory{
+		{WalletID: 7, Timestamp: priceDay(time.March, 8).Add(10 * time.Hour)},
+	}}
+	mockInvRepo := new(MockInvestmentRepository)
+	mockTxRepo := new(MockInvestmentTransactionRepository)
+	mockMarketData := new(MockMarketDataService)
+	mockFX := new(MockFXRateService)
+
+	svc := NewPortfolioHistoryService(historyRepo, nil, nil, mockFX).(*portfolioHistoryService)
+	svc.SetBackfillSources(mockInvRepo, mockTxRepo, mockMarketData)
+
+	investments := []*models.Investment{
+		{ID: 1, WalletID: 7, Symbol: "AAA", Type: int32(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK), Currency: "USD"},
+		{ID: 2, WalletID: 7, Symbol: "VNM", Type: int32(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK), Currency: "VND"},
+	}
+	buyAAA := backfillTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY, priceDay(time.March, 5).Add(10*time.Hour), 100000, 10000)
+	buyVNM := backfillTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY, priceDay(time.March, 6).Add(3*time.Hour), 1000000, 70000) // 100 shares @ 70,000 VND
+	buyVNM.InvestmentID = 2
+
+	mockInvRepo.On("ListByWalletID", ctx, int32(7), repository.ListOptions{Limit: 1000}, investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED).Return(investments, 2, nil)
+	mockTxRepo.On("ListByInvestmentIDs", ctx, []int32{1, 2}).Return([]*models.InvestmentTransaction{buyAAA, buyVNM}, nil)
+	from, end := priceDay(time.February, 27), priceDay(time.March, 8)
+	mockMarketData.On("GetPriceHistory", ctx, "AAA", "USD", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, from, end).
+		Return([]*models.PriceHistory{{PriceDate: priceDay(time.March, 6), Close: 10500, Source: models.PriceSourceYahoo}}, nil)
+	mockMarketData.On("GetPriceHistory", ctx, "VNM", "VND", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, from, end).
+		Return([]*models.PriceHistory{{PriceDate: priceDay(time.March, 7), Close: 75000, Source: models.PriceSourceYahoo}}, nil)
+	mockFX.On("GetRate", ctx, "VND", "USD").Return(0.00004, nil).Once()
+	mockFX.On("ConvertAmountWithRate", ctx, int64(7000000), 0.00004, "VND", "USD").Return(int64(28000), nil)
+	mockFX.On("ConvertAmountWithRate", ctx, int64(7500000), 0.00004, "VND", "USD").Return(int64(30000), nil)
+	mockFX.On("ConvertAmountWithRate", ctx, int64(0), 0.00004, "VND", "USD").Return(int64(0), nil)
+
+	created, err := svc.backfillWallet(ctx, 1, 7, "USD", today, make(map[string]float64))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, created, "March 5 to 7, up to the first snapshot")
+	require.Len(t, historyRepo.created, 3)
+
+	first := historyRepo.created[0]
+	assert.Equal(t, int32(7), first.WalletID)
+	assert.Equal(t, "USD", first.Currency)
+	assert.Equal(t, priceDay(time.March, 6).Add(-time.Second), first.Timestamp, "end of March 5")
+	assert.Equal(t, int64(100000), first.TotalValue)
+
+	assert.Equal(t, int64(105000+28000), historyRepo.created[1].TotalValue)
+	assert.Equal(t, int64(100000+28000), historyRepo.created[1].TotalCost)
+	assert.Equal(t, int64(105000+30000), historyRepo.created[2].TotalValue)
+	assert.Equal(t, int64(7000), historyRepo.created[2].TotalPnl)
+	mockFX.AssertExpectations(t)
+}
+
+func TestPortfolioHistoryService_BackfillWallet_NothingMissing(t *testing.T) {
+	ctx := context.Background()
+	historyRepo := &stubBackfillHistoryRepository{existing: []*models.PortfolioHistory{
+		{WalletID: 7, Timestamp: priceDay(time.March, 5).Add(10 * time.Hour)},
+	}}
+	mockInvRepo := new(MockInvestmentRepository)
+	mockTxRepo := new(MockInvestmentTransactionRepository)
+	svc := NewPortfolioHistoryService(historyRepo, nil, nil, nil).(*portfolioHistoryService)
+	svc.SetBackfillSources(mockInvRepo, mockTxRepo, new(MockMarketDataService))
+
+	investments := []*models.Investment{{ID: 1, WalletID: 7, Symbol: "AAA", Currency: "USD"}}
+	mockInvRepo.On("ListByWalletID", ctx, int32(7), repository.ListOptions{Limit: 1000}, investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED).Return(investments, 1, nil)
+	mockTxRepo.On("ListByInvestmentIDs", ctx, []int32{1}).Return([]*models.InvestmentTransaction{
+		backfillTx(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY, priceDay(time.March, 5).Add(9*time.Hour), 10000, 10000),
+	}, nil)
+
+	created, err := svc.backfillWallet(ctx, 1, 7, "USD", priceDay(time.March, 10), make(map[string]float64))
+
+	require.NoError(t, err)
+	assert.Zero(t, created, "the first snapshot is on the first trading day")
+	assert.Empty(t, historyRepo.created)
+}
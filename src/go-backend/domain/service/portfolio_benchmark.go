@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/yahoo"
+	investmentv1 "wealthjourney/protobuf/v1"
+)
+
+const (
+	// benchmarkLookback is how far before the first portfolio point benchmark prices are
+	// loaded, so that a series starting on a weekend or holiday has a close to start from.
+	benchmarkLookback = 7 * 24 * time.Hour
+
+	// benchmarkRefreshInterval limits how often missing benchmark prices are fetched again;
+	// weekends and holidays never get a close, so a gap is not always fillable.
+	benchmarkRefreshInterval = 6 * time.Hour
+)
+
+// benchmarkTickers maps friendly benchmark names to Yahoo Finance tickers.
+// Other symbols are used as Yahoo Finance tickers as they are (e.g. "SPY", "VOO").
+var benchmarkTickers = map[string]string{
+	"VNINDEX": "^VNINDEX.VN",
+	"VN30":    "^VN30.VN",
+	"SPX":     "^GSPC",
+	"XAU":     "GC=F", // Gold futures, matching the silver price fallback
+	"XAG":     "SI=F",
+}
+
+// historicalPriceFetcher fetches daily closes from the market data provider.
+type historicalPriceFetcher func(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error)
+
+// SetBenchmarkPriceRepository wires the benchmark price store used by benchmark comparison.
+func (s *portfolioHistoryService) SetBenchmarkPriceRepository(benchmarkRepo repository.BenchmarkPriceRepository) {
+	s.benchmarkRepo = benchmarkRepo
+}
+
+// compareBenchmark rebases the benchmark onto the sampled points in data and measures
+// the portfolio against it over the whole history.
+func (s *portfolioHistoryService) compareBenchmark(ctx context.Context, symbol string, histories []*models.PortfolioHistory, data []*investmentv1.HistoricalPortfolioValue, convert func(currency string, amount int64) float64) (*investmentv1.BenchmarkComparison, error) {
+	if s.benchmarkRepo == nil {
+		return nil, apperrors.NewInternalError("benchmark comparison is not configured")
+	}
+
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	ticker := symbol
+	if mapped, ok := benchmarkTickers[symbol]; ok {
+		ticker = mapped
+	}
+	if len(ticker) > 20 {
+		return nil, apperrors.NewValidationError("benchmark symbol is too long")
+	}
+
+	comparison := &investmentv1.BenchmarkComparison{Symbol: symbol, Ticker: ticker}
+	if len(histories) == 0 || len(data) == 0 {
+		return comparison, nil
+	}
+
+	first := histories[0].Timestamp
+	last := histories[len(histories)-1].Timestamp
+	prices, err := s.loadBenchmarkPrices(ctx, ticker, first.Add(-benchmarkLookback), last)
+	if err != nil {
+		return nil, err
+	}
+	if len(prices) > 0 {
+		comparison.Currency = prices[len(prices)-1].Currency
+	}
+
+	startPrice, ok := benchmarkCloseAt(prices, first)
+	if !ok {
+		return comparison, nil
+	}
+	endPrice, _ := benchmarkCloseAt(prices, last)
+	comparison.StartPrice = startPrice
+	comparison.EndPrice = endPrice
+	comparison.BenchmarkReturn = endPrice/startPrice - 1
+	comparison.PortfolioReturn = contributionAdjustedReturn(histories, convert)
+	comparison.Alpha = comparison.PortfolioReturn - comparison.BenchmarkReturn
+
+	// Rebase so the benchmark line starts where the portfolio line starts
+	base := data[0].DisplayTotalValue
+	basePrice, ok := benchmarkCloseAt(prices, time.Unix(data[0].Timestamp, 0))
+	if !ok {
+		basePrice = startPrice
+	}
+	for _, point := range data {
+		price, ok := benchmarkCloseAt(prices, time.Unix(point.Timestamp, 0))
+		if !ok {
+			continue
+		}
+		point.BenchmarkValue = &investmentv1.Money{
+			Amount:   int64(float64(base.Amount) * price / basePrice),
+			Currency: base.Currency,
+		}
+	}
+
+	return comparison, nil
+}
+
+// loadBenchmarkPrices returns stored closes for the range, fetching and storing them
+// first when the store does not cover it. Stored prices are used when fetching fails.
+func (s *portfolioHistoryService) loadBenchmarkPrices(ctx context.Context, ticker string, from, to time.Time) ([]*models.BenchmarkPrice, error) {
+	stored, err := s.benchmarkRepo.ListBySymbol(ctx, ticker, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if !benchmarkNeedsRefresh(stored, from, to) {
+		return stored, nil
+	}
+
+	history, err := s.fetchHistory(ctx, ticker, from, to.Add(24*time.Hour))
+	if err != nil {
+		if len(stored) > 0 {
+			log.Printf("Warning: failed to refresh benchmark %s, using stored prices: %v", ticker, err)
+			return stored, nil
+		}
+		if errors.Is(err, yahoo.ErrSymbolNotFound) {
+			return nil, apperrors.NewNotFoundErrorWithMessage(fmt.Sprintf("benchmark symbol %s not found", ticker))
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to fetch benchmark prices", err)
+	}
+
+	prices := make([]*models.BenchmarkPrice, 0, len(history.Prices))
+	for _, p := range history.Prices {
+		prices = append(prices, &models.BenchmarkPrice{
+			Symbol:    ticker,
+			PriceDate: p.Date,
+			Close:     p.Close,
+			Currency:  history.Currency,
+		})
+	}
+	if err := s.benchmarkRepo.Upsert(ctx, prices); err != nil {
+		return nil, err
+	}
+
+	return s.benchmarkRepo.ListBySymbol(ctx, ticker, from, to)
+}
+
+// benchmarkNeedsRefresh reports whether stored closes leave a gap at either end of the
+// range that has not been looked for recently.
+func benchmarkNeedsRefresh(stored []*models.BenchmarkPrice, from, to time.Time) bool {
+	if len(stored) == 0 {
+		return true
+	}
+
+	startGap := stored[0].PriceDate.After(from.Add(benchmarkLookback))
+	endGap := stored[len(stored)-1].PriceDate.Before(to.Add(-24 * time.Hour))
+	if !startGap && !endGap {
+		return false
+	}
+
+	var lastFetched time.Time
+	for _, price := range stored {
+		if price.UpdatedAt.After(lastFetched) {
+			lastFetched = price.UpdatedAt
+		}
+	}
+	return time.Since(lastFetched) > benchmarkRefreshInterval
+}
+
+// benchmarkCloseAt returns the last close on or before t; prices are sorted oldest first.
+func benchmarkCloseAt(prices []*models.BenchmarkPrice, t time.Time) (float64, bool) {
+	i := sort.Search(len(prices), func(i int) bool { return prices[i].PriceDate.After(t) })
+	if i == 0 {
+		return 0, false
+	}
+	return prices[i-1].Close, true
+}
+
+// contributionAdjustedReturn measures the growth of wallet snapshots net of money moved
+// in or out, with the Modified Dietz method over the whole series: a change in a
+// wallet's total cost between snapshots counts as a contribution at the later snapshot,
+// and a wallet first seen after the first day contributes its first value.
+func contributionAdjustedReturn(histories []*models.PortfolioHistory, convert func(currency string, amount int64) float64) float64 {
+	if len(histories) == 0 {
+		return 0
+	}
+
+	sorted := make([]*models.PortfolioHistory, len(histories))
+	copy(sorted, histories)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Timestamp.Before(sorted[j].Timestamp) })
+
+	start := sorted[0].Timestamp
+	end := sorted[len(sorted)-1].Timestamp
+	length := end.Sub(start).Seconds()
+
+	latest := make(map[int32]*models.PortfolioHistory)
+	var startValue, net, weighted float64
+	for _, h := range sorted {
+		value := convert(h.Currency, h.TotalValue)
+		prev, seen := latest[h.WalletID]
+		latest[h.WalletID] = h
+
+		var contribution float64
+		switch {
+		case !seen && sameDay(h.Timestamp, start):
+			startValue += value
+			continue
+		case !seen:
+			contribution = value
+		default:
+			if h.TotalCost == prev.TotalCost {
+				continue
+			}
+			contribution = convert(h.Currency, h.TotalCost-prev.TotalCost)
+		}
+
+		weight := 0.0
+		if length > 0 {
+			weight = end.Sub(h.Timestamp).Seconds() / length
+		}
+		net += contribution
+		weighted += weight * contribution
+	}
+
+	var endValue float64
+	for _, h := range latest {
+		endValue += convert(h.Currency, h.TotalValue)
+	}
+
+	base := startValue + weighted
+	if base <= 0 {
+		return 0
+	}
+	return (endValue - startValue - net) / base
+}
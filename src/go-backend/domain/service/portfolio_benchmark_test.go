@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/yahoo"
+	investmentv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBenchmarkPriceRepository keeps benchmark prices in memory.
+type stubBenchmarkPriceRepository struct {
+	repository.BenchmarkPriceRepository
+	prices map[string]*models.BenchmarkPrice
+}
+
+func newStubBenchmarkPriceRepository(prices ...*models.BenchmarkPrice) *stubBenchmarkPriceRepository {
+	repo := &stubBenchmarkPriceRepository{prices: make(map[string]*models.BenchmarkPrice)}
+	_ = repo.Upsert(context.Background(), prices)
+	return repo
+}
+
+func (s *stubBenchmarkPriceRepository) ListBySymbol(ctx context.Context, symbol string, from, to time.Time) ([]*models.BenchmarkPrice, error) {
+	var result []*models.BenchmarkPrice
+	for _, price := range s.prices {
+		if price.Symbol == symbol && !price.PriceDate.Before(from) && !price.PriceDate.After(to) {
+			result = append(result, price)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].PriceDate.Before(result[j].PriceDate) })
+	return result, nil
+}
+
+func (s *stubBenchmarkPriceRepository) Upsert(ctx context.Context, prices []*models.BenchmarkPrice) error {
+	for _, price := range prices {
+		stored := *price
+		stored.UpdatedAt = time.Now()
+		s.prices[price.Symbol+price.PriceDate.Format("2006-01-02")] = &stored
+	}
+	return nil
+}
+
+// stubPortfolioHistoryRepository serves fixed snapshots.
+type stubPortfolioHistoryRepository struct {
+	repository.PortfolioHistoryRepository
+	histories []*models.PortfolioHistory
+}
+
+func (s *stubPortfolioHistoryRepository) GetAggregatedHistory(ctx context.Context, userID int32, from, to time.Time, limit int) ([]*models.PortfolioHistory, error) {
+	return s.histories, nil
+}
+
+func benchmarkDay(month time.Month, day int) time.Time {
+	return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestContributionAdjustedReturn(t *testing.T) {
+	identity := func(currency string, amount int64) float64 { return float64(amount) }
+
+	t.Run("no contributions", func(t *testing.T) {
+		got := contributionAdjustedReturn([]*models.PortfolioHistory{
+			{WalletID: 1, TotalValue: 1000, TotalCost: 1000, Timestamp: benchmarkDay(time.January, 1)},
+			{WalletID: 1, TotalValue: 1100, TotalCost: 1000, Timestamp: benchmarkDay(time.January, 11)},
+		}, identity)
+		assert.InDelta(t, 0.1, got, 1e-9)
+	})
+
+	t.Run("deposit halfway is not counted as growth", func(t *testing.T) {
+		got := contributionAdjustedReturn([]*models.PortfolioHistory{
+			{WalletID: 1, TotalValue: 1000, TotalCost: 1000, Timestamp: benchmarkDay(time.January, 1)},
+			{WalletID: 1, TotalValue: 2100, TotalCost: 2000, Timestamp: benchmarkDay(time.January, 6)},
+			{WalletID: 1, TotalValue: 2200, TotalCost: 2000, Timestamp: benchmarkDay(time.January, 11)},
+		}, identity)
+		// Gain 200 on an average capital of 1000 + 1000 × 1/2
+		assert.InDelta(t, 200.0/1500, got, 1e-9)
+	})
+
+	t.Run("wallet opened later contributes its first value", func(t *testing.T) {
+		got := contributionAdjustedReturn([]*models.PortfolioHistory{
+			{WalletID: 1, TotalValue: 1000, TotalCost: 1000, Timestamp: benchmarkDay(time.January, 1)},
+			{WalletID: 2, TotalValue: 500, TotalCost: 500, Timestamp: benchmarkDay(time.January, 11)},
+			{WalletID: 1, TotalValue: 1100, TotalCost: 1000, Timestamp: benchmarkDay(time.January, 11)},
+		}, identity)
+		assert.InDelta(t, 0.1, got, 1e-9)
+	})
+}
+
+func TestBenchmarkCloseAt(t *testing.T) {
+	prices := []*models.BenchmarkPrice{
+		{PriceDate: benchmarkDay(time.March, 1), Close: 100}, // Friday
+		{PriceDate: benchmarkDay(time.March, 4), Close: 102}, // Monday
+	}
+
+	_, ok := benchmarkCloseAt(prices, benchmarkDay(time.February, 29))
+	assert.False(t, ok)
+
+	price, ok := benchmarkCloseAt(prices, benchmarkDay(time.March, 3).Add(12*time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, 100.0, price, "weekend uses Friday's close")
+
+	price, _ = benchmarkCloseAt(prices, benchmarkDay(time.March, 4).Add(9*time.Hour))
+	assert.Equal(t, 102.0, price)
+}
+
+func newBenchmarkTestService(t *testing.T, benchmarkRepo repository.BenchmarkPriceRepository, fetch historicalPriceFetcher) PortfolioHistoryService {
+	t.Helper()
+	mockUserRepo := new(MockUserRepository)
+	mockUserRepo.On("GetByID", context.Background(), int32(1)).Return(&models.User{ID: 1, PreferredCurrency: "USD"}, nil)
+
+	historyRepo := &stubPortfolioHistoryRepository{histories: []*models.PortfolioHistory{
+		{WalletID: 1, TotalValue: 100000, TotalCost: 100000, Currency: "USD", Timestamp: benchmarkDay(time.March, 2).Add(10 * time.Hour)},
+		{WalletID: 1, TotalValue: 108000, TotalCost: 100000, Currency: "USD", Timestamp: benchmarkDay(time.March, 5).Add(10 * time.Hour)},
+	}}
+
+	svc := NewPortfolioHistoryService(historyRepo, nil, mockUserRepo, nil)
+	ps := svc.(*portfolioHistoryService)
+	ps.SetBenchmarkPriceRepository(benchmarkRepo)
+	ps.fetchHistory = fetch
+	return svc
+}
+
+func TestPortfolioHistoryService_GetHistoricalValues_Benchmark(t *testing.T) {
+	ctx := context.Background()
+	benchmarkRepo := newStubBenchmarkPriceRepository()
+	fetches := 0
+	fetch := func(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error) {
+		fetches++
+		assert.Equal(t, "^GSPC", symbol)
+		return &yahoo.HistoricalPrices{Symbol: symbol, Currency: "USD", Prices: []yahoo.HistoricalPrice{
+			{Date: benchmarkDay(time.March, 1), Close: 5000},
+			{Date: benchmarkDay(time.March, 4), Close: 5100},
+			{Date: benchmarkDay(time.March, 5), Close: 5250},
+		}}, nil
+	}
+	svc := newBenchmarkTestService(t, benchmarkRepo, fetch)
+
+	resp, err := svc.GetHistoricalValues(ctx, 1, &investmentv1.GetHistoricalPortfolioValuesRequest{BenchmarkSymbol: "spx"})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Benchmark)
+	assert.Equal(t, "SPX", resp.Benchmark.Symbol)
+	assert.Equal(t, "^GSPC", resp.Benchmark.Ticker)
+	assert.Equal(t, "USD", resp.Benchmark.Currency)
+	assert.Equal(t, 5000.0, resp.Benchmark.StartPrice, "Saturday starts from Friday's close")
+	assert.Equal(t, 5250.0, resp.Benchmark.EndPrice)
+	assert.InDelta(t, 0.05, resp.Benchmark.BenchmarkReturn, 1e-9)
+	assert.InDelta(t, 0.08, resp.Benchmark.PortfolioReturn, 1e-9)
+	assert.InDelta(t, 0.03, resp.Benchmark.Alpha, 1e-9)
+
+	require.Len(t, resp.Data, 2)
+	assert.Equal(t, int64(100000), resp.Data[0].BenchmarkValue.Amount)
+	assert.Equal(t, int64(105000), resp.Data[1].BenchmarkValue.Amount)
+	assert.Equal(t, "USD", resp.Data[1].BenchmarkValue.Currency)
+
+	assert.Len(t, benchmarkRepo.prices, 3, "fetched prices are stored")
+
+	_, err = svc.GetHistoricalValues(ctx, 1, &investmentv1.GetHistoricalPortfolioValuesRequest{BenchmarkSymbol: "SPX"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches, "stored prices are reused")
+}
+
+func TestPortfolioHistoryService_GetHistoricalValues_BenchmarkFetchFails(t *testing.T) {
+	ctx := context.Background()
+	failing := func(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error) {
+		return nil, yahoo.ErrSymbolNotFound
+	}
+
+	t.Run("unknown symbol", func(t *testing.T) {
+		svc := newBenchmarkTestService(t, newStubBenchmarkPriceRepository(), failing)
+
+		_, err := svc.GetHistoricalValues(ctx, 1, &investmentv1.GetHistoricalPortfolioValuesRequest{BenchmarkSymbol: "NOPE"})
+
+		var notFoundErr apperrors.NotFoundError
+		require.True(t, errors.As(err, &notFoundErr))
+	})
+
+	t.Run("stale stored prices are still used", func(t *testing.T) {
+		stored := newStubBenchmarkPriceRepository(
+			&models.BenchmarkPrice{Symbol: "GC=F", PriceDate: benchmarkDay(time.March, 1), Close: 2000},
+		)
+		for _, price := range stored.prices {
+			price.UpdatedAt = time.Now().Add(-2 * benchmarkRefreshInterval)
+		}
+		svc := newBenchmarkTestService(t, stored, failing)
+
+		resp, err := svc.GetHistoricalValues(ctx, 1, &investmentv1.GetHistoricalPortfolioValuesRequest{BenchmarkSymbol: "XAU"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, resp.Benchmark.BenchmarkReturn)
+		assert.Equal(t, int64(100000), resp.Data[1].BenchmarkValue.Amount)
+	})
+}
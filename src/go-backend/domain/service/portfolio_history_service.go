@@ -9,15 +9,21 @@ import (
 	"wealthjourney/domain/repository"
 	apperrors "wealthjourney/pkg/errors"
 	"wealthjourney/pkg/validator"
+	"wealthjourney/pkg/yahoo"
 	investmentv1 "wealthjourney/protobuf/v1"
 )
 
 // PortfolioHistoryService handles historical portfolio data
 type portfolioHistoryService struct {
-	historyRepo   repository.PortfolioHistoryRepository
-	investmentSvc InvestmentService
-	userRepo      repository.UserRepository
-	fxRateSvc     FXRateService
+	historyRepo    repository.PortfolioHistoryRepository
+	investmentSvc  InvestmentService
+	userRepo       repository.UserRepository
+	fxRateSvc      FXRateService
+	benchmarkRepo  repository.BenchmarkPriceRepository
+	fetchHistory   historicalPriceFetcher
+	investmentRepo repository.InvestmentRepository            // Optional; see SetBackfillSources
+	txRepo         repository.InvestmentTransactionRepository // Optional; see SetBackfillSources
+	marketDataSvc  MarketDataService                          // Optional; see SetBackfillSources
 }
 
 // NewPortfolioHistoryService creates a new PortfolioHistoryService
@@ -32,6 +38,7 @@ func NewPortfolioHistoryService(
 		investmentSvc: investmentSvc,
 		userRepo:      userRepo,
 		fxRateSvc:     fxRateSvc,
+		fetchHistory:  yahoo.GetHistoricalPrices,
 	}
 }
 
@@ -108,12 +115,39 @@ func (s *portfolioHistoryService) GetHistoricalValues(ctx context.Context, userI
 		}
 	}
 
-	return &investmentv1.GetHistoricalPortfolioValuesResponse{
+	resp := &investmentv1.GetHistoricalPortfolioValuesResponse{
 		Success:   true,
 		Message:   "Historical values retrieved successfully",
 		Data:      data,
 		Timestamp: time.Now().Format(time.RFC3339),
-	}, nil
+	}
+
+	// 7. Compare against a benchmark if requested
+	if req.BenchmarkSymbol != "" {
+		rates := make(map[string]float64)
+		convert := func(currency string, amount int64) float64 {
+			if currency == userCurrency {
+				return float64(amount)
+			}
+			rate, ok := rates[currency]
+			if !ok {
+				var err error
+				rate, err = s.fxRateSvc.GetRate(ctx, currency, userCurrency)
+				if err != nil || rate <= 0 {
+					rate = 1 // Same fallback as the display values
+				}
+				rates[currency] = rate
+			}
+			return float64(amount) * rate
+		}
+
+		resp.Benchmark, err = s.compareBenchmark(ctx, req.BenchmarkSymbol, histories, data, convert)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
 }
 
 // sampleData reduces the dataset to the requested number of points using time-based sampling
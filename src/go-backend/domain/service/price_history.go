@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/gold"
+	"wealthjourney/pkg/silver"
+	"wealthjourney/pkg/validator"
+	"wealthjourney/pkg/yahoo"
+	investmentv1 "wealthjourney/protobuf/v1"
+)
+
+const (
+	// priceHistoryLookback is how far before a requested range prices are loaded, so
+	// that a range starting on a weekend or holiday has a close to start from.
+	priceHistoryLookback = 7 * 24 * time.Hour
+
+	// priceHistoryRefreshInterval limits how often missing days are fetched again;
+	// weekends and holidays never get a bar, so a gap is not always fillable.
+	priceHistoryRefreshInterval = 6 * time.Hour
+)
+
+// ErrPriceHistoryUnavailable is returned by price sources that publish no history for
+// a symbol; its history is then built from recorded quotes only.
+var ErrPriceHistoryUnavailable = errors.New("no price history source for symbol")
+
+// SetPriceHistoryRepository wires the daily price store. Live prices are recorded into
+// it and GetPriceHistory backfills it.
+func (s *marketDataService) SetPriceHistoryRepository(priceHistoryRepo repository.PriceHistoryRepository) {
+	s.priceHistoryRepo = priceHistoryRepo
+}
+
+// GetPriceHistory retrieves daily prices between from and to (inclusive), oldest first.
+// Missing days are backfilled from the price source first; stored prices are returned
+// when that fails.
+func (s *marketDataService) GetPriceHistory(ctx context.Context, symbol, currency string, investmentType investmentv1.InvestmentType, from, to time.Time) ([]*models.PriceHistory, error) {
+	if s.priceHistoryRepo == nil {
+		return nil, apperrors.NewInternalError("price history is not configured")
+	}
+	if symbol == "" {
+		return nil, apperrors.NewValidationError("symbol is required")
+	}
+	if err := validator.Currency(currency); err != nil {
+		return nil, err
+	}
+	from, to = startOfDayUTC(from), startOfDayUTC(to)
+	if from.After(to) {
+		return nil, apperrors.NewValidationError("start date must not be after end date")
+	}
+
+	stored, err := s.priceHistoryRepo.ListBySymbol(ctx, symbol, currency, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if !priceHistoryNeedsBackfill(stored, from, to) {
+		return stored, nil
+	}
+
+	bars, err := s.fetchPriceHistory(ctx, symbol, currency, investmentType, from, to.Add(24*time.Hour))
+	if err != nil {
+		if errors.Is(err, ErrPriceHistoryUnavailable) {
+			return stored, nil
+		}
+		if len(stored) > 0 {
+			log.Printf("Warning: failed to backfill price history for %s, using stored prices: %v", symbol, err)
+			return stored, nil
+		}
+		if errors.Is(err, yahoo.ErrSymbolNotFound) {
+			return nil, apperrors.NewNotFoundErrorWithMessage(fmt.Sprintf("no price history for %s", symbol))
+		}
+		var validationErr apperrors.ValidationError
+		if errors.As(err, &validationErr) {
+			return nil, err
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to fetch price history", err)
+	}
+	if err := s.priceHistoryRepo.Upsert(ctx, bars); err != nil {
+		return nil, err
+	}
+
+	return s.priceHistoryRepo.ListBySymbol(ctx, symbol, currency, from, to)
+}
+
+// fetchPriceHistory fetches daily bars from the source matching the investment type and
+// converts them to the storage unit of that type.
+func (s *marketDataService) fetchPriceHistory(ctx context.Context, symbol, currency string, investmentType investmentv1.InvestmentType, from, to time.Time) ([]*models.PriceHistory, error) {
+	var history *yahoo.HistoricalPrices
+	var err error
+	toStorageUnit := func(price int64) int64 { return price }
+
+	switch {
+	case gold.IsGoldType(investmentType):
+		history, err = s.goldPriceService.FetchHistoricalPrices(ctx, symbol, from, to)
+		toStorageUnit = func(price int64) int64 {
+			return s.goldConverter.ProcessMarketPrice(price, currency, investmentType)
+		}
+	case silver.IsSilverType(investmentType):
+		history, err = s.silverPriceService.FetchHistoricalPrices(ctx, symbol, from, to)
+		toStorageUnit = func(price int64) int64 {
+			return s.silverConverter.ProcessMarketPrice(price, currency, investmentType, symbol)
+		}
+	default:
+		history, err = s.fetchHistory(ctx, symbol, from, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Bars are stored in the investment currency; a listing quoted in another one
+	// cannot value the holding
+	if history.Currency != "" && !strings.EqualFold(history.Currency, currency) {
+		return nil, apperrors.NewValidationError(fmt.Sprintf("%s is quoted in %s, not %s", symbol, history.Currency, currency))
+	}
+
+	convert := func(price float64) int64 {
+		return toStorageUnit(yahoo.ToSmallestCurrencyUnitByCurrency(price, currency))
+	}
+	bars := make([]*models.PriceHistory, 0, len(history.Prices))
+	for _, p := range history.Prices {
+		bars = append(bars, &models.PriceHistory{
+			Symbol:    symbol,
+			Currency:  currency,
+			PriceDate: p.Date,
+			Open:      convert(p.Open),
+			High:      convert(p.High),
+			Low:       convert(p.Low),
+			Close:     convert(p.Close),
+			Volume:    p.Volume,
+			Source:    models.PriceSourceYahoo,
+		})
+	}
+	return bars, nil
+}
+
+// recordQuote folds a freshly fetched price into today's bar. Failures are logged only,
+// as recording history must never break price lookups.
+func (s *marketDataService) recordQuote(ctx context.Context, data *models.MarketData) {
+	if s.priceHistoryRepo == nil || data.Price <= 0 {
+		return
+	}
+	if err := s.priceHistoryRepo.RecordQuote(ctx, data.Symbol, data.Currency, startOfDayUTC(data.Timestamp), data.Price); err != nil {
+		log.Printf("Warning: failed to record price history for %s: %v", data.Symbol, err)
+	}
+}
+
+// lastClosePrice returns the close of the latest stored bar of a symbol, already in the
+// storage unit, or nil when price history is not configured or has no bar.
+func (s *marketDataService) lastClosePrice(ctx context.Context, symbol, currency string) *models.MarketData {
+	if s.priceHistoryRepo == nil {
+		return nil
+	}
+	bar, err := s.priceHistoryRepo.GetLatest(ctx, symbol, currency)
+	if err != nil || bar.Close <= 0 {
+		return nil
+	}
+	return &models.MarketData{
+		Symbol:    bar.Symbol,
+		Currency:  bar.Currency,
+		Price:     bar.Close,
+		Timestamp: bar.PriceDate,
+	}
+}
+
+// priceHistoryNeedsBackfill reports whether stored bars leave a gap at either end of the
+// range that has not been looked for recently. Bars recorded from live quotes do not
+// count as a lookup.
+func priceHistoryNeedsBackfill(stored []*models.PriceHistory, from, to time.Time) bool {
+	if len(stored) == 0 {
+		return true
+	}
+
+	startGap := stored[0].PriceDate.After(from.Add(priceHistoryLookback))
+	endGap := stored[len(stored)-1].PriceDate.Before(to.Add(-24 * time.Hour))
+	if !startGap && !endGap {
+		return false
+	}
+
+	var lastFetched time.Time
+	for _, price := range stored {
+		if price.Source == models.PriceSourceYahoo && price.UpdatedAt.After(lastFetched) {
+			lastFetched = price.UpdatedAt
+		}
+	}
+	return time.Since(lastFetched) > priceHistoryRefreshInterval
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/yahoo"
+	investmentv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPriceHistoryRepository keeps daily bars in memory.
+type stubPriceHistoryRepository struct {
+	bars map[string]*models.PriceHistory
+}
+
+func newStubPriceHistoryRepository(bars ...*models.PriceHistory) *stubPriceHistoryRepository {
+	repo := &stubPriceHistoryRepository{bars: make(map[string]*models.PriceHistory)}
+	_ = repo.Upsert(context.Background(), bars)
+	return repo
+}
+
+func priceHistoryKey(symbol, currency string, day time.Time) string {
+	return symbol + "/" + currency + "/" + day.Format("2006-01-02")
+}
+
+func (s *stubPriceHistoryRepository) ListBySymbol(ctx context.Context, symbol, currency string, from, to time.Time) ([]*models.PriceHistory, error) {
+	var result []*models.PriceHistory
+	for _, bar := range s.bars {
+		if bar.Symbol == symbol && bar.Currency == currency && !bar.PriceDate.Before(from) && !bar.PriceDate.After(to) {
+			result = append(result, bar)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].PriceDate.Before(result[j].PriceDate) })
+	return result, nil
+}
+
+func (s *stubPriceHistoryRepository) GetLatest(ctx context.Context, symbol, currency string) (*models.PriceHistory, error) {
+	bars, _ := s.ListBySymbol(ctx, symbol, currency, time.Time{}, time.Now().AddDate(1, 0, 0))
+	if len(bars) == 0 {
+		return nil, apperrors.NewNotFoundError("price history")
+	}
+	return bars[len(bars)-1], nil
+}
+
+func (s *stubPriceHistoryRepository) Upsert(ctx context.Context, bars []*models.PriceHistory) error {
+	for _, bar := range bars {
+		stored := *bar
+		stored.UpdatedAt = time.Now()
+		s.bars[priceHistoryKey(bar.Symbol, bar.Currency, bar.PriceDate)] = &stored
+	}
+	return nil
+}
+
+func (s *stubPriceHistoryRepository) RecordQuote(ctx context.Context, symbol, currency string, day time.Time, price int64) error {
+	key := priceHistoryKey(symbol, currency, day)
+	bar, ok := s.bars[key]
+	if !ok {
+		bar = &models.PriceHistory{Symbol: symbol, Currency: currency, PriceDate: day, Source: models.PriceSourceQuote}
+		s.bars[key] = bar
+	}
+	bar.AddQuote(price)
+	bar.UpdatedAt = time.Now()
+	return nil
+}
+
+// stubGoldPriceService publishes no gold history.
+type stubGoldPriceService struct {
+	GoldPriceService
+}
+
+func (s *stubGoldPriceService) FetchHistoricalPrices(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error) {
+	return nil, ErrPriceHistoryUnavailable
+}
+
+func newPriceHistoryTestService(repo repository.PriceHistoryRepository, fetch historicalPriceFetcher) *marketDataService {
+	svc := NewMarketDataService(nil, &stubGoldPriceService{}, nil).(*marketDataService)
+	svc.SetPriceHistoryRepository(repo)
+	svc.fetchHistory = fetch
+	return svc
+}
+
+func priceDay(month time.Month, day int) time.Time {
+	return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestMarketDataService_GetPriceHistory_Backfill(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubPriceHistoryRepository()
+	fetches := 0
+	fetch := func(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error) {
+		fetches++
+		assert.Equal(t, "AAPL", symbol)
+		assert.Equal(t, priceDay(time.March, 2), to, "the end day is included")
+		return &yahoo.HistoricalPrices{Symbol: symbol, Currency: "USD", Prices: []yahoo.HistoricalPrice{
+			{Date: priceDay(time.February, 28), Open: 180.5, High: 182.25, Low: 179.1, Close: 181.42, Volume: 1200},
+			{Date: priceDay(time.February, 29), Open: 181, High: 181, Low: 181, Close: 180.75},
+		}}, nil
+	}
+	svc := newPriceHistoryTestService(repo, fetch)
+
+	bars, err := svc.GetPriceHistory(ctx, "AAPL", "USD", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, priceDay(time.February, 26), priceDay(time.March, 1).Add(15*time.Hour))
+
+	require.NoError(t, err)
+	require.Len(t, bars, 2)
+	assert.Equal(t, int64(18050), bars[0].Open)
+	assert.Equal(t, int64(18225), bars[0].High)
+	assert.Equal(t, int64(17910), bars[0].Low)
+	assert.Equal(t, int64(18142), bars[0].Close)
+	assert.Equal(t, int64(1200), bars[0].Volume)
+	assert.Equal(t, models.PriceSourceYahoo, bars[1].Source)
+
+	_, err = svc.GetPriceHistory(ctx, "AAPL", "USD", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, priceDay(time.February, 26), priceDay(time.March, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches, "a recent lookup is not repeated for the weekend gap")
+}
+
+func TestMarketDataService_GetPriceHistory_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("listing quoted in another currency", func(t *testing.T) {
+		svc := newPriceHistoryTestService(newStubPriceHistoryRepository(), func(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error) {
+			return &yahoo.HistoricalPrices{Symbol: symbol, Currency: "USD", Prices: []yahoo.HistoricalPrice{{Date: priceDay(time.March, 1), Close: 10}}}, nil
+		})
+
+		_, err := svc.GetPriceHistory(ctx, "AAPL", "VND", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, priceDay(time.March, 1), priceDay(time.March, 1))
+
+		var validationErr apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("unknown symbol", func(t *testing.T) {
+		svc := newPriceHistoryTestService(newStubPriceHistoryRepository(), func(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error) {
+			return nil, yahoo.ErrSymbolNotFound
+		})
+
+		_, err := svc.GetPriceHistory(ctx, "NOPE", "USD", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, priceDay(time.March, 1), priceDay(time.March, 1))
+
+		var notFoundErr apperrors.NotFoundError
+		require.True(t, errors.As(err, &notFoundErr))
+	})
+
+	t.Run("inverted range", func(t *testing.T) {
+		svc := newPriceHistoryTestService(newStubPriceHistoryRepository(), nil)
+
+		_, err := svc.GetPriceHistory(ctx, "AAPL", "USD", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, priceDay(time.March, 2), priceDay(time.March, 1))
+
+		var validationErr apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestMarketDataService_PriceHistoryFromQuotes(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubPriceHistoryRepository()
+	svc := newPriceHistoryTestService(repo, nil)
+
+	// Live prices of VND gold are folded into daily bars, as vang247 has no history
+	for _, price := range []int64{2150000, 2160000, 2140000} {
+		svc.recordQuote(ctx, &models.MarketData{Symbol: "SJC", Currency: "VND", Price: price, Timestamp: priceDay(time.March, 1).Add(9 * time.Hour)})
+	}
+
+	bars, err := svc.GetPriceHistory(ctx, "SJC", "VND", investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_VND, priceDay(time.February, 1), priceDay(time.March, 1))
+
+	require.NoError(t, err)
+	require.Len(t, bars, 1)
+	assert.Equal(t, models.PriceSourceQuote, bars[0].Source)
+	assert.Equal(t, int64(2150000), bars[0].Open)
+	assert.Equal(t, int64(2160000), bars[0].High)
+	assert.Equal(t, int64(2140000), bars[0].Low)
+	assert.Equal(t, int64(2140000), bars[0].Close)
+}
+
+func TestPriceHistoryNeedsBackfill(t *testing.T) {
+	from, to := priceDay(time.March, 1), priceDay(time.March, 29)
+	fresh := time.Now()
+
+	assert.True(t, priceHistoryNeedsBackfill(nil, from, to))
+	assert.False(t, priceHistoryNeedsBackfill([]*models.PriceHistory{
+		{PriceDate: priceDay(time.March, 1), Source: models.PriceSourceYahoo},
+		{PriceDate: priceDay(time.March, 28), Source: models.PriceSourceYahoo},
+	}, from, to), "covered range")
+	assert.False(t, priceHistoryNeedsBackfill([]*models.PriceHistory{
+		{PriceDate: priceDay(time.March, 20), Source: models.PriceSourceYahoo, UpdatedAt: fresh},
+	}, from, to), "gap looked for recently")
+	assert.True(t, priceHistoryNeedsBackfill([]*models.PriceHistory{
+		{PriceDate: priceDay(time.March, 29), Source: models.PriceSourceQuote, UpdatedAt: fresh},
+	}, from, to), "a recorded quote is not a lookup")
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"wealthjourney/pkg/pricing"
+	"wealthjourney/pkg/yahoo"
+	investmentv1 "wealthjourney/protobuf/v1"
+)
+
+// metalPriceRequestsPerMin throttles the vang247 gold and silver lookups; the API
+// publishes few updates a minute, so faster polling only risks being blocked.
+const metalPriceRequestsPerMin = 60
+
+// Names of the built-in price providers
+const (
+	goldPriceProviderName   = "vang247-gold"
+	silverPriceProviderName = "vang247-silver"
+)
+
+// newPriceProviderRegistry builds the provider chains: gold and silver from vang247,
+// everything else from Yahoo Finance. A fixture provider, when given, is tried first
+// on every chain. When a chain fails, GetPrice serves the last cached price or, for a
+// symbol without one, the last stored daily close.
+func newPriceProviderRegistry(goldPriceService GoldPriceService, silverPriceService SilverPriceService, fixture pricing.PriceProvider) *pricing.Registry {
+	registry := pricing.NewRegistry(pricing.DefaultFailureThreshold, pricing.DefaultCooldown)
+	chain := func(names ...string) []string {
+		if fixture == nil {
+			return names
+		}
+		return append([]string{fixture.Name()}, names...)
+	}
+	if fixture != nil {
+		registry.Register(fixture, nil)
+	}
+
+	// Yahoo calls already wait on the global Yahoo throttler
+	yahooProvider := pricing.NewYahooProvider()
+	registry.Register(yahooProvider, nil)
+	registry.SetDefaultRoute(chain(yahooProvider.Name())...)
+
+	if goldPriceService != nil {
+		registry.Register(&goldPriceProvider{svc: goldPriceService}, yahoo.NewThrottler(metalPriceRequestsPerMin))
+		registry.Route(investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_VND, "", chain(goldPriceProviderName)...)
+		registry.Route(investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_USD, "", chain(goldPriceProviderName)...)
+	}
+	if silverPriceService != nil {
+		registry.Register(&silverPriceProvider{svc: silverPriceService}, yahoo.NewThrottler(metalPriceRequestsPerMin))
+		registry.Route(investmentv1.InvestmentType_INVESTMENT_TYPE_SILVER_VND, "", chain(silverPriceProviderName)...)
+		registry.Route(investmentv1.InvestmentType_INVESTMENT_TYPE_SILVER_USD, "", chain(silverPriceProviderName)...)
+	}
+
+	return registry
+}
+
+// SetPriceProviders replaces the provider chains used for live prices.
+func (s *marketDataService) SetPriceProviders(providers *pricing.Registry) {
+	s.providers = providers
+}
+
+// SetPriceFixture serves prices from fixture ahead of the live providers, so the price
+// pipeline can run offline.
+func (s *marketDataService) SetPriceFixture(fixture pricing.PriceProvider) {
+	s.providers = newPriceProviderRegistry(s.goldPriceService, s.silverPriceService, fixture)
+}
+
+// ProviderHealth reports the health of each price provider.
+func (s *marketDataService) ProviderHealth() []pricing.ProviderHealth {
+	return s.providers.Health()
+}
+
+// goldPriceProvider serves gold prices from vang247 through GoldPriceService.
+// Prices are per market unit (tael for VND gold, ounce for world gold).
+type goldPriceProvider struct {
+	svc GoldPriceService
+}
+
+func (p *goldPriceProvider) Name() string {
+	return goldPriceProviderName
+}
+
+func (p *goldPriceProvider) FetchQuote(ctx context.Context, symbol, currency string) (*pricing.Quote, error) {
+	price, err := p.svc.FetchPriceForSymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch gold price: %w", err)
+	}
+
+	return &pricing.Quote{
+		Symbol:    symbol,
+		Currency:  currency,
+		Price:     price.Buy,
+		Timestamp: price.UpdateTime, // vang247 provides change in absolute value, not percent
+	}, nil
+}
+
+// silverPriceProvider serves silver prices from vang247 through SilverPriceService.
+// Prices are per market unit of the symbol (tael, kg or ounce).
+type silverPriceProvider struct {
+	svc SilverPriceService
+}
+
+func (p *silverPriceProvider) Name() string {
+	return silverPriceProviderName
+}
+
+func (p *silverPriceProvider) FetchQuote(ctx context.Context, symbol, currency string) (*pricing.Quote, error) {
+	price, err := p.svc.FetchPriceForSymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch silver price: %w", err)
+	}
+
+	return &pricing.Quote{
+		Symbol:    symbol,
+		Currency:  currency,
+		Price:     price.Buy,
+		Timestamp: price.UpdateTime,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/pricing"
+	investmentv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubMarketDataRepository caches latest prices in memory.
+type stubMarketDataRepository struct {
+	repository.MarketDataRepository
+	prices map[string]*models.MarketData
+}
+
+func newStubMarketDataRepository(prices ...*models.MarketData) *stubMarketDataRepository {
+	repo := &stubMarketDataRepository{prices: make(map[string]*models.MarketData)}
+	for _, price := range prices {
+		repo.prices[price.Symbol+"/"+price.Currency] = price
+	}
+	return repo
+}
+
+func (s *stubMarketDataRepository) GetBySymbolAndCurrency(ctx context.Context, symbol, currency string) (*models.MarketData, error) {
+	price, ok := s.prices[symbol+"/"+currency]
+	if !ok {
+		return nil, apperrors.NewNotFoundError("market data")
+	}
+	return price, nil
+}
+
+func (s *stubMarketDataRepository) Create(ctx context.Context, data *models.MarketData) error {
+	s.prices[data.Symbol+"/"+data.Currency] = data
+	return nil
+}
+
+func (s *stubMarketDataRepository) Update(ctx context.Context, data *models.MarketData) error {
+	return s.Create(ctx, data)
+}
+
+func newPriceFixture(t *testing.T) pricing.PriceProvider {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"AAPL": {"currency": "USD", "price": 189.5},
+		"VNM.VN": {"currency": "VND", "price": 69800},
+		"SJL1L10": {"currency": "VND", "price": 85000000}
+	}`), 0o600))
+	fixture, err := pricing.NewFileProvider(path)
+	require.NoError(t, err)
+	return fixture
+}
+
+func TestMarketDataService_GetPrice_FromFixture(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubMarketDataRepository()
+	svc := NewMarketDataService(repo, &stubGoldPriceService{}, nil).(*marketDataService)
+	svc.SetPriceFixture(newPriceFixture(t))
+
+	price, err := svc.GetPrice(ctx, "AAPL", "USD", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(18950), price.Price)
+	assert.Contains(t, repo.prices, "AAPL/USD", "cached like a live price")
+
+	// The fixture serves the market unit; VND gold is stored per gram
+	price, err = svc.GetPrice(ctx, "SJL1L10", "VND", investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_VND, 0)
+	require.NoError(t, err)
+	assert.Equal(t, svc.goldConverter.ProcessMarketPrice(85000000, "VND", investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_VND), price.Price)
+	assert.Less(t, price.Price, int64(85000000))
+
+	batch, err := svc.GetPriceBatch(ctx, []string{"AAPL", "VNM.VN"})
+	require.NoError(t, err)
+	require.Len(t, batch, 2)
+	assert.Equal(t, int64(69800), batch["VNM.VN"].Price)
+
+	health := svc.ProviderHealth()
+	require.NotEmpty(t, health)
+	assert.Equal(t, "file", health[0].Name)
+}
+
+func TestMarketDataService_GetPrice_StaleWhenProvidersFail(t *testing.T) {
+	ctx := context.Background()
+	stale := &models.MarketData{ID: 3, Symbol: "MSFT", Currency: "USD", Price: 41000, Timestamp: time.Now().Add(-2 * time.Hour)}
+	svc := NewMarketDataService(newStubMarketDataRepository(stale), nil, nil).(*marketDataService)
+	registry := pricing.NewRegistry(pricing.DefaultFailureThreshold, pricing.DefaultCooldown)
+	registry.Register(newPriceFixture(t), nil)
+	registry.SetDefaultRoute("file")
+	svc.SetPriceProviders(registry)
+
+	price, err := svc.GetPrice(ctx, "MSFT", "USD", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, 15*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(41000), price.Price, "the stale price is served")
+
+	_, err = svc.GetPrice(ctx, "GOOG", "USD", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, 15*time.Minute)
+	assert.ErrorIs(t, err, pricing.ErrSymbolNotFound)
+
+	batch, err := svc.GetPriceBatch(ctx, []string{"AAPL", "GOOG"})
+	require.NoError(t, err)
+	assert.Len(t, batch, 1, "unpriced symbols are left out")
+}
+
+func TestMarketDataService_GetPrice_LastCloseWhenProvidersFail(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubMarketDataRepository()
+	svc := NewMarketDataService(repo, nil, nil).(*marketDataService)
+	registry := pricing.NewRegistry(pricing.DefaultFailureThreshold, pricing.DefaultCooldown)
+	registry.Register(newPriceFixture(t), nil)
+	registry.SetDefaultRoute("file")
+	svc.SetPriceProviders(registry)
+	svc.SetPriceHistoryRepository(newStubPriceHistoryRepository(
+		&models.PriceHistory{Symbol: "DOJINHTV", Currency: "VND", PriceDate: priceDay(5, 2), Close: 2250000},
+		&models.PriceHistory{Symbol: "DOJINHTV", Currency: "VND", PriceDate: priceDay(5, 3), Close: 2260000},
+	))
+
+	// The stored close is already per gram, so it is not converted again
+	price, err := svc.GetPrice(ctx, "DOJINHTV", "VND", investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_VND, 15*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2260000), price.Price, "the latest close is served")
+	assert.True(t, price.Timestamp.Equal(priceDay(5, 3)), "dated by its bar")
+	assert.NotContains(t, repo.prices, "DOJINHTV/VND", "not cached as a live price")
+
+	_, err = svc.GetPrice(ctx, "DOJIHN", "VND", investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_VND, 15*time.Minute)
+	assert.ErrorIs(t, err, pricing.ErrSymbolNotFound, "no stored close either")
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/fx"
+	"wealthjourney/pkg/units"
+	investmentv1 "wealthjourney/protobuf/v1"
+)
+
+// historicalRateLookback is how far back a missing daily rate may be taken from
+// (weekends and holidays have no rate of their own).
+const historicalRateLookback = 7 * 24 * time.Hour
+
+// SetExchangeRateRepository wires the daily exchange rate history used to convert
+// realized gains at the rate on the disposal date. Without it the current rate is used.
+func (s *investmentService) SetExchangeRateRepository(exchangeRateRepo repository.ExchangeRateRepository) {
+	s.exchangeRateRepo = exchangeRateRepo
+}
+
+// GetRealizedGainsReport lists every disposal matching the filters with its gain or
+// loss, converted to the user's preferred currency at the rate on the disposal date.
+func (s *investmentService) GetRealizedGainsReport(ctx context.Context, userID int32, req *investmentv1.GetRealizedGainsReportRequest) (*investmentv1.GetRealizedGainsReportResponse, error) {
+	if req.Year != 0 && (req.Year < 1970 || req.Year > 9999) {
+		return nil, apperrors.NewValidationError(fmt.Sprintf("invalid year: %d", req.Year))
+	}
+	if req.WalletId > 0 {
+		if _, err := s.walletRepo.GetByIDForUser(ctx, req.WalletId, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	preferredCurrency := user.PreferredCurrency
+	if preferredCurrency == "" {
+		preferredCurrency = "USD" // Default fallback
+	}
+
+	filter := repository.SellTransactionFilter{
+		WalletID: req.WalletId,
+		Type:     req.TypeFilter,
+	}
+	if req.Year != 0 {
+		filter.From = time.Date(int(req.Year), time.January, 1, 0, 0, 0, 0, time.UTC)
+		filter.To = filter.From.AddDate(1, 0, 0)
+	}
+
+	sells, err := s.txRepo.ListSellsForUser(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &investmentv1.RealizedGainsSummary{Currency: preferredCurrency}
+	gains := make([]*investmentv1.RealizedGain, 0, len(sells))
+	rates := make(map[string]float64)
+
+	for _, tx := range sells {
+		if tx.Investment == nil {
+			continue
+		}
+		rate, err := s.rateOnDate(ctx, rates, tx.Investment.Currency, preferredCurrency, tx.TransactionDate)
+		if err != nil {
+			return nil, apperrors.NewInternalErrorWithCause(
+				fmt.Sprintf("failed to get %s to %s rate for %s", tx.Investment.Currency, preferredCurrency, tx.TransactionDate.Format("2006-01-02")), err)
+		}
+
+		for _, gain := range realizedGainsForSell(tx) {
+			if err := s.convertRealizedGain(ctx, gain, rate, preferredCurrency); err != nil {
+				return nil, err
+			}
+			addToRealizedGainsSummary(summary, gain)
+			gains = append(gains, gain)
+		}
+	}
+
+	return &investmentv1.GetRealizedGainsReportResponse{
+		Success:   true,
+		Message:   "Realized gains report generated successfully",
+		Data:      gains,
+		Summary:   summary,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// realizedGainsForSell splits a sell into one disposal per consumed lot, in the
+// investment's currency. The sell's fees are shared out by quantity.
+//
+// Sells recorded before lot allocations were kept become a single disposal
+// against the lot they were linked to, or the investment's average cost.
+func realizedGainsForSell(tx *models.InvestmentTransaction) []*investmentv1.RealizedGain {
+	investment := tx.Investment
+	investmentType := investmentv1.InvestmentType(investment.Type)
+
+	newGain := func(lot *models.InvestmentLot, quantity, costBasis, proceeds int64) *investmentv1.RealizedGain {
+		gain := &investmentv1.RealizedGain{
+			TransactionId:    tx.ID,
+			InvestmentId:     investment.ID,
+			WalletId:         tx.WalletID,
+			Symbol:           investment.Symbol,
+			Name:             investment.Name,
+			Type:             investmentType,
+			DisposalDate:     tx.TransactionDate.Unix(),
+			Quantity:         quantity,
+			CostBasis:        costBasis,
+			Proceeds:         proceeds,
+			Currency:         investment.Currency,
+			OriginalCurrency: investment.Currency,
+			ExchangeRate:     1,
+		}
+		if lot != nil {
+			gain.LotId = lot.ID
+			gain.AcquisitionDate = lot.PurchasedAt.Unix()
+			gain.HoldingDays, gain.HoldingPeriod = holdingPeriod(lot.PurchasedAt, tx.TransactionDate)
+		}
+		return gain
+	}
+
+	var gains []*investmentv1.RealizedGain
+	if len(tx.Allocations) > 0 {
+		gains = make([]*investmentv1.RealizedGain, 0, len(tx.Allocations))
+		for _, allocation := range tx.Allocations {
+			gains = append(gains, newGain(allocation.Lot, allocation.Quantity, allocation.CostBasis, allocation.CostBasis+allocation.RealizedPNL))
+		}
+	} else {
+		unitCost := investment.AverageCost
+		if tx.Lot != nil {
+			unitCost = tx.Lot.AverageCost
+		}
+		precision := units.GetPrecisionForInvestmentType(investmentType)
+		costBasis := int64(float64(unitCost) * float64(tx.Quantity) / float64(precision))
+		gains = []*investmentv1.RealizedGain{newGain(tx.Lot, tx.Quantity, costBasis, tx.Cost)}
+	}
+
+	// Share fees by quantity; the last disposal takes the rounding remainder
+	remainingFees := tx.Fees
+	for i, gain := range gains {
+		if i == len(gains)-1 || tx.Quantity == 0 {
+			gain.Fees = remainingFees
+		} else {
+			gain.Fees = tx.Fees * gain.Quantity / tx.Quantity
+		}
+		remainingFees -= gain.Fees
+		gain.GainLoss = gain.Proceeds - gain.CostBasis - gain.Fees
+	}
+	return gains
+}
+
+// holdingPeriod returns the whole days between acquisition and disposal and whether
+// the disposal is long term (held for more than one year).
+func holdingPeriod(acquired, disposed time.Time) (int32, investmentv1.HoldingPeriod) {
+	if acquired.IsZero() {
+		return 0, investmentv1.HoldingPeriod_HOLDING_PERIOD_UNSPECIFIED
+	}
+	days := int32(disposed.Sub(acquired).Hours() / 24)
+	if disposed.After(acquired.AddDate(1, 0, 0)) {
+		return days, investmentv1.HoldingPeriod_HOLDING_PERIOD_LONG_TERM
+	}
+	return days, investmentv1.HoldingPeriod_HOLDING_PERIOD_SHORT_TERM
+}
+
+// convertRealizedGain converts a disposal's amounts to currency at rate.
+func (s *investmentService) convertRealizedGain(ctx context.Context, gain *investmentv1.RealizedGain, rate float64, currency string) error {
+	if gain.OriginalCurrency == currency {
+		return nil
+	}
+
+	for _, amount := range []*int64{&gain.CostBasis, &gain.Proceeds, &gain.Fees} {
+		converted, err := s.fxRateSvc.ConvertAmountWithRate(ctx, *amount, rate, gain.OriginalCurrency, currency)
+		if err != nil {
+			return apperrors.NewInternalErrorWithCause(fmt.Sprintf("failed to convert %s to %s", gain.OriginalCurrency, currency), err)
+		}
+		*amount = converted
+	}
+	// Recompute from the converted parts so each row still adds up
+	gain.GainLoss = gain.Proceeds - gain.CostBasis - gain.Fees
+	gain.Currency = currency
+	gain.ExchangeRate = rate
+	return nil
+}
+
+// rateOnDate returns the from->to rate on date, taking the closest earlier daily rate
+// within historicalRateLookback. Falls back to the current rate when there is no history.
+// Rates are memoized in rates for the duration of one report.
+func (s *investmentService) rateOnDate(ctx context.Context, rates map[string]float64, from, to string, date time.Time) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	key := fmt.Sprintf("%s:%s:%s", from, to, day.Format("2006-01-02"))
+	if rate, ok := rates[key]; ok {
+		return rate, nil
+	}
+
+	var rate float64
+	if s.exchangeRateRepo != nil {
+		// ListRates returns the newest rate first
+		history, err := s.exchangeRateRepo.ListRates(ctx, from, to, day.Add(-historicalRateLookback), day)
+		if err == nil && len(history) > 0 {
+			rate = history[0].Rate
+		}
+	}
+
+	if rate == 0 {
+		current, err := s.fxRateSvc.GetRate(ctx, from, to)
+		if err != nil {
+			return 0, err
+		}
+		log.Printf("Warning: no %s->%s rate on %s, using the current rate", from, to, day.Format("2006-01-02"))
+		rate = current
+	}
+
+	rates[key] = rate
+	return rate, nil
+}
+
+// addToRealizedGainsSummary adds a converted disposal to the report totals.
+func addToRealizedGainsSummary(summary *investmentv1.RealizedGainsSummary, gain *investmentv1.RealizedGain) {
+	summary.TotalProceeds += gain.Proceeds
+	summary.TotalCostBasis += gain.CostBasis
+	summary.TotalFees += gain.Fees
+	summary.TotalGainLoss += gain.GainLoss
+
+	switch gain.HoldingPeriod {
+	case investmentv1.HoldingPeriod_HOLDING_PERIOD_LONG_TERM:
+		summary.LongTermGainLoss += gain.GainLoss
+	default:
+		summary.ShortTermGainLoss += gain.GainLoss
+	}
+}
+
+// realizedGainsCSVHeader is the column layout of the realized gains CSV export.
+var realizedGainsCSVHeader = []string{
+	"Symbol", "Name", "Lot ID", "Acquisition Date", "Disposal Date", "Quantity",
+	"Holding Days", "Holding Period", "Currency", "Cost Basis", "Proceeds", "Fees",
+	"Gain/Loss", "Original Currency", "Exchange Rate",
+}
+
+// WriteRealizedGainsCSV writes a realized gains report as CSV, one row per disposal,
+// with amounts in major currency units (e.g. dollars, not cents).
+func WriteRealizedGainsCSV(w io.Writer, gains []*investmentv1.RealizedGain) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(realizedGainsCSVHeader); err != nil {
+		return err
+	}
+
+	for _, gain := range gains {
+		acquisitionDate := ""
+		if gain.AcquisitionDate != 0 {
+			acquisitionDate = time.Unix(gain.AcquisitionDate, 0).UTC().Format("2006-01-02")
+		}
+		holdingPeriod := ""
+		switch gain.HoldingPeriod {
+		case investmentv1.HoldingPeriod_HOLDING_PERIOD_SHORT_TERM:
+			holdingPeriod = "Short term"
+		case investmentv1.HoldingPeriod_HOLDING_PERIOD_LONG_TERM:
+			holdingPeriod = "Long term"
+		}
+		precision := int64(units.GetPrecisionForInvestmentType(gain.Type))
+
+		record := []string{
+			gain.Symbol,
+			gain.Name,
+			strconv.Itoa(int(gain.LotId)),
+			acquisitionDate,
+			time.Unix(gain.DisposalDate, 0).UTC().Format("2006-01-02"),
+			decimal.NewFromInt(gain.Quantity).Div(decimal.NewFromInt(precision)).String(),
+			strconv.Itoa(int(gain.HoldingDays)),
+			holdingPeriod,
+			gain.Currency,
+			formatMinorUnits(gain.CostBasis, gain.Currency),
+			formatMinorUnits(gain.Proceeds, gain.Currency),
+			formatMinorUnits(gain.Fees, gain.Currency),
+			formatMinorUnits(gain.GainLoss, gain.Currency),
+			gain.OriginalCurrency,
+			strconv.FormatFloat(gain.ExchangeRate, 'f', -1, 64),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// formatMinorUnits formats an amount in the currency's smallest unit as a decimal string.
+func formatMinorUnits(amount int64, currency string) string {
+	places := int32(fx.GetDecimalPlaces(currency))
+	return decimal.New(amount, -places).StringFixed(places)
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	investmentv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubExchangeRateRepository serves a fixed rate history, newest first.
+type stubExchangeRateRepository struct {
+	repository.ExchangeRateRepository
+	rates []*models.ExchangeRate
+}
+
+func (s *stubExchangeRateRepository) ListRates(ctx context.Context, fromCurrency, toCurrency string, startDate, endDate time.Time) ([]*models.ExchangeRate, error) {
+	var result []*models.ExchangeRate
+	for _, rate := range s.rates {
+		if rate.FromCurrency == fromCurrency && rate.ToCurrency == toCurrency &&
+			!rate.RateDate.Before(startDate) && !rate.RateDate.After(endDate) {
+			result = append(result, rate)
+		}
+	}
+	return result, nil
+}
+
+func testSell(lots ...*models.InvestmentLot) *models.InvestmentTransaction {
+	tx := &models.InvestmentTransaction{
+		ID:              42,
+		InvestmentID:    7,
+		WalletID:        3,
+		Type:            int32(investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL),
+		Quantity:        1500000, // 150 shares
+		Price:           17000,
+		Cost:            2550000,
+		Fees:            1000,
+		TransactionDate: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		Investment: &models.Investment{
+			ID:          7,
+			Symbol:      "AAPL",
+			Name:        "Apple Inc.",
+			Type:        int32(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK),
+			Currency:    "USD",
+			AverageCost: 15333,
+		},
+	}
+	for _, lot := range lots {
+		tx.Allocations = append(tx.Allocations, models.InvestmentSellAllocation{LotID: lot.ID, Lot: lot})
+	}
+	return tx
+}
+
+func TestRealizedGainsForSell_SplitsByLot(t *testing.T) {
+	longLot := &models.InvestmentLot{ID: 1, AverageCost: 15000, PurchasedAt: time.Date(2023, time.January, 10, 0, 0, 0, 0, time.UTC)}
+	shortLot := &models.InvestmentLot{ID: 2, AverageCost: 16000, PurchasedAt: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)}
+	tx := testSell(longLot, shortLot)
+	tx.Allocations[0].Quantity, tx.Allocations[0].CostBasis, tx.Allocations[0].RealizedPNL = 1000000, 1500000, 200000
+	tx.Allocations[1].Quantity, tx.Allocations[1].CostBasis, tx.Allocations[1].RealizedPNL = 500000, 800000, 50000
+
+	gains := realizedGainsForSell(tx)
+
+	require.Len(t, gains, 2)
+
+	assert.Equal(t, int32(1), gains[0].LotId)
+	assert.Equal(t, longLot.PurchasedAt.Unix(), gains[0].AcquisitionDate)
+	assert.Equal(t, int64(1500000), gains[0].CostBasis)
+	assert.Equal(t, int64(1700000), gains[0].Proceeds)
+	assert.Equal(t, int64(666), gains[0].Fees) // 2/3 of the fees, rounded down
+	assert.Equal(t, int64(1700000-1500000-666), gains[0].GainLoss)
+	assert.Equal(t, investmentv1.HoldingPeriod_HOLDING_PERIOD_LONG_TERM, gains[0].HoldingPeriod)
+
+	assert.Equal(t, int32(2), gains[1].LotId)
+	assert.Equal(t, int64(334), gains[1].Fees) // Remainder
+	assert.Equal(t, int64(850000-800000-334), gains[1].GainLoss)
+	assert.Equal(t, investmentv1.HoldingPeriod_HOLDING_PERIOD_SHORT_TERM, gains[1].HoldingPeriod)
+	assert.Equal(t, int32(92), gains[1].HoldingDays)
+}
+
+func TestRealizedGainsForSell_LegacySell(t *testing.T) {
+	tx := testSell()
+
+	gains := realizedGainsForSell(tx)
+
+	require.Len(t, gains, 1)
+	assert.Equal(t, int32(0), gains[0].LotId)
+	assert.Equal(t, int64(0), gains[0].AcquisitionDate)
+	assert.Equal(t, investmentv1.HoldingPeriod_HOLDING_PERIOD_UNSPECIFIED, gains[0].HoldingPeriod)
+	assert.Equal(t, int64(2299950), gains[0].CostBasis) // Investment average cost × 150
+	assert.Equal(t, int64(2550000), gains[0].Proceeds)
+	assert.Equal(t, int64(1000), gains[0].Fees)
+	assert.Equal(t, int64(2550000-2299950-1000), gains[0].GainLoss)
+}
+
+func TestHoldingPeriod(t *testing.T) {
+	acquired := time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC)
+
+	days, period := holdingPeriod(acquired, time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, int32(366), days)
+	assert.Equal(t, investmentv1.HoldingPeriod_HOLDING_PERIOD_SHORT_TERM, period, "exactly one year is still short term")
+
+	_, period = holdingPeriod(acquired, time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, investmentv1.HoldingPeriod_HOLDING_PERIOD_LONG_TERM, period)
+
+	_, period = holdingPeriod(time.Time{}, acquired)
+	assert.Equal(t, investmentv1.HoldingPeriod_HOLDING_PERIOD_UNSPECIFIED, period)
+}
+
+func TestInvestmentService_GetRealizedGainsReport(t *testing.T) {
+	ctx := context.Background()
+	mockTxRepo := new(MockInvestmentTransactionRepository)
+	mockUserRepo := new(MockUserRepository)
+	mockFXRate := new(MockFXRateService)
+
+	svc := NewInvestmentService(nil, new(MockWalletRepository), mockTxRepo, nil, mockUserRepo, mockFXRate, nil, nil)
+	svc.(*investmentService).SetExchangeRateRepository(&stubExchangeRateRepository{
+		rates: []*models.ExchangeRate{
+			// Friday rate covers a Saturday sell
+			{FromCurrency: "USD", ToCurrency: "VND", Rate: 25000, RateDate: time.Date(2024, time.May, 31, 0, 0, 0, 0, time.UTC)},
+		},
+	})
+
+	lot := &models.InvestmentLot{ID: 1, AverageCost: 15000, PurchasedAt: time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)}
+	tx := testSell(lot)
+	tx.Quantity = 1000000
+	tx.Allocations[0].Quantity, tx.Allocations[0].CostBasis, tx.Allocations[0].RealizedPNL = 1000000, 1500000, 200000
+
+	mockUserRepo.On("GetByID", ctx, int32(1)).Return(&models.User{ID: 1, PreferredCurrency: "VND"}, nil)
+	mockTxRepo.On("ListSellsForUser", ctx, int32(1), repository.SellTransactionFilter{
+		From: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Type: investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK,
+	}).Return([]*models.InvestmentTransaction{tx}, nil)
+	mockFXRate.On("ConvertAmountWithRate", ctx, int64(1500000), 25000.0, "USD", "VND").Return(int64(375000000), nil)
+	mockFXRate.On("ConvertAmountWithRate", ctx, int64(1700000), 25000.0, "USD", "VND").Return(int64(425000000), nil)
+	mockFXRate.On("ConvertAmountWithRate", ctx, int64(1000), 25000.0, "USD", "VND").Return(int64(250000), nil)
+
+	resp, err := svc.GetRealizedGainsReport(ctx, 1, &investmentv1.GetRealizedGainsReportRequest{
+		Year:       2024,
+		TypeFilter: investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	gain := resp.Data[0]
+	assert.Equal(t, "VND", gain.Currency)
+	assert.Equal(t, "USD", gain.OriginalCurrency)
+	assert.Equal(t, 25000.0, gain.ExchangeRate)
+	assert.Equal(t, int64(425000000-375000000-250000), gain.GainLoss)
+	assert.Equal(t, gain.GainLoss, resp.Summary.TotalGainLoss)
+	assert.Equal(t, gain.GainLoss, resp.Summary.LongTermGainLoss)
+	assert.Equal(t, int64(0), resp.Summary.ShortTermGainLoss)
+	assert.Equal(t, "VND", resp.Summary.Currency)
+	mockFXRate.AssertNotCalled(t, "GetRate", ctx, "USD", "VND")
+}
+
+func TestInvestmentService_GetRealizedGainsReport_InvalidYear(t *testing.T) {
+	svc := NewInvestmentService(nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := svc.GetRealizedGainsReport(context.Background(), 1, &investmentv1.GetRealizedGainsReportRequest{Year: 202})
+
+	var validationErr apperrors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func TestWriteRealizedGainsCSV(t *testing.T) {
+	gains := []*investmentv1.RealizedGain{
+		{
+			Symbol:           "AAPL",
+			Name:             "Apple Inc.",
+			Type:             investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK,
+			LotId:            1,
+			AcquisitionDate:  time.Date(2023, time.January, 10, 0, 0, 0, 0, time.UTC).Unix(),
+			DisposalDate:     time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			Quantity:         1000000,
+			HoldingDays:      508,
+			HoldingPeriod:    investmentv1.HoldingPeriod_HOLDING_PERIOD_LONG_TERM,
+			CostBasis:        1500000,
+			Proceeds:         1700000,
+			Fees:             666,
+			GainLoss:         199334,
+			Currency:         "USD",
+			OriginalCurrency: "USD",
+			ExchangeRate:     1,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRealizedGainsCSV(&buf, gains))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, realizedGainsCSVHeader, records[0])
+	assert.Equal(t, []string{
+		"AAPL", "Apple Inc.", "1", "2023-01-10", "2024-06-01", "100", "508", "Long term",
+		"USD", "15000.00", "17000.00", "6.66", "1993.34", "USD", "1",
+	}, records[1])
+}
@@ -0,0 +1,468 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/units"
+	investmentv1 "wealthjourney/protobuf/v1"
+	walletv1 "wealthjourney/protobuf/v1"
+)
+
+// allocationPercentTolerance is how far target weights may add up from 100%.
+const allocationPercentTolerance = 0.01
+
+// rebalanceMaxInvestments bounds the holdings a rebalancing plan is computed over, for
+// one wallet or all of them alike.
+const rebalanceMaxInvestments = 10000
+
+// allocationKey identifies the group a holding belongs to; only the field of the
+// dimension in use is set.
+type allocationKey struct {
+	investmentType investmentv1.InvestmentType
+	symbol         string
+	assetClass     string
+}
+
+// rebalanceHolding is an investment valued in the user's preferred currency.
+type rebalanceHolding struct {
+	investment *models.Investment
+	value      int64
+}
+
+// plannedTrade is a buy (positive amount) or sell (negative amount) in the user's preferred currency.
+type plannedTrade struct {
+	holding *rebalanceHolding
+	amount  int64
+}
+
+// SetAllocationTargetRepository wires the allocation target store used by rebalancing.
+func (s *investmentService) SetAllocationTargetRepository(allocationTargetRepo repository.AllocationTargetRepository) {
+	s.allocationTargetRepo = allocationTargetRepo
+}
+
+// SetAllocationTargets replaces the allocation targets of a wallet (0 = all investment wallets).
+func (s *investmentService) SetAllocationTargets(ctx context.Context, userID int32, req *investmentv1.SetAllocationTargetsRequest) (*investmentv1.SetAllocationTargetsResponse, error) {
+	if err := s.checkAllocationWallet(ctx, userID, req.WalletId); err != nil {
+		return nil, err
+	}
+
+	targets, err := allocationTargetsFromProto(req.Dimension, req.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.allocationTargetRepo.ReplaceForWallet(ctx, userID, req.WalletId, targets); err != nil {
+		return nil, err
+	}
+
+	return &investmentv1.SetAllocationTargetsResponse{
+		Success:   true,
+		Message:   "Allocation targets saved successfully",
+		Data:      allocationTargetsToProto(targets),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListAllocationTargets returns the allocation targets of a wallet (0 = all investment wallets).
+func (s *investmentService) ListAllocationTargets(ctx context.Context, userID int32, req *investmentv1.ListAllocationTargetsRequest) (*investmentv1.ListAllocationTargetsResponse, error) {
+	if err := s.checkAllocationWallet(ctx, userID, req.WalletId); err != nil {
+		return nil, err
+	}
+
+	targets, err := s.allocationTargetRepo.ListByWallet(ctx, userID, req.WalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &investmentv1.ListAllocationTargetsResponse{
+		Success:   true,
+		Message:   "Allocation targets retrieved successfully",
+		Data:      allocationTargetsToProto(targets),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// GetRebalancingPlan compares current holdings with the allocation targets and suggests
+// the trades that bring the wallet back on target.
+func (s *investmentService) GetRebalancingPlan(ctx context.Context, userID int32, req *investmentv1.GetRebalancingPlanRequest) (*investmentv1.GetRebalancingPlanResponse, error) {
+	if req.NewCash < 0 {
+		return nil, apperrors.NewValidationError("newCash cannot be negative")
+	}
+	if req.MinTradeAmount < 0 {
+		return nil, apperrors.NewValidationError("minTradeAmount cannot be negative")
+	}
+	if err := s.checkAllocationWallet(ctx, userID, req.WalletId); err != nil {
+		return nil, err
+	}
+
+	targets, err := s.allocationTargetRepo.ListByWallet(ctx, userID, req.WalletId)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, apperrors.NewNotFoundErrorWithMessage("no allocation targets are set for this wallet")
+	}
+	dimension := investmentv1.AllocationDimension(targets[0].Dimension)
+
+	// The summary refreshes stale prices and fixes the currency the plan is in
+	summary, err := s.GetAggregatedPortfolioSummary(ctx, userID, &investmentv1.GetAggregatedPortfolioSummaryRequest{WalletId: req.WalletId})
+	if err != nil {
+		return nil, err
+	}
+	currency := summary.Data.Currency
+
+	var investments []*models.Investment
+	if req.WalletId > 0 {
+		investments, _, err = s.investmentRepo.ListByWalletID(ctx, req.WalletId, repository.ListOptions{Limit: rebalanceMaxInvestments}, investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED)
+	} else {
+		investments, _, err = s.investmentRepo.ListByUserID(ctx, userID, repository.ListOptions{Limit: rebalanceMaxInvestments}, investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	holdings := make([]*rebalanceHolding, 0, len(investments))
+	for _, inv := range investments {
+		if inv.Quantity <= 0 {
+			continue
+		}
+		value := inv.CurrentValue
+		if inv.Currency != "" && inv.Currency != currency {
+			value, err = s.fxRateSvc.ConvertAmount(ctx, inv.CurrentValue, inv.Currency, currency)
+			if err != nil {
+				log.Printf("Warning: FX conversion failed for investment %d: %v, using original value", inv.ID, err)
+				value = inv.CurrentValue
+			}
+		}
+		holdings = append(holdings, &rebalanceHolding{investment: inv, value: value})
+	}
+
+	drift, planned := planRebalance(dimension, targets, holdings, req.NewCash, req.MinTradeAmount)
+
+	plan := &investmentv1.RebalancingPlan{
+		Dimension:     dimension,
+		NewCash:       req.NewCash,
+		RemainingCash: req.NewCash,
+		Currency:      currency,
+		Drift:         drift,
+	}
+	for _, row := range drift {
+		plan.TotalValue += row.CurrentValue
+	}
+	plan.TotalValue += req.NewCash
+
+	for _, p := range planned {
+		trade, err := s.rebalanceTrade(ctx, p, currency)
+		if err != nil {
+			return nil, err
+		}
+		if trade == nil {
+			continue
+		}
+		if trade.Action == investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY {
+			plan.RemainingCash -= trade.DisplayAmount.Amount
+		} else {
+			plan.RemainingCash += trade.DisplayAmount.Amount
+		}
+		plan.Trades = append(plan.Trades, trade)
+	}
+
+	return &investmentv1.GetRebalancingPlanResponse{
+		Success:   true,
+		Message:   "Rebalancing plan calculated successfully",
+		Data:      plan,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// checkAllocationWallet verifies that a wallet (0 = all investment wallets) is an
+// investment wallet of the user.
+func (s *investmentService) checkAllocationWallet(ctx context.Context, userID, walletID int32) error {
+	if walletID < 0 {
+		return apperrors.NewValidationError("walletId cannot be negative")
+	}
+	if walletID == 0 {
+		return nil
+	}
+
+	wallet, err := s.walletRepo.GetByIDForUser(ctx, walletID, userID)
+	if err != nil {
+		return err
+	}
+	if walletv1.WalletType(wallet.Type) != walletv1.WalletType_INVESTMENT {
+		return apperrors.NewValidationError("allocation targets can only be set on investment wallets")
+	}
+	return nil
+}
+
+// rebalanceTrade turns a planned amount into a whole-step quantity at the current price.
+// Returns nil when the amount is too small for a single step.
+func (s *investmentService) rebalanceTrade(ctx context.Context, p plannedTrade, currency string) (*investmentv1.RebalanceTrade, error) {
+	inv := p.holding.investment
+	investmentType := investmentv1.InvestmentType(inv.Type)
+
+	action := investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_BUY
+	amount := p.amount
+	if amount < 0 {
+		action = investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL
+		amount = -amount
+	}
+
+	invCurrency := inv.Currency
+	if invCurrency == "" {
+		invCurrency = currency
+	}
+	if invCurrency != currency {
+		converted, err := s.fxRateSvc.ConvertAmount(ctx, amount, currency, invCurrency)
+		if err != nil {
+			return nil, apperrors.NewInternalErrorWithCause(fmt.Sprintf("failed to convert %s to %s", currency, invCurrency), err)
+		}
+		amount = converted
+	}
+
+	step := rebalanceQuantityStep(investmentType)
+	precision := float64(units.GetPrecisionForInvestmentType(investmentType))
+	quantity := int64(float64(amount) / float64(inv.CurrentPrice) * precision)
+	quantity -= quantity % step
+	if action == investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL && quantity > inv.Quantity {
+		quantity = inv.Quantity
+	}
+	if quantity <= 0 {
+		return nil, nil
+	}
+
+	tradeAmount := units.CalculateTransactionCost(quantity, inv.CurrentPrice, investmentType)
+	displayAmount := tradeAmount
+	if invCurrency != currency {
+		converted, err := s.fxRateSvc.ConvertAmount(ctx, tradeAmount, invCurrency, currency)
+		if err != nil {
+			return nil, apperrors.NewInternalErrorWithCause(fmt.Sprintf("failed to convert %s to %s", invCurrency, currency), err)
+		}
+		displayAmount = converted
+	}
+
+	return &investmentv1.RebalanceTrade{
+		InvestmentId:  inv.ID,
+		WalletId:      inv.WalletID,
+		Symbol:        inv.Symbol,
+		Name:          inv.Name,
+		Action:        action,
+		Quantity:      quantity,
+		Price:         inv.CurrentPrice,
+		Amount:        tradeAmount,
+		Currency:      invCurrency,
+		DisplayAmount: &investmentv1.Money{Amount: displayAmount, Currency: currency},
+	}, nil
+}
+
+// rebalanceQuantityStep is the smallest quantity suggested for a trade, in storage units.
+// Shares and ETF units are traded whole; other types may be fractional.
+func rebalanceQuantityStep(investmentType investmentv1.InvestmentType) int64 {
+	switch investmentType {
+	case investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, investmentv1.InvestmentType_INVESTMENT_TYPE_ETF:
+		return int64(units.GetPrecisionForInvestmentType(investmentType))
+	default:
+		return 1
+	}
+}
+
+// planRebalance groups holdings by the targets' dimension and returns the drift of every
+// group and the trades that close it. Within a group, buys and sells are split across
+// its holdings in proportion to their value. Groups without holdings cannot be bought
+// since there is no price to buy at; holdings outside every target are sold.
+func planRebalance(dimension investmentv1.AllocationDimension, targets []*models.AllocationTarget, holdings []*rebalanceHolding, newCash, minTradeAmount int64) ([]*investmentv1.AllocationDrift, []plannedTrade) {
+	type group struct {
+		key      allocationKey
+		target   float64
+		holdings []*rebalanceHolding
+		value    int64
+	}
+
+	var groups []*group
+	byKey := make(map[allocationKey]*group)
+	for _, target := range targets {
+		key := allocationKey{
+			investmentType: investmentv1.InvestmentType(target.InvestmentType),
+			symbol:         target.Symbol,
+			assetClass:     target.AssetClass,
+		}
+		g := &group{key: key, target: target.TargetPercent}
+		groups = append(groups, g)
+		byKey[key] = g
+	}
+
+	total := newCash
+	for _, h := range holdings {
+		key := allocationKeyFor(dimension, h.investment)
+		g, ok := byKey[key]
+		if !ok {
+			g = &group{key: key}
+			groups = append(groups, g)
+			byKey[key] = g
+		}
+		g.holdings = append(g.holdings, h)
+		g.value += h.value
+		total += h.value
+	}
+
+	drift := make([]*investmentv1.AllocationDrift, 0, len(groups))
+	var buys, sells []plannedTrade
+	for _, g := range groups {
+		targetValue := int64(math.Round(float64(total) * g.target / 100))
+		row := &investmentv1.AllocationDrift{
+			Dimension:      dimension,
+			InvestmentType: g.key.investmentType,
+			Symbol:         g.key.symbol,
+			AssetClass:     g.key.assetClass,
+			TargetPercent:  g.target,
+			CurrentValue:   g.value,
+			TargetValue:    targetValue,
+			DriftValue:     g.value - targetValue,
+		}
+		if total > 0 {
+			row.CurrentPercent = float64(g.value) / float64(total) * 100
+		}
+		row.DriftPercent = row.CurrentPercent - row.TargetPercent
+		drift = append(drift, row)
+
+		gap := targetValue - g.value
+		for _, trade := range splitAcrossHoldings(g.holdings, g.value, gap) {
+			if abs64(trade.amount) < minTradeAmount || trade.amount == 0 {
+				continue
+			}
+			if trade.amount > 0 {
+				buys = append(buys, trade)
+			} else {
+				sells = append(sells, trade)
+			}
+		}
+	}
+
+	// Sells first: they fund the buys
+	sort.SliceStable(sells, func(i, j int) bool { return sells[i].amount < sells[j].amount })
+	sort.SliceStable(buys, func(i, j int) bool { return buys[i].amount > buys[j].amount })
+	return drift, append(sells, buys...)
+}
+
+// splitAcrossHoldings spreads amount over priced holdings in proportion to their value
+// (equally when they are all worth nothing); the rounding remainder goes to the last one.
+func splitAcrossHoldings(holdings []*rebalanceHolding, groupValue, amount int64) []plannedTrade {
+	var priced []*rebalanceHolding
+	for _, h := range holdings {
+		if h.investment.CurrentPrice > 0 {
+			priced = append(priced, h)
+		}
+	}
+	if len(priced) == 0 || amount == 0 {
+		return nil
+	}
+
+	trades := make([]plannedTrade, len(priced))
+	remaining := amount
+	for i, h := range priced {
+		share := amount / int64(len(priced))
+		if groupValue > 0 {
+			share = int64(float64(amount) * float64(h.value) / float64(groupValue))
+		}
+		if i == len(priced)-1 {
+			share = remaining
+		}
+		remaining -= share
+		trades[i] = plannedTrade{holding: h, amount: share}
+	}
+	return trades
+}
+
+// allocationKeyFor returns the group of an investment for a dimension.
+func allocationKeyFor(dimension investmentv1.AllocationDimension, inv *models.Investment) allocationKey {
+	switch dimension {
+	case investmentv1.AllocationDimension_ALLOCATION_DIMENSION_TYPE:
+		return allocationKey{investmentType: investmentv1.InvestmentType(inv.Type)}
+	case investmentv1.AllocationDimension_ALLOCATION_DIMENSION_SYMBOL:
+		return allocationKey{symbol: strings.ToUpper(inv.Symbol)}
+	default:
+		return allocationKey{assetClass: inv.AssetClass}
+	}
+}
+
+// allocationTargetsFromProto validates a target set and converts it to models.
+func allocationTargetsFromProto(dimension investmentv1.AllocationDimension, targets []*investmentv1.AllocationTarget) ([]*models.AllocationTarget, error) {
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	if _, ok := investmentv1.AllocationDimension_name[int32(dimension)]; !ok || dimension == investmentv1.AllocationDimension_ALLOCATION_DIMENSION_UNSPECIFIED {
+		return nil, apperrors.NewValidationError("a valid allocation dimension is required")
+	}
+
+	result := make([]*models.AllocationTarget, 0, len(targets))
+	seen := make(map[allocationKey]bool)
+	var sum float64
+	for _, t := range targets {
+		if t.TargetPercent <= 0 || t.TargetPercent > 100 {
+			return nil, apperrors.NewValidationError("targetPercent must be greater than 0 and at most 100")
+		}
+
+		target := &models.AllocationTarget{Dimension: int32(dimension), TargetPercent: t.TargetPercent}
+		var key allocationKey
+		switch dimension {
+		case investmentv1.AllocationDimension_ALLOCATION_DIMENSION_TYPE:
+			if _, ok := investmentv1.InvestmentType_name[int32(t.InvestmentType)]; !ok || t.InvestmentType == investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED {
+				return nil, apperrors.NewValidationError("investmentType is required for type targets")
+			}
+			target.InvestmentType = int32(t.InvestmentType)
+			key.investmentType = t.InvestmentType
+		case investmentv1.AllocationDimension_ALLOCATION_DIMENSION_SYMBOL:
+			symbol := strings.ToUpper(strings.TrimSpace(t.Symbol))
+			if symbol == "" || len(symbol) > 20 {
+				return nil, apperrors.NewValidationError("symbol is required for symbol targets and must be at most 20 characters")
+			}
+			target.Symbol = symbol
+			key.symbol = symbol
+		case investmentv1.AllocationDimension_ALLOCATION_DIMENSION_ASSET_CLASS:
+			assetClass := strings.TrimSpace(t.AssetClass)
+			if assetClass == "" || len(assetClass) > 50 {
+				return nil, apperrors.NewValidationError("assetClass is required for asset class targets and must be at most 50 characters")
+			}
+			target.AssetClass = assetClass
+			key.assetClass = assetClass
+		}
+
+		if seen[key] {
+			return nil, apperrors.NewValidationError("each group can only have one target")
+		}
+		seen[key] = true
+		sum += t.TargetPercent
+		result = append(result, target)
+	}
+
+	if math.Abs(sum-100) > allocationPercentTolerance {
+		return nil, apperrors.NewValidationError(fmt.Sprintf("target weights must add up to 100%%, got %.2f%%", sum))
+	}
+	return result, nil
+}
+
+// allocationTargetsToProto converts allocation target models to protobuf messages.
+func allocationTargetsToProto(targets []*models.AllocationTarget) []*investmentv1.AllocationTarget {
+	result := make([]*investmentv1.AllocationTarget, len(targets))
+	for i, target := range targets {
+		result[i] = target.ToProto()
+	}
+	return result
+}
+
+// abs64 returns the absolute value of n.
+func abs64(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	investmentv1 "wealthjourney/protobuf/v1"
+	walletv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAllocationTargetRepository keeps one target set per wallet in memory.
+type stubAllocationTargetRepository struct {
+	targets map[int32][]*models.AllocationTarget
+}
+
+func (s *stubAllocationTargetRepository) ListByWallet(ctx context.Context, userID, walletID int32) ([]*models.AllocationTarget, error) {
+	return s.targets[walletID], nil
+}
+
+func (s *stubAllocationTargetRepository) ReplaceForWallet(ctx context.Context, userID, walletID int32, targets []*models.AllocationTarget) error {
+	for _, target := range targets {
+		target.UserID, target.WalletID = userID, walletID
+	}
+	s.targets[walletID] = targets
+	return nil
+}
+
+func typeTarget(investmentType investmentv1.InvestmentType, percent float64) *models.AllocationTarget {
+	return &models.AllocationTarget{
+		Dimension:      int32(investmentv1.AllocationDimension_ALLOCATION_DIMENSION_TYPE),
+		InvestmentType: int32(investmentType),
+		TargetPercent:  percent,
+	}
+}
+
+func rebalanceTestInvestment(id int32, symbol string, investmentType investmentv1.InvestmentType, quantity, price int64) *models.Investment {
+	inv := &models.Investment{
+		ID:           id,
+		WalletID:     1,
+		Symbol:       symbol,
+		Type:         int32(investmentType),
+		Quantity:     quantity,
+		CurrentPrice: price,
+		Currency:     "USD",
+		UpdatedAt:    time.Now(),
+	}
+	inv.Recalculate()
+	return inv
+}
+
+func TestAllocationTargetsFromProto(t *testing.T) {
+	symbolDimension := investmentv1.AllocationDimension_ALLOCATION_DIMENSION_SYMBOL
+
+	targets, err := allocationTargetsFromProto(symbolDimension, []*investmentv1.AllocationTarget{
+		{Symbol: " vnm ", TargetPercent: 60},
+		{Symbol: "FPT", TargetPercent: 40},
+	})
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	assert.Equal(t, "VNM", targets[0].Symbol)
+	assert.Equal(t, int32(symbolDimension), targets[0].Dimension)
+
+	targets, err = allocationTargetsFromProto(investmentv1.AllocationDimension_ALLOCATION_DIMENSION_UNSPECIFIED, nil)
+	require.NoError(t, err)
+	assert.Empty(t, targets, "an empty list clears the targets")
+
+	invalid := []struct {
+		name      string
+		dimension investmentv1.AllocationDimension
+		targets   []*investmentv1.AllocationTarget
+	}{
+		{
+			name:      "weights do not add up to 100",
+			dimension: symbolDimension,
+			targets:   []*investmentv1.AllocationTarget{{Symbol: "VNM", TargetPercent: 60}, {Symbol: "FPT", TargetPercent: 30}},
+		},
+		{
+			name:      "duplicate group",
+			dimension: symbolDimension,
+			targets:   []*investmentv1.AllocationTarget{{Symbol: "VNM", TargetPercent: 50}, {Symbol: "vnm", TargetPercent: 50}},
+		},
+		{
+			name:      "missing key",
+			dimension: investmentv1.AllocationDimension_ALLOCATION_DIMENSION_TYPE,
+			targets:   []*investmentv1.AllocationTarget{{Symbol: "VNM", TargetPercent: 100}},
+		},
+		{
+			name:      "missing dimension",
+			dimension: investmentv1.AllocationDimension_ALLOCATION_DIMENSION_UNSPECIFIED,
+			targets:   []*investmentv1.AllocationTarget{{Symbol: "VNM", TargetPercent: 100}},
+		},
+		{
+			name:      "negative weight",
+			dimension: investmentv1.AllocationDimension_ALLOCATION_DIMENSION_ASSET_CLASS,
+			targets:   []*investmentv1.AllocationTarget{{AssetClass: "Bonds", TargetPercent: 110}, {AssetClass: "Cash", TargetPercent: -10}},
+		},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := allocationTargetsFromProto(tt.dimension, tt.targets)
+
+			var validationErr apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+}
+
+func TestPlanRebalance(t *testing.T) {
+	stockA := &rebalanceHolding{investment: rebalanceTestInvestment(1, "AAA", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, 1, 1), value: 4000}
+	stockB := &rebalanceHolding{investment: rebalanceTestInvestment(2, "BBB", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, 1, 1), value: 2000}
+	crypto := &rebalanceHolding{investment: rebalanceTestInvestment(3, "BTC", investmentv1.InvestmentType_INVESTMENT_TYPE_CRYPTOCURRENCY, 1, 1), value: 3000}
+	bond := &rebalanceHolding{investment: rebalanceTestInvestment(4, "GOV", investmentv1.InvestmentType_INVESTMENT_TYPE_BOND, 1, 1), value: 1000}
+	holdings := []*rebalanceHolding{stockA, stockB, crypto, bond}
+	targets := []*models.AllocationTarget{
+		typeTarget(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, 50),
+		typeTarget(investmentv1.InvestmentType_INVESTMENT_TYPE_CRYPTOCURRENCY, 50),
+	}
+
+	drift, trades := planRebalance(investmentv1.AllocationDimension_ALLOCATION_DIMENSION_TYPE, targets, holdings, 0, 0)
+
+	require.Len(t, drift, 3)
+	assert.Equal(t, investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, drift[0].InvestmentType)
+	assert.Equal(t, int64(6000), drift[0].CurrentValue)
+	assert.Equal(t, int64(5000), drift[0].TargetValue)
+	assert.InDelta(t, 10.0, drift[0].DriftPercent, 1e-9)
+	assert.Equal(t, investmentv1.InvestmentType_INVESTMENT_TYPE_BOND, drift[2].InvestmentType, "untargeted holdings are reported")
+	assert.Equal(t, 0.0, drift[2].TargetPercent)
+	assert.Equal(t, int64(1000), drift[2].DriftValue)
+
+	// Sells first, largest first; the stock sell is split 2:1 by value
+	require.Len(t, trades, 4)
+	assert.Equal(t, plannedTrade{holding: bond, amount: -1000}, trades[0])
+	assert.Equal(t, plannedTrade{holding: stockA, amount: -666}, trades[1])
+	assert.Equal(t, plannedTrade{holding: stockB, amount: -334}, trades[2])
+	assert.Equal(t, plannedTrade{holding: crypto, amount: 2000}, trades[3])
+
+	t.Run("new cash and minimum trade size", func(t *testing.T) {
+		_, trades := planRebalance(investmentv1.AllocationDimension_ALLOCATION_DIMENSION_TYPE, targets, holdings, 2000, 500)
+
+		// Total 12000: stocks on target, crypto buys 3000, the bond sells 1000
+		require.Len(t, trades, 2)
+		assert.Equal(t, plannedTrade{holding: bond, amount: -1000}, trades[0])
+		assert.Equal(t, plannedTrade{holding: crypto, amount: 3000}, trades[1])
+
+		_, trades = planRebalance(investmentv1.AllocationDimension_ALLOCATION_DIMENSION_TYPE, targets, holdings, 2000, 1500)
+		require.Len(t, trades, 1)
+		assert.Equal(t, crypto, trades[0].holding)
+	})
+}
+
+func TestInvestmentService_GetRebalancingPlan(t *testing.T) {
+	ctx := context.Background()
+	mockInvRepo := new(MockInvestmentRepository)
+	mockUserRepo := new(MockUserRepository)
+	svc := NewInvestmentService(mockInvRepo, new(MockWalletRepository), nil, nil, mockUserRepo, new(MockFXRateService), nil, nil)
+	svc.(*investmentService).SetAllocationTargetRepository(&stubAllocationTargetRepository{targets: map[int32][]*models.AllocationTarget{
+		0: {
+			typeTarget(investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, 55),
+			typeTarget(investmentv1.InvestmentType_INVESTMENT_TYPE_CRYPTOCURRENCY, 45),
+		},
+	}})
+
+	investments := []*models.Investment{
+		rebalanceTestInvestment(1, "AAA", investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, 400000, 10000),              // 40 shares @ $100
+		rebalanceTestInvestment(2, "BTC", investmentv1.InvestmentType_INVESTMENT_TYPE_CRYPTOCURRENCY, 10000000, 3000000), // 0.1 BTC @ $30,000
+		rebalanceTestInvestment(3, "GOV", investmentv1.InvestmentType_INVESTMENT_TYPE_BOND, 100000, 10000),               // 10 bonds @ $100
+	}
+	mockUserRepo.On("GetByID", ctx, int32(1)).Return(&models.User{ID: 1, PreferredCurrency: "USD"}, nil)
+	mockInvRepo.On("ListByUserID", ctx, int32(1), mock.AnythingOfType("repository.ListOptions"), investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED).
+		Return(investments, len(investments), nil)
+
+	resp, err := svc.GetRebalancingPlan(ctx, 1, &investmentv1.GetRebalancingPlanRequest{NewCash: 15000})
+
+	require.NoError(t, err)
+	plan := resp.Data
+	assert.Equal(t, "USD", plan.Currency)
+	assert.Equal(t, int64(815000), plan.TotalValue)
+	require.Len(t, plan.Trades, 3)
+
+	sell := plan.Trades[0]
+	assert.Equal(t, "GOV", sell.Symbol)
+	assert.Equal(t, investmentv1.InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_SELL, sell.Action)
+	assert.Equal(t, int64(100000), sell.Quantity)
+
+	// Crypto can be bought fractionally; shares are rounded down to whole shares
+	assert.Equal(t, "BTC", plan.Trades[1].Symbol)
+	assert.Equal(t, int64(2225000), plan.Trades[1].Quantity)
+	assert.Equal(t, int64(66750), plan.Trades[1].Amount)
+	assert.Equal(t, "AAA", plan.Trades[2].Symbol)
+	assert.Equal(t, int64(40000), plan.Trades[2].Quantity, "4.825 shares rounded down to 4")
+	assert.Equal(t, int64(40000), plan.Trades[2].DisplayAmount.Amount)
+
+	assert.Equal(t, int64(15000+100000-66750-40000), plan.RemainingCash)
+}
+
+func TestInvestmentService_SetAllocationTargets_RequiresInvestmentWallet(t *testing.T) {
+	ctx := context.Background()
+	mockWalletRepo := new(MockWalletRepository)
+	svc := NewInvestmentService(nil, mockWalletRepo, nil, nil, nil, nil, nil, nil)
+	svc.(*investmentService).SetAllocationTargetRepository(&stubAllocationTargetRepository{targets: map[int32][]*models.AllocationTarget{}})
+	mockWalletRepo.On("GetByIDForUser", ctx, int32(5), int32(1)).Return(createTestWallet(5, 1, walletv1.WalletType_BASIC), nil)
+
+	_, err := svc.SetAllocationTargets(ctx, 1, &investmentv1.SetAllocationTargetsRequest{WalletId: 5})
+
+	var validationErr apperrors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+var _ repository.AllocationTargetRepository = (*stubAllocationTargetRepository)(nil)
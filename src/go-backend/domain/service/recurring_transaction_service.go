@@ -0,0 +1,474 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/types"
+	"wealthjourney/pkg/validator"
+
+	v1 "wealthjourney/protobuf/v1"
+)
+
+const (
+	// recurringDueBatchSize limits how many schedules one scheduler run picks up
+	recurringDueBatchSize = 500
+	// recurringMaxCatchUp caps the occurrences generated per schedule per run so a
+	// schedule far in the past (e.g. daily since years ago) cannot stall the job
+	recurringMaxCatchUp = 366
+	// recurringSkipSearchLimit bounds the slots searched when skipping a specific date
+	recurringSkipSearchLimit = 1000
+)
+
+// recurringTransactionService implements RecurringTransactionService.
+type recurringTransactionService struct {
+	recurringRepo repository.RecurringTransactionRepository
+	walletRepo    repository.WalletRepository
+	categoryRepo  repository.CategoryRepository
+	mapper        *RecurringTransactionMapper
+}
+
+// NewRecurringTransactionService creates a new RecurringTransactionService.
+func NewRecurringTransactionService(
+	recurringRepo repository.RecurringTransactionRepository,
+	walletRepo repository.WalletRepository,
+	categoryRepo repository.CategoryRepository,
+) RecurringTransactionService {
+	return &recurringTransactionService{
+		recurringRepo: recurringRepo,
+		walletRepo:    walletRepo,
+		categoryRepo:  categoryRepo,
+		mapper:        NewRecurringTransactionMapper(),
+	}
+}
+
+// GetRecurringTransaction retrieves a recurring transaction by ID, ensuring it belongs to the user.
+func (s *recurringTransactionService) GetRecurringTransaction(ctx context.Context, recurringID int32, userID int32) (*v1.GetRecurringTransactionResponse, error) {
+	if err := validator.ID(recurringID); err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.recurringRepo.GetByIDForUser(ctx, recurringID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetRecurringTransactionResponse{
+		Success:   true,
+		Message:   "Recurring transaction retrieved successfully",
+		Data:      s.mapper.ModelToProto(recurring),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListRecurringTransactions retrieves recurring transactions for a user with optional filtering.
+func (s *recurringTransactionService) ListRecurringTransactions(ctx context.Context, userID int32, req *v1.ListRecurringTransactionsRequest) (*v1.ListRecurringTransactionsResponse, error) {
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+
+	params := types.NewPaginationParams()
+	if req.Pagination != nil {
+		params = types.PaginationParams{
+			Page:     int(req.Pagination.Page),
+			PageSize: int(req.Pagination.PageSize),
+			OrderBy:  req.Pagination.OrderBy,
+			Order:    req.Pagination.Order,
+		}
+	}
+	params = params.Validate()
+
+	filter := repository.RecurringTransactionFilter{WalletID: req.WalletId}
+	if req.Status != nil && *req.Status != v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_UNSPECIFIED {
+		status := int32(*req.Status)
+		filter.Status = &status
+	}
+
+	// Recurring transactions have no "date" column, so only pass through columns they share
+	orderBy := ""
+	switch params.OrderBy {
+	case "id", "amount", "created_at", "updated_at":
+		orderBy = params.OrderBy
+	}
+
+	recurring, total, err := s.recurringRepo.ListByUserID(ctx, userID, filter, repository.ListOptions{
+		Limit:   params.Limit(),
+		Offset:  params.Offset(),
+		OrderBy: orderBy,
+		Order:   params.Order,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	paginationResult := types.NewPaginationResult(params.Page, params.PageSize, total)
+
+	return &v1.ListRecurringTransactionsResponse{
+		Success:               true,
+		Message:               "Recurring transactions retrieved successfully",
+		RecurringTransactions: s.mapper.ModelSliceToProto(recurring),
+		Pagination: &v1.PaginationResult{
+			Page:       int32(paginationResult.Page),
+			PageSize:   int32(paginationResult.PageSize),
+			TotalCount: int32(paginationResult.TotalCount),
+			TotalPages: int32(paginationResult.TotalPages),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// CreateRecurringTransaction creates a new schedule and generates any occurrences already due.
+func (s *recurringTransactionService) CreateRecurringTransaction(ctx context.Context, userID int32, req *v1.CreateRecurringTransactionRequest) (*v1.CreateRecurringTransactionResponse, error) {
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if req.Amount == nil || req.Amount.Amount == 0 {
+		return nil, apperrors.NewValidationError("amount is required")
+	}
+	if err := validateRecurrenceRule(req.Rule); err != nil {
+		return nil, err
+	}
+
+	// Validate wallet belongs to user
+	wallet, err := s.walletRepo.GetByIDForUser(ctx, req.WalletId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate category if provided
+	if req.CategoryId != nil {
+		if _, err := s.categoryRepo.GetByIDForUser(ctx, *req.CategoryId, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	recurring := &models.RecurringTransaction{
+		UserID:     userID,
+		WalletID:   req.WalletId,
+		CategoryID: req.CategoryId,
+		Amount:     req.Amount.Amount,
+		Currency:   wallet.Currency,
+		Status:     int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE),
+	}
+	if req.Note != nil {
+		recurring.Note = *req.Note
+	}
+	applyRecurrenceRule(recurring, req.Rule)
+	recurring.ResetSchedule(time.Unix(req.Rule.StartDate, 0))
+
+	if err := s.recurringRepo.Create(ctx, recurring); err != nil {
+		return nil, err
+	}
+
+	// Back-fill occurrences whose date has already passed (e.g. a rent schedule
+	// created mid-month starting on the 1st)
+	generated, err := s.processSchedule(ctx, recurring.ID, time.Now())
+	if err != nil {
+		slog.Warn("Failed to generate due recurring occurrences",
+			"recurring_id", recurring.ID,
+			"user_id", userID,
+			"error", err)
+	}
+
+	if refreshed, err := s.recurringRepo.GetByIDForUser(ctx, recurring.ID, userID); err == nil {
+		recurring = refreshed
+	}
+
+	return &v1.CreateRecurringTransactionResponse{
+		Success:        true,
+		Message:        "Recurring transaction created successfully",
+		Data:           s.mapper.ModelToProto(recurring),
+		GeneratedCount: int32(generated),
+		Timestamp:      time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// UpdateRecurringTransaction updates a schedule. Changes apply to future occurrences only;
+// transactions that were already generated are left untouched.
+func (s *recurringTransactionService) UpdateRecurringTransaction(ctx context.Context, recurringID int32, userID int32, req *v1.UpdateRecurringTransactionRequest) (*v1.UpdateRecurringTransactionResponse, error) {
+	if err := validator.ID(recurringID); err != nil {
+		return nil, err
+	}
+	if req.Amount != nil && req.Amount.Amount == 0 {
+		return nil, apperrors.NewValidationError("amount cannot be zero")
+	}
+
+	var walletCurrency string
+	if req.WalletId != nil {
+		wallet, err := s.walletRepo.GetByIDForUser(ctx, *req.WalletId, userID)
+		if err != nil {
+			return nil, err
+		}
+		walletCurrency = wallet.Currency
+	}
+	if req.CategoryId != nil {
+		if _, err := s.categoryRepo.GetByIDForUser(ctx, *req.CategoryId, userID); err != nil {
+			return nil, err
+		}
+	}
+	if req.Rule != nil {
+		if err := validateRecurrenceRule(req.Rule); err != nil {
+			return nil, err
+		}
+		if time.Unix(req.Rule.StartDate, 0).Before(startOfDayUTC(time.Now())) {
+			return nil, apperrors.NewValidationError("rule start date cannot be in the past; only future occurrences can be changed")
+		}
+	}
+
+	recurring, err := s.recurringRepo.UpdateLocked(ctx, recurringID, userID, func(r *models.RecurringTransaction) error {
+		if req.WalletId != nil {
+			r.WalletID = *req.WalletId
+			r.Currency = walletCurrency
+		}
+		if req.CategoryId != nil {
+			r.CategoryID = req.CategoryId
+		}
+		if req.Amount != nil {
+			r.Amount = req.Amount.Amount
+		}
+		if req.Note != nil {
+			r.Note = *req.Note
+		}
+		if req.Rule != nil {
+			if req.Rule.Count != nil && *req.Rule.Count <= r.OccurrenceCount {
+				return apperrors.NewValidationError("rule count must be greater than the number of occurrences already processed")
+			}
+			applyRecurrenceRule(r, req.Rule)
+			r.ResetSchedule(time.Unix(req.Rule.StartDate, 0))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateRecurringTransactionResponse{
+		Success:   true,
+		Message:   "Recurring transaction updated successfully",
+		Data:      s.mapper.ModelToProto(recurring),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// DeleteRecurringTransaction deletes a schedule. Generated transactions are kept.
+func (s *recurringTransactionService) DeleteRecurringTransaction(ctx context.Context, recurringID int32, userID int32) (*v1.DeleteRecurringTransactionResponse, error) {
+	if err := validator.ID(recurringID); err != nil {
+		return nil, err
+	}
+
+	// Verify ownership
+	if _, err := s.recurringRepo.GetByIDForUser(ctx, recurringID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.recurringRepo.Delete(ctx, recurringID); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteRecurringTransactionResponse{
+		Success:   true,
+		Message:   "Recurring transaction deleted successfully",
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// PauseRecurringTransaction stops generating occurrences until resumed.
+func (s *recurringTransactionService) PauseRecurringTransaction(ctx context.Context, recurringID int32, userID int32) (*v1.PauseRecurringTransactionResponse, error) {
+	if err := validator.ID(recurringID); err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.recurringRepo.UpdateLocked(ctx, recurringID, userID, func(r *models.RecurringTransaction) error {
+		if !r.IsActive() {
+			return apperrors.NewValidationError("only active recurring transactions can be paused")
+		}
+		r.Status = int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_PAUSED)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.PauseRecurringTransactionResponse{
+		Success:   true,
+		Message:   "Recurring transaction paused successfully",
+		Data:      s.mapper.ModelToProto(recurring),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ResumeRecurringTransaction resumes a paused schedule. Slots that fell before today
+// while paused are consumed without being generated.
+func (s *recurringTransactionService) ResumeRecurringTransaction(ctx context.Context, recurringID int32, userID int32) (*v1.ResumeRecurringTransactionResponse, error) {
+	if err := validator.ID(recurringID); err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.recurringRepo.UpdateLocked(ctx, recurringID, userID, func(r *models.RecurringTransaction) error {
+		if r.Status != int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_PAUSED) {
+			return apperrors.NewValidationError("only paused recurring transactions can be resumed")
+		}
+		r.Status = int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE)
+		r.FastForward(startOfDayUTC(time.Now()))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate today's occurrence right away if it is due
+	if _, err := s.processSchedule(ctx, recurring.ID, time.Now()); err != nil {
+		slog.Warn("Failed to generate due recurring occurrences",
+			"recurring_id", recurring.ID,
+			"user_id", userID,
+			"error", err)
+	} else if refreshed, err := s.recurringRepo.GetByIDForUser(ctx, recurring.ID, userID); err == nil {
+		recurring = refreshed
+	}
+
+	return &v1.ResumeRecurringTransactionResponse{
+		Success:   true,
+		Message:   "Recurring transaction resumed successfully",
+		Data:      s.mapper.ModelToProto(recurring),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// SkipRecurringOccurrence skips the next (or a specific upcoming) occurrence.
+func (s *recurringTransactionService) SkipRecurringOccurrence(ctx context.Context, recurringID int32, userID int32, req *v1.SkipRecurringOccurrenceRequest) (*v1.SkipRecurringOccurrenceResponse, error) {
+	if err := validator.ID(recurringID); err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.recurringRepo.GetByIDForUser(ctx, recurringID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.NextOccurrence == nil {
+		return nil, apperrors.NewValidationError("recurring transaction has no upcoming occurrences")
+	}
+
+	date := *recurring.NextOccurrence
+	if req.OccurrenceDate != nil && *req.OccurrenceDate > 0 {
+		found := false
+		for _, upcoming := range recurring.UpcomingOccurrences(recurringSkipSearchLimit) {
+			if upcoming.Unix() == *req.OccurrenceDate {
+				date, found = upcoming, true
+				break
+			}
+		}
+		if !found {
+			return nil, apperrors.NewValidationError("occurrence date does not match an upcoming occurrence")
+		}
+	}
+
+	recurring, err = s.recurringRepo.SkipOccurrence(ctx, recurringID, userID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SkipRecurringOccurrenceResponse{
+		Success:     true,
+		Message:     "Occurrence skipped successfully",
+		Data:        s.mapper.ModelToProto(recurring),
+		SkippedDate: date.Unix(),
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ProcessDueOccurrences generates every occurrence due at now across all users.
+// A failing schedule (e.g. insufficient wallet balance) is logged and retried on
+// the next run without blocking the others.
+func (s *recurringTransactionService) ProcessDueOccurrences(ctx context.Context, now time.Time) (int, error) {
+	ids, err := s.recurringRepo.ListDueIDs(ctx, now, recurringDueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return total, ctx.Err()
+		}
+
+		generated, err := s.processSchedule(ctx, id, now)
+		total += generated
+		if err != nil {
+			slog.Warn("Failed to process recurring transaction",
+				"recurring_id", id,
+				"error", err)
+		}
+	}
+
+	return total, nil
+}
+
+// processSchedule materializes due occurrences of one schedule until it is caught up.
+func (s *recurringTransactionService) processSchedule(ctx context.Context, recurringID int32, now time.Time) (int, error) {
+	generated := 0
+	for i := 0; i < recurringMaxCatchUp; i++ {
+		occurrence, err := s.recurringRepo.ProcessNextOccurrence(ctx, recurringID, now)
+		if err != nil {
+			return generated, err
+		}
+		if occurrence == nil {
+			break
+		}
+		if occurrence.Status == models.RecurringOccurrenceGenerated && occurrence.TransactionID != nil {
+			generated++
+		}
+	}
+	return generated, nil
+}
+
+// validateRecurrenceRule validates a recurrence rule from a request.
+func validateRecurrenceRule(rule *v1.RecurrenceRule) error {
+	if rule == nil {
+		return apperrors.NewValidationError("rule is required")
+	}
+	switch rule.Frequency {
+	case v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY,
+		v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY,
+		v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY,
+		v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY:
+	default:
+		return apperrors.NewValidationError("rule frequency must be daily, weekly, monthly or yearly")
+	}
+	if rule.Interval < 0 {
+		return apperrors.NewValidationError("rule interval cannot be negative")
+	}
+	if rule.StartDate <= 0 {
+		return apperrors.NewValidationError("rule start date is required")
+	}
+	if rule.EndDate != nil && *rule.EndDate < rule.StartDate {
+		return apperrors.NewValidationError("rule end date must be after start date")
+	}
+	if rule.Count != nil && *rule.Count <= 0 {
+		return apperrors.NewValidationError("rule count must be positive")
+	}
+	return nil
+}
+
+// applyRecurrenceRule copies rule fields (except the start date) onto the model.
+func applyRecurrenceRule(recurring *models.RecurringTransaction, rule *v1.RecurrenceRule) {
+	recurring.Frequency = int32(rule.Frequency)
+	recurring.Interval = rule.Interval
+	if recurring.Interval == 0 {
+		recurring.Interval = 1
+	}
+	recurring.EndDate = nil
+	if rule.EndDate != nil {
+		endDate := time.Unix(*rule.EndDate, 0)
+		recurring.EndDate = &endDate
+	}
+	recurring.MaxOccurrences = rule.Count
+}
+
+// startOfDayUTC returns midnight UTC of the given time's day.
+func startOfDayUTC(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRecurringTransactionRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecurringTransactionRepository) Create(ctx context.Context, recurring *models.RecurringTransaction) error {
+	args := m.Called(ctx, recurring)
+	return args.Error(0)
+}
+
+func (m *MockRecurringTransactionRepository) GetByIDForUser(ctx context.Context, id, userID int32) (*models.RecurringTransaction, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RecurringTransaction), args.Error(1)
+}
+
+func (m *MockRecurringTransactionRepository) ListByUserID(ctx context.Context, userID int32, filter repository.RecurringTransactionFilter, opts repository.ListOptions) ([]*models.RecurringTransaction, int, error) {
+	args := m.Called(ctx, userID, filter, opts)
+	return args.Get(0).([]*models.RecurringTransaction), args.Int(1), args.Error(2)
+}
+
+func (m *MockRecurringTransactionRepository) UpdateLocked(ctx context.Context, id, userID int32, fn func(recurring *models.RecurringTransaction) error) (*models.RecurringTransaction, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	recurring := args.Get(0).(*models.RecurringTransaction)
+	if err := fn(recurring); err != nil {
+		return nil, err
+	}
+	return recurring, args.Error(1)
+}
+
+func (m *MockRecurringTransactionRepository) Delete(ctx context.Context, id int32) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRecurringTransactionRepository) ListDueIDs(ctx context.Context, now time.Time, limit int) ([]int32, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int32), args.Error(1)
+}
+
+func (m *MockRecurringTransactionRepository) ProcessNextOccurrence(ctx context.Context, id int32, now time.Time) (*models.RecurringTransactionOccurrence, error) {
+	args := m.Called(ctx, id, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RecurringTransactionOccurrence), args.Error(1)
+}
+
+func (m *MockRecurringTransactionRepository) SkipOccurrence(ctx context.Context, id, userID int32, date time.Time) (*models.RecurringTransaction, error) {
+	args := m.Called(ctx, id, userID, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RecurringTransaction), args.Error(1)
+}
+
+func generatedOccurrence(txID int32) *models.RecurringTransactionOccurrence {
+	return &models.RecurringTransactionOccurrence{
+		TransactionID: &txID,
+		Status:        models.RecurringOccurrenceGenerated,
+	}
+}
+
+func TestRecurringTransactionService_ProcessDueOccurrences(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	repo := new(MockRecurringTransactionRepository)
+	svc := NewRecurringTransactionService(repo, nil, nil)
+
+	repo.On("ListDueIDs", ctx, now, recurringDueBatchSize).Return([]int32{1, 2, 3}, nil)
+
+	// Schedule 1 catches up two occurrences, one of them previously skipped
+	repo.On("ProcessNextOccurrence", ctx, int32(1), now).Return(generatedOccurrence(10), nil).Once()
+	repo.On("ProcessNextOccurrence", ctx, int32(1), now).Return(&models.RecurringTransactionOccurrence{Status: models.RecurringOccurrenceSkipped}, nil).Once()
+	repo.On("ProcessNextOccurrence", ctx, int32(1), now).Return(generatedOccurrence(11), nil).Once()
+	repo.On("ProcessNextOccurrence", ctx, int32(1), now).Return(nil, nil).Once()
+
+	// Schedule 2 fails and must not block schedule 3
+	repo.On("ProcessNextOccurrence", ctx, int32(2), now).Return(nil, apperrors.NewValidationError("Insufficient balance for this transaction")).Once()
+
+	repo.On("ProcessNextOccurrence", ctx, int32(3), now).Return(generatedOccurrence(12), nil).Once()
+	repo.On("ProcessNextOccurrence", ctx, int32(3), now).Return(nil, nil).Once()
+
+	generated, err := svc.ProcessDueOccurrences(ctx, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, generated)
+	repo.AssertExpectations(t)
+}
+
+func TestRecurringTransactionService_CreateValidation(t *testing.T) {
+	ctx := context.Background()
+	svc := NewRecurringTransactionService(new(MockRecurringTransactionRepository), new(MockWalletRepository), nil)
+
+	count := int32(0)
+	endBeforeStart := int64(1000)
+
+	tests := []struct {
+		name string
+		req  *v1.CreateRecurringTransactionRequest
+	}{
+		{
+			name: "missing amount",
+			req: &v1.CreateRecurringTransactionRequest{
+				WalletId: 1,
+				Rule:     &v1.RecurrenceRule{Frequency: v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY, StartDate: 2000},
+			},
+		},
+		{
+			name: "missing rule",
+			req: &v1.CreateRecurringTransactionRequest{
+				WalletId: 1,
+				Amount:   &v1.Money{Amount: -5000, Currency: "VND"},
+			},
+		},
+		{
+			name: "unspecified frequency",
+			req: &v1.CreateRecurringTransactionRequest{
+				WalletId: 1,
+				Amount:   &v1.Money{Amount: -5000, Currency: "VND"},
+				Rule:     &v1.RecurrenceRule{StartDate: 2000},
+			},
+		},
+		{
+			name: "end date before start date",
+			req: &v1.CreateRecurringTransactionRequest{
+				WalletId: 1,
+				Amount:   &v1.Money{Amount: -5000, Currency: "VND"},
+				Rule:     &v1.RecurrenceRule{Frequency: v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, StartDate: 2000, EndDate: &endBeforeStart},
+			},
+		},
+		{
+			name: "non-positive count",
+			req: &v1.CreateRecurringTransactionRequest{
+				WalletId: 1,
+				Amount:   &v1.Money{Amount: -5000, Currency: "VND"},
+				Rule:     &v1.RecurrenceRule{Frequency: v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, StartDate: 2000, Count: &count},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.CreateRecurringTransaction(ctx, 1, tt.req)
+			assert.Nil(t, resp)
+			var validationErr apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+}
+
+func TestRecurringTransactionService_PauseAndResume(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRecurringTransactionRepository)
+	svc := NewRecurringTransactionService(repo, nil, nil)
+
+	recurring := &models.RecurringTransaction{
+		ID:        7,
+		UserID:    1,
+		Frequency: int32(v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY),
+		Interval:  1,
+		Status:    int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE),
+	}
+	// Anchor well in the past so resuming has to skip the missed slots
+	recurring.ResetSchedule(time.Now().UTC().AddDate(0, 0, -10))
+
+	repo.On("UpdateLocked", ctx, int32(7), int32(1)).Return(recurring, nil)
+	repo.On("ProcessNextOccurrence", ctx, int32(7), mock.Anything).Return(nil, nil)
+	repo.On("GetByIDForUser", ctx, int32(7), int32(1)).Return(recurring, nil)
+
+	pauseResp, err := svc.PauseRecurringTransaction(ctx, 7, 1)
+	require.NoError(t, err)
+	assert.Equal(t, v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_PAUSED, pauseResp.Data.Status)
+
+	// Pausing twice is rejected
+	_, err = svc.PauseRecurringTransaction(ctx, 7, 1)
+	assert.Error(t, err)
+
+	resumeResp, err := svc.ResumeRecurringTransaction(ctx, 7, 1)
+	require.NoError(t, err)
+	assert.Equal(t, v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE, resumeResp.Data.Status)
+	require.NotNil(t, recurring.NextOccurrence)
+	assert.False(t, recurring.NextOccurrence.Before(startOfDayUTC(time.Now())), "missed slots should be skipped on resume")
+	assert.Equal(t, int32(10), recurring.OccurrenceCount)
+}
+
+func TestRecurringTransactionService_SkipRejectsUnknownDate(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRecurringTransactionRepository)
+	svc := NewRecurringTransactionService(repo, nil, nil)
+
+	recurring := &models.RecurringTransaction{
+		ID:        3,
+		UserID:    1,
+		Frequency: int32(v1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY),
+		Interval:  1,
+		Status:    int32(v1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE),
+	}
+	recurring.ResetSchedule(time.Date(2030, time.January, 7, 0, 0, 0, 0, time.UTC))
+	repo.On("GetByIDForUser", ctx, int32(3), int32(1)).Return(recurring, nil)
+
+	notAnOccurrence := time.Date(2030, time.January, 8, 0, 0, 0, 0, time.UTC).Unix()
+	_, err := svc.SkipRecurringOccurrence(ctx, 3, 1, &v1.SkipRecurringOccurrenceRequest{OccurrenceDate: &notAnOccurrence})
+	assert.Error(t, err)
+
+	thirdWeek := time.Date(2030, time.January, 21, 0, 0, 0, 0, time.UTC)
+	thirdWeekUnix := thirdWeek.Unix()
+	repo.On("SkipOccurrence", ctx, int32(3), int32(1), thirdWeek).Return(recurring, nil)
+
+	resp, err := svc.SkipRecurringOccurrence(ctx, 3, 1, &v1.SkipRecurringOccurrenceRequest{OccurrenceDate: &thirdWeekUnix})
+	require.NoError(t, err)
+	assert.Equal(t, thirdWeekUnix, resp.SkippedDate)
+}
@@ -5,6 +5,8 @@ import (
 
 	"wealthjourney/domain/repository"
 	"wealthjourney/pkg/cache"
+	"wealthjourney/pkg/notification"
+	"wealthjourney/pkg/pricing"
 )
 
 // Services holds all service instances.
@@ -13,12 +15,19 @@ type Services struct {
 	User               UserService
 	Transaction        TransactionService
 	Category           CategoryService
+	Tag                TagService
 	Budget             BudgetService
 	Investment         InvestmentService
 	FXRate             FXRateService
 	PortfolioHistory   PortfolioHistoryService
 	MarketData         MarketDataService
 	Import             ImportService
+	Recurring          RecurringTransactionService
+	Notification       NotificationService
+
+	// Notifier delivers budget alerts. It starts with the in-app inbox; email and
+	// webhook channels are registered once the configuration is known.
+	Notifier *notification.Dispatcher
 }
 
 // NewServices creates all service instances.
@@ -42,6 +51,9 @@ func NewServices(repos *Repositories, redisClient *redis.Client) *Services {
 
 	// Create market data service
 	marketDataSvc := NewMarketDataService(repos.MarketData, goldPriceSvc, silverPriceSvc)
+	if ms, ok := marketDataSvc.(*marketDataService); ok {
+		ms.SetPriceHistoryRepository(repos.PriceHistory)
+	}
 
 	// Create currency cache
 	currencyCache := cache.NewCurrencyCache(redisClient)
@@ -52,22 +64,81 @@ func NewServices(repos *Repositories, redisClient *redis.Client) *Services {
 	}
 
 	walletSvc := NewWalletService(repos.Wallet, repos.User, repos.Transaction, repos.Category, categorySvc, fxRateSvc, currencyCache, repos.Investment, redisClient)
+	if ws, ok := walletSvc.(*walletService); ok {
+		ws.SetReconciliationRepository(repos.WalletReconciliation)
+		ws.SetTransferRepository(repos.Transfer)
+	}
 
 	// Create portfolio history service
 	portfolioHistorySvc := NewPortfolioHistoryService(repos.PortfolioHistory, NewInvestmentService(repos.Investment, repos.Wallet, repos.InvestmentTransaction, marketDataSvc, repos.User, fxRateSvc, currencyCache, walletSvc), repos.User, fxRateSvc)
 
+	if ps, ok := portfolioHistorySvc.(*portfolioHistoryService); ok {
+		ps.SetBenchmarkPriceRepository(repos.BenchmarkPrice)
+		ps.SetBackfillSources(repos.Investment, repos.InvestmentTransaction, marketDataSvc)
+	}
+
+	investmentSvc := NewInvestmentService(repos.Investment, repos.Wallet, repos.InvestmentTransaction, marketDataSvc, repos.User, fxRateSvc, currencyCache, walletSvc)
+	if is, ok := investmentSvc.(*investmentService); ok {
+		is.SetExchangeRateRepository(repos.ExchangeRate)
+		is.SetPortfolioHistoryRepository(repos.PortfolioHistory)
+		is.SetAllocationTargetRepository(repos.AllocationTarget)
+	}
+
+	transactionSvc := NewTransactionService(repos.Transaction, repos.Wallet, repos.Category, repos.User, fxRateSvc, currencyCache)
+	if ts, ok := transactionSvc.(*transactionService); ok {
+		ts.SetTagRepository(repos.Tag)
+		ts.SetTransferRepository(repos.Transfer)
+	}
+
+	// Budget alerts land in the in-app inbox
+	notificationSvc := NewNotificationService(repos.Notification)
+	notifier := notification.NewDispatcher()
+	if repos.Notification != nil {
+		notifier.Register(notification.ChannelInApp, notificationSvc)
+	}
+
+	budgetSvc := NewBudgetService(repos.Budget, repos.BudgetItem, repos.User, fxRateSvc, currencyCache)
+	if bs, ok := budgetSvc.(*budgetService); ok {
+		bs.SetSpendingRepositories(repos.Transaction, repos.Category, repos.Wallet)
+		bs.SetEnvelopeRepository(repos.BudgetEnvelope)
+		bs.SetAlertRepository(repos.BudgetAlert)
+		bs.SetTemplateRepository(repos.BudgetTemplate)
+		bs.SetNotifier(notifier)
+	}
+	// Created and updated transactions check the budget alerts they may trigger
+	if ts, ok := transactionSvc.(*transactionService); ok {
+		ts.SetBudgetAlertEvaluator(budgetSvc)
+	}
+
 	return &Services{
 		Wallet:           walletSvc,
 		User:             userSvc,
-		Transaction:      NewTransactionService(repos.Transaction, repos.Wallet, repos.Category, repos.User, fxRateSvc, currencyCache),
+		Transaction:      transactionSvc,
 		Category:         categorySvc,
-		Budget:           NewBudgetService(repos.Budget, repos.BudgetItem, repos.User, fxRateSvc, currencyCache),
-		Investment:       NewInvestmentService(repos.Investment, repos.Wallet, repos.InvestmentTransaction, marketDataSvc, repos.User, fxRateSvc, currencyCache, walletSvc),
+		Tag:              NewTagService(repos.Tag),
+		Budget:           budgetSvc,
+		Investment:       investmentSvc,
 		FXRate:           fxRateSvc,
 		PortfolioHistory: portfolioHistorySvc,
 		MarketData:       marketDataSvc,
 		Import:           nil, // Import service is created separately in main.go with job queue
+		Recurring:        NewRecurringTransactionService(repos.RecurringTransaction, repos.Wallet, repos.Category),
+		Notification:     notificationSvc,
+		Notifier:         notifier,
+	}
+}
+
+// UsePriceFixture serves market prices from a JSON fixture file ahead of the live
+// providers, so the price pipeline can run offline.
+func (s *Services) UsePriceFixture(path string) error {
+	fixture, err := pricing.NewFileProvider(path)
+	if err != nil {
+		return err
+	}
+	if ms, ok := s.MarketData.(*marketDataService); ok {
+		ms.SetPriceFixture(fixture)
 	}
+	return nil
 }
 
 // Repositories holds all repository instances.
@@ -75,19 +146,31 @@ type Repositories struct {
 	Wallet                repository.WalletRepository
 	User                  repository.UserRepository
 	Transaction           repository.TransactionRepository
+	Transfer              repository.TransferRepository
 	Category              repository.CategoryRepository
+	Tag                   repository.TagRepository
+	Attachment            repository.AttachmentRepository
 	Budget                repository.BudgetRepository
 	BudgetItem            repository.BudgetItemRepository
+	BudgetEnvelope        repository.BudgetEnvelopeRepository
+	BudgetAlert           repository.BudgetAlertRepository
+	BudgetTemplate        repository.BudgetTemplateRepository
+	Notification          repository.NotificationRepository
 	Investment            repository.InvestmentRepository
 	InvestmentTransaction repository.InvestmentTransactionRepository
 	MarketData            repository.MarketDataRepository
+	PriceHistory          repository.PriceHistoryRepository
 	FXRate                repository.FXRateRepository
 	ExchangeRate          repository.ExchangeRateRepository
 	PortfolioHistory      repository.PortfolioHistoryRepository
+	BenchmarkPrice        repository.BenchmarkPriceRepository
+	AllocationTarget      repository.AllocationTargetRepository
 	Import                repository.ImportRepository
 	MerchantRule          repository.MerchantRuleRepository
 	Keyword               repository.KeywordRepository
 	UserMapping           repository.UserMappingRepository
+	RecurringTransaction  repository.RecurringTransactionRepository
+	WalletReconciliation  repository.WalletReconciliationRepository
 }
 
 // NewRepositories creates all repository instances.
@@ -17,6 +17,7 @@ import (
 type SilverPriceService interface {
 	FetchPriceForSymbol(ctx context.Context, symbol string) (*CachedSilverPrice, error)
 	FetchAllPrices(ctx context.Context) ([]*CachedSilverPrice, error)
+	FetchHistoricalPrices(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error)
 }
 
 // CachedSilverPrice represents a cached silver price with metadata
@@ -235,3 +236,13 @@ func (s *silverPriceService) FetchAllPrices(ctx context.Context) ([]*CachedSilve
 
 	return prices, nil
 }
+
+// FetchHistoricalPrices fetches daily prices for a silver symbol (USD per ounce)
+// Only world silver has a history, from Yahoo Finance silver futures; VND silver
+// history is built from recorded quotes
+func (s *silverPriceService) FetchHistoricalPrices(ctx context.Context, symbol string, from, to time.Time) (*yahoo.HistoricalPrices, error) {
+	if symbol != "XAGUSD" && symbol != "SI=F" {
+		return nil, ErrPriceHistoryUnavailable
+	}
+	return yahoo.GetHistoricalPrices(ctx, "SI=F", from, to)
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/types"
+
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// tagNameSeparators split a cell of an imported tags column into tag names, so they
+// cannot appear in a name.
+const tagNameSeparators = ",;|"
+
+// tagService implements TagService.
+type tagService struct {
+	tagRepo repository.TagRepository
+}
+
+// NewTagService creates a new TagService.
+func NewTagService(tagRepo repository.TagRepository) TagService {
+	return &tagService{
+		tagRepo: tagRepo,
+	}
+}
+
+// CreateTag creates a new tag for a user.
+func (s *tagService) CreateTag(ctx context.Context, userID int32, req *v1.CreateTagRequest) (*v1.CreateTagResponse, error) {
+	name, err := validateTagName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameAvailable(ctx, userID, 0, name); err != nil {
+		return nil, err
+	}
+
+	tag := &models.Tag{
+		UserID: userID,
+		Name:   name,
+	}
+	if err := s.tagRepo.Create(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateTagResponse{
+		Success:   true,
+		Message:   "Tag created successfully",
+		Data:      tagToProto(tag),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// GetTag retrieves a tag by ID.
+func (s *tagService) GetTag(ctx context.Context, tagID int32, userID int32) (*v1.GetTagResponse, error) {
+	tag, err := s.tagRepo.GetByIDForUser(ctx, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetTagResponse{
+		Success:   true,
+		Message:   "Tag retrieved successfully",
+		Data:      tagToProto(tag),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListTags retrieves the tags of a user ordered by name.
+func (s *tagService) ListTags(ctx context.Context, userID int32, req *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	params := types.NewPaginationParams()
+	if req.Pagination != nil {
+		params = types.PaginationParams{
+			Page:     int(req.Pagination.Page),
+			PageSize: int(req.Pagination.PageSize),
+		}
+	}
+	params = params.Validate()
+
+	tags, total, err := s.tagRepo.ListByUserID(ctx, userID, repository.ListOptions{
+		Limit:  params.Limit(),
+		Offset: params.Offset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	paginationResult := types.NewPaginationResult(params.Page, params.PageSize, total)
+	return &v1.ListTagsResponse{
+		Success: true,
+		Message: "Tags retrieved successfully",
+		Tags:    tagsToProto(tags),
+		Pagination: &v1.PaginationResult{
+			Page:       int32(paginationResult.Page),
+			PageSize:   int32(paginationResult.PageSize),
+			TotalCount: int32(paginationResult.TotalCount),
+			TotalPages: int32(paginationResult.TotalPages),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// UpdateTag renames a tag.
+func (s *tagService) UpdateTag(ctx context.Context, tagID int32, userID int32, req *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error) {
+	name, err := validateTagName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.tagRepo.GetByIDForUser(ctx, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameAvailable(ctx, userID, tagID, name); err != nil {
+		return nil, err
+	}
+
+	tag.Name = name
+	if err := s.tagRepo.Update(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateTagResponse{
+		Success:   true,
+		Message:   "Tag updated successfully",
+		Data:      tagToProto(tag),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// DeleteTag deletes a tag and removes it from all transactions.
+func (s *tagService) DeleteTag(ctx context.Context, tagID int32, userID int32) (*v1.DeleteTagResponse, error) {
+	// Verify ownership
+	if _, err := s.tagRepo.GetByIDForUser(ctx, tagID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.tagRepo.Delete(ctx, tagID); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteTagResponse{
+		Success:   true,
+		Message:   "Tag deleted successfully",
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// checkNameAvailable fails with a conflict when another tag of the user has the name,
+// ignoring case. tagID is the tag being renamed, or 0 for a new tag.
+func (s *tagService) checkNameAvailable(ctx context.Context, userID, tagID int32, name string) error {
+	existing, err := s.tagRepo.GetByName(ctx, userID, name)
+	if err != nil {
+		var notFound apperrors.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return err
+	}
+	if existing.ID != tagID {
+		return apperrors.NewConflictError("a tag with this name already exists")
+	}
+	return nil
+}
+
+// validateTagName normalizes and validates a tag name.
+func validateTagName(name string) (string, error) {
+	name = models.NormalizeTagName(name)
+	if name == "" {
+		return "", apperrors.NewValidationError("tag name is required")
+	}
+	if len(name) > 50 {
+		return "", apperrors.NewValidationError("tag name must be 50 characters or less")
+	}
+	if strings.ContainsAny(name, tagNameSeparators) {
+		return "", apperrors.NewValidationError("tag name cannot contain commas, semicolons or pipes")
+	}
+	return name, nil
+}
+
+// tagToProto converts a model Tag to protobuf.
+func tagToProto(tag *models.Tag) *v1.Tag {
+	return &v1.Tag{
+		Id:        tag.ID,
+		UserId:    tag.UserID,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt.Unix(),
+		UpdatedAt: tag.UpdatedAt.Unix(),
+	}
+}
+
+// tagsToProto converts model tags to protobuf.
+func tagsToProto(tags []*models.Tag) []*v1.Tag {
+	result := make([]*v1.Tag, len(tags))
+	for i, tag := range tags {
+		result[i] = tagToProto(tag)
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTagRepository keeps tags in memory.
+type stubTagRepository struct {
+	repository.TagRepository
+	tags []*models.Tag
+}
+
+func (r *stubTagRepository) Create(ctx context.Context, tag *models.Tag) error {
+	tag.ID = int32(len(r.tags) + 1)
+	r.tags = append(r.tags, tag)
+	return nil
+}
+
+func (r *stubTagRepository) GetByIDForUser(ctx context.Context, tagID, userID int32) (*models.Tag, error) {
+	for _, tag := range r.tags {
+		if tag.ID == tagID && tag.UserID == userID {
+			return tag, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("tag")
+}
+
+func (r *stubTagRepository) GetByIDsForUser(ctx context.Context, userID int32, ids []int32) ([]*models.Tag, error) {
+	var result []*models.Tag
+	for _, tag := range r.tags {
+		for _, id := range ids {
+			if tag.ID == id && tag.UserID == userID {
+				result = append(result, tag)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func (r *stubTagRepository) GetByName(ctx context.Context, userID int32, name string) (*models.Tag, error) {
+	for _, tag := range r.tags {
+		if tag.UserID == userID && strings.EqualFold(tag.Name, name) {
+			return tag, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("tag")
+}
+
+func (r *stubTagRepository) GetOrCreateByName(ctx context.Context, userID int32, name string) (*models.Tag, error) {
+	if tag, err := r.GetByName(ctx, userID, name); err == nil {
+		return tag, nil
+	}
+	tag := &models.Tag{UserID: userID, Name: name}
+	return tag, r.Create(ctx, tag)
+}
+
+func (r *stubTagRepository) Update(ctx context.Context, tag *models.Tag) error {
+	return nil
+}
+
+func TestTagService_CreateAndRename(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubTagRepository{}
+	svc := NewTagService(repo)
+
+	created, err := svc.CreateTag(ctx, 7, &v1.CreateTagRequest{Name: "  trip-2026-japan "})
+	require.NoError(t, err)
+	assert.Equal(t, "trip-2026-japan", created.Data.Name)
+
+	_, err = svc.CreateTag(ctx, 7, &v1.CreateTagRequest{Name: "Trip-2026-Japan"})
+	assert.IsType(t, apperrors.ConflictError{}, err, "names are unique ignoring case")
+
+	_, err = svc.CreateTag(ctx, 8, &v1.CreateTagRequest{Name: "trip-2026-japan"})
+	assert.NoError(t, err, "another user can use the same name")
+
+	for _, name := range []string{"", "   ", "business, travel", strings.Repeat("x", 51)} {
+		_, err = svc.CreateTag(ctx, 7, &v1.CreateTagRequest{Name: name})
+		assert.IsType(t, apperrors.ValidationError{}, err, "name %q", name)
+	}
+
+	business, err := svc.CreateTag(ctx, 7, &v1.CreateTagRequest{Name: "business"})
+	require.NoError(t, err)
+
+	renamed, err := svc.UpdateTag(ctx, business.Data.Id, 7, &v1.UpdateTagRequest{Name: "Business"})
+	require.NoError(t, err, "a tag can change the case of its own name")
+	assert.Equal(t, "Business", renamed.Data.Name)
+
+	_, err = svc.UpdateTag(ctx, business.Data.Id, 7, &v1.UpdateTagRequest{Name: "TRIP-2026-JAPAN"})
+	assert.IsType(t, apperrors.ConflictError{}, err)
+
+	_, err = svc.UpdateTag(ctx, business.Data.Id, 8, &v1.UpdateTagRequest{Name: "reimbursable"})
+	assert.IsType(t, apperrors.NotFoundError{}, err, "other users cannot rename the tag")
+}
+
+func TestTransactionService_ResolveTags(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubTagRepository{tags: []*models.Tag{
+		{ID: 1, UserID: 7, Name: "business"},
+		{ID: 2, UserID: 7, Name: "reimbursable"},
+		{ID: 3, UserID: 8, Name: "business"},
+	}}
+	svc := &transactionService{}
+	svc.SetTagRepository(repo)
+
+	tags, err := svc.resolveTags(ctx, 7, []int32{2, 1, 2})
+	require.NoError(t, err)
+	assert.Len(t, tags, 2, "repeated IDs are ignored")
+
+	_, err = svc.resolveTags(ctx, 7, []int32{1, 3})
+	assert.IsType(t, apperrors.NotFoundError{}, err, "tags of another user are rejected")
+
+	tags, err = svc.resolveTags(ctx, 7, nil)
+	require.NoError(t, err)
+	assert.Nil(t, tags)
+}
+
+func TestTransactionService_BuildFilterTags(t *testing.T) {
+	svc := &transactionService{}
+
+	filter := svc.buildFilter(&v1.TransactionFilter{TagIds: []int32{1, 2}})
+	assert.Equal(t, []int32{1, 2}, filter.TagIDs)
+	assert.False(t, filter.TagMatchAll, "any is the default")
+
+	filter = svc.buildFilter(&v1.TransactionFilter{TagIds: []int32{1, 2}, TagMatch: v1.TagMatchMode_TAG_MATCH_MODE_ALL})
+	assert.True(t, filter.TagMatchAll)
+}
+
+func TestImportTagResolver(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubTagRepository{tags: []*models.Tag{{ID: 1, UserID: 7, Name: "Business"}}}
+	resolver := newImportTagResolver(repo, 7)
+
+	tags := resolver.resolve(ctx, []string{"business", "trip-2026-japan", "BUSINESS", strings.Repeat("x", 51)})
+	require.Len(t, tags, 2)
+	assert.Equal(t, int32(1), tags[0].ID, "existing tags are matched ignoring case")
+	assert.Equal(t, "trip-2026-japan", tags[1].Name)
+	assert.Len(t, repo.tags, 2, "missing tags are created once, invalid names skipped")
+
+	resolver.resolve(ctx, []string{"trip-2026-japan"})
+	assert.Len(t, repo.tags, 2)
+
+	assert.Nil(t, newImportTagResolver(nil, 7).resolve(ctx, []string{"business"}), "tags are dropped without a repository")
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	"wealthjourney/pkg/fx"
+	"wealthjourney/pkg/parser"
+)
+
+// exportPageSize bounds the transactions loaded per query during an export
+const exportPageSize = 500
+
+// ExportQIF writes the transactions of a wallet as a QIF bank account, oldest first,
+// with the names of their categories and split categories. The payee and memo are read
+// from the note the way QIF imports write them, so an exported file imports back
+// unchanged.
+func (s *transactionService) ExportQIF(ctx context.Context, userID, walletID int32, w io.Writer) error {
+	wallet, err := s.walletRepo.GetByIDForUser(ctx, walletID, userID)
+	if err != nil {
+		return err
+	}
+
+	// Page by ID so pages are stable, then order by date
+	var transactions []*models.Transaction
+	filter := repository.TransactionFilter{WalletID: &walletID}
+	for offset := 0; ; offset += exportPageSize {
+		page, total, err := s.txRepo.List(ctx, userID, filter, repository.ListOptions{
+			Limit:   exportPageSize,
+			Offset:  offset,
+			OrderBy: "id",
+			Order:   "asc",
+		})
+		if err != nil {
+			return err
+		}
+		transactions = append(transactions, page...)
+		if len(page) < exportPageSize || len(transactions) >= total {
+			break
+		}
+	}
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].Date.Before(transactions[j].Date)
+	})
+
+	// Split categories are not preloaded with the transactions
+	var splitCategoryIDs []int32
+	for _, tx := range transactions {
+		for _, split := range tx.Splits {
+			splitCategoryIDs = append(splitCategoryIDs, split.CategoryID)
+		}
+	}
+	splitCategories := map[int32]*models.Category{}
+	if len(splitCategoryIDs) > 0 {
+		if splitCategories, err = s.categoryRepo.GetByIDs(ctx, splitCategoryIDs); err != nil {
+			return err
+		}
+	}
+
+	// QIF amounts are written from the parser unit (×10000 of the major unit), which the
+	// import converts back to the wallet currency
+	toParserUnit := func(amount int64) int64 {
+		return fx.StorageToParsedAmount(amount, wallet.Currency)
+	}
+
+	records := make([]*parser.QIFTransaction, 0, len(transactions))
+	for _, tx := range transactions {
+		payee, memo := parser.SplitQIFNote(tx.Note)
+		record := &parser.QIFTransaction{
+			Date:   tx.Date,
+			Amount: toParserUnit(tx.Amount),
+			Payee:  payee,
+			Memo:   memo,
+		}
+		if tx.Category != nil {
+			record.Category = tx.Category.Name
+		}
+		for _, split := range tx.Splits {
+			line := parser.ParsedSplit{Memo: split.Note, Amount: toParserUnit(split.Amount)}
+			if category, ok := splitCategories[split.CategoryID]; ok {
+				line.CategoryName = category.Name
+			}
+			record.Splits = append(record.Splits, line)
+		}
+		records = append(records, record)
+	}
+
+	return parser.WriteQIF(w, parser.QIFTypeBank, records)
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/fx"
+	"wealthjourney/pkg/parser"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubWalletRepository serves a fixed set of wallets.
+type stubWalletRepository struct {
+	repository.WalletRepository
+	wallets map[int32]*models.Wallet
+}
+
+func (r *stubWalletRepository) GetByIDForUser(ctx context.Context, walletID, userID int32) (*models.Wallet, error) {
+	wallet, ok := r.wallets[walletID]
+	if !ok || wallet.UserID != userID {
+		return nil, apperrors.NewNotFoundError("wallet")
+	}
+	return wallet, nil
+}
+
+func (r *stubWalletRepository) ListByUserID(ctx context.Context, userID int32, opts repository.ListOptions) ([]*models.Wallet, int, error) {
+	var wallets []*models.Wallet
+	for _, wallet := range r.wallets {
+		if wallet.UserID == userID {
+			wallets = append(wallets, wallet)
+		}
+	}
+	sort.Slice(wallets, func(i, j int) bool { return wallets[i].ID < wallets[j].ID })
+	return wallets, len(wallets), nil
+}
+
+func (r *stubWalletRepository) GetByID(ctx context.Context, walletID int32) (*models.Wallet, error) {
+	wallet, ok := r.wallets[walletID]
+	if !ok {
+		return nil, apperrors.NewNotFoundError("wallet")
+	}
+	return wallet, nil
+}
+
+// stubTransactionListRepository pages through the transactions of one wallet in ID order.
+type stubTransactionListRepository struct {
+	repository.TransactionRepository
+	transactions []*models.Transaction
+}
+
+func (r *stubTransactionListRepository) List(ctx context.Context, userID int32, filter repository.TransactionFilter, opts repository.ListOptions) ([]*models.Transaction, int, error) {
+	var matched []*models.Transaction
+	for _, tx := range r.transactions {
+		if filter.WalletID == nil || tx.WalletID == *filter.WalletID {
+			matched = append(matched, tx)
+		}
+	}
+	start := min(opts.Offset, len(matched))
+	end := len(matched)
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, len(matched))
+	}
+	return matched[start:end], len(matched), nil
+}
+
+func newExportService(wallet *models.Wallet, categories *stubNamedCategoryRepository, transactions ...*models.Transaction) *transactionService {
+	return &transactionService{
+		txRepo:       &stubTransactionListRepository{transactions: transactions},
+		walletRepo:   &stubWalletRepository{wallets: map[int32]*models.Wallet{wallet.ID: wallet}},
+		categoryRepo: categories,
+	}
+}
+
+func TestTransactionService_ExportQIF(t *testing.T) {
+	ctx := context.Background()
+	tz, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	require.NoError(t, err)
+
+	categories := newStubNamedCategoryRepository(
+		&models.Category{ID: 1, UserID: 7, Name: "Housing:Rent"},
+		&models.Category{ID: 2, UserID: 7, Name: "Groceries"},
+		&models.Category{ID: 3, UserID: 7, Name: "Household"},
+	)
+	rent := &models.Transaction{
+		ID: 10, WalletID: 1, Amount: -8000000, Note: "Landlord\nJanuary rent",
+		Date:     time.Date(2024, 1, 5, 0, 0, 0, 0, tz),
+		Category: categories.byID[1],
+	}
+	groceries := &models.Transaction{
+		ID: 11, WalletID: 1, Amount: -450000, Note: "Co.op Mart",
+		Date: time.Date(2024, 1, 3, 0, 0, 0, 0, tz),
+		Splits: []models.TransactionSplit{
+			{CategoryID: 2, Amount: -300000, Note: "Food"},
+			{CategoryID: 3, Amount: -150000},
+		},
+	}
+	other := &models.Transaction{ID: 12, WalletID: 2, Amount: -1000, Date: rent.Date}
+
+	svc := newExportService(&models.Wallet{ID: 1, UserID: 7, Currency: "VND"}, categories, rent, groceries, other)
+
+	var buf bytes.Buffer
+	require.NoError(t, svc.ExportQIF(ctx, 7, 1, &buf))
+	assert.Equal(t, `!Type:Bank
+D01/03/2024
+T-450000.00
+PCo.op Mart
+SGroceries
+EFood
+$-300000.00
+SHousehold
+$-150000.00
+^
+D01/05/2024
+T-8000000.00
+PLandlord
+MJanuary rent
+LHousing:Rent
+^
+`, buf.String(), "oldest first, other wallets left out")
+
+	// Importing the export gives back the same values
+	path := filepath.Join(t.TempDir(), "export.qif")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	rows, err := parser.NewQIFParser(path, nil).Parse()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.True(t, rows[1].Date.Equal(rent.Date))
+	assert.Equal(t, rent.Amount*10000, rows[1].Amount)
+	assert.Equal(t, rent.Note, rows[1].Description)
+	assert.Equal(t, "Housing:Rent", rows[1].CategoryName)
+	assert.Equal(t, []parser.ParsedSplit{
+		{CategoryName: "Groceries", Memo: "Food", Amount: -3000000000},
+		{CategoryName: "Household", Amount: -1500000000},
+	}, rows[0].Splits)
+
+	err = svc.ExportQIF(ctx, 8, 1, &bytes.Buffer{})
+	assert.IsType(t, apperrors.NotFoundError{}, err, "another user's wallet")
+}
+
+func TestTransactionService_ExportQIF_MinorUnits(t *testing.T) {
+	tx := &models.Transaction{ID: 1, WalletID: 1, Amount: -12345, Note: "Bookshop", Date: time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)}
+	svc := newExportService(&models.Wallet{ID: 1, UserID: 7, Currency: "USD"}, newStubNamedCategoryRepository(), tx)
+
+	var buf bytes.Buffer
+	require.NoError(t, svc.ExportQIF(context.Background(), 7, 1, &buf))
+	assert.Equal(t, "!Type:Bank\nD02/01/2024\nT-123.45\nPBookshop\n^\n", buf.String())
+}
+
+func TestTransactionService_ExportQIF_RoundTripMinorUnits(t *testing.T) {
+	ctx := context.Background()
+	categories := newStubNamedCategoryRepository(
+		&models.Category{ID: 1, UserID: 7, Name: "Books", Type: int32(v1.CategoryType_CATEGORY_TYPE_EXPENSE)},
+		&models.Category{ID: 2, UserID: 7, Name: "Stationery", Type: int32(v1.CategoryType_CATEGORY_TYPE_EXPENSE)},
+	)
+	tx := &models.Transaction{
+		ID: 1, WalletID: 1, Amount: -12345, Note: "Bookshop",
+		Date: time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC),
+		Splits: []models.TransactionSplit{
+			{CategoryID: 1, Amount: -10000},
+			{CategoryID: 2, Amount: -2345},
+		},
+	}
+	svc := newExportService(&models.Wallet{ID: 1, UserID: 7, Currency: "USD"}, categories, tx)
+
+	var buf bytes.Buffer
+	require.NoError(t, svc.ExportQIF(ctx, 7, 1, &buf))
+	path := filepath.Join(t.TempDir(), "export.qif")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	rows, err := parser.NewQIFParser(path, nil).Parse()
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+
+	// The import stores the parsed amounts back in cents
+	amount := fx.ParsedToStorageAmount(rows[0].Amount, "USD")
+	assert.Equal(t, tx.Amount, amount)
+
+	lines := make([]*v1.ParsedSplit, 0, len(rows[0].Splits))
+	for _, split := range rows[0].Splits {
+		lines = append(lines, &v1.ParsedSplit{CategoryName: split.CategoryName, Amount: &v1.Money{Amount: split.Amount, Currency: "USD"}})
+	}
+	splits := newImportCategoryResolver(categories, 7).splits(ctx, lines, rows[0].Amount, amount, "USD")
+	require.Len(t, splits, 2)
+	assert.Equal(t, models.TransactionSplit{CategoryID: 1, Amount: -10000}, splits[0])
+	assert.Equal(t, models.TransactionSplit{CategoryID: 2, Amount: -2345}, splits[1])
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 	"time"
 
 	"wealthjourney/domain/models"
@@ -23,6 +24,15 @@ type transactionService struct {
 	userRepo     repository.UserRepository
 	fxRateSvc    FXRateService
 	currencyCache *cache.CurrencyCache
+
+	// Optional: transaction tags
+	tagRepo repository.TagRepository
+	// Optional: removes attachments of deleted transactions; see SetAttachmentCleaner
+	attachmentCleaner AttachmentCleaner
+	// Optional: transfers between wallets, edited and deleted through either leg
+	transferRepo repository.TransferRepository
+	// Optional: checks budget alerts after transactions are created or updated
+	budgetAlerts BudgetAlertEvaluator
 }
 
 // NewTransactionService creates a new TransactionService.
@@ -44,6 +54,17 @@ func NewTransactionService(
 	}
 }
 
+// SetTagRepository enables tagging transactions and the tag breakdown.
+func (s *transactionService) SetTagRepository(tagRepo repository.TagRepository) {
+	s.tagRepo = tagRepo
+}
+
+// SetBudgetAlertEvaluator makes created and updated transactions check the user's
+// budget alerts.
+func (s *transactionService) SetBudgetAlertEvaluator(evaluator BudgetAlertEvaluator) {
+	s.budgetAlerts = evaluator
+}
+
 // CreateTransaction creates a new transaction and updates wallet balance.
 func (s *transactionService) CreateTransaction(ctx context.Context, userID int32, req *v1.CreateTransactionRequest) (*v1.CreateTransactionResponse, error) {
 	// Validate amount is provided
@@ -66,6 +87,18 @@ func (s *transactionService) CreateTransaction(ctx context.Context, userID int32
 		}
 	}
 
+	// Validate split lines if provided
+	splits, err := s.buildSplits(ctx, userID, req.Amount.Amount, req.Splits)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate tags if provided
+	tags, err := s.resolveTags(ctx, userID, req.TagIds)
+	if err != nil {
+		return nil, err
+	}
+
 	// Calculate balance delta based on category type
 	balanceDelta := s.calculateBalanceDelta(req.Amount.Amount, category)
 
@@ -79,6 +112,8 @@ func (s *transactionService) CreateTransaction(ctx context.Context, userID int32
 	transaction := &models.Transaction{
 		WalletID: req.WalletId,
 		Amount:   req.Amount.Amount,
+		Splits:   splits,
+		Tags:     tags,
 	}
 
 	if req.CategoryId != nil {
@@ -123,6 +158,8 @@ func (s *transactionService) CreateTransaction(ctx context.Context, userID int32
 	// Enrich with conversion fields
 	s.enrichTransactionProto(ctx, userID, txProto, transaction, updatedWallet.Currency)
 
+	evaluateBudgetAlertsAsync(s.budgetAlerts, userID)
+
 	return &v1.CreateTransactionResponse{
 		Success: true,
 		Message: "Transaction created successfully",
@@ -227,6 +264,25 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, transactionI
 	if err != nil {
 		return nil, err
 	}
+	if oldTransaction.IsReconciled() {
+		return nil, apperrors.NewConflictError("transaction is reconciled and cannot be edited")
+	}
+
+	// Only the reconciliation workflow marks transactions reconciled
+	status := oldTransaction.Status
+	if req.Status != nil {
+		switch *req.Status {
+		case v1.TransactionStatus_TRANSACTION_STATUS_PENDING, v1.TransactionStatus_TRANSACTION_STATUS_CLEARED:
+			status = int32(*req.Status)
+		default:
+			return nil, apperrors.NewValidationError("status must be pending or cleared")
+		}
+	}
+
+	// Both legs of a transfer change together
+	if oldTransaction.IsTransfer() {
+		return s.updateTransfer(ctx, oldTransaction, userID, status, req)
+	}
 
 	// Determine which wallet to use (new wallet from request or existing)
 	targetWalletID := oldTransaction.WalletID
@@ -257,6 +313,37 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, transactionI
 		category, _ = s.categoryRepo.GetByID(ctx, *oldTransaction.CategoryID)
 	}
 
+	// Determine split lines: replace, clear, or keep existing ones (which must still add up)
+	var newSplits []models.TransactionSplit
+	replaceSplits := false
+	if len(req.Splits) > 0 {
+		newSplits, err = s.buildSplits(ctx, userID, req.Amount.Amount, req.Splits)
+		if err != nil {
+			return nil, err
+		}
+		replaceSplits = true
+	} else if req.ClearSplits {
+		replaceSplits = true
+	} else if oldTransaction.IsSplit() && models.SumSplitAmounts(oldTransaction.Splits) != req.Amount.Amount {
+		return nil, apperrors.NewValidationError("splits must sum to the transaction amount; provide updated splits or clear them")
+	}
+
+	// Determine tags: replace, clear, or keep existing ones
+	var newTagIDs []int32
+	replaceTags := false
+	if len(req.TagIds) > 0 {
+		tags, err := s.resolveTags(ctx, userID, req.TagIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range tags {
+			newTagIDs = append(newTagIDs, tag.ID)
+		}
+		replaceTags = true
+	} else if req.ClearTags {
+		replaceTags = true
+	}
+
 	// Handle wallet change if needed
 	if req.WalletId != nil && *req.WalletId != oldTransaction.WalletID {
 		// Revert old transaction's effect on old wallet
@@ -304,6 +391,7 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, transactionI
 		ID:       transactionID,
 		WalletID: targetWalletID,
 		Amount:   req.Amount.Amount,
+		Status:   status,
 	}
 	if req.CategoryId != nil {
 		updates.CategoryID = req.CategoryId
@@ -320,6 +408,18 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, transactionI
 		return nil, err
 	}
 
+	if replaceSplits {
+		if err := s.txRepo.ReplaceSplits(ctx, transactionID, newSplits); err != nil {
+			return nil, err
+		}
+	}
+
+	if replaceTags {
+		if err := s.txRepo.ReplaceTags(ctx, transactionID, newTagIDs); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get updated data
 	updatedTransaction, _ := s.txRepo.GetByID(ctx, transactionID)
 	updatedWallet, _ := s.walletRepo.GetByID(ctx, targetWalletID)
@@ -342,6 +442,8 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, transactionI
 	// Enrich with conversion fields
 	s.enrichTransactionProto(ctx, userID, txProto, updatedTransaction, updatedWallet.Currency)
 
+	evaluateBudgetAlertsAsync(s.budgetAlerts, userID)
+
 	return &v1.UpdateTransactionResponse{
 		Success: true,
 		Message: "Transaction updated successfully",
@@ -361,6 +463,12 @@ func (s *transactionService) DeleteTransaction(ctx context.Context, transactionI
 	if err != nil {
 		return nil, err
 	}
+	if transaction.IsReconciled() {
+		return nil, apperrors.NewConflictError("transaction is reconciled and cannot be deleted")
+	}
+	if transaction.IsTransfer() {
+		return s.deleteTransfer(ctx, transaction, userID)
+	}
 
 	// Get category to calculate balance restoration
 	var category *models.Category
@@ -384,6 +492,15 @@ func (s *transactionService) DeleteTransaction(ctx context.Context, transactionI
 		return nil, err
 	}
 
+	// Remove receipts and documents; a failure leaves them behind but the transaction is gone
+	if s.attachmentCleaner != nil {
+		if err := s.attachmentCleaner.DeleteTransactionAttachments(ctx, transactionID); err != nil {
+			slog.Warn("Failed to delete transaction attachments",
+				"transaction_id", transactionID,
+				"error", err)
+		}
+	}
+
 	// Invalidate currency cache
 	if err := s.invalidateTransactionCache(ctx, userID, transactionID); err != nil {
 		slog.Warn("Failed to invalidate currency cache",
@@ -478,9 +595,10 @@ func (s *transactionService) GetFinancialReport(ctx context.Context, userID int3
 	}
 
 	filter := repository.TransactionFilter{
-		WalletIDs: walletIDs,
-		StartDate: &startDate,
-		EndDate:   &endDate,
+		WalletIDs:        walletIDs,
+		StartDate:        &startDate,
+		EndDate:          &endDate,
+		ExcludeTransfers: !req.IncludeTransfers,
 	}
 
 	transactions, _, err := s.txRepo.List(ctx, userID, filter, repository.ListOptions{
@@ -526,6 +644,9 @@ func (s *transactionService) GetFinancialReport(ctx context.Context, userID int3
 		walletCurrencyMap[wallet.ID] = wallet.Currency
 	}
 
+	// Per-category monthly totals in preferred currency (splits go to their own category)
+	categoryMonthly := make(map[int32][]*v1.MonthlyFinancialData)
+
 	// Process transactions and aggregate by wallet and month
 	for _, tx := range transactions {
 		walletData := walletDataMap[tx.WalletID]
@@ -548,33 +669,44 @@ func (s *transactionService) GetFinancialReport(ctx context.Context, userID int3
 			walletCurrency = types.VND
 		}
 
-		// Convert amount to preferred currency for display
-		convertedAmount := tx.Amount
-		if walletCurrency != preferredCurrency {
-			converted, err := s.fxRateSvc.ConvertAmount(ctx, tx.Amount, walletCurrency, preferredCurrency)
-			if err != nil {
-				// Log error but continue with original amount as fallback
-				slog.Warn("Failed to convert amount for transaction",
-					"transaction_id", tx.ID,
-					"from_currency", walletCurrency,
-					"to_currency", preferredCurrency,
-					"error", err)
-			} else {
-				convertedAmount = converted
-			}
-		}
-
-		// Add to income or expense based on signed amount
-		// Amounts are signed: positive for income, negative for expense
+		// Income and expense follow the net amount of the transaction, so a split line
+		// with the opposite sign of its parent (e.g. a refund line on a receipt) reduces
+		// the parent's side instead of counting on the other one
+		convertedAmount := s.convertReportAmount(ctx, tx.ID, tx.Amount, walletCurrency, preferredCurrency)
 		if tx.Amount > 0 {
 			monthlyEntry.Income.Amount += tx.Amount
 			monthlyEntry.DisplayIncome.Amount += convertedAmount
 		} else {
-			monthlyEntry.Expense.Amount += -tx.Amount  // Convert to positive for display
-			monthlyEntry.DisplayExpense.Amount += -convertedAmount  // Convert to positive for display
+			monthlyEntry.Expense.Amount += -tx.Amount               // Convert to positive for display
+			monthlyEntry.DisplayExpense.Amount += -convertedAmount // Convert to positive for display
+		}
+
+		// The category breakdown reports a split transaction line by line
+		for _, line := range reportLines(tx) {
+			if line.categoryID == nil {
+				continue
+			}
+			convertedLine := s.convertReportAmount(ctx, tx.ID, line.amount, walletCurrency, preferredCurrency)
+			months, ok := categoryMonthly[*line.categoryID]
+			if !ok {
+				months = newMonthlyFinancialData(preferredCurrency)
+				categoryMonthly[*line.categoryID] = months
+			}
+			if convertedLine > 0 {
+				months[month].Income.Amount += convertedLine
+				months[month].DisplayIncome.Amount += convertedLine
+			} else {
+				months[month].Expense.Amount += -convertedLine
+				months[month].DisplayExpense.Amount += -convertedLine
+			}
 		}
 	}
 
+	categoryData, err := s.buildCategoryFinancialData(ctx, categoryMonthly)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build response
 	walletDataList := make([]*v1.WalletFinancialData, 0, len(walletDataMap))
 	for _, data := range walletDataMap {
@@ -619,10 +751,97 @@ func (s *transactionService) GetFinancialReport(ctx context.Context, userID int3
 		Year:      req.Year,
 		WalletData: walletDataList,
 		Totals:    totals,
+		CategoryData: categoryData,
 		Timestamp: time.Now().Format(time.RFC3339),
 	}, nil
 }
 
+// reportLine is a single category-attributed amount used for reporting.
+type reportLine struct {
+	categoryID *int32
+	amount     int64
+}
+
+// convertReportAmount converts an amount of a transaction to the report currency, or
+// returns it unchanged when no rate is available.
+func (s *transactionService) convertReportAmount(ctx context.Context, txID int32, amount int64, from, to string) int64 {
+	if from == to {
+		return amount
+	}
+	converted, err := s.fxRateSvc.ConvertAmount(ctx, amount, from, to)
+	if err != nil {
+		// Log error but continue with original amount as fallback
+		slog.Warn("Failed to convert amount for transaction",
+			"transaction_id", txID,
+			"from_currency", from,
+			"to_currency", to,
+			"error", err)
+		return amount
+	}
+	return converted
+}
+
+// reportLines returns the lines a transaction contributes to reports: one per
+// split for split transactions, otherwise the transaction itself.
+func reportLines(tx *models.Transaction) []reportLine {
+	if !tx.IsSplit() {
+		return []reportLine{{categoryID: tx.CategoryID, amount: tx.Amount}}
+	}
+
+	lines := make([]reportLine, len(tx.Splits))
+	for i := range tx.Splits {
+		lines[i] = reportLine{categoryID: &tx.Splits[i].CategoryID, amount: tx.Splits[i].Amount}
+	}
+	return lines
+}
+
+// newMonthlyFinancialData creates 12 empty monthly entries in the given currency.
+func newMonthlyFinancialData(currency string) []*v1.MonthlyFinancialData {
+	months := make([]*v1.MonthlyFinancialData, 12)
+	for month := 0; month < 12; month++ {
+		months[month] = &v1.MonthlyFinancialData{
+			Month:          int32(month),
+			Income:         &v1.Money{Amount: 0, Currency: currency},
+			Expense:        &v1.Money{Amount: 0, Currency: currency},
+			DisplayIncome:  &v1.Money{Amount: 0, Currency: currency},
+			DisplayExpense: &v1.Money{Amount: 0, Currency: currency},
+		}
+	}
+	return months
+}
+
+// buildCategoryFinancialData attaches category names and types to per-category monthly totals.
+func (s *transactionService) buildCategoryFinancialData(ctx context.Context, categoryMonthly map[int32][]*v1.MonthlyFinancialData) ([]*v1.CategoryFinancialData, error) {
+	if len(categoryMonthly) == 0 {
+		return []*v1.CategoryFinancialData{}, nil
+	}
+
+	categoryIDs := make([]int32, 0, len(categoryMonthly))
+	for id := range categoryMonthly {
+		categoryIDs = append(categoryIDs, id)
+	}
+	sort.Slice(categoryIDs, func(i, j int) bool { return categoryIDs[i] < categoryIDs[j] })
+
+	categories, err := s.categoryRepo.GetByIDs(ctx, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*v1.CategoryFinancialData, 0, len(categoryIDs))
+	for _, id := range categoryIDs {
+		data := &v1.CategoryFinancialData{
+			CategoryId:  id,
+			MonthlyData: categoryMonthly[id],
+		}
+		if category, ok := categories[id]; ok {
+			data.CategoryName = category.Name
+			data.Type = v1.CategoryType(category.Type)
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
 // GetCategoryBreakdown retrieves category-wise transaction summary for a date range.
 func (s *transactionService) GetCategoryBreakdown(ctx context.Context, userID int32, req *v1.GetCategoryBreakdownRequest) (*v1.GetCategoryBreakdownResponse, error) {
 	// Validate date range
@@ -652,8 +871,9 @@ func (s *transactionService) GetCategoryBreakdown(ctx context.Context, userID in
 
 	// Build filter
 	filter := repository.TransactionFilter{
-		StartDate: &startDate,
-		EndDate:   &endDate,
+		StartDate:        &startDate,
+		EndDate:          &endDate,
+		ExcludeTransfers: !req.IncludeTransfers,
 	}
 
 	// Apply wallet filter if provided
@@ -774,6 +994,94 @@ func (s *transactionService) GetCategoryBreakdown(ctx context.Context, userID in
 	}, nil
 }
 
+// GetTagBreakdown retrieves tag-wise income and expense for a date range, converted to
+// the user's preferred currency. A transaction with several tags counts towards each.
+func (s *transactionService) GetTagBreakdown(ctx context.Context, userID int32, req *v1.GetTagBreakdownRequest) (*v1.GetTagBreakdownResponse, error) {
+	// Validate date range
+	if req.StartDate <= 0 {
+		return nil, apperrors.NewValidationError("start_date must be greater than 0")
+	}
+	if req.EndDate <= 0 {
+		return nil, apperrors.NewValidationError("end_date must be greater than 0")
+	}
+	if req.StartDate > req.EndDate {
+		return nil, apperrors.NewValidationError("start_date must be less than or equal to end_date")
+	}
+
+	startDate := time.Unix(req.StartDate, 0)
+	endDate := time.Unix(req.EndDate, 0)
+
+	// Get user's preferred currency for aggregation
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	preferredCurrency := types.VND // Default
+	if user != nil && user.PreferredCurrency != "" {
+		preferredCurrency = user.PreferredCurrency
+	}
+
+	breakdownItems, err := s.txRepo.GetTagBreakdown(ctx, userID, repository.TransactionFilter{
+		StartDate:        &startDate,
+		EndDate:          &endDate,
+		WalletIDs:        req.WalletIds,
+		TagIDs:           req.TagIds,
+		ExcludeTransfers: !req.IncludeTransfers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*v1.TagBreakdownItem, 0, len(breakdownItems))
+	for _, item := range breakdownItems {
+		income := s.sumInCurrency(ctx, item.IncomeByCurrency, preferredCurrency)
+		expense := s.sumInCurrency(ctx, item.ExpenseByCurrency, preferredCurrency)
+
+		tags = append(tags, &v1.TagBreakdownItem{
+			TagId:            item.TagID,
+			TagName:          item.TagName,
+			Income:           &v1.Money{Amount: income, Currency: preferredCurrency},
+			Expense:          &v1.Money{Amount: expense, Currency: preferredCurrency},
+			Net:              &v1.Money{Amount: income - expense, Currency: preferredCurrency},
+			TransactionCount: item.TransactionCount,
+		})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].TagName < tags[j].TagName
+	})
+
+	message := "Tag breakdown retrieved successfully"
+	if len(tags) == 0 {
+		message = "No tagged transactions found for the specified period"
+	}
+
+	return &v1.GetTagBreakdownResponse{
+		Success:   true,
+		Message:   message,
+		Tags:      tags,
+		Currency:  preferredCurrency,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// sumInCurrency adds up per-currency amounts converted to the target currency.
+// Currencies that cannot be converted are left out, as in the category breakdown.
+func (s *transactionService) sumInCurrency(ctx context.Context, amounts map[string]int64, currency string) int64 {
+	var total int64
+	for from, amount := range amounts {
+		if from == currency || amount == 0 {
+			total += amount
+			continue
+		}
+		converted, err := s.fxRateSvc.ConvertAmount(ctx, amount, from, currency)
+		if err != nil {
+			continue
+		}
+		total += converted
+	}
+	return total
+}
+
 // Helper methods
 
 // calculateBalanceDelta calculates the balance change based on signed amount.
@@ -835,6 +1143,13 @@ func (s *transactionService) buildFilter(filter *v1.TransactionFilter) repositor
 	if filter.SearchNote != nil {
 		repoFilter.SearchNote = filter.SearchNote
 	}
+	if filter.Status != nil {
+		repoFilter.Status = filter.Status
+	}
+	if len(filter.TagIds) > 0 {
+		repoFilter.TagIDs = filter.TagIds
+		repoFilter.TagMatchAll = filter.TagMatch == v1.TagMatchMode_TAG_MATCH_MODE_ALL
+	}
 
 	return repoFilter
 }
@@ -854,11 +1169,20 @@ func (s *transactionService) modelToProto(tx *models.Transaction, wallet *models
 		CreatedAt: tx.CreatedAt.Unix(),
 		UpdatedAt: tx.UpdatedAt.Unix(),
 		Currency:  wallet.Currency, // Set the transaction's original currency
+		Splits:    splitsToProto(tx.Splits, wallet.Currency),
+		Status:    v1.TransactionStatus(tx.Status),
+		Tags:      transactionTagsToProto(tx.Tags),
 	}
 
 	if tx.CategoryID != nil {
 		proto.CategoryId = *tx.CategoryID
 	}
+	if tx.ReconciliationID != nil {
+		proto.ReconciliationId = *tx.ReconciliationID
+	}
+	if tx.TransferID != nil {
+		proto.TransferId = *tx.TransferID
+	}
 
 	return proto
 }
@@ -884,15 +1208,132 @@ func (s *transactionService) modelToProtoSimple(tx *models.Transaction) *v1.Tran
 		CreatedAt: tx.CreatedAt.Unix(),
 		Currency:  currency,
 		UpdatedAt: tx.UpdatedAt.Unix(),
+		Splits:    splitsToProto(tx.Splits, currency),
+		Status:    v1.TransactionStatus(tx.Status),
+		Tags:      transactionTagsToProto(tx.Tags),
 	}
 
 	if tx.CategoryID != nil {
 		proto.CategoryId = *tx.CategoryID
 	}
+	if tx.ReconciliationID != nil {
+		proto.ReconciliationId = *tx.ReconciliationID
+	}
+	if tx.TransferID != nil {
+		proto.TransferId = *tx.TransferID
+	}
 
 	return proto
 }
 
+// splitsToProto converts split lines to protobuf, using the parent's currency.
+func splitsToProto(splits []models.TransactionSplit, currency string) []*v1.TransactionSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	result := make([]*v1.TransactionSplit, len(splits))
+	for i, split := range splits {
+		result[i] = &v1.TransactionSplit{
+			Id:         split.ID,
+			CategoryId: split.CategoryID,
+			Amount: &v1.Money{
+				Amount:   split.Amount,
+				Currency: currency,
+			},
+			Note: split.Note,
+		}
+	}
+	return result
+}
+
+// transactionTagsToProto converts the tags of a transaction to protobuf.
+func transactionTagsToProto(tags []models.Tag) []*v1.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	result := make([]*v1.Tag, len(tags))
+	for i := range tags {
+		result[i] = tagToProto(&tags[i])
+	}
+	return result
+}
+
+// resolveTags loads the user's tags with the given IDs, ignoring repeated IDs.
+// Returns nil when no tag IDs are given.
+func (s *transactionService) resolveTags(ctx context.Context, userID int32, tagIDs []int32) ([]models.Tag, error) {
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+	if s.tagRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("tags are not configured")
+	}
+
+	distinct := make(map[int32]bool, len(tagIDs))
+	for _, id := range tagIDs {
+		distinct[id] = true
+	}
+
+	found, err := s.tagRepo.GetByIDsForUser(ctx, userID, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) != len(distinct) {
+		return nil, apperrors.NewNotFoundError("tag")
+	}
+
+	tags := make([]models.Tag, len(found))
+	for i, tag := range found {
+		tags[i] = *tag
+	}
+	return tags, nil
+}
+
+// buildSplits validates split lines against the parent amount and the user's categories.
+// Returns nil when no split lines are given.
+func (s *transactionService) buildSplits(ctx context.Context, userID int32, amount int64, lines []*v1.TransactionSplit) ([]models.TransactionSplit, error) {
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	if len(lines) < 2 {
+		return nil, apperrors.NewValidationError("a split transaction needs at least two lines")
+	}
+
+	splits := make([]models.TransactionSplit, 0, len(lines))
+	checked := make(map[int32]bool)
+	var total int64
+
+	for i, line := range lines {
+		if line == nil || line.Amount == nil || line.Amount.Amount == 0 {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("split %d: amount is required", i+1))
+		}
+		if line.CategoryId == 0 {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("split %d: category is required", i+1))
+		}
+
+		if !checked[line.CategoryId] {
+			if _, err := s.categoryRepo.GetByIDForUser(ctx, line.CategoryId, userID); err != nil {
+				return nil, err
+			}
+			checked[line.CategoryId] = true
+		}
+
+		total += line.Amount.Amount
+		splits = append(splits, models.TransactionSplit{
+			CategoryID: line.CategoryId,
+			Amount:     line.Amount.Amount,
+			Note:       line.Note,
+		})
+	}
+
+	if total != amount {
+		return nil, apperrors.NewValidationError(fmt.Sprintf("splits must sum to the transaction amount (got %d, expected %d)", total, amount))
+	}
+
+	return splits, nil
+}
+
 // deriveTransactionType converts category type to transaction type
 func deriveTransactionType(category *models.Category) v1.TransactionType {
 	if category == nil {
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubCategoryRepository only answers ownership checks; other methods panic if called.
+type stubCategoryRepository struct {
+	repository.CategoryRepository
+	owned map[int32]bool
+}
+
+func (r *stubCategoryRepository) GetByIDForUser(ctx context.Context, categoryID, userID int32) (*models.Category, error) {
+	if !r.owned[categoryID] {
+		return nil, apperrors.NewNotFoundError("category")
+	}
+	return &models.Category{ID: categoryID, UserID: userID}, nil
+}
+
+func splitLine(categoryID int32, amount int64) *v1.TransactionSplit {
+	return &v1.TransactionSplit{CategoryId: categoryID, Amount: &v1.Money{Amount: amount, Currency: "VND"}}
+}
+
+func TestTransactionService_BuildSplits(t *testing.T) {
+	ctx := context.Background()
+	svc := &transactionService{categoryRepo: &stubCategoryRepository{owned: map[int32]bool{1: true, 2: true, 3: true}}}
+
+	t.Run("no lines means no splits", func(t *testing.T) {
+		splits, err := svc.buildSplits(ctx, 1, -500000, nil)
+		require.NoError(t, err)
+		assert.Nil(t, splits)
+	})
+
+	t.Run("valid lines including a refund", func(t *testing.T) {
+		splits, err := svc.buildSplits(ctx, 1, -500000, []*v1.TransactionSplit{
+			splitLine(1, -300000),
+			splitLine(2, -250000),
+			splitLine(3, 50000),
+		})
+		require.NoError(t, err)
+		require.Len(t, splits, 3)
+		assert.Equal(t, int32(2), splits[1].CategoryID)
+		assert.Equal(t, int64(-500000), models.SumSplitAmounts(splits))
+	})
+
+	invalid := []struct {
+		name  string
+		lines []*v1.TransactionSplit
+	}{
+		{name: "single line", lines: []*v1.TransactionSplit{splitLine(1, -500000)}},
+		{name: "sum mismatch", lines: []*v1.TransactionSplit{splitLine(1, -300000), splitLine(2, -100000)}},
+		{name: "zero amount", lines: []*v1.TransactionSplit{splitLine(1, -500000), splitLine(2, 0)}},
+		{name: "missing category", lines: []*v1.TransactionSplit{splitLine(1, -300000), splitLine(0, -200000)}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.buildSplits(ctx, 1, -500000, tt.lines)
+			var validationErr apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+
+	t.Run("category of another user", func(t *testing.T) {
+		_, err := svc.buildSplits(ctx, 1, -500000, []*v1.TransactionSplit{splitLine(1, -300000), splitLine(9, -200000)})
+		assert.Error(t, err)
+	})
+}
+
+func TestReportLines(t *testing.T) {
+	categoryID := int32(5)
+	plain := &models.Transaction{CategoryID: &categoryID, Amount: -100000}
+
+	lines := reportLines(plain)
+	require.Len(t, lines, 1)
+	assert.Equal(t, categoryID, *lines[0].categoryID)
+	assert.Equal(t, int64(-100000), lines[0].amount)
+
+	split := &models.Transaction{
+		CategoryID: &categoryID,
+		Amount:     -500000,
+		Splits: []models.TransactionSplit{
+			{CategoryID: 1, Amount: -550000},
+			{CategoryID: 2, Amount: 50000},
+		},
+	}
+	lines = reportLines(split)
+	require.Len(t, lines, 2)
+	assert.Equal(t, int32(1), *lines[0].categoryID)
+	assert.Equal(t, int64(50000), lines[1].amount)
+}
+
+func TestTransactionService_GetFinancialReport_NetsSplits(t *testing.T) {
+	categories := newStubNamedCategoryRepository(
+		&models.Category{ID: 1, UserID: 7, Name: "Groceries", Type: int32(v1.CategoryType_CATEGORY_TYPE_EXPENSE)},
+		&models.Category{ID: 2, UserID: 7, Name: "Refunds", Type: int32(v1.CategoryType_CATEGORY_TYPE_INCOME)},
+	)
+	// A receipt with a returned item
+	receipt := &models.Transaction{
+		ID: 1, WalletID: 1, Amount: -100000, Date: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+		Splits: []models.TransactionSplit{
+			{CategoryID: 1, Amount: -120000},
+			{CategoryID: 2, Amount: 20000},
+		},
+	}
+	svc := newExportService(&models.Wallet{ID: 1, UserID: 7, Currency: "VND"}, categories, receipt)
+	svc.userRepo = &stubNoUserRepository{}
+
+	resp, err := svc.GetFinancialReport(context.Background(), 7, &v1.GetFinancialReportRequest{Year: 2024})
+	require.NoError(t, err)
+
+	require.Len(t, resp.WalletData, 1)
+	march := resp.WalletData[0].MonthlyData[2]
+	assert.Equal(t, int64(100000), march.Expense.Amount, "the refund line reduces the expense")
+	assert.Equal(t, int64(0), march.Income.Amount, "the refund line is not income")
+	assert.Equal(t, int64(100000), resp.Totals[2].DisplayExpense.Amount)
+
+	require.Len(t, resp.CategoryData, 2)
+	assert.Equal(t, int64(120000), resp.CategoryData[0].MonthlyData[2].Expense.Amount, "categories are reported line by line")
+	assert.Equal(t, int64(20000), resp.CategoryData[1].MonthlyData[2].Income.Amount)
+}
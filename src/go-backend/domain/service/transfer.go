@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	"wealthjourney/pkg/currency"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/fx"
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// SetTransferRepository wires the store for transfers between wallets.
+func (s *walletService) SetTransferRepository(transferRepo repository.TransferRepository) {
+	s.transferRepo = transferRepo
+}
+
+// SetTransferRepository enables editing and deleting transfers through either leg.
+func (s *transactionService) SetTransferRepository(transferRepo repository.TransferRepository) {
+	s.transferRepo = transferRepo
+}
+
+// resolveTransferAmount returns the amount received by the destination wallet and the
+// exchange rate for a transfer of amount out of the source wallet. Between wallets of
+// the same currency the rate is 1; otherwise the received amount is taken from the
+// request, derived from the requested rate, or converted at the current FX rate.
+func (s *walletService) resolveTransferAmount(ctx context.Context, req *v1.TransferFundsRequest, fromCurrency, toCurrency string) (int64, float64, error) {
+	amount := req.Amount.Amount
+
+	if fromCurrency == toCurrency {
+		if req.ToAmount != nil && req.ToAmount.Amount != 0 && req.ToAmount.Amount != amount {
+			return 0, 0, apperrors.NewValidationError("toAmount must equal amount for wallets with the same currency")
+		}
+		if req.ExchangeRate != nil && *req.ExchangeRate != 1 {
+			return 0, 0, apperrors.NewValidationError("exchange rate must be 1 for wallets with the same currency")
+		}
+		return amount, 1, nil
+	}
+
+	switch {
+	case req.ToAmount != nil && req.ToAmount.Amount != 0:
+		if req.ToAmount.Amount < 0 {
+			return 0, 0, apperrors.NewValidationError("toAmount must be positive")
+		}
+		if req.ToAmount.Currency != toCurrency {
+			return 0, 0, apperrors.NewValidationError("toAmount currency must match the destination wallet")
+		}
+		return req.ToAmount.Amount, transferRate(amount, fromCurrency, req.ToAmount.Amount, toCurrency), nil
+	case req.ExchangeRate != nil:
+		if *req.ExchangeRate <= 0 {
+			return 0, 0, apperrors.NewValidationError("exchange rate must be positive")
+		}
+		return convertTransferAmount(amount, *req.ExchangeRate, fromCurrency, toCurrency), *req.ExchangeRate, nil
+	default:
+		if s.fxRateSvc == nil {
+			return 0, 0, apperrors.NewValidationError("toAmount or exchangeRate is required for wallets with different currencies")
+		}
+		rate, err := s.fxRateSvc.GetRate(ctx, fromCurrency, toCurrency)
+		if err != nil {
+			return 0, 0, apperrors.NewValidationErrorWithCause("no exchange rate available; provide toAmount or exchangeRate", err)
+		}
+		return convertTransferAmount(amount, rate, fromCurrency, toCurrency), rate, nil
+	}
+}
+
+// convertTransferAmount converts an amount in smallest units at a rate between major units.
+func convertTransferAmount(amount int64, rate float64, fromCurrency, toCurrency string) int64 {
+	return currency.NewConverter(nil).ConvertAmountWithRateAndCurrencies(amount, rate, fromCurrency, toCurrency)
+}
+
+// transferRate derives the rate between major units from the amounts sent and received.
+func transferRate(sent int64, fromCurrency string, received int64, toCurrency string) float64 {
+	sentMajor := float64(sent) / float64(fx.GetDecimalMultiplier(fromCurrency))
+	receivedMajor := float64(received) / float64(fx.GetDecimalMultiplier(toCurrency))
+	return receivedMajor / sentMajor
+}
+
+// transferPair is a transfer with its outgoing and incoming legs.
+type transferPair struct {
+	transfer *models.Transfer
+	from     *models.Transaction
+	to       *models.Transaction
+}
+
+// sent returns the amount that left the source wallet, excluding the fee.
+func (p *transferPair) sent() int64 {
+	return -p.from.Amount
+}
+
+// isReconciled reports whether either leg is reconciled, which locks the transfer.
+func (p *transferPair) isReconciled() bool {
+	return p.from.IsReconciled() || p.to.IsReconciled()
+}
+
+// transferToProto converts a transfer and its legs to protobuf.
+func transferToProto(p *transferPair) *v1.Transfer {
+	var feeTransactionID int32
+	if p.transfer.FeeTransactionID != nil {
+		feeTransactionID = *p.transfer.FeeTransactionID
+	}
+	return &v1.Transfer{
+		Id:                p.transfer.ID,
+		FromWalletId:      p.transfer.FromWalletID,
+		ToWalletId:        p.transfer.ToWalletID,
+		FromTransactionId: p.transfer.FromTransactionID,
+		ToTransactionId:   p.transfer.ToTransactionID,
+		Amount:            &v1.Money{Amount: p.sent(), Currency: p.from.Currency},
+		ToAmount:          &v1.Money{Amount: p.to.Amount, Currency: p.to.Currency},
+		ExchangeRate:      p.transfer.ExchangeRate,
+		Fee:               &v1.Money{Amount: p.transfer.Fee, Currency: p.from.Currency},
+		Date:              p.from.Date.Unix(),
+		CreatedAt:         p.transfer.CreatedAt.Unix(),
+		FeeTransactionId:  feeTransactionID,
+	}
+}
+
+// loadTransfer loads the transfer a leg belongs to together with both legs.
+func (s *transactionService) loadTransfer(ctx context.Context, leg *models.Transaction, userID int32) (*transferPair, error) {
+	if s.transferRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("transfers are not configured")
+	}
+
+	transfer, err := s.transferRepo.GetByIDForUser(ctx, *leg.TransferID, userID)
+	if err != nil {
+		return nil, err
+	}
+	from, err := s.txRepo.GetByID(ctx, transfer.FromTransactionID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.txRepo.GetByID(ctx, transfer.ToTransactionID)
+	if err != nil {
+		return nil, err
+	}
+	return &transferPair{transfer: transfer, from: from, to: to}, nil
+}
+
+// updateTransfer applies an update of one leg to the whole transfer: the other leg
+// follows amount changes at the transfer's exchange rate and date changes. Notes,
+// status and tags stay per leg, and the fee transaction is edited on its own. The
+// wallets, categories and splits of a transfer cannot be changed.
+func (s *transactionService) updateTransfer(ctx context.Context, leg *models.Transaction, userID int32, status int32, req *v1.UpdateTransactionRequest) (*v1.UpdateTransactionResponse, error) {
+	if (req.WalletId != nil && *req.WalletId != leg.WalletID) ||
+		(req.CategoryId != nil && (leg.CategoryID == nil || *req.CategoryId != *leg.CategoryID)) ||
+		len(req.Splits) > 0 {
+		return nil, apperrors.NewValidationError("the wallets, category and splits of a transfer cannot be changed; delete the transfer and create a new one")
+	}
+
+	pair, err := s.loadTransfer(ctx, leg, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pair.isReconciled() {
+		return nil, apperrors.NewConflictError("transfer is reconciled and cannot be edited")
+	}
+
+	edited := pair.to
+	if leg.ID == pair.from.ID {
+		edited = pair.from
+		if req.Amount.Amount >= 0 {
+			return nil, apperrors.NewValidationError("the outgoing leg of a transfer must be negative")
+		}
+		pair.to.Amount = convertTransferAmount(-req.Amount.Amount, pair.transfer.ExchangeRate, pair.from.Currency, pair.to.Currency)
+		pair.from.Amount = req.Amount.Amount
+	} else {
+		if req.Amount.Amount <= 0 {
+			return nil, apperrors.NewValidationError("the incoming leg of a transfer must be positive")
+		}
+		sent := convertTransferAmount(req.Amount.Amount, 1/pair.transfer.ExchangeRate, pair.to.Currency, pair.from.Currency)
+		pair.from.Amount = -sent
+		pair.to.Amount = req.Amount.Amount
+	}
+
+	if req.Date != nil {
+		pair.from.Date = time.Unix(*req.Date, 0)
+		pair.to.Date = pair.from.Date
+	}
+	if req.Note != nil {
+		edited.Note = *req.Note
+	}
+	edited.Status = status
+
+	// Resolve tags before writing anything
+	var newTagIDs []int32
+	replaceTags := req.ClearTags
+	if len(req.TagIds) > 0 {
+		tags, err := s.resolveTags(ctx, userID, req.TagIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range tags {
+			newTagIDs = append(newTagIDs, tag.ID)
+		}
+		replaceTags = true
+	}
+
+	if err := s.transferRepo.Update(ctx, pair.transfer, pair.from, pair.to); err != nil {
+		return nil, err
+	}
+	if replaceTags {
+		if err := s.txRepo.ReplaceTags(ctx, edited.ID, newTagIDs); err != nil {
+			return nil, err
+		}
+	}
+	s.invalidateTransferCache(ctx, userID, pair)
+
+	updatedTransaction, err := s.txRepo.GetByID(ctx, edited.ID)
+	if err != nil {
+		return nil, err
+	}
+	updatedWallet, err := s.walletRepo.GetByID(ctx, edited.WalletID)
+	if err != nil {
+		return nil, err
+	}
+	var category *models.Category
+	if updatedTransaction.CategoryID != nil {
+		category, _ = s.categoryRepo.GetByID(ctx, *updatedTransaction.CategoryID)
+	}
+
+	txProto := s.modelToProto(updatedTransaction, updatedWallet, category)
+	s.enrichTransactionProto(ctx, userID, txProto, updatedTransaction, updatedWallet.Currency)
+
+	return &v1.UpdateTransactionResponse{
+		Success: true,
+		Message: "Transfer updated successfully",
+		Data:    txProto,
+		NewBalance: &v1.Money{
+			Amount:   updatedWallet.Balance,
+			Currency: updatedWallet.Currency,
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// deleteTransfer deletes both legs of the transfer a leg belongs to and restores both
+// wallet balances.
+func (s *transactionService) deleteTransfer(ctx context.Context, leg *models.Transaction, userID int32) (*v1.DeleteTransactionResponse, error) {
+	pair, err := s.loadTransfer(ctx, leg, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pair.isReconciled() {
+		return nil, apperrors.NewConflictError("transfer is reconciled and cannot be deleted")
+	}
+
+	if err := s.transferRepo.Delete(ctx, pair.transfer); err != nil {
+		return nil, err
+	}
+
+	// Remove receipts and documents; a failure leaves them behind but the transfer is gone
+	if s.attachmentCleaner != nil {
+		ids := []int32{pair.from.ID, pair.to.ID}
+		if pair.transfer.FeeTransactionID != nil {
+			ids = append(ids, *pair.transfer.FeeTransactionID)
+		}
+		for _, id := range ids {
+			if err := s.attachmentCleaner.DeleteTransactionAttachments(ctx, id); err != nil {
+				slog.Warn("Failed to delete transaction attachments",
+					"transaction_id", id,
+					"error", err)
+			}
+		}
+	}
+	s.invalidateTransferCache(ctx, userID, pair)
+
+	updatedWallet, err := s.walletRepo.GetByID(ctx, leg.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteTransactionResponse{
+		Success: true,
+		Message: "Transfer deleted successfully",
+		NewBalance: &v1.Money{
+			Amount:   updatedWallet.Balance,
+			Currency: updatedWallet.Currency,
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// invalidateTransferCache drops the cached converted amounts of both legs and the fee.
+func (s *transactionService) invalidateTransferCache(ctx context.Context, userID int32, pair *transferPair) {
+	if s.currencyCache == nil {
+		return
+	}
+	ids := []int32{pair.from.ID, pair.to.ID}
+	if pair.transfer.FeeTransactionID != nil {
+		ids = append(ids, *pair.transfer.FeeTransactionID)
+	}
+	for _, id := range ids {
+		if err := s.invalidateTransactionCache(ctx, userID, id); err != nil {
+			slog.Warn("Failed to invalidate currency cache",
+				"transaction_id", id,
+				"user_id", userID,
+				"error", err)
+		}
+	}
+}
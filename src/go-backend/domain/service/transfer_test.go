@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTransferLegRepository serves the legs stored by stubTransferRepository. GetByID
+// returns copies so changes only land through the transfer repository.
+type stubTransferLegRepository struct {
+	repository.TransactionRepository
+	transactions map[int32]*models.Transaction
+	listFilter   repository.TransactionFilter
+}
+
+func (r *stubTransferLegRepository) GetByID(ctx context.Context, id int32) (*models.Transaction, error) {
+	tx, ok := r.transactions[id]
+	if !ok {
+		return nil, apperrors.NewNotFoundError("transaction")
+	}
+	copied := *tx
+	return &copied, nil
+}
+
+func (r *stubTransferLegRepository) GetByIDForUser(ctx context.Context, id, userID int32) (*models.Transaction, error) {
+	return r.GetByID(ctx, id)
+}
+
+func (r *stubTransferLegRepository) FindByWalletAndDateRange(ctx context.Context, walletID int32, startDate, endDate time.Time) ([]*models.Transaction, error) {
+	var result []*models.Transaction
+	for _, tx := range r.transactions {
+		if tx.WalletID == walletID && !tx.Date.Before(startDate) && !tx.Date.After(endDate) {
+			result = append(result, tx)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+func (r *stubTransferLegRepository) List(ctx context.Context, userID int32, filter repository.TransactionFilter, opts repository.ListOptions) ([]*models.Transaction, int, error) {
+	r.listFilter = filter
+	return nil, 0, nil
+}
+
+func (r *stubTransferLegRepository) GetTagBreakdown(ctx context.Context, userID int32, filter repository.TransactionFilter) ([]*repository.TagBreakdownByCurrency, error) {
+	r.listFilter = filter
+	return nil, nil
+}
+
+// stubTransferRepository keeps transfers in memory and applies their legs to the wallet
+// stub like the real repository does.
+type stubTransferRepository struct {
+	wallets   *stubWalletRepository
+	legs      *stubTransferLegRepository
+	transfers map[string]*models.Transfer
+}
+
+func newStubTransferRepository(wallets ...*models.Wallet) *stubTransferRepository {
+	walletRepo := &stubWalletRepository{wallets: make(map[int32]*models.Wallet)}
+	for _, wallet := range wallets {
+		walletRepo.wallets[wallet.ID] = wallet
+	}
+	return &stubTransferRepository{
+		wallets:   walletRepo,
+		legs:      &stubTransferLegRepository{transactions: make(map[int32]*models.Transaction)},
+		transfers: make(map[string]*models.Transfer),
+	}
+}
+
+// applyDeltas moves the wallet balances, failing without changes when one would go negative.
+func (r *stubTransferRepository) applyDeltas(deltas map[int32]int64) error {
+	for walletID, delta := range deltas {
+		if r.wallets.wallets[walletID].Balance+delta < 0 {
+			return apperrors.NewValidationError("Insufficient balance")
+		}
+	}
+	for walletID, delta := range deltas {
+		r.wallets.wallets[walletID].Balance += delta
+	}
+	return nil
+}
+
+func (r *stubTransferRepository) Create(ctx context.Context, transfer *models.Transfer, from, to, fee *models.Transaction) error {
+	deltas := map[int32]int64{from.WalletID: from.Amount, to.WalletID: to.Amount}
+	if fee != nil {
+		deltas[fee.WalletID] += fee.Amount
+	}
+	if err := r.applyDeltas(deltas); err != nil {
+		return err
+	}
+	for _, leg := range []*models.Transaction{from, to} {
+		leg.ID = int32(len(r.legs.transactions) + 1)
+		leg.TransferID = &transfer.ID
+		copied := *leg
+		r.legs.transactions[leg.ID] = &copied
+	}
+	if fee != nil {
+		fee.ID = int32(len(r.legs.transactions) + 1)
+		copied := *fee
+		r.legs.transactions[fee.ID] = &copied
+		transfer.FeeTransactionID = &fee.ID
+	}
+	transfer.FromTransactionID = from.ID
+	transfer.ToTransactionID = to.ID
+	r.transfers[transfer.ID] = transfer
+	return nil
+}
+
+func (r *stubTransferRepository) GetByIDForUser(ctx context.Context, id string, userID int32) (*models.Transfer, error) {
+	transfer, ok := r.transfers[id]
+	if !ok || transfer.UserID != userID {
+		return nil, apperrors.NewNotFoundError("transfer")
+	}
+	return transfer, nil
+}
+
+func (r *stubTransferRepository) Update(ctx context.Context, transfer *models.Transfer, from, to *models.Transaction) error {
+	deltas := make(map[int32]int64)
+	for _, leg := range []*models.Transaction{from, to} {
+		deltas[leg.WalletID] += leg.Amount - r.legs.transactions[leg.ID].Amount
+	}
+	if err := r.applyDeltas(deltas); err != nil {
+		return err
+	}
+	for _, leg := range []*models.Transaction{from, to} {
+		copied := *leg
+		r.legs.transactions[leg.ID] = &copied
+	}
+	return nil
+}
+
+func (r *stubTransferRepository) Delete(ctx context.Context, transfer *models.Transfer) error {
+	from := r.legs.transactions[transfer.FromTransactionID]
+	to := r.legs.transactions[transfer.ToTransactionID]
+	deltas := map[int32]int64{from.WalletID: -from.Amount, to.WalletID: -to.Amount}
+	if transfer.FeeTransactionID != nil {
+		if fee, ok := r.legs.transactions[*transfer.FeeTransactionID]; ok {
+			deltas[fee.WalletID] -= fee.Amount
+			delete(r.legs.transactions, fee.ID)
+		}
+	}
+	if err := r.applyDeltas(deltas); err != nil {
+		return err
+	}
+	delete(r.legs.transactions, from.ID)
+	delete(r.legs.transactions, to.ID)
+	delete(r.transfers, transfer.ID)
+	return nil
+}
+
+func (r *stubTransferRepository) Link(ctx context.Context, transfer *models.Transfer, from, to *models.Transaction) error {
+	for _, leg := range []*models.Transaction{from, to} {
+		if r.legs.transactions[leg.ID].TransferID != nil {
+			return apperrors.NewConflictError("transaction is already part of a transfer")
+		}
+	}
+	for _, leg := range []*models.Transaction{from, to} {
+		leg.TransferID = &transfer.ID
+		r.legs.transactions[leg.ID].TransferID = &transfer.ID
+	}
+	transfer.FromTransactionID = from.ID
+	transfer.ToTransactionID = to.ID
+	r.transfers[transfer.ID] = transfer
+	return nil
+}
+
+func (r *stubTransferRepository) UnlinkWithTx(ctx context.Context, dbTx interface{}, transferIDs []string) error {
+	for _, id := range transferIDs {
+		for _, leg := range r.legs.transactions {
+			if leg.TransferID != nil && *leg.TransferID == id {
+				leg.TransferID = nil
+			}
+		}
+		delete(r.transfers, id)
+	}
+	return nil
+}
+
+func newTransferServices(repo *stubTransferRepository) (*walletService, *transactionService) {
+	categories := newStubNamedCategoryRepository()
+	walletSvc := &walletService{walletRepo: repo.wallets, categoryRepo: categories, transferRepo: repo}
+	txSvc := &transactionService{txRepo: repo.legs, walletRepo: repo.wallets, categoryRepo: categories, transferRepo: repo}
+	return walletSvc, txSvc
+}
+
+func money(amount int64, currency string) *v1.Money {
+	return &v1.Money{Amount: amount, Currency: currency}
+}
+
+func TestWalletService_TransferFunds(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubTransferRepository(
+		&models.Wallet{ID: 1, UserID: 7, WalletName: "Checking", Currency: "VND", Balance: 1000000},
+		&models.Wallet{ID: 2, UserID: 7, WalletName: "Savings", Currency: "VND"},
+	)
+	walletSvc, _ := newTransferServices(repo)
+
+	_, err := walletSvc.TransferFunds(ctx, 7, &v1.TransferFundsRequest{FromWalletId: 1, ToWalletId: 2, Amount: money(999000, "VND"), Fee: money(5000, "VND")})
+	require.Error(t, err, "the fee must be covered too")
+	assert.Equal(t, int64(1000000), repo.wallets.wallets[1].Balance)
+
+	resp, err := walletSvc.TransferFunds(ctx, 7, &v1.TransferFundsRequest{FromWalletId: 1, ToWalletId: 2, Amount: money(300000, "VND"), Fee: money(5000, "VND")})
+	require.NoError(t, err)
+	assert.Equal(t, int64(695000), repo.wallets.wallets[1].Balance)
+	assert.Equal(t, int64(300000), repo.wallets.wallets[2].Balance)
+
+	transfer := resp.Data
+	assert.Equal(t, int64(300000), transfer.Amount.Amount)
+	assert.Equal(t, int64(300000), transfer.ToAmount.Amount)
+	assert.Equal(t, int64(5000), transfer.Fee.Amount)
+	assert.Equal(t, 1.0, transfer.ExchangeRate)
+
+	from := repo.legs.transactions[transfer.FromTransactionId]
+	to := repo.legs.transactions[transfer.ToTransactionId]
+	assert.Equal(t, int64(-300000), from.Amount, "the outgoing leg leaves out the fee")
+	assert.Equal(t, transfer.Id, *from.TransferID)
+	assert.Equal(t, transfer.Id, *to.TransferID)
+
+	fee := repo.legs.transactions[transfer.FeeTransactionId]
+	require.NotNil(t, fee, "the fee is recorded as its own transaction")
+	assert.Equal(t, int64(-5000), fee.Amount)
+	assert.Equal(t, int32(1), fee.WalletID)
+	assert.Nil(t, fee.TransferID, "the fee is not a transfer leg, so reports count it")
+
+	_, err = walletSvc.TransferFunds(ctx, 7, &v1.TransferFundsRequest{FromWalletId: 1, ToWalletId: 2, Amount: money(1000, "VND"), ExchangeRate: func() *float64 { r := 1.1; return &r }()})
+	assert.IsType(t, apperrors.ValidationError{}, err, "wallets with the same currency transfer at 1")
+}
+
+func TestWalletService_TransferFunds_CrossCurrency(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubTransferRepository(
+		&models.Wallet{ID: 1, UserID: 7, WalletName: "USD", Currency: "USD", Balance: 10000},
+		&models.Wallet{ID: 2, UserID: 7, WalletName: "VND", Currency: "VND"},
+	)
+	walletSvc, _ := newTransferServices(repo)
+
+	rate := 25000.0
+	resp, err := walletSvc.TransferFunds(ctx, 7, &v1.TransferFundsRequest{FromWalletId: 1, ToWalletId: 2, Amount: money(2000, "USD"), ExchangeRate: &rate, Fee: money(100, "USD")})
+	require.NoError(t, err)
+	assert.Equal(t, int64(500000), resp.Data.ToAmount.Amount, "$20.00 at 25,000 VND per dollar")
+	assert.Equal(t, int64(7900), repo.wallets.wallets[1].Balance)
+	assert.Equal(t, int64(500000), repo.wallets.wallets[2].Balance)
+
+	resp, err = walletSvc.TransferFunds(ctx, 7, &v1.TransferFundsRequest{FromWalletId: 1, ToWalletId: 2, Amount: money(1000, "USD"), ToAmount: money(240000, "VND")})
+	require.NoError(t, err)
+	assert.InDelta(t, 24000.0, resp.Data.ExchangeRate, 0.0001, "the rate is derived from the amount received")
+
+	_, err = walletSvc.TransferFunds(ctx, 7, &v1.TransferFundsRequest{FromWalletId: 1, ToWalletId: 2, Amount: money(1000, "USD")})
+	assert.IsType(t, apperrors.ValidationError{}, err, "without an FX service the rate or the received amount is required")
+}
+
+func TestTransactionService_UpdateTransferLeg(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubTransferRepository(
+		&models.Wallet{ID: 1, UserID: 7, WalletName: "USD", Currency: "USD", Balance: 10000},
+		&models.Wallet{ID: 2, UserID: 7, WalletName: "VND", Currency: "VND"},
+	)
+	walletSvc, txSvc := newTransferServices(repo)
+
+	rate := 25000.0
+	resp, err := walletSvc.TransferFunds(ctx, 7, &v1.TransferFundsRequest{FromWalletId: 1, ToWalletId: 2, Amount: money(2000, "USD"), ExchangeRate: &rate, Fee: money(100, "USD")})
+	require.NoError(t, err)
+	fromID, toID := resp.Data.FromTransactionId, resp.Data.ToTransactionId
+
+	// Sending $30.00 instead: the incoming leg follows at the transfer's rate
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Unix()
+	note := "Rent share"
+	_, err = txSvc.UpdateTransaction(ctx, fromID, 7, &v1.UpdateTransactionRequest{Amount: money(-3000, "USD"), Date: &date, Note: &note})
+	require.NoError(t, err)
+	assert.Equal(t, int64(750000), repo.legs.transactions[toID].Amount)
+	assert.Equal(t, int64(6900), repo.wallets.wallets[1].Balance)
+	assert.Equal(t, int64(750000), repo.wallets.wallets[2].Balance)
+	assert.Equal(t, date, repo.legs.transactions[toID].Date.Unix(), "the date applies to both legs")
+	assert.Equal(t, note, repo.legs.transactions[fromID].Note)
+	assert.NotEqual(t, note, repo.legs.transactions[toID].Note, "notes stay per leg")
+
+	// Receiving 250,000 VND: the outgoing leg follows, the fee stays
+	_, err = txSvc.UpdateTransaction(ctx, toID, 7, &v1.UpdateTransactionRequest{Amount: money(250000, "VND")})
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1000), repo.legs.transactions[fromID].Amount)
+	assert.Equal(t, int64(8900), repo.wallets.wallets[1].Balance)
+	assert.Equal(t, int64(-100), repo.legs.transactions[resp.Data.FeeTransactionId].Amount)
+
+	otherWallet := int32(2)
+	_, err = txSvc.UpdateTransaction(ctx, fromID, 7, &v1.UpdateTransactionRequest{Amount: money(-1000, "USD"), WalletId: &otherWallet})
+	assert.IsType(t, apperrors.ValidationError{}, err, "legs cannot move between wallets")
+
+	_, err = txSvc.UpdateTransaction(ctx, fromID, 7, &v1.UpdateTransactionRequest{Amount: money(50, "USD")})
+	assert.IsType(t, apperrors.ValidationError{}, err, "the outgoing leg must be negative")
+
+	repo.legs.transactions[toID].Status = int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)
+	_, err = txSvc.UpdateTransaction(ctx, fromID, 7, &v1.UpdateTransactionRequest{Amount: money(-2100, "USD")})
+	assert.IsType(t, apperrors.ConflictError{}, err, "a reconciled leg locks the whole transfer")
+}
+
+func TestTransactionService_DeleteTransferLeg(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubTransferRepository(
+		&models.Wallet{ID: 1, UserID: 7, WalletName: "Checking", Currency: "VND", Balance: 1000000},
+		&models.Wallet{ID: 2, UserID: 7, WalletName: "Savings", Currency: "VND"},
+	)
+	walletSvc, txSvc := newTransferServices(repo)
+
+	resp, err := walletSvc.TransferFunds(ctx, 7, &v1.TransferFundsRequest{FromWalletId: 1, ToWalletId: 2, Amount: money(300000, "VND"), Fee: money(5000, "VND")})
+	require.NoError(t, err)
+
+	deleted, err := txSvc.DeleteTransaction(ctx, resp.Data.ToTransactionId, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted.NewBalance.Amount)
+	assert.Equal(t, int64(1000000), repo.wallets.wallets[1].Balance, "the fee is refunded with the transfer")
+	assert.Empty(t, repo.legs.transactions, "both legs and the fee are deleted")
+	assert.Empty(t, repo.transfers)
+}
+
+func TestTransactionService_ReportsExcludeTransfers(t *testing.T) {
+	ctx := context.Background()
+	legs := &stubTransferLegRepository{}
+	svc := &transactionService{
+		txRepo:     legs,
+		walletRepo: &stubWalletRepository{wallets: map[int32]*models.Wallet{1: {ID: 1, UserID: 7, Currency: "VND"}}},
+		userRepo:   &stubNoUserRepository{},
+	}
+
+	_, err := svc.GetFinancialReport(ctx, 7, &v1.GetFinancialReportRequest{Year: 2024})
+	require.NoError(t, err)
+	assert.True(t, legs.listFilter.ExcludeTransfers, "transfers are excluded by default")
+
+	_, err = svc.GetFinancialReport(ctx, 7, &v1.GetFinancialReportRequest{Year: 2024, IncludeTransfers: true})
+	require.NoError(t, err)
+	assert.False(t, legs.listFilter.ExcludeTransfers)
+
+	svc.userRepo = &stubPreferredCurrencyUserRepository{currency: "VND"}
+	_, err = svc.GetTagBreakdown(ctx, 7, &v1.GetTagBreakdownRequest{StartDate: 1, EndDate: 2})
+	require.NoError(t, err)
+	assert.True(t, legs.listFilter.ExcludeTransfers, "tag reports leave out transfers too")
+
+	_, err = svc.GetTagBreakdown(ctx, 7, &v1.GetTagBreakdownRequest{StartDate: 1, EndDate: 2, IncludeTransfers: true})
+	require.NoError(t, err)
+	assert.False(t, legs.listFilter.ExcludeTransfers)
+}
+
+// stubNoUserRepository finds no users.
+type stubNoUserRepository struct {
+	repository.UserRepository
+}
+
+func (r *stubNoUserRepository) GetByID(ctx context.Context, id int32) (*models.User, error) {
+	return nil, apperrors.NewNotFoundError(fmt.Sprintf("user %d", id))
+}
@@ -644,6 +644,12 @@ func (s *userService) UpdatePreferences(ctx context.Context, userID int32, req *
 	var preferredCurrency string
 	if req.Preferences != nil {
 		preferredCurrency = req.Preferences.PreferredCurrency
+
+		if req.Preferences.DefaultCostBasisMethod != v1.CostBasisMethod_COST_BASIS_METHOD_UNSPECIFIED {
+			if err := s.updateDefaultCostBasisMethod(ctx, userID, req.Preferences.DefaultCostBasisMethod); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Call the existing implementation
@@ -660,3 +666,27 @@ func (s *userService) UpdatePreferences(ctx context.Context, userID int32, req *
 		Timestamp: updateResp.Timestamp,
 	}, nil
 }
+
+// updateDefaultCostBasisMethod sets the cost-basis method applied to the user's new investments.
+func (s *userService) updateDefaultCostBasisMethod(ctx context.Context, userID int32, method v1.CostBasisMethod) error {
+	if err := validator.ID(userID); err != nil {
+		return err
+	}
+	if err := validateCostBasisMethod(method); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.DefaultCostBasisMethod == int32(method) {
+		return nil
+	}
+
+	user.DefaultCostBasisMethod = int32(method)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to update user preferences: %w", err)
+	}
+	return nil
+}
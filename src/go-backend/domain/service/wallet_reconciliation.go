@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/types"
+	"wealthjourney/pkg/validator"
+	walletv1 "wealthjourney/protobuf/v1"
+)
+
+// SetReconciliationRepository wires the store for wallet reconciliations.
+func (s *walletService) SetReconciliationRepository(reconciliationRepo repository.WalletReconciliationRepository) {
+	s.reconciliationRepo = reconciliationRepo
+}
+
+// reconciliationState is an open reconciliation computed from the wallet's transactions
+type reconciliationState struct {
+	opening      int64                 // Sum of the transactions reconciled before
+	cleared      int64                 // Opening plus the cleared candidates
+	candidates   []*models.Transaction // Unreconciled transactions up to the statement date
+	clearedIDs   []int32
+	candidateIDs map[int32]bool
+}
+
+// computeReconciliation sums the cleared candidates onto the opening balance
+func computeReconciliation(opening int64, candidates []*models.Transaction) *reconciliationState {
+	state := &reconciliationState{
+		opening:      opening,
+		cleared:      opening,
+		candidates:   candidates,
+		candidateIDs: make(map[int32]bool, len(candidates)),
+	}
+	for _, tx := range candidates {
+		state.candidateIDs[tx.ID] = true
+		if tx.Status == int32(walletv1.TransactionStatus_TRANSACTION_STATUS_CLEARED) {
+			state.cleared += tx.Amount
+			state.clearedIDs = append(state.clearedIDs, tx.ID)
+		}
+	}
+	return state
+}
+
+// statementThrough returns the last instant of the statement day; transactions dated
+// any time that day belong to the statement
+func statementThrough(statementDate time.Time) time.Time {
+	return startOfDayUTC(statementDate).AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+// loadReconciliation computes the state of an open reconciliation
+func (s *walletService) loadReconciliation(ctx context.Context, reconciliation *models.WalletReconciliation) (*reconciliationState, error) {
+	opening, err := s.txRepo.GetReconciledSum(ctx, reconciliation.WalletID)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.txRepo.ListUnreconciled(ctx, reconciliation.WalletID, statementThrough(reconciliation.StatementDate))
+	if err != nil {
+		return nil, err
+	}
+
+	state := computeReconciliation(opening, candidates)
+	reconciliation.OpeningBalance = state.opening
+	reconciliation.ClearedBalance = state.cleared
+	return state, nil
+}
+
+// getReconciliation retrieves a reconciliation of the user's wallet
+func (s *walletService) getReconciliation(ctx context.Context, walletID, reconciliationID, userID int32) (*models.WalletReconciliation, *models.Wallet, error) {
+	if s.reconciliationRepo == nil {
+		return nil, nil, apperrors.NewServiceUnavailableError("wallet reconciliation is not configured")
+	}
+	if err := validator.ID(reconciliationID); err != nil {
+		return nil, nil, err
+	}
+
+	wallet, err := s.walletRepo.GetByIDForUser(ctx, walletID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	reconciliation, err := s.reconciliationRepo.GetByIDForUser(ctx, reconciliationID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reconciliation.WalletID != wallet.ID {
+		return nil, nil, apperrors.NewNotFoundError("reconciliation")
+	}
+	return reconciliation, wallet, nil
+}
+
+// getOpenReconciliation retrieves a reconciliation that is still in progress
+func (s *walletService) getOpenReconciliation(ctx context.Context, walletID, reconciliationID, userID int32) (*models.WalletReconciliation, *models.Wallet, error) {
+	reconciliation, wallet, err := s.getReconciliation(ctx, walletID, reconciliationID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reconciliation.Status != int32(walletv1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_IN_PROGRESS) {
+		return nil, nil, apperrors.NewConflictError("reconciliation is no longer in progress")
+	}
+	return reconciliation, wallet, nil
+}
+
+// StartReconciliation starts reconciling a wallet against a bank statement. A wallet has
+// at most one open reconciliation; starting again resumes it with the new statement.
+func (s *walletService) StartReconciliation(ctx context.Context, walletID int32, userID int32, req *walletv1.StartReconciliationRequest) (*walletv1.StartReconciliationResponse, error) {
+	if err := validator.ID(walletID); err != nil {
+		return nil, err
+	}
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if s.reconciliationRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("wallet reconciliation is not configured")
+	}
+	if req.StatementDate <= 0 {
+		return nil, apperrors.NewValidationError("statement date is required")
+	}
+	if req.StatementBalance == nil {
+		return nil, apperrors.NewValidationError("statement balance is required")
+	}
+
+	wallet, err := s.walletRepo.GetByIDForUser(ctx, walletID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if req.StatementBalance.Currency != "" && req.StatementBalance.Currency != wallet.Currency {
+		return nil, apperrors.NewValidationError(fmt.Sprintf("statement balance must be in the wallet currency %s", wallet.Currency))
+	}
+
+	reconciliation, err := s.reconciliationRepo.GetInProgress(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	statementDate := startOfDayUTC(time.Unix(req.StatementDate, 0))
+	message := "Reconciliation resumed"
+	if reconciliation == nil {
+		reconciliation = &models.WalletReconciliation{
+			UserID:           userID,
+			WalletID:         walletID,
+			StatementDate:    statementDate,
+			StatementBalance: req.StatementBalance.Amount,
+			Status:           int32(walletv1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_IN_PROGRESS),
+		}
+		if err := s.reconciliationRepo.Create(ctx, reconciliation); err != nil {
+			return nil, err
+		}
+		message = "Reconciliation started"
+	} else {
+		reconciliation.StatementDate = statementDate
+		reconciliation.StatementBalance = req.StatementBalance.Amount
+		if err := s.reconciliationRepo.Update(ctx, reconciliation); err != nil {
+			return nil, err
+		}
+	}
+
+	state, err := s.loadReconciliation(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &walletv1.StartReconciliationResponse{
+		Success:      true,
+		Message:      message,
+		Data:         s.mapper.ReconciliationToProto(reconciliation, wallet.Currency),
+		Transactions: s.mapper.ReconciliationTransactionsToProto(state.candidates, wallet.Currency),
+		Timestamp:    time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ListReconciliations lists the reconciliations of a wallet, latest statement first.
+func (s *walletService) ListReconciliations(ctx context.Context, walletID int32, userID int32, req *walletv1.ListReconciliationsRequest) (*walletv1.ListReconciliationsResponse, error) {
+	if err := validator.ID(walletID); err != nil {
+		return nil, err
+	}
+	if err := validator.ID(userID); err != nil {
+		return nil, err
+	}
+	if s.reconciliationRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("wallet reconciliation is not configured")
+	}
+
+	wallet, err := s.walletRepo.GetByIDForUser(ctx, walletID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewPaginationParams()
+	if req.Pagination != nil {
+		params = types.PaginationParams{
+			Page:     int(req.Pagination.Page),
+			PageSize: int(req.Pagination.PageSize),
+		}
+	}
+	params = params.Validate()
+
+	reconciliations, total, err := s.reconciliationRepo.ListByWalletID(ctx, walletID, repository.ListOptions{
+		Limit:  params.Limit(),
+		Offset: params.Offset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Open reconciliations show their live balances
+	for _, reconciliation := range reconciliations {
+		if reconciliation.Status == int32(walletv1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_IN_PROGRESS) {
+			if _, err := s.loadReconciliation(ctx, reconciliation); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	data := make([]*walletv1.WalletReconciliation, len(reconciliations))
+	for i, reconciliation := range reconciliations {
+		data[i] = s.mapper.ReconciliationToProto(reconciliation, wallet.Currency)
+	}
+
+	return &walletv1.ListReconciliationsResponse{
+		Success:         true,
+		Message:         "Reconciliations retrieved successfully",
+		Reconciliations: data,
+		Pagination:      s.mapper.PaginationResultToProto(types.NewPaginationResult(params.Page, params.PageSize, total)),
+		Timestamp:       time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// GetReconciliation retrieves a reconciliation. Open reconciliations include the
+// transactions that can be ticked.
+func (s *walletService) GetReconciliation(ctx context.Context, walletID int32, reconciliationID int32, userID int32) (*walletv1.GetReconciliationResponse, error) {
+	reconciliation, wallet, err := s.getReconciliation(ctx, walletID, reconciliationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var transactions []*walletv1.ReconciliationTransaction
+	if reconciliation.Status == int32(walletv1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_IN_PROGRESS) {
+		state, err := s.loadReconciliation(ctx, reconciliation)
+		if err != nil {
+			return nil, err
+		}
+		transactions = s.mapper.ReconciliationTransactionsToProto(state.candidates, wallet.Currency)
+	}
+
+	return &walletv1.GetReconciliationResponse{
+		Success:      true,
+		Message:      "Reconciliation retrieved successfully",
+		Data:         s.mapper.ReconciliationToProto(reconciliation, wallet.Currency),
+		Transactions: transactions,
+		Timestamp:    time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// UpdateReconciliationTransactions ticks (clears) or unticks transactions in an open
+// reconciliation and returns the new difference.
+func (s *walletService) UpdateReconciliationTransactions(ctx context.Context, walletID int32, reconciliationID int32, userID int32, req *walletv1.UpdateReconciliationTransactionsRequest) (*walletv1.UpdateReconciliationTransactionsResponse, error) {
+	reconciliation, wallet, err := s.getOpenReconciliation(ctx, walletID, reconciliationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ClearedTransactionIds) == 0 && len(req.PendingTransactionIds) == 0 {
+		return nil, apperrors.NewValidationError("no transactions to update")
+	}
+
+	state, err := s.loadReconciliation(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+	for _, ids := range [][]int32{req.ClearedTransactionIds, req.PendingTransactionIds} {
+		for _, id := range ids {
+			if !state.candidateIDs[id] {
+				return nil, apperrors.NewValidationError(fmt.Sprintf("transaction %d is not part of this reconciliation", id))
+			}
+		}
+	}
+
+	if _, err := s.txRepo.UpdateStatus(ctx, walletID, req.ClearedTransactionIds, walletv1.TransactionStatus_TRANSACTION_STATUS_CLEARED); err != nil {
+		return nil, err
+	}
+	if _, err := s.txRepo.UpdateStatus(ctx, walletID, req.PendingTransactionIds, walletv1.TransactionStatus_TRANSACTION_STATUS_PENDING); err != nil {
+		return nil, err
+	}
+
+	state, err = s.loadReconciliation(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &walletv1.UpdateReconciliationTransactionsResponse{
+		Success:      true,
+		Message:      "Reconciliation updated",
+		Data:         s.mapper.ReconciliationToProto(reconciliation, wallet.Currency),
+		Transactions: s.mapper.ReconciliationTransactionsToProto(state.candidates, wallet.Currency),
+		Timestamp:    time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// FinishReconciliation completes a reconciliation whose difference is zero. The cleared
+// transactions become reconciled and can no longer be edited or deleted.
+func (s *walletService) FinishReconciliation(ctx context.Context, walletID int32, reconciliationID int32, userID int32) (*walletv1.FinishReconciliationResponse, error) {
+	reconciliation, wallet, err := s.getOpenReconciliation(ctx, walletID, reconciliationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.loadReconciliation(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+	if difference := reconciliation.StatementBalance - state.cleared; difference != 0 {
+		return nil, apperrors.NewValidationError(fmt.Sprintf("cleared balance differs from the statement balance by %d; tick or untick transactions until the difference is zero", difference))
+	}
+
+	now := time.Now()
+	reconciliation.Status = int32(walletv1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_COMPLETED)
+	reconciliation.TransactionCount = int32(len(state.clearedIDs))
+	reconciliation.CompletedAt = &now
+	if err := s.reconciliationRepo.Complete(ctx, reconciliation, state.clearedIDs); err != nil {
+		return nil, err
+	}
+
+	return &walletv1.FinishReconciliationResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Reconciled %d transactions", reconciliation.TransactionCount),
+		Data:      s.mapper.ReconciliationToProto(reconciliation, wallet.Currency),
+		Timestamp: now.Format(time.RFC3339),
+	}, nil
+}
+
+// CancelReconciliation abandons an open reconciliation. Ticked transactions stay cleared
+// for the next reconciliation.
+func (s *walletService) CancelReconciliation(ctx context.Context, walletID int32, reconciliationID int32, userID int32) (*walletv1.CancelReconciliationResponse, error) {
+	reconciliation, wallet, err := s.getOpenReconciliation(ctx, walletID, reconciliationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the balances as they stood for the history
+	if _, err := s.loadReconciliation(ctx, reconciliation); err != nil {
+		return nil, err
+	}
+	reconciliation.Status = int32(walletv1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_CANCELLED)
+	if err := s.reconciliationRepo.Update(ctx, reconciliation); err != nil {
+		return nil, err
+	}
+
+	return &walletv1.CancelReconciliationResponse{
+		Success:   true,
+		Message:   "Reconciliation cancelled",
+		Data:      s.mapper.ReconciliationToProto(reconciliation, wallet.Currency),
+		Timestamp: time.Now().Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/domain/repository"
+	apperrors "wealthjourney/pkg/errors"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubReconcileTransactionRepository keeps the transactions of one wallet in memory.
+type stubReconcileTransactionRepository struct {
+	repository.TransactionRepository
+	transactions []*models.Transaction
+}
+
+func (r *stubReconcileTransactionRepository) GetByIDForUser(ctx context.Context, txID, userID int32) (*models.Transaction, error) {
+	for _, tx := range r.transactions {
+		if tx.ID == txID {
+			return tx, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("transaction")
+}
+
+func (r *stubReconcileTransactionRepository) ListUnreconciled(ctx context.Context, walletID int32, through time.Time) ([]*models.Transaction, error) {
+	var result []*models.Transaction
+	for _, tx := range r.transactions {
+		if tx.WalletID == walletID && !tx.Date.After(through) && !tx.IsReconciled() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+
+func (r *stubReconcileTransactionRepository) GetReconciledSum(ctx context.Context, walletID int32) (int64, error) {
+	var sum int64
+	for _, tx := range r.transactions {
+		if tx.WalletID == walletID && tx.IsReconciled() {
+			sum += tx.Amount
+		}
+	}
+	return sum, nil
+}
+
+func (r *stubReconcileTransactionRepository) UpdateStatus(ctx context.Context, walletID int32, transactionIDs []int32, status v1.TransactionStatus) (int, error) {
+	updated := 0
+	for _, tx := range r.transactions {
+		for _, id := range transactionIDs {
+			if tx.ID == id && tx.WalletID == walletID && !tx.IsReconciled() {
+				tx.Status = int32(status)
+				updated++
+			}
+		}
+	}
+	return updated, nil
+}
+
+// stubReconciliationRepository stores reconciliations in memory and applies Complete
+// to the transaction stub.
+type stubReconciliationRepository struct {
+	repository.WalletReconciliationRepository
+	transactions    *stubReconcileTransactionRepository
+	reconciliations []*models.WalletReconciliation
+}
+
+func (r *stubReconciliationRepository) Create(ctx context.Context, reconciliation *models.WalletReconciliation) error {
+	reconciliation.ID = int32(len(r.reconciliations) + 1)
+	r.reconciliations = append(r.reconciliations, reconciliation)
+	return nil
+}
+
+func (r *stubReconciliationRepository) GetByIDForUser(ctx context.Context, id, userID int32) (*models.WalletReconciliation, error) {
+	for _, reconciliation := range r.reconciliations {
+		if reconciliation.ID == id && reconciliation.UserID == userID {
+			return reconciliation, nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("reconciliation")
+}
+
+func (r *stubReconciliationRepository) GetInProgress(ctx context.Context, walletID int32) (*models.WalletReconciliation, error) {
+	for _, reconciliation := range r.reconciliations {
+		if reconciliation.WalletID == walletID && reconciliation.Status == int32(v1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_IN_PROGRESS) {
+			return reconciliation, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *stubReconciliationRepository) Update(ctx context.Context, reconciliation *models.WalletReconciliation) error {
+	return nil
+}
+
+func (r *stubReconciliationRepository) Complete(ctx context.Context, reconciliation *models.WalletReconciliation, transactionIDs []int32) error {
+	for _, tx := range r.transactions.transactions {
+		for _, id := range transactionIDs {
+			if tx.ID == id {
+				tx.Status = int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)
+				tx.ReconciliationID = &reconciliation.ID
+			}
+		}
+	}
+	return nil
+}
+
+func reconcileTx(id int32, amount int64, date time.Time) *models.Transaction {
+	return &models.Transaction{ID: id, WalletID: 1, Amount: amount, Date: date, Status: int32(v1.TransactionStatus_TRANSACTION_STATUS_PENDING)}
+}
+
+func TestComputeReconciliation(t *testing.T) {
+	cleared := reconcileTx(1, 5000, time.Now())
+	cleared.Status = int32(v1.TransactionStatus_TRANSACTION_STATUS_CLEARED)
+	state := computeReconciliation(100000, []*models.Transaction{cleared, reconcileTx(2, -2000, time.Now())})
+
+	assert.Equal(t, int64(105000), state.cleared, "only cleared transactions count")
+	assert.Equal(t, []int32{1}, state.clearedIDs)
+	assert.True(t, state.candidateIDs[2])
+}
+
+func TestWalletReconciliationWorkflow(t *testing.T) {
+	ctx := context.Background()
+	statementDate := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	opening := reconcileTx(1, 1000000, statementDate.AddDate(0, -1, 0))
+	opening.Status = int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)
+	txRepo := &stubReconcileTransactionRepository{transactions: []*models.Transaction{
+		opening,
+		reconcileTx(2, -150000, statementDate.AddDate(0, 0, -20)),
+		reconcileTx(3, 2500000, statementDate.AddDate(0, 0, -10)),
+		reconcileTx(4, -50000, statementDate.Add(18*time.Hour)), // Late on the statement day
+		reconcileTx(5, -99000, statementDate.AddDate(0, 0, 1)),  // After the statement
+	}}
+	reconciliationRepo := &stubReconciliationRepository{transactions: txRepo}
+	svc := &walletService{
+		walletRepo: &stubWalletRepository{wallets: map[int32]*models.Wallet{1: {ID: 1, UserID: 7, Currency: "VND"}}},
+		txRepo:     txRepo,
+		mapper:     NewWalletMapper(),
+	}
+	svc.SetReconciliationRepository(reconciliationRepo)
+
+	started, err := svc.StartReconciliation(ctx, 1, 7, &v1.StartReconciliationRequest{
+		StatementDate:    statementDate.Unix(),
+		StatementBalance: &v1.Money{Amount: 3300000, Currency: "VND"},
+	})
+	require.NoError(t, err)
+	reconciliationID := started.Data.Id
+	assert.Equal(t, int64(1000000), started.Data.OpeningBalance.Amount)
+	assert.Equal(t, int64(2300000), started.Data.Difference.Amount)
+	assert.Len(t, started.Transactions, 3, "the opening and the transaction after the statement are not candidates")
+
+	resumed, err := svc.StartReconciliation(ctx, 1, 7, &v1.StartReconciliationRequest{
+		StatementDate:    statementDate.Unix(),
+		StatementBalance: &v1.Money{Amount: 3300000},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, reconciliationID, resumed.Data.Id, "a wallet has one open reconciliation")
+
+	_, err = svc.UpdateReconciliationTransactions(ctx, 1, reconciliationID, 7, &v1.UpdateReconciliationTransactionsRequest{ClearedTransactionIds: []int32{5}})
+	assert.Error(t, err, "transactions after the statement date cannot be ticked")
+
+	updated, err := svc.UpdateReconciliationTransactions(ctx, 1, reconciliationID, 7, &v1.UpdateReconciliationTransactionsRequest{ClearedTransactionIds: []int32{2, 3}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(-50000), updated.Data.Difference.Amount)
+
+	_, err = svc.FinishReconciliation(ctx, 1, reconciliationID, 7)
+	assert.Error(t, err, "cannot finish with a difference")
+
+	_, err = svc.UpdateReconciliationTransactions(ctx, 1, reconciliationID, 7, &v1.UpdateReconciliationTransactionsRequest{ClearedTransactionIds: []int32{4}})
+	require.NoError(t, err)
+
+	finished, err := svc.FinishReconciliation(ctx, 1, reconciliationID, 7)
+	require.NoError(t, err)
+	assert.Equal(t, v1.WalletReconciliationStatus_WALLET_RECONCILIATION_STATUS_COMPLETED, finished.Data.Status)
+	assert.Equal(t, int32(3), finished.Data.TransactionCount)
+	assert.Equal(t, int64(0), finished.Data.Difference.Amount)
+	assert.NotZero(t, finished.Data.CompletedAt)
+	for _, tx := range txRepo.transactions[1:4] {
+		assert.True(t, tx.IsReconciled())
+	}
+	assert.False(t, txRepo.transactions[4].IsReconciled())
+
+	_, err = svc.CancelReconciliation(ctx, 1, reconciliationID, 7)
+	assert.Error(t, err, "a completed reconciliation cannot be cancelled")
+
+	_, err = svc.GetReconciliation(ctx, 1, reconciliationID, 8)
+	assert.Error(t, err, "other users cannot see the reconciliation")
+}
+
+func TestReconciledTransactionsAreLocked(t *testing.T) {
+	ctx := context.Background()
+	reconciled := reconcileTx(1, -150000, time.Now())
+	reconciled.Status = int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)
+	svc := &transactionService{txRepo: &stubReconcileTransactionRepository{transactions: []*models.Transaction{reconciled}}}
+
+	_, err := svc.UpdateTransaction(ctx, 1, 7, &v1.UpdateTransactionRequest{Amount: &v1.Money{Amount: -100000, Currency: "VND"}})
+	require.Error(t, err)
+	assert.IsType(t, apperrors.ConflictError{}, err)
+
+	_, err = svc.DeleteTransaction(ctx, 1, 7)
+	require.Error(t, err)
+	assert.IsType(t, apperrors.ConflictError{}, err)
+}
+
+// stubUndoImportRepository serves one import batch and its transactions.
+type stubUndoImportRepository struct {
+	repository.ImportRepository
+	batch        *models.ImportBatch
+	transactions []*models.Transaction
+}
+
+func (r *stubUndoImportRepository) GetImportBatchByID(ctx context.Context, id string) (*models.ImportBatch, error) {
+	return r.batch, nil
+}
+
+func (r *stubUndoImportRepository) GetTransactionsByImportBatchID(ctx context.Context, importBatchID string) ([]*models.Transaction, error) {
+	return r.transactions, nil
+}
+
+func TestUndoImportRejectsReconciledTransactions(t *testing.T) {
+	ctx := context.Background()
+	reconciled := reconcileTx(2, -150000, time.Now())
+	reconciled.Status = int32(v1.TransactionStatus_TRANSACTION_STATUS_RECONCILED)
+	importRepo := &stubUndoImportRepository{
+		batch:        &models.ImportBatch{ID: "batch-1", UserID: 7, CanUndo: true, UndoExpiresAt: time.Now().Add(time.Hour)},
+		transactions: []*models.Transaction{reconcileTx(1, 50000, time.Now()), reconciled},
+	}
+	// Without a database the undo only gets as far as its checks
+	svc := &importService{importRepo: importRepo}
+
+	_, err := svc.UndoImport(ctx, 7, "batch-1")
+	require.Error(t, err)
+	assert.IsType(t, apperrors.ConflictError{}, err)
+	assert.Nil(t, importRepo.batch.UndoneAt)
+}
@@ -17,6 +17,7 @@ import (
 	walletv1 "wealthjourney/protobuf/v1"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 )
 
 // walletService implements WalletService.
@@ -31,6 +32,11 @@ type walletService struct {
 	investmentRepo  repository.InvestmentRepository
 	redisCache      *redis.Client
 	mapper          *WalletMapper
+
+	// Optional: wallet reconciliation sessions
+	reconciliationRepo repository.WalletReconciliationRepository
+	// Optional: transfers between wallets as linked transaction pairs
+	transferRepo repository.TransferRepository
 }
 
 // NewWalletService creates a new WalletService.
@@ -570,7 +576,9 @@ func (s *walletService) WithdrawFunds(ctx context.Context, walletID int32, userI
 }
 
 // TransferFunds transfers funds between two wallets belonging to the same user.
-// It also creates two transactions: one for the outgoing transfer (expense) and one for the incoming transfer (income).
+// The transfer is recorded as a linked pair of transactions: an outgoing leg in the
+// source wallet and an incoming leg in the destination wallet. A fee is recorded as a
+// separate "Transfer Fee" expense in the source wallet, so reports still count it.
 func (s *walletService) TransferFunds(ctx context.Context, userID int32, req *walletv1.TransferFundsRequest) (*walletv1.TransferFundsResponse, error) {
 	if err := validator.ID(userID); err != nil {
 		return nil, err
@@ -578,6 +586,9 @@ func (s *walletService) TransferFunds(ctx context.Context, userID int32, req *wa
 	if req.FromWalletId == req.ToWalletId {
 		return nil, apperrors.NewValidationError("source and destination wallets cannot be the same")
 	}
+	if s.transferRepo == nil {
+		return nil, apperrors.NewServiceUnavailableError("transfers are not configured")
+	}
 
 	// Verify both wallets exist and belong to user
 	fromWallet, err := s.walletRepo.GetByIDForUser(ctx, req.FromWalletId, userID)
@@ -597,12 +608,29 @@ func (s *walletService) TransferFunds(ctx context.Context, userID int32, req *wa
 	if req.Amount.Currency != fromWallet.Currency {
 		return nil, apperrors.NewValidationError("currency mismatch with source wallet")
 	}
-	if toWallet.Currency != fromWallet.Currency {
-		return nil, apperrors.NewValidationError("wallets must have the same currency")
+
+	// Validate fee
+	var fee int64
+	if req.Fee != nil && req.Fee.Amount != 0 {
+		if req.Fee.Amount < 0 {
+			return nil, apperrors.NewValidationError("fee cannot be negative")
+		}
+		if req.Fee.Currency != fromWallet.Currency {
+			return nil, apperrors.NewValidationError("fee currency must match the source wallet")
+		}
+		fee = req.Fee.Amount
+	}
+
+	toAmount, exchangeRate, err := s.resolveTransferAmount(ctx, req, fromWallet.Currency, toWallet.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if toAmount <= 0 {
+		return nil, apperrors.NewValidationError("amount is too small to transfer at this exchange rate")
 	}
 
 	// Check sufficient balance
-	if fromWallet.Balance < req.Amount.Amount {
+	if fromWallet.Balance < req.Amount.Amount+fee {
 		return nil, apperrors.NewValidationError("Insufficient balance")
 	}
 
@@ -618,64 +646,72 @@ func (s *walletService) TransferFunds(ctx context.Context, userID int32, req *wa
 		return nil, apperrors.NewInternalErrorWithCause("failed to get incoming transfer category", err)
 	}
 
-	// Create outgoing transaction (expense) for source wallet - negative amount
+	now := time.Now()
+	transfer := &models.Transfer{
+		ID:           uuid.New().String(),
+		UserID:       userID,
+		FromWalletID: fromWallet.ID,
+		ToWalletID:   toWallet.ID,
+		ExchangeRate: exchangeRate,
+		Fee:          fee,
+	}
+
+	// Outgoing leg (expense) for source wallet - negative amount
 	outgoingTx := &models.Transaction{
-		WalletID:   req.FromWalletId,
+		WalletID:   fromWallet.ID,
 		CategoryID: &outgoingCategory.ID,
-		Amount:     -req.Amount.Amount, // Negative for expense
-		Date:       time.Now(),
+		Amount:     -req.Amount.Amount,
+		Currency:   fromWallet.Currency,
+		Date:       now,
 		Note:       fmt.Sprintf("Transfer to wallet: %s", toWallet.WalletName),
 	}
 
-	// Create incoming transaction (income) for destination wallet - positive amount
+	// Incoming leg (income) for destination wallet - positive amount
 	incomingTx := &models.Transaction{
-		WalletID:   req.ToWalletId,
+		WalletID:   toWallet.ID,
 		CategoryID: &incomingCategory.ID,
-		Amount:     req.Amount.Amount, // Positive for income
-		Date:       time.Now(),
+		Amount:     toAmount,
+		Currency:   toWallet.Currency,
+		Date:       now,
 		Note:       fmt.Sprintf("Transfer from wallet: %s", fromWallet.WalletName),
 	}
 
-	// Create both transactions
-	if err := s.txRepo.Create(ctx, outgoingTx); err != nil {
-		return nil, apperrors.NewInternalErrorWithCause("failed to create outgoing transaction", err)
-	}
-
-	if err := s.txRepo.Create(ctx, incomingTx); err != nil {
-		// Attempt to rollback by deleting the outgoing transaction
-		_ = s.txRepo.Delete(ctx, outgoingTx.ID)
-		return nil, apperrors.NewInternalErrorWithCause("failed to create incoming transaction", err)
+	// Fee (expense) for source wallet, outside the transfer so it is not left out of reports
+	var feeTx *models.Transaction
+	if fee > 0 {
+		feeCategory, err := s.categoryRepo.GetByNameAndType(ctx, userID, "Transfer Fee", walletv1.CategoryType_CATEGORY_TYPE_EXPENSE)
+		if err != nil {
+			return nil, apperrors.NewInternalErrorWithCause("failed to get transfer fee category", err)
+		}
+		feeTx = &models.Transaction{
+			WalletID:   fromWallet.ID,
+			CategoryID: &feeCategory.ID,
+			Amount:     -fee,
+			Currency:   fromWallet.Currency,
+			Date:       now,
+			Note:       fmt.Sprintf("Fee for transfer to wallet: %s", toWallet.WalletName),
+		}
 	}
 
-	// Withdraw from source
-	_, err = s.walletRepo.UpdateBalance(ctx, req.FromWalletId, -req.Amount.Amount)
-	if err != nil {
-		// Attempt to rollback by refunding source and deleting transactions
-		_, _ = s.walletRepo.UpdateBalance(ctx, req.FromWalletId, req.Amount.Amount)
-		_ = s.txRepo.Delete(ctx, outgoingTx.ID)
-		_ = s.txRepo.Delete(ctx, incomingTx.ID)
-		return nil, err
-	}
-
-	// Add to destination
-	_, err = s.walletRepo.UpdateBalance(ctx, req.ToWalletId, req.Amount.Amount)
-	if err != nil {
-		// Attempt to rollback by refunding source and deleting transactions
-		_, _ = s.walletRepo.UpdateBalance(ctx, req.FromWalletId, req.Amount.Amount)
-		_ = s.txRepo.Delete(ctx, outgoingTx.ID)
-		_ = s.txRepo.Delete(ctx, incomingTx.ID)
+	// Create both legs and the fee and move both balances atomically
+	if err := s.transferRepo.Create(ctx, transfer, outgoingTx, incomingTx, feeTx); err != nil {
 		return nil, err
 	}
 
 	// Invalidate and repopulate currency cache for both wallets
-	_ = s.invalidateWalletCache(ctx, userID, req.FromWalletId)
-	_ = s.populateWalletCache(ctx, userID, fromWallet)
-	_ = s.invalidateWalletCache(ctx, userID, req.ToWalletId)
-	_ = s.populateWalletCache(ctx, userID, toWallet)
+	if s.currencyCache != nil {
+		for _, wallet := range []*models.Wallet{fromWallet, toWallet} {
+			_ = s.invalidateWalletCache(ctx, userID, wallet.ID)
+			if updated, err := s.walletRepo.GetByID(ctx, wallet.ID); err == nil {
+				_ = s.populateWalletCache(ctx, userID, updated)
+			}
+		}
+	}
 
 	return &walletv1.TransferFundsResponse{
 		Success:   true,
 		Message:   "Funds transferred successfully",
+		Data:      transferToProto(&transferPair{transfer: transfer, from: outgoingTx, to: incomingTx}),
 		Timestamp: time.Now().Format(time.RFC3339),
 	}, nil
 }
@@ -764,10 +800,11 @@ func (s *walletService) AdjustBalance(ctx context.Context, walletID int32, userI
 	_ = s.enrichWalletProto(ctx, userID, walletProto, updatedWallet)
 
 	return &walletv1.AdjustBalanceResponse{
-		Success:   true,
-		Message:   "Balance adjusted successfully",
-		Data:      walletProto,
-		Timestamp: time.Now().Format(time.RFC3339),
+		Success:       true,
+		Message:       "Balance adjusted successfully",
+		Data:          walletProto,
+		Timestamp:     time.Now().Format(time.RFC3339),
+		TransactionId: adjustmentTx.ID,
 	}, nil
 }
 
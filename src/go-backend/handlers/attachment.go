@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wealthjourney/domain/service"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/handler"
+	transactionv1 "wealthjourney/protobuf/v1"
+)
+
+// AttachmentHandlers handles receipt and document attachment HTTP requests.
+type AttachmentHandlers struct {
+	attachmentService service.AttachmentService
+}
+
+// NewAttachmentHandlers creates a new AttachmentHandlers instance.
+func NewAttachmentHandlers(attachmentService service.AttachmentService) *AttachmentHandlers {
+	return &AttachmentHandlers{
+		attachmentService: attachmentService,
+	}
+}
+
+// UploadTransactionAttachment attaches a receipt or document to a transaction.
+// @Summary Attach a file to a transaction
+// @Description Accepts PDF, JPEG, PNG and WebP files up to 10MB
+// @Tags attachments
+// @Accept json
+// @Produce json
+// @Param id path int true "Transaction ID"
+// @Param request body transactionv1.UploadTransactionAttachmentRequest true "Attachment upload request"
+// @Success 201 {object} types.APIResponse{data=transactionv1.UploadAttachmentResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/{id}/attachments [post]
+func (h *AttachmentHandlers) UploadTransactionAttachment(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse transaction ID
+	transactionID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req transactionv1.UploadTransactionAttachmentRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.FileData) == 0 {
+		handler.BadRequest(c, apperrors.NewValidationError("file is required"))
+		return
+	}
+
+	// Call service
+	result, err := h.attachmentService.UploadTransactionAttachment(c.Request.Context(), transactionID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// ListTransactionAttachments lists the attachments of a transaction.
+// @Summary List transaction attachments
+// @Tags attachments
+// @Produce json
+// @Param id path int true "Transaction ID"
+// @Success 200 {object} types.APIResponse{data=transactionv1.ListAttachmentsResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/{id}/attachments [get]
+func (h *AttachmentHandlers) ListTransactionAttachments(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse transaction ID
+	transactionID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.attachmentService.ListTransactionAttachments(c.Request.Context(), transactionID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// UploadInvestmentTransactionAttachment attaches a receipt or document to an investment transaction.
+// @Summary Attach a file to an investment transaction
+// @Description Accepts PDF, JPEG, PNG and WebP files up to 10MB
+// @Tags attachments
+// @Accept json
+// @Produce json
+// @Param id path int true "Investment transaction ID"
+// @Param request body transactionv1.UploadInvestmentTransactionAttachmentRequest true "Attachment upload request"
+// @Success 201 {object} types.APIResponse{data=transactionv1.UploadAttachmentResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/investment-transactions/{id}/attachments [post]
+func (h *AttachmentHandlers) UploadInvestmentTransactionAttachment(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse transaction ID
+	transactionID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req transactionv1.UploadInvestmentTransactionAttachmentRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Validate required fields
+	if len(req.FileData) == 0 {
+		handler.BadRequest(c, apperrors.NewValidationError("file is required"))
+		return
+	}
+
+	// Call service
+	result, err := h.attachmentService.UploadInvestmentTransactionAttachment(c.Request.Context(), transactionID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// ListInvestmentTransactionAttachments lists the attachments of an investment transaction.
+// @Summary List investment transaction attachments
+// @Tags attachments
+// @Produce json
+// @Param id path int true "Investment transaction ID"
+// @Success 200 {object} types.APIResponse{data=transactionv1.ListAttachmentsResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/investment-transactions/{id}/attachments [get]
+func (h *AttachmentHandlers) ListInvestmentTransactionAttachments(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse transaction ID
+	transactionID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.attachmentService.ListInvestmentTransactionAttachments(c.Request.Context(), transactionID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// GetAttachment retrieves an attachment with a signed URL to download it.
+// @Summary Get an attachment
+// @Tags attachments
+// @Produce json
+// @Param id path int true "Attachment ID"
+// @Success 200 {object} types.APIResponse{data=transactionv1.GetAttachmentResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/attachments/{id} [get]
+func (h *AttachmentHandlers) GetAttachment(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse attachment ID
+	attachmentID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.attachmentService.GetAttachment(c.Request.Context(), attachmentID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// DeleteAttachment deletes an attachment and its stored file.
+// @Summary Delete an attachment
+// @Tags attachments
+// @Produce json
+// @Param id path int true "Attachment ID"
+// @Success 200 {object} types.APIResponse
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/attachments/{id} [delete]
+func (h *AttachmentHandlers) DeleteAttachment(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse attachment ID
+	attachmentID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.attachmentService.DeleteAttachment(c.Request.Context(), attachmentID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
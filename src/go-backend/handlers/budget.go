@@ -1,6 +1,11 @@
 package handlers
 
 import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
 	"github.com/gin-gonic/gin"
 
 	"wealthjourney/domain/service"
@@ -444,3 +449,645 @@ func (h *BudgetHandlers) DeleteBudgetItem(c *gin.Context) {
 
 	handler.Success(c, result)
 }
+
+// GetBudgetEnvelopes retrieves the envelopes of a monthly or weekly budget for a period.
+// @Summary Get budget envelopes
+// @Tags budgets
+// @Produce json
+// @Param id path int true "Budget ID"
+// @Param date query int false "Any unix timestamp in the period (default: now)"
+// @Success 200 {object} types.APIResponse{data=budgetv1.GetBudgetEnvelopesResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/envelopes [get]
+func (h *BudgetHandlers) GetBudgetEnvelopes(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	date, err := parseBudgetDateQuery(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	req := &budgetv1.GetBudgetEnvelopesRequest{BudgetId: budgetID, Date: date}
+	result, err := h.budgetService.GetBudgetEnvelopes(c.Request.Context(), budgetID, userID, req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// MoveBudgetMoney moves money between two envelopes of a budget in the current period.
+// @Summary Move money between envelopes
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param id path int true "Budget ID"
+// @Param request body budgetv1.MoveBudgetMoneyRequest true "Move request"
+// @Success 201 {object} types.APIResponse{data=budgetv1.BudgetMove}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/envelopes/moves [post]
+func (h *BudgetHandlers) MoveBudgetMoney(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req budgetv1.MoveBudgetMoneyRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	req.BudgetId = budgetID
+
+	// Call service
+	result, err := h.budgetService.MoveBudgetMoney(c.Request.Context(), budgetID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// ListBudgetMoves lists the money moved between the envelopes of a budget in a period.
+// @Summary List envelope moves
+// @Tags budgets
+// @Produce json
+// @Param id path int true "Budget ID"
+// @Param date query int false "Any unix timestamp in the period (default: now)"
+// @Success 200 {object} types.APIResponse{data=budgetv1.ListBudgetMovesResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/envelopes/moves [get]
+func (h *BudgetHandlers) ListBudgetMoves(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	date, err := parseBudgetDateQuery(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	req := &budgetv1.ListBudgetMovesRequest{BudgetId: budgetID, Date: date}
+	result, err := h.budgetService.ListBudgetMoves(c.Request.Context(), budgetID, userID, req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// CreateBudgetAlertRule creates an alert rule on a category-linked budget item.
+// @Summary Create a budget alert rule
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param id path int true "Budget ID"
+// @Param request body budgetv1.CreateBudgetAlertRuleRequest true "Alert rule creation request"
+// @Success 201 {object} types.APIResponse{data=budgetv1.BudgetAlertRule}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Failure 503 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/alert-rules [post]
+func (h *BudgetHandlers) CreateBudgetAlertRule(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req budgetv1.CreateBudgetAlertRuleRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	req.BudgetId = budgetID
+
+	// Call service
+	result, err := h.budgetService.CreateBudgetAlertRule(c.Request.Context(), budgetID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// ListBudgetAlertRules lists the alert rules of a budget.
+// @Summary List budget alert rules
+// @Tags budgets
+// @Produce json
+// @Param id path int true "Budget ID"
+// @Success 200 {object} types.APIResponse{data=budgetv1.ListBudgetAlertRulesResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/alert-rules [get]
+func (h *BudgetHandlers) ListBudgetAlertRules(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.budgetService.ListBudgetAlertRules(c.Request.Context(), budgetID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// UpdateBudgetAlertRule updates an alert rule.
+// @Summary Update a budget alert rule
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param id path int true "Budget ID"
+// @Param ruleId path int true "Alert rule ID"
+// @Param request body budgetv1.UpdateBudgetAlertRuleRequest true "Alert rule update request"
+// @Success 200 {object} types.APIResponse{data=budgetv1.BudgetAlertRule}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/alert-rules/{ruleId} [put]
+func (h *BudgetHandlers) UpdateBudgetAlertRule(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Parse rule ID
+	ruleID, err := parseIDParam(c, "ruleId")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req budgetv1.UpdateBudgetAlertRuleRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	req.BudgetId = budgetID
+	req.RuleId = ruleID
+
+	// Call service
+	result, err := h.budgetService.UpdateBudgetAlertRule(c.Request.Context(), budgetID, ruleID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// DeleteBudgetAlertRule deletes an alert rule.
+// @Summary Delete a budget alert rule
+// @Tags budgets
+// @Produce json
+// @Param id path int true "Budget ID"
+// @Param ruleId path int true "Alert rule ID"
+// @Success 200 {object} types.APIResponse
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/alert-rules/{ruleId} [delete]
+func (h *BudgetHandlers) DeleteBudgetAlertRule(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Parse rule ID
+	ruleID, err := parseIDParam(c, "ruleId")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.budgetService.DeleteBudgetAlertRule(c.Request.Context(), budgetID, ruleID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// GetBudgetVarianceReport compares the allocation of each budget item with its spending,
+// month by month, including spending on categories no item tracks.
+// @Summary Get budget variance report
+// @Tags budgets
+// @Produce json
+// @Produce text/csv
+// @Param id path int true "Budget ID"
+// @Param startMonth query int false "Any unix timestamp in the first month (default: five months before endMonth)"
+// @Param endMonth query int false "Any unix timestamp in the last month (default: now)"
+// @Param preferredCurrency query bool false "Report in the user's preferred currency"
+// @Param format query string false "Response format: json (default) or csv"
+// @Success 200 {object} types.APIResponse{data=budgetv1.GetBudgetVarianceReportResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/variance [get]
+func (h *BudgetHandlers) GetBudgetVarianceReport(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	req := &budgetv1.GetBudgetVarianceReportRequest{BudgetId: budgetID}
+	if req.StartMonth, err = parseBudgetMonthQuery(c, "startMonth"); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	if req.EndMonth, err = parseBudgetMonthQuery(c, "endMonth"); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	req.PreferredCurrency, _ = strconv.ParseBool(c.Query("preferredCurrency"))
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		handler.BadRequest(c, apperrors.NewValidationError("format must be json or csv"))
+		return
+	}
+
+	// Call service
+	result, err := h.budgetService.GetBudgetVarianceReport(c.Request.Context(), budgetID, userID, req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	if format == "csv" {
+		var buf bytes.Buffer
+		if err := service.WriteBudgetVarianceCSV(&buf, result); err != nil {
+			handler.HandleError(c, apperrors.NewInternalErrorWithCause("failed to write budget variance CSV", err))
+			return
+		}
+
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("budget-%d-variance.csv", budgetID)))
+		c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// CopyBudgetToPeriod creates a new budget with the total and items of a budget.
+// @Summary Copy a budget to another period
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param id path int true "Budget ID"
+// @Param request body budgetv1.CopyBudgetToPeriodRequest true "Copy request"
+// @Success 201 {object} types.APIResponse{data=budgetv1.Budget}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budgets/{id}/copy [post]
+func (h *BudgetHandlers) CopyBudgetToPeriod(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse budget ID
+	budgetID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req budgetv1.CopyBudgetToPeriodRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	req.BudgetId = budgetID
+
+	// Call service
+	result, err := h.budgetService.CopyBudgetToPeriod(c.Request.Context(), budgetID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// CreateBudgetTemplate saves a budget template, from a budget or from items.
+// @Summary Create a budget template
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param request body budgetv1.CreateBudgetTemplateRequest true "Template creation request"
+// @Success 201 {object} types.APIResponse{data=budgetv1.BudgetTemplate}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budget-templates [post]
+func (h *BudgetHandlers) CreateBudgetTemplate(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Bind and validate request
+	var req budgetv1.CreateBudgetTemplateRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.budgetService.CreateBudgetTemplate(c.Request.Context(), userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// ListBudgetTemplates lists the budget templates of the user.
+// @Summary List budget templates
+// @Tags budgets
+// @Produce json
+// @Success 200 {object} types.APIResponse{data=budgetv1.ListBudgetTemplatesResponse}
+// @Failure 401 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budget-templates [get]
+func (h *BudgetHandlers) ListBudgetTemplates(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Call service
+	result, err := h.budgetService.ListBudgetTemplates(c.Request.Context(), userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// GetBudgetTemplate retrieves a budget template.
+// @Summary Get a budget template
+// @Tags budgets
+// @Produce json
+// @Param id path int true "Template ID"
+// @Success 200 {object} types.APIResponse{data=budgetv1.BudgetTemplate}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budget-templates/{id} [get]
+func (h *BudgetHandlers) GetBudgetTemplate(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse template ID
+	templateID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.budgetService.GetBudgetTemplate(c.Request.Context(), templateID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// DeleteBudgetTemplate deletes a budget template.
+// @Summary Delete a budget template
+// @Tags budgets
+// @Produce json
+// @Param id path int true "Template ID"
+// @Success 200 {object} types.APIResponse
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budget-templates/{id} [delete]
+func (h *BudgetHandlers) DeleteBudgetTemplate(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse template ID
+	templateID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.budgetService.DeleteBudgetTemplate(c.Request.Context(), templateID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// ApplyBudgetTemplate creates a new budget from a template.
+// @Summary Create a budget from a template
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Param request body budgetv1.ApplyBudgetTemplateRequest true "Apply request"
+// @Success 201 {object} types.APIResponse{data=budgetv1.Budget}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/budget-templates/{id}/apply [post]
+func (h *BudgetHandlers) ApplyBudgetTemplate(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse template ID
+	templateID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req budgetv1.ApplyBudgetTemplateRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	req.TemplateId = templateID
+
+	// Call service
+	result, err := h.budgetService.ApplyBudgetTemplate(c.Request.Context(), templateID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// parseBudgetDateQuery parses the optional date query parameter selecting a budget
+// period, as a unix timestamp. Zero means now.
+func parseBudgetDateQuery(c *gin.Context) (int64, error) {
+	dateStr := c.Query("date")
+	if dateStr == "" {
+		return 0, nil
+	}
+	date, err := strconv.ParseInt(dateStr, 10, 64)
+	if err != nil {
+		return 0, apperrors.NewValidationError("invalid date format")
+	}
+	return date, nil
+}
+
+// parseBudgetMonthQuery parses an optional query parameter selecting a month, as a unix
+// timestamp. Zero means the default month.
+func parseBudgetMonthQuery(c *gin.Context, name string) (int64, error) {
+	monthStr := c.Query(name)
+	if monthStr == "" {
+		return 0, nil
+	}
+	month, err := strconv.ParseInt(monthStr, 10, 64)
+	if err != nil {
+		return 0, apperrors.NewValidationError(fmt.Sprintf("invalid %s format", name))
+	}
+	return month, nil
+}
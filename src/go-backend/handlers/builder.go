@@ -1,8 +1,13 @@
 package handlers
 
 import (
+	"log"
+
 	"wealthjourney/domain/service"
+	"wealthjourney/pkg/fileupload"
 	"wealthjourney/pkg/jobs"
+	"wealthjourney/pkg/notification"
+	"wealthjourney/pkg/storage"
 )
 
 
@@ -13,12 +18,17 @@ type AllHandlers struct {
 	Auth        *AuthHandlers
 	Transaction *TransactionHandlers
 	Category    *CategoryHandlers
+	Tag         *TagHandlers
+	Attachment  *AttachmentHandlers
+	Files       *FileHandler // nil unless files are stored on the local filesystem
 	Budget      *BudgetHandlers
 	Investment  *InvestmentHandlers
 	Gold         *GoldHandler
 	Silver       *SilverHandler
 	MarketPrices *MarketPricesHandler
 	Import       *ImportHandler
+	Recurring    *RecurringTransactionHandlers
+	Notification *NotificationHandlers
 }
 
 // NewHandlers creates all handler instances with proper dependency injection.
@@ -61,6 +71,39 @@ func NewHandlers(services *service.Services, repos *service.Repositories) *AllHa
 		fxService,
 		adaptedQueue,
 	)
+	// Statement reconciliation differences are posted as wallet balance adjustments
+	service.SetImportBalanceAdjuster(importService, services.Wallet)
+	// Tags named by an imported tags column are created on first use
+	service.SetImportTagRepository(importService, repos.Tag)
+	// Rows mirrored in another wallet can be imported as the other leg of a transfer
+	service.SetImportTransferRepository(importService, repos.Transfer)
+	// Imported rows check the budget alerts they may trigger
+	service.SetImportBudgetAlertEvaluator(importService, services.Budget)
+
+	// Budget alerts also go out by email and webhook when configured
+	if deps != nil && deps.Cfg != nil && services.Notifier != nil {
+		for name, channel := range notification.NewChannels(deps.Cfg.Notification) {
+			services.Notifier.Register(name, channel)
+		}
+	}
+
+	// Receipts and documents are removed together with their transactions
+	fileStorage := newStorageProvider(deps)
+	attachmentService := service.NewAttachmentService(
+		repos.Attachment,
+		repos.Transaction,
+		repos.InvestmentTransaction,
+		fileStorage,
+	)
+	service.SetAttachmentCleaner(services, attachmentService)
+	// Statement uploads are stored with the same provider
+	fileupload.InitializeDefaultService(fileStorage)
+
+	// Files on the local filesystem are downloaded through the API
+	var fileHandler *FileHandler
+	if localStorage, ok := fileStorage.(*storage.LocalStorage); ok {
+		fileHandler = NewFileHandler(localStorage)
+	}
 
 	return &AllHandlers{
 		Wallet:      NewWalletHandlers(services.Wallet),
@@ -68,13 +111,35 @@ func NewHandlers(services *service.Services, repos *service.Repositories) *AllHa
 		Auth:        NewAuthHandlers(services.User),
 		Transaction: NewTransactionHandlers(services.Transaction),
 		Category:    NewCategoryHandlers(services.Category),
+		Tag:         NewTagHandlers(services.Tag),
+		Attachment:  NewAttachmentHandlers(attachmentService),
+		Files:       fileHandler,
 		Budget:      NewBudgetHandlers(services.Budget),
 		Investment:  NewInvestmentHandlers(services.Investment, services.PortfolioHistory, services.MarketData),
 		Gold:         NewGoldHandler(),
 		Silver:       NewSilverHandler(),
 		MarketPrices: marketPricesHandler,
 		Import:       NewImportHandler(repos.Import, importService),
+		Recurring:    NewRecurringTransactionHandlers(services.Recurring),
+		Notification: NewNotificationHandlers(services.Notification),
+	}
+}
+
+// newStorageProvider creates the storage provider selected by STORAGE_PROVIDER, used for
+// attachments and statement uploads. Without a usable configuration, such as the default
+// supabase provider without SUPABASE_URL, files are stored on the local filesystem.
+func newStorageProvider(deps *Dependencies) storage.StorageProvider {
+	if deps == nil || deps.Cfg == nil {
+		return storage.NewLocalStorage(fileupload.UploadDir, "", "")
+	}
+
+	cfg := deps.Cfg.Storage
+	provider, err := storage.NewProvider(cfg)
+	if err != nil {
+		log.Printf("[STORAGE] %v, storing attachments in %s", err, cfg.LocalDir)
+		return storage.NewLocalStorage(cfg.LocalDir, cfg.LocalBaseURL, cfg.LocalSigningKey)
 	}
+	return provider
 }
 
 // AuthHandlers handles authentication-related HTTP requests.
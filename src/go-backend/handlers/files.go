@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"wealthjourney/pkg/handler"
+	"wealthjourney/pkg/storage"
+)
+
+// FileHandler serves files of the local storage provider under the signed URLs it issues.
+type FileHandler struct {
+	storage *storage.LocalStorage
+}
+
+// NewFileHandler creates a new FileHandler instance.
+func NewFileHandler(localStorage *storage.LocalStorage) *FileHandler {
+	return &FileHandler{
+		storage: localStorage,
+	}
+}
+
+// ServeFile serves a stored file. The URL signature authorizes the request, so no
+// authentication is required.
+// @Summary Download a stored file
+// @Tags files
+// @Produce octet-stream
+// @Param key path string true "Storage key"
+// @Param expires query int true "Expiry as Unix timestamp"
+// @Param signature query string true "URL signature"
+// @Success 200 {file} file
+// @Failure 403 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Router /api/v1/files/{key} [get]
+func (h *FileHandler) ServeFile(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	filePath, err := h.storage.Resolve(key, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidSignature) {
+			handler.Forbidden(c, "Invalid or expired file URL")
+			return
+		}
+		handler.NotFound(c, "File not found")
+		return
+	}
+
+	if info, err := os.Stat(filePath); err != nil || info.IsDir() {
+		handler.NotFound(c, "File not found")
+		return
+	}
+
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.File(filePath)
+}
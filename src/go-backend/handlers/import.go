@@ -273,6 +273,7 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 			TypeColumn        int `json:"typeColumn"`
 			CategoryColumn    int `json:"categoryColumn"`
 			ReferenceColumn   int `json:"referenceColumn"`
+			TagsColumn        int `json:"tagsColumn"`
 		}
 
 		if err := json.Unmarshal(template.ColumnMapping, &mapping); err != nil {
@@ -290,6 +291,7 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 			TypeColumn:        mapping.TypeColumn - 1,    // -1 if not present (0 index becomes -1)
 			CategoryColumn:    mapping.CategoryColumn - 1,
 			ReferenceColumn:   mapping.ReferenceColumn - 1,
+			TagsColumn:        mapping.TagsColumn - 1,
 			DateFormat:        template.DateFormat,
 			Currency:          template.Currency,
 		}
@@ -302,6 +304,7 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 		typeCol, _ := strconv.Atoi(req.CustomMapping.TypeColumn)
 		catCol, _ := strconv.Atoi(req.CustomMapping.CategoryColumn)
 		refCol, _ := strconv.Atoi(req.CustomMapping.ReferenceColumn)
+		tagsCol, _ := strconv.Atoi(req.CustomMapping.TagsColumn)
 
 		columnMapping = &parser.ColumnMapping{
 			DateColumn:        dateCol,
@@ -312,14 +315,16 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 			TypeColumn:        typeCol - 1,    // -1 if not present
 			CategoryColumn:    catCol - 1,
 			ReferenceColumn:   refCol - 1,
+			TagsColumn:        tagsCol - 1,
 			DateFormat:        req.CustomMapping.DateFormat,
 			Currency:          req.CustomMapping.Currency,
 		}
 	}
 
 	// Validate column mapping requirement based on file type
-	// CSV files require explicit mapping, but PDF/Excel can use auto-detection
-	if columnMapping == nil && fileExt != ".pdf" && fileExt != ".xlsx" && fileExt != ".xls" {
+	// CSV files require explicit mapping, but PDF/Excel can use auto-detection and OFX/QIF/MT940/CAMT are self-describing
+	if columnMapping == nil && fileExt != ".pdf" && fileExt != ".xlsx" && fileExt != ".xls" && fileExt != ".ofx" && fileExt != ".qfx" && fileExt != ".qif" &&
+		fileExt != ".sta" && fileExt != ".mt940" && fileExt != ".xml" {
 		handler.BadRequest(c, apperrors.NewValidationError("column mapping is required for CSV files. Please select a bank template or provide custom mapping"))
 		return
 	}
@@ -330,6 +335,7 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 
 	// Parse file based on extension
 	var parsedRows []*parser.ParsedRow
+	var balances *parser.StatementBalances
 
 	// Track parsing duration
 	parseStart := time.Now()
@@ -367,6 +373,8 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 		if columnMapping == nil {
 			columnMapping = pdfParser.GetDetectedMapping()
 		}
+		// Opening/closing balance lines printed on the statement, if any
+		balances = pdfParser.GetStatementBalances()
 	case ".xlsx", ".xls":
 		fileTypeStr = "excel"
 		// Use Excel parser with auto-detection (ignore template mapping for Excel files)
@@ -403,6 +411,120 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 		}
 		// Get the detected mapping (includes extracted currency from file metadata)
 		columnMapping = excelParser.GetDetectedMapping()
+	case ".ofx", ".qfx":
+		fileTypeStr = "ofx"
+		// OFX carries its own structure; template mappings do not apply
+		ofxParser := parser.NewOFXParser(fileURL)
+		parsedRows, err = ofxParser.Parse()
+		if err != nil {
+			metrics.ImportAttempts.WithLabelValues("error", fileTypeStr).Inc()
+			logger.LogImportError(c.Request.Context(), userID, "parse:ofx", err, logger.ImportErrorMetadata(
+				req.FileId, fileTypeStr, 0, 0,
+			))
+
+			// Audit log: Failed parse
+			logger.LogImportAudit(c.Request.Context(), logger.NewParseAuditLog(
+				userID,
+				req.FileId,
+				fileTypeStr,
+				ipAddress,
+				userAgent,
+				0,
+				false,
+				err.Error(),
+			))
+
+			handler.BadRequest(c, apperrors.WrapWithUserMessage(err))
+			return
+		}
+		// Currency comes from CURDEF; the ledger balance lets the import check the closing balance
+		columnMapping = ofxParser.GetDetectedMapping()
+		balances = ofxParser.GetStatementBalances()
+	case ".qif":
+		fileTypeStr = "qif"
+		// A template or custom mapping only contributes its date format and currency
+		qifParser := parser.NewQIFParser(fileURL, columnMapping)
+		parsedRows, err = qifParser.Parse()
+		if err != nil {
+			metrics.ImportAttempts.WithLabelValues("error", fileTypeStr).Inc()
+			logger.LogImportError(c.Request.Context(), userID, "parse:qif", err, logger.ImportErrorMetadata(
+				req.FileId, fileTypeStr, 0, 0,
+			))
+
+			// Audit log: Failed parse
+			logger.LogImportAudit(c.Request.Context(), logger.NewParseAuditLog(
+				userID,
+				req.FileId,
+				fileTypeStr,
+				ipAddress,
+				userAgent,
+				0,
+				false,
+				err.Error(),
+			))
+
+			handler.BadRequest(c, apperrors.WrapWithUserMessage(err))
+			return
+		}
+		columnMapping = qifParser.GetDetectedMapping()
+	case ".sta", ".mt940":
+		fileTypeStr = "mt940"
+		// MT940 carries its own structure; template mappings do not apply
+		mt940Parser := parser.NewMT940Parser(fileURL)
+		parsedRows, err = mt940Parser.Parse()
+		if err != nil {
+			metrics.ImportAttempts.WithLabelValues("error", fileTypeStr).Inc()
+			logger.LogImportError(c.Request.Context(), userID, "parse:mt940", err, logger.ImportErrorMetadata(
+				req.FileId, fileTypeStr, 0, 0,
+			))
+
+			// Audit log: Failed parse
+			logger.LogImportAudit(c.Request.Context(), logger.NewParseAuditLog(
+				userID,
+				req.FileId,
+				fileTypeStr,
+				ipAddress,
+				userAgent,
+				0,
+				false,
+				err.Error(),
+			))
+
+			handler.BadRequest(c, apperrors.WrapWithUserMessage(err))
+			return
+		}
+		// Currency comes from the opening balance (:60F:); both balances go to the import summary
+		columnMapping = mt940Parser.GetDetectedMapping()
+		balances = mt940Parser.GetStatementBalances()
+	case ".xml":
+		fileTypeStr = "camt"
+		// CAMT.053 carries its own structure; template mappings do not apply
+		camtParser := parser.NewCAMTParser(fileURL)
+		parsedRows, err = camtParser.Parse()
+		if err != nil {
+			metrics.ImportAttempts.WithLabelValues("error", fileTypeStr).Inc()
+			logger.LogImportError(c.Request.Context(), userID, "parse:camt", err, logger.ImportErrorMetadata(
+				req.FileId, fileTypeStr, 0, 0,
+			))
+
+			// Audit log: Failed parse
+			logger.LogImportAudit(c.Request.Context(), logger.NewParseAuditLog(
+				userID,
+				req.FileId,
+				fileTypeStr,
+				ipAddress,
+				userAgent,
+				0,
+				false,
+				err.Error(),
+			))
+
+			handler.BadRequest(c, apperrors.WrapWithUserMessage(err))
+			return
+		}
+		// Currency comes from the account or balances; OPBD and CLBD go to the import summary
+		columnMapping = camtParser.GetDetectedMapping()
+		balances = camtParser.GetStatementBalances()
 	default:
 		// Use CSV parser (default for .csv and any other text format)
 		csvParser := parser.NewCSVParser(fileURL, columnMapping)
@@ -481,6 +603,11 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 			currency = columnMapping.Currency
 		}
 
+		var valueDate int64
+		if !row.ValueDate.IsZero() {
+			valueDate = row.ValueDate.Unix()
+		}
+
 		transactions = append(transactions, &v1.ParsedTransaction{
 			RowNumber:   int32(row.RowNumber),
 			Date:        row.Date.Unix(),
@@ -496,6 +623,11 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 			ReferenceNumber:     row.ReferenceNum,
 			ValidationErrors:    validationErrors,
 			IsValid:             row.IsValid,
+			CategoryName:        row.CategoryName,
+			Splits:              parsedSplitsToProto(row.Splits, currency),
+			ValueDate:           valueDate,
+			Counterparty:        row.Counterparty,
+			Tags:                row.Tags,
 		})
 	}
 
@@ -531,6 +663,9 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 		currencyList = append(currencyList, currency)
 	}
 
+	// Check the rows against the statement balances so missing or extra rows surface before import
+	statementBalance := statementBalanceToProto(balances, statementCurrency)
+
 	// Build response
 	response := &v1.ParseStatementResponse{
 		Success:      true,
@@ -547,7 +682,9 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 			CurrenciesFound: currencyList,
 			NeedsConversion: needsConversion,
 		},
-		Timestamp: time.Now().Format(time.RFC3339),
+		StatementBalance: statementBalance,
+		Reconciliation:   h.importService.ReconcileStatement(statementBalance, transactions),
+		Timestamp:        time.Now().Format(time.RFC3339),
 	}
 
 	// Audit log: Successful parse
@@ -565,6 +702,49 @@ func (h *ImportHandler) ParseFile(c *gin.Context) {
 	handler.Success(c, response)
 }
 
+// parsedSplitsToProto converts the split lines of a parsed row
+func parsedSplitsToProto(splits []parser.ParsedSplit, currency string) []*v1.ParsedSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+	result := make([]*v1.ParsedSplit, 0, len(splits))
+	for _, split := range splits {
+		result = append(result, &v1.ParsedSplit{
+			CategoryName: split.CategoryName,
+			Amount:       &v1.Money{Amount: split.Amount, Currency: currency},
+			Memo:         split.Memo,
+		})
+	}
+	return result
+}
+
+// statementBalanceToProto converts the balances reported by a statement, or returns nil
+// when it reports none.
+func statementBalanceToProto(balances *parser.StatementBalances, fallbackCurrency string) *v1.StatementBalance {
+	if balances == nil || (balances.Opening == nil && balances.Closing == nil) {
+		return nil
+	}
+
+	currency := balances.Currency
+	if currency == "" {
+		currency = fallbackCurrency
+	}
+	result := &v1.StatementBalance{}
+	if balances.Opening != nil {
+		result.OpeningBalance = &v1.Money{Amount: *balances.Opening, Currency: currency}
+		if !balances.OpeningDate.IsZero() {
+			result.OpeningDate = balances.OpeningDate.Unix()
+		}
+	}
+	if balances.Closing != nil {
+		result.ClosingBalance = &v1.Money{Amount: *balances.Closing, Currency: currency}
+		if !balances.ClosingDate.IsZero() {
+			result.ClosingDate = balances.ClosingDate.Unix()
+		}
+	}
+	return result
+}
+
 // DetectDuplicates detects potential duplicates for imported transactions.
 // @Summary Detect duplicate transactions
 // @Tags import
@@ -827,6 +1007,7 @@ func (h *ImportHandler) GetImportBatch(c *gin.Context) {
 			TotalSkipped:      batch.SkippedRows,
 			DuplicatesMerged:  batch.DuplicatesMerged,
 			DuplicatesSkipped: batch.DuplicatesSkipped,
+			TransfersLinked:   batch.TransfersLinked,
 			TotalIncome: &v1.Money{
 				Amount:   batch.TotalIncome,
 				Currency: "VND",
@@ -857,6 +1038,7 @@ func (h *ImportHandler) GetImportBatch(c *gin.Context) {
 // @Failure 400 {object} types.APIResponse
 // @Failure 401 {object} types.APIResponse
 // @Failure 404 {object} types.APIResponse
+// @Failure 409 {object} types.APIResponse
 // @Failure 500 {object} types.APIResponse
 // @Router /api/v1/import/{id}/undo [post]
 func (h *ImportHandler) UndoImport(c *gin.Context) {
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"bytes"
 	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -542,6 +544,7 @@ func (h *InvestmentHandlers) DeleteTransaction(c *gin.Context) {
 // @Tags investments
 // @Produce json
 // @Param id path int true "Wallet ID"
+// @Param period query int false "Include XIRR/TWR over this return period (1=YTD, 2=1Y, 3=3Y, 4=since inception)"
 // @Success 200 {object} types.APIResponse{data=investmentv1.PortfolioSummary}
 // @Failure 400 {object} types.APIResponse
 // @Failure 401 {object} types.APIResponse
@@ -563,6 +566,12 @@ func (h *InvestmentHandlers) GetPortfolioSummary(c *gin.Context) {
 		return
 	}
 
+	period, err := parseReturnPeriod(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
 	// Call service
 	result, err := h.investmentService.GetPortfolioSummary(c.Request.Context(), walletID, userID)
 	if err != nil {
@@ -570,6 +579,11 @@ func (h *InvestmentHandlers) GetPortfolioSummary(c *gin.Context) {
 		return
 	}
 
+	if err := h.attachReturns(c, userID, walletID, investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED, period, result); err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
 	handler.Success(c, result)
 }
 
@@ -734,6 +748,7 @@ func (h *InvestmentHandlers) ListUserInvestments(c *gin.Context) {
 // @Produce json
 // @Param walletId query int false "Filter by specific wallet (optional, 0 or omitted = all wallets)"
 // @Param typeFilter query int false "Filter by investment type"
+// @Param period query int false "Include XIRR/TWR over this return period (1=YTD, 2=1Y, 3=3Y, 4=since inception)"
 // @Success 200 {object} types.APIResponse{data=investmentv1.PortfolioSummary}
 // @Failure 400 {object} types.APIResponse
 // @Failure 401 {object} types.APIResponse
@@ -778,6 +793,13 @@ func (h *InvestmentHandlers) GetAggregatedPortfolioSummary(c *gin.Context) {
 		req.TypeFilter = investmentv1.InvestmentType(typeFilter)
 	}
 
+	period, err := parseReturnPeriod(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	req.Period = period
+
 	// Call service
 	result, err := h.investmentService.GetAggregatedPortfolioSummary(c.Request.Context(), userID, &req)
 	if err != nil {
@@ -785,6 +807,11 @@ func (h *InvestmentHandlers) GetAggregatedPortfolioSummary(c *gin.Context) {
 		return
 	}
 
+	if err := h.attachReturns(c, userID, req.WalletId, req.TypeFilter, req.Period, result); err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
 	handler.Success(c, result)
 }
 
@@ -795,6 +822,7 @@ func (h *InvestmentHandlers) GetAggregatedPortfolioSummary(c *gin.Context) {
 // @Param walletId query int false "Filter by specific wallet (optional, 0 or omitted = all wallets)"
 // @Param days query int false "Number of days to look back (default: 30, max: 365)"
 // @Param points query int false "Number of data points to return (default: 10, max: 100)"
+// @Param benchmarkSymbol query string false "Benchmark to compare against, e.g. VNINDEX, SPY, XAU (optional)"
 // @Success 200 {object} types.APIResponse{data=investmentv1.GetHistoricalPortfolioValuesResponse}
 // @Failure 400 {object} types.APIResponse
 // @Failure 401 {object} types.APIResponse
@@ -841,6 +869,12 @@ func (h *InvestmentHandlers) GetHistoricalPortfolioValues(c *gin.Context) {
 	}
 	req.Points = int32(points)
 
+	// Parse optional benchmark symbol (support both snake_case and camelCase)
+	req.BenchmarkSymbol = c.Query("benchmarkSymbol")
+	if req.BenchmarkSymbol == "" {
+		req.BenchmarkSymbol = c.Query("benchmark_symbol") // Fallback to snake_case
+	}
+
 	// Call service
 	result, err := h.portfolioHistorySvc.GetHistoricalValues(c.Request.Context(), userID, &req)
 	if err != nil {
@@ -851,6 +885,214 @@ func (h *InvestmentHandlers) GetHistoricalPortfolioValues(c *gin.Context) {
 	handler.Success(c, result)
 }
 
+// GetPerformanceReturns retrieves money-weighted (XIRR) and time-weighted (TWR) returns.
+// @Summary Get performance returns
+// @Tags investments
+// @Produce json
+// @Param investmentId query int false "Single investment (takes precedence over walletId)"
+// @Param walletId query int false "Filter by specific wallet (optional, 0 or omitted = all wallets)"
+// @Param period query int false "Return period (1=YTD, 2=1Y, 3=3Y, 4=since inception; default since inception)"
+// @Param typeFilter query int false "Filter by investment type"
+// @Success 200 {object} types.APIResponse{data=investmentv1.GetPerformanceReturnsResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/portfolio/returns [get]
+func (h *InvestmentHandlers) GetPerformanceReturns(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Build request
+	var req investmentv1.GetPerformanceReturnsRequest
+
+	if investmentIDStr := c.Query("investmentId"); investmentIDStr != "" {
+		investmentID, err := strconv.ParseInt(investmentIDStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid investmentId parameter"))
+			return
+		}
+		req.InvestmentId = int32(investmentID)
+	}
+
+	// Parse optional walletId (support both snake_case and camelCase)
+	walletIDStr := c.Query("walletId")
+	if walletIDStr == "" {
+		walletIDStr = c.Query("wallet_id") // Fallback to snake_case
+	}
+	if walletIDStr != "" {
+		walletID, err := strconv.ParseInt(walletIDStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid walletId parameter"))
+			return
+		}
+		req.WalletId = int32(walletID)
+	}
+
+	// Parse type filter if provided (support both snake_case and camelCase)
+	typeFilterStr := c.Query("typeFilter")
+	if typeFilterStr == "" {
+		typeFilterStr = c.Query("type_filter") // Fallback to snake_case
+	}
+	if typeFilterStr != "" {
+		typeFilter, err := strconv.ParseInt(typeFilterStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid typeFilter parameter"))
+			return
+		}
+		req.TypeFilter = investmentv1.InvestmentType(typeFilter)
+	}
+
+	period, err := parseReturnPeriod(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+	req.Period = period
+
+	// Call service
+	result, err := h.investmentService.GetPerformanceReturns(c.Request.Context(), userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// attachReturns adds XIRR/TWR over period to a portfolio summary when a period was requested.
+func (h *InvestmentHandlers) attachReturns(c *gin.Context, userID, walletID int32, typeFilter investmentv1.InvestmentType, period investmentv1.ReturnPeriod, summary *investmentv1.GetPortfolioSummaryResponse) error {
+	if period == investmentv1.ReturnPeriod_RETURN_PERIOD_UNSPECIFIED || summary == nil || summary.Data == nil {
+		return nil
+	}
+
+	result, err := h.investmentService.GetPerformanceReturns(c.Request.Context(), userID, &investmentv1.GetPerformanceReturnsRequest{
+		WalletId:   walletID,
+		Period:     period,
+		TypeFilter: typeFilter,
+	})
+	if err != nil {
+		return err
+	}
+	summary.Data.Returns = result.Data
+	return nil
+}
+
+// parseReturnPeriod parses the optional period query parameter.
+func parseReturnPeriod(c *gin.Context) (investmentv1.ReturnPeriod, error) {
+	periodStr := c.Query("period")
+	if periodStr == "" {
+		return investmentv1.ReturnPeriod_RETURN_PERIOD_UNSPECIFIED, nil
+	}
+
+	period, err := strconv.ParseInt(periodStr, 10, 32)
+	if err != nil {
+		return 0, apperrors.NewValidationError("invalid period parameter")
+	}
+	if _, ok := investmentv1.ReturnPeriod_name[int32(period)]; !ok {
+		return 0, apperrors.NewValidationError("invalid period parameter")
+	}
+	return investmentv1.ReturnPeriod(period), nil
+}
+
+// GetRealizedGainsReport retrieves realized capital gains per disposal for tax reporting.
+// @Summary Get realized gains report
+// @Tags investments
+// @Produce json
+// @Produce text/csv
+// @Param year query int false "Tax year of the disposal date (0 or omitted = all years)"
+// @Param walletId query int false "Filter by specific wallet (optional, 0 or omitted = all wallets)"
+// @Param typeFilter query int false "Filter by investment type"
+// @Param format query string false "Response format: json (default) or csv"
+// @Success 200 {object} types.APIResponse{data=investmentv1.GetRealizedGainsReportResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/portfolio/realized-gains [get]
+func (h *InvestmentHandlers) GetRealizedGainsReport(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Build request
+	var req investmentv1.GetRealizedGainsReportRequest
+
+	if yearStr := c.Query("year"); yearStr != "" {
+		year, err := strconv.ParseInt(yearStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid year parameter"))
+			return
+		}
+		req.Year = int32(year)
+	}
+
+	// Parse optional walletId (support both snake_case and camelCase)
+	walletIDStr := c.Query("walletId")
+	if walletIDStr == "" {
+		walletIDStr = c.Query("wallet_id") // Fallback to snake_case
+	}
+	if walletIDStr != "" {
+		walletID, err := strconv.ParseInt(walletIDStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid walletId parameter"))
+			return
+		}
+		req.WalletId = int32(walletID)
+	}
+
+	// Parse type filter if provided (support both snake_case and camelCase)
+	typeFilterStr := c.Query("typeFilter")
+	if typeFilterStr == "" {
+		typeFilterStr = c.Query("type_filter") // Fallback to snake_case
+	}
+	if typeFilterStr != "" {
+		typeFilter, err := strconv.ParseInt(typeFilterStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid typeFilter parameter"))
+			return
+		}
+		req.TypeFilter = investmentv1.InvestmentType(typeFilter)
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		handler.BadRequest(c, apperrors.NewValidationError("format must be json or csv"))
+		return
+	}
+
+	// Call service
+	result, err := h.investmentService.GetRealizedGainsReport(c.Request.Context(), userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	if format == "csv" {
+		var buf bytes.Buffer
+		if err := service.WriteRealizedGainsCSV(&buf, result.Data); err != nil {
+			handler.HandleError(c, apperrors.NewInternalErrorWithCause("failed to write realized gains CSV", err))
+			return
+		}
+
+		filename := "realized-gains.csv"
+		if req.Year != 0 {
+			filename = fmt.Sprintf("realized-gains-%d.csv", req.Year)
+		}
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+		return
+	}
+
+	handler.Success(c, result)
+}
+
 // GetMarketPrice retrieves current market price for a symbol.
 // @Summary Get current market price for display
 // @Tags investments
@@ -939,6 +1181,94 @@ func (h *InvestmentHandlers) GetMarketPrice(c *gin.Context) {
 	handler.Success(c, response)
 }
 
+// GetPriceHistory retrieves daily OHLC prices for a symbol.
+// @Summary Get daily price history
+// @Description Returns stored daily prices, backfilling missing days from the price source first
+// @Tags investments
+// @Produce json
+// @Param symbol query string true "Symbol (e.g., AAPL, BTC-USD, XAUUSD)"
+// @Param currency query string true "Currency code (e.g., USD, VND)"
+// @Param type query int false "Investment type (default: 2 for stock)"
+// @Param startDate query int false "Start date (Unix timestamp, default one year ago)"
+// @Param endDate query int false "End date (Unix timestamp, default now)"
+// @Success 200 {object} types.APIResponse{data=investmentv1.GetPriceHistoryResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/investments/price-history [get]
+func (h *InvestmentHandlers) GetPriceHistory(c *gin.Context) {
+	// Get user ID from context (for authentication check)
+	_, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	symbol := c.Query("symbol")
+	currency := c.Query("currency")
+	if symbol == "" || currency == "" {
+		handler.BadRequest(c, apperrors.NewValidationError("symbol and currency are required"))
+		return
+	}
+
+	// Parse investment type (default to stock)
+	investmentType := investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK
+	if typeStr := c.Query("type"); typeStr != "" {
+		typeInt, err := strconv.ParseInt(typeStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid type parameter"))
+			return
+		}
+		investmentType = investmentv1.InvestmentType(typeInt)
+	}
+
+	to := time.Now()
+	from := to.AddDate(-1, 0, 0)
+	dates := []struct {
+		camel, snake string
+		dest         *time.Time
+	}{
+		{"startDate", "start_date", &from},
+		{"endDate", "end_date", &to},
+	}
+	for _, date := range dates {
+		value := c.Query(date.camel)
+		if value == "" {
+			value = c.Query(date.snake) // Fallback to snake_case
+		}
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid "+date.camel+" parameter"))
+			return
+		}
+		*date.dest = time.Unix(parsed, 0)
+	}
+
+	prices, err := h.marketDataService.GetPriceHistory(c.Request.Context(), symbol, currency, investmentType, from, to)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	data := make([]*investmentv1.PriceBar, len(prices))
+	for i, price := range prices {
+		data[i] = price.ToProto()
+	}
+
+	handler.Success(c, &investmentv1.GetPriceHistoryResponse{
+		Success:   true,
+		Message:   "Price history retrieved successfully",
+		Symbol:    symbol,
+		Currency:  currency,
+		Data:      data,
+		Timestamp: time.Now().Format(time.RFC3339),
+	})
+}
+
 // getDisplayUnitForType returns the appropriate display unit for an investment type and symbol.
 func getDisplayUnitForType(investmentType investmentv1.InvestmentType, symbol string) string {
 	switch investmentType {
@@ -998,3 +1328,204 @@ func convertPriceForDisplay(storagePrice int64, currency string, investmentType
 	}
 }
 
+
+// ListAllocationTargets retrieves the allocation targets of a wallet.
+// @Summary List allocation targets
+// @Tags investments
+// @Produce json
+// @Param walletId query int false "Investment wallet (optional, 0 or omitted = all wallets)"
+// @Success 200 {object} types.APIResponse{data=investmentv1.ListAllocationTargetsResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/portfolio/allocation-targets [get]
+func (h *InvestmentHandlers) ListAllocationTargets(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Build request
+	var req investmentv1.ListAllocationTargetsRequest
+
+	// Parse optional walletId (support both snake_case and camelCase)
+	walletIDStr := c.Query("walletId")
+	if walletIDStr == "" {
+		walletIDStr = c.Query("wallet_id") // Fallback to snake_case
+	}
+	if walletIDStr != "" {
+		walletID, err := strconv.ParseInt(walletIDStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid walletId parameter"))
+			return
+		}
+		req.WalletId = int32(walletID)
+	}
+
+	// Call service
+	result, err := h.investmentService.ListAllocationTargets(c.Request.Context(), userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// SetAllocationTargets replaces the allocation targets of a wallet.
+// @Summary Set allocation targets
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Param request body investmentv1.SetAllocationTargetsRequest true "Target weights; an empty list clears them"
+// @Success 200 {object} types.APIResponse{data=investmentv1.SetAllocationTargetsResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/portfolio/allocation-targets [put]
+func (h *InvestmentHandlers) SetAllocationTargets(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Bind and validate request
+	var req investmentv1.SetAllocationTargetsRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.investmentService.SetAllocationTargets(c.Request.Context(), userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// GetRebalancingPlan compares holdings with the allocation targets and suggests trades.
+// @Summary Get rebalancing plan
+// @Tags investments
+// @Produce json
+// @Param walletId query int false "Investment wallet (optional, 0 or omitted = all wallets)"
+// @Param newCash query int false "Cash to deploy, in the preferred currency's smallest unit"
+// @Param minTradeAmount query int false "Skip trades smaller than this, in the preferred currency's smallest unit"
+// @Success 200 {object} types.APIResponse{data=investmentv1.GetRebalancingPlanResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/portfolio/rebalance [get]
+func (h *InvestmentHandlers) GetRebalancingPlan(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Build request
+	var req investmentv1.GetRebalancingPlanRequest
+
+	// Parse optional walletId (support both snake_case and camelCase)
+	walletIDStr := c.Query("walletId")
+	if walletIDStr == "" {
+		walletIDStr = c.Query("wallet_id") // Fallback to snake_case
+	}
+	if walletIDStr != "" {
+		walletID, err := strconv.ParseInt(walletIDStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid walletId parameter"))
+			return
+		}
+		req.WalletId = int32(walletID)
+	}
+
+	// Parse optional amounts (support both snake_case and camelCase)
+	amounts := []struct {
+		camel, snake string
+		dest         *int64
+	}{
+		{"newCash", "new_cash", &req.NewCash},
+		{"minTradeAmount", "min_trade_amount", &req.MinTradeAmount},
+	}
+	for _, amount := range amounts {
+		value := c.Query(amount.camel)
+		if value == "" {
+			value = c.Query(amount.snake) // Fallback to snake_case
+		}
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid "+amount.camel+" parameter"))
+			return
+		}
+		*amount.dest = parsed
+	}
+
+	// Call service
+	result, err := h.investmentService.GetRebalancingPlan(c.Request.Context(), userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// GetPriceProviderHealth reports the health of the market price providers.
+// @Summary Get price provider health
+// @Description Returns each price provider with its recent failures and cooldown, to tell why prices may be stale
+// @Tags investments
+// @Produce json
+// @Success 200 {object} types.APIResponse{data=investmentv1.GetPriceProviderHealthResponse}
+// @Failure 401 {object} types.APIResponse
+// @Router /api/v1/investments/price-providers [get]
+func (h *InvestmentHandlers) GetPriceProviderHealth(c *gin.Context) {
+	// Get user ID from context (for authentication check)
+	_, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	providers := h.marketDataService.ProviderHealth()
+	data := make([]*investmentv1.PriceProviderHealth, len(providers))
+	for i, provider := range providers {
+		data[i] = &investmentv1.PriceProviderHealth{
+			Name:                provider.Name,
+			Healthy:             provider.Healthy,
+			ConsecutiveFailures: int32(provider.ConsecutiveFailures),
+			LastError:           provider.LastError,
+			LastSuccess:         unixOrZero(provider.LastSuccess),
+			LastFailure:         unixOrZero(provider.LastFailure),
+			CooldownUntil:       unixOrZero(provider.CooldownUntil),
+		}
+	}
+
+	handler.Success(c, &investmentv1.GetPriceProviderHealthResponse{
+		Success:   true,
+		Message:   "Price provider health retrieved successfully",
+		Data:      data,
+		Timestamp: time.Now().Format(time.RFC3339),
+	})
+}
+
+// unixOrZero returns the Unix timestamp of t, or 0 when t is not set.
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"wealthjourney/domain/service"
+	"wealthjourney/pkg/handler"
+	notificationv1 "wealthjourney/protobuf/v1"
+)
+
+// NotificationHandlers handles requests to the in-app notification inbox.
+type NotificationHandlers struct {
+	notificationService service.NotificationService
+}
+
+// NewNotificationHandlers creates a new NotificationHandlers instance.
+func NewNotificationHandlers(notificationService service.NotificationService) *NotificationHandlers {
+	return &NotificationHandlers{
+		notificationService: notificationService,
+	}
+}
+
+// ListNotifications lists the notifications of the authenticated user, newest first.
+// @Summary List notifications
+// @Tags notifications
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 20, max: 100)"
+// @Param unread_only query bool false "Only unread notifications"
+// @Success 200 {object} types.APIResponse{data=notificationv1.ListNotificationsResponse}
+// @Failure 401 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/notifications [get]
+func (h *NotificationHandlers) ListNotifications(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	unreadOnly, _ := strconv.ParseBool(c.Query("unread_only"))
+	req := &notificationv1.ListNotificationsRequest{
+		Pagination: parsePaginationParamsProto(c),
+		UnreadOnly: unreadOnly,
+	}
+
+	// Call service
+	result, err := h.notificationService.ListNotifications(c.Request.Context(), userID, req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// MarkNotificationRead marks a notification as read.
+// @Summary Mark a notification as read
+// @Tags notifications
+// @Produce json
+// @Param id path int true "Notification ID"
+// @Success 200 {object} types.APIResponse{data=notificationv1.Notification}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/notifications/{id}/read [post]
+func (h *NotificationHandlers) MarkNotificationRead(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse notification ID
+	notificationID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.notificationService.MarkNotificationRead(c.Request.Context(), notificationID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// MarkAllNotificationsRead marks all notifications of the authenticated user as read.
+// @Summary Mark all notifications as read
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} types.APIResponse{data=notificationv1.MarkAllNotificationsReadResponse}
+// @Failure 401 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/notifications/read [post]
+func (h *NotificationHandlers) MarkAllNotificationsRead(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Call service
+	result, err := h.notificationService.MarkAllNotificationsRead(c.Request.Context(), userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
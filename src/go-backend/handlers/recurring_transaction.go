@@ -0,0 +1,377 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"wealthjourney/domain/service"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/handler"
+	transactionv1 "wealthjourney/protobuf/v1"
+)
+
+// RecurringTransactionHandlers handles recurring transaction HTTP requests.
+type RecurringTransactionHandlers struct {
+	recurringService service.RecurringTransactionService
+}
+
+// NewRecurringTransactionHandlers creates a new RecurringTransactionHandlers instance.
+func NewRecurringTransactionHandlers(recurringService service.RecurringTransactionService) *RecurringTransactionHandlers {
+	return &RecurringTransactionHandlers{
+		recurringService: recurringService,
+	}
+}
+
+// CreateRecurringTransaction creates a new recurring transaction.
+// @Summary Create a recurring transaction
+// @Tags recurring-transactions
+// @Accept json
+// @Produce json
+// @Param request body transactionv1.CreateRecurringTransactionRequest true "Recurring transaction creation request"
+// @Success 201 {object} types.APIResponse{data=transactionv1.RecurringTransaction}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/recurring [post]
+func (h *RecurringTransactionHandlers) CreateRecurringTransaction(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Bind and validate request
+	var req transactionv1.CreateRecurringTransactionRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Validate required fields
+	if req.WalletId == 0 {
+		handler.BadRequest(c, apperrors.NewValidationError("wallet_id is required"))
+		return
+	}
+
+	if req.Rule == nil {
+		handler.BadRequest(c, apperrors.NewValidationError("rule is required"))
+		return
+	}
+
+	// Call service
+	result, err := h.recurringService.CreateRecurringTransaction(c.Request.Context(), userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// GetRecurringTransaction retrieves a recurring transaction by ID.
+// @Summary Get a recurring transaction
+// @Tags recurring-transactions
+// @Produce json
+// @Param id path int true "Recurring transaction ID"
+// @Success 200 {object} types.APIResponse{data=transactionv1.RecurringTransaction}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/recurring/{id} [get]
+func (h *RecurringTransactionHandlers) GetRecurringTransaction(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse recurring transaction ID
+	recurringID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.recurringService.GetRecurringTransaction(c.Request.Context(), recurringID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// ListRecurringTransactions lists recurring transactions.
+// @Summary List recurring transactions
+// @Tags recurring-transactions
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 20, max: 100)"
+// @Param wallet_id query int false "Filter by wallet ID"
+// @Param status query string false "Filter by status (active, paused, completed)"
+// @Success 200 {object} types.APIResponse{data=transactionv1.ListRecurringTransactionsResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/recurring [get]
+func (h *RecurringTransactionHandlers) ListRecurringTransactions(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Build request from query parameters
+	req := &transactionv1.ListRecurringTransactionsRequest{
+		Pagination: parsePaginationParamsProto(c),
+	}
+
+	if walletIDStr := c.Query("wallet_id"); walletIDStr != "" {
+		walletID, err := strconv.ParseInt(walletIDStr, 10, 32)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid wallet_id"))
+			return
+		}
+		id := int32(walletID)
+		req.WalletId = &id
+	}
+
+	if statusStr := c.Query("status"); statusStr != "" {
+		status, ok := parseRecurringStatus(statusStr)
+		if !ok {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid status"))
+			return
+		}
+		req.Status = &status
+	}
+
+	// Call service
+	result, err := h.recurringService.ListRecurringTransactions(c.Request.Context(), userID, req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// UpdateRecurringTransaction updates a recurring transaction. Only future occurrences are affected.
+// @Summary Update a recurring transaction
+// @Tags recurring-transactions
+// @Accept json
+// @Produce json
+// @Param id path int true "Recurring transaction ID"
+// @Param request body transactionv1.UpdateRecurringTransactionRequest true "Recurring transaction update request"
+// @Success 200 {object} types.APIResponse{data=transactionv1.RecurringTransaction}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/recurring/{id} [put]
+func (h *RecurringTransactionHandlers) UpdateRecurringTransaction(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse recurring transaction ID
+	recurringID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req transactionv1.UpdateRecurringTransactionRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.recurringService.UpdateRecurringTransaction(c.Request.Context(), recurringID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// DeleteRecurringTransaction deletes a recurring transaction. Generated transactions are kept.
+// @Summary Delete a recurring transaction
+// @Tags recurring-transactions
+// @Produce json
+// @Param id path int true "Recurring transaction ID"
+// @Success 200 {object} types.APIResponse
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/recurring/{id} [delete]
+func (h *RecurringTransactionHandlers) DeleteRecurringTransaction(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse recurring transaction ID
+	recurringID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.recurringService.DeleteRecurringTransaction(c.Request.Context(), recurringID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// PauseRecurringTransaction pauses a recurring transaction.
+// @Summary Pause a recurring transaction
+// @Tags recurring-transactions
+// @Produce json
+// @Param id path int true "Recurring transaction ID"
+// @Success 200 {object} types.APIResponse{data=transactionv1.RecurringTransaction}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/recurring/{id}/pause [post]
+func (h *RecurringTransactionHandlers) PauseRecurringTransaction(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse recurring transaction ID
+	recurringID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.recurringService.PauseRecurringTransaction(c.Request.Context(), recurringID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// ResumeRecurringTransaction resumes a paused recurring transaction.
+// @Summary Resume a recurring transaction
+// @Tags recurring-transactions
+// @Produce json
+// @Param id path int true "Recurring transaction ID"
+// @Success 200 {object} types.APIResponse{data=transactionv1.RecurringTransaction}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/recurring/{id}/resume [post]
+func (h *RecurringTransactionHandlers) ResumeRecurringTransaction(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse recurring transaction ID
+	recurringID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.recurringService.ResumeRecurringTransaction(c.Request.Context(), recurringID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// SkipRecurringOccurrence skips the next (or a specific upcoming) occurrence.
+// @Summary Skip a recurring occurrence
+// @Tags recurring-transactions
+// @Accept json
+// @Produce json
+// @Param id path int true "Recurring transaction ID"
+// @Param request body transactionv1.SkipRecurringOccurrenceRequest false "Occurrence to skip (defaults to the next one)"
+// @Success 200 {object} types.APIResponse{data=transactionv1.RecurringTransaction}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/recurring/{id}/skip [post]
+func (h *RecurringTransactionHandlers) SkipRecurringOccurrence(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse recurring transaction ID
+	recurringID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Body is optional: an empty body skips the next occurrence
+	var req transactionv1.SkipRecurringOccurrenceRequest
+	if c.Request.ContentLength > 0 {
+		if err := handler.BindAndValidate(c, &req); err != nil {
+			handler.BadRequest(c, err)
+			return
+		}
+	}
+
+	// Call service
+	result, err := h.recurringService.SkipRecurringOccurrence(c.Request.Context(), recurringID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// parseRecurringStatus parses a recurring transaction status from a query value.
+func parseRecurringStatus(value string) (transactionv1.RecurringTransactionStatus, bool) {
+	switch strings.ToLower(value) {
+	case "active", "recurring_transaction_status_active":
+		return transactionv1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE, true
+	case "paused", "recurring_transaction_status_paused":
+		return transactionv1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_PAUSED, true
+	case "completed", "recurring_transaction_status_completed":
+		return transactionv1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_COMPLETED, true
+	default:
+		return transactionv1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_UNSPECIFIED, false
+	}
+}
@@ -89,6 +89,13 @@ func RegisterRoutes(
 		wallets.POST("/:id/add", h.Wallet.AddFunds)
 		wallets.POST("/:id/withdraw", h.Wallet.WithdrawFunds)
 		wallets.POST("/:id/adjust", h.Wallet.AdjustBalance)
+		// Reconciliation routes
+		wallets.POST("/:id/reconciliations", h.Wallet.StartReconciliation)
+		wallets.GET("/:id/reconciliations", h.Wallet.ListReconciliations)
+		wallets.GET("/:id/reconciliations/:reconciliationId", h.Wallet.GetReconciliation)
+		wallets.POST("/:id/reconciliations/:reconciliationId/transactions", h.Wallet.UpdateReconciliationTransactions)
+		wallets.POST("/:id/reconciliations/:reconciliationId/finish", h.Wallet.FinishReconciliation)
+		wallets.POST("/:id/reconciliations/:reconciliationId/cancel", h.Wallet.CancelReconciliation)
 	}
 
 	// Transaction routes (protected)
@@ -104,10 +111,24 @@ func RegisterRoutes(
 		transactions.GET("/available-years", h.Transaction.GetAvailableYears)
 		transactions.GET("/financial-report", h.Transaction.GetFinancialReport)
 		transactions.GET("/category-breakdown", h.Transaction.GetCategoryBreakdown)
+		transactions.GET("/tag-breakdown", h.Transaction.GetTagBreakdown)
+		transactions.GET("/export/qif", h.Transaction.ExportQIF)
+
+		// Recurring transaction routes (must be before /:id)
+		transactions.POST("/recurring", h.Recurring.CreateRecurringTransaction)
+		transactions.GET("/recurring", h.Recurring.ListRecurringTransactions)
+		transactions.GET("/recurring/:id", h.Recurring.GetRecurringTransaction)
+		transactions.PUT("/recurring/:id", h.Recurring.UpdateRecurringTransaction)
+		transactions.DELETE("/recurring/:id", h.Recurring.DeleteRecurringTransaction)
+		transactions.POST("/recurring/:id/pause", h.Recurring.PauseRecurringTransaction)
+		transactions.POST("/recurring/:id/resume", h.Recurring.ResumeRecurringTransaction)
+		transactions.POST("/recurring/:id/skip", h.Recurring.SkipRecurringOccurrence)
 		// Parameterized routes
 		transactions.GET("/:id", h.Transaction.GetTransaction)
 		transactions.PUT("/:id", h.Transaction.UpdateTransaction)
 		transactions.DELETE("/:id", h.Transaction.DeleteTransaction)
+		transactions.POST("/:id/attachments", h.Attachment.UploadTransactionAttachment)
+		transactions.GET("/:id/attachments", h.Attachment.ListTransactionAttachments)
 	}
 
 	// Category routes (protected)
@@ -124,6 +145,40 @@ func RegisterRoutes(
 		categories.DELETE("/:id", h.Category.DeleteCategory)
 	}
 
+	// Tag routes (protected)
+	tags := v1.Group("/tags")
+	if rateLimiter != nil {
+		tags.Use(appmiddleware.RateLimitByUser(rateLimiter))
+	}
+	tags.Use(AuthMiddleware())
+	{
+		tags.POST("", h.Tag.CreateTag)
+		tags.GET("", h.Tag.ListTags)
+		tags.GET("/:id", h.Tag.GetTag)
+		tags.PUT("/:id", h.Tag.UpdateTag)
+		tags.DELETE("/:id", h.Tag.DeleteTag)
+	}
+
+	// Attachment routes (protected)
+	attachments := v1.Group("/attachments")
+	if rateLimiter != nil {
+		attachments.Use(appmiddleware.RateLimitByUser(rateLimiter))
+	}
+	attachments.Use(AuthMiddleware())
+	{
+		attachments.GET("/:id", h.Attachment.GetAttachment)
+		attachments.DELETE("/:id", h.Attachment.DeleteAttachment)
+	}
+
+	// Stored files (public: the signed URL authorizes the download)
+	if h.Files != nil {
+		files := v1.Group("/files")
+		if rateLimiter != nil {
+			files.Use(appmiddleware.RateLimitByIP(rateLimiter))
+		}
+		files.GET("/*key", h.Files.ServeFile)
+	}
+
 	// Budget routes (protected)
 	budgets := v1.Group("/budgets")
 	if rateLimiter != nil {
@@ -140,6 +195,41 @@ func RegisterRoutes(
 		budgets.POST("/:id/items", h.Budget.CreateBudgetItem)
 		budgets.PUT("/:id/items/:itemId", h.Budget.UpdateBudgetItem)
 		budgets.DELETE("/:id/items/:itemId", h.Budget.DeleteBudgetItem)
+		budgets.GET("/:id/envelopes", h.Budget.GetBudgetEnvelopes)
+		budgets.POST("/:id/envelopes/moves", h.Budget.MoveBudgetMoney)
+		budgets.GET("/:id/envelopes/moves", h.Budget.ListBudgetMoves)
+		budgets.POST("/:id/alert-rules", h.Budget.CreateBudgetAlertRule)
+		budgets.GET("/:id/alert-rules", h.Budget.ListBudgetAlertRules)
+		budgets.PUT("/:id/alert-rules/:ruleId", h.Budget.UpdateBudgetAlertRule)
+		budgets.DELETE("/:id/alert-rules/:ruleId", h.Budget.DeleteBudgetAlertRule)
+		budgets.GET("/:id/variance", h.Budget.GetBudgetVarianceReport)
+		budgets.POST("/:id/copy", h.Budget.CopyBudgetToPeriod)
+	}
+
+	// Budget template routes (protected)
+	budgetTemplates := v1.Group("/budget-templates")
+	if rateLimiter != nil {
+		budgetTemplates.Use(appmiddleware.RateLimitByUser(rateLimiter))
+	}
+	budgetTemplates.Use(AuthMiddleware())
+	{
+		budgetTemplates.POST("", h.Budget.CreateBudgetTemplate)
+		budgetTemplates.GET("", h.Budget.ListBudgetTemplates)
+		budgetTemplates.GET("/:id", h.Budget.GetBudgetTemplate)
+		budgetTemplates.DELETE("/:id", h.Budget.DeleteBudgetTemplate)
+		budgetTemplates.POST("/:id/apply", h.Budget.ApplyBudgetTemplate)
+	}
+
+	// Notification inbox routes (protected)
+	notifications := v1.Group("/notifications")
+	if rateLimiter != nil {
+		notifications.Use(appmiddleware.RateLimitByUser(rateLimiter))
+	}
+	notifications.Use(AuthMiddleware())
+	{
+		notifications.GET("", h.Notification.ListNotifications)
+		notifications.POST("/read", h.Notification.MarkAllNotificationsRead)
+		notifications.POST("/:id/read", h.Notification.MarkNotificationRead)
 	}
 
 	// Investment routes (protected)
@@ -157,6 +247,10 @@ func RegisterRoutes(
 		investments.GET("/symbols/search", h.Investment.SearchSymbols)
 		// Market price lookup (must come before :id parameterized route)
 		investments.GET("/market-price", h.Investment.GetMarketPrice)
+		// Daily price history (must come before :id parameterized route)
+		investments.GET("/price-history", h.Investment.GetPriceHistory)
+		// Price provider health (must come before :id parameterized route)
+		investments.GET("/price-providers", h.Investment.GetPriceProviderHealth)
 		// Gold type codes (must come before :id parameterized route)
 		investments.GET("/gold-types", h.Gold.GetGoldTypeCodes)
 		// Silver type codes (must come before :id parameterized route)
@@ -184,6 +278,8 @@ func RegisterRoutes(
 	{
 		investmentTransactions.PUT("/:id", h.Investment.EditTransaction)
 		investmentTransactions.DELETE("/:id", h.Investment.DeleteTransaction)
+		investmentTransactions.POST("/:id/attachments", h.Attachment.UploadInvestmentTransactionAttachment)
+		investmentTransactions.GET("/:id/attachments", h.Attachment.ListInvestmentTransactionAttachments)
 	}
 
 	// Aggregated portfolio summary route (protected)
@@ -206,6 +302,11 @@ func RegisterRoutes(
 	portfolio.Use(AuthMiddleware())
 	{
 		portfolio.GET("/historical-values", h.Investment.GetHistoricalPortfolioValues)
+		portfolio.GET("/returns", h.Investment.GetPerformanceReturns)
+		portfolio.GET("/realized-gains", h.Investment.GetRealizedGainsReport)
+		portfolio.GET("/allocation-targets", h.Investment.ListAllocationTargets)
+		portfolio.PUT("/allocation-targets", h.Investment.SetAllocationTargets)
+		portfolio.GET("/rebalance", h.Investment.GetRebalancingPlan)
 	}
 
 	// Import routes (protected)
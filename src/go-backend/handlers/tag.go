@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wealthjourney/domain/service"
+	apperrors "wealthjourney/pkg/errors"
+	"wealthjourney/pkg/handler"
+	transactionv1 "wealthjourney/protobuf/v1"
+)
+
+// TagHandlers handles tag-related HTTP requests.
+type TagHandlers struct {
+	tagService service.TagService
+}
+
+// NewTagHandlers creates a new TagHandlers instance.
+func NewTagHandlers(tagService service.TagService) *TagHandlers {
+	return &TagHandlers{
+		tagService: tagService,
+	}
+}
+
+// CreateTag creates a new tag.
+// @Summary Create a new tag
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param request body transactionv1.CreateTagRequest true "Tag creation request"
+// @Success 201 {object} types.APIResponse{data=transactionv1.Tag}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 409 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/tags [post]
+func (h *TagHandlers) CreateTag(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Bind and validate request
+	var req transactionv1.CreateTagRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Validate required fields
+	if req.Name == "" {
+		handler.BadRequest(c, apperrors.NewValidationError("name is required"))
+		return
+	}
+
+	// Call service
+	result, err := h.tagService.CreateTag(c.Request.Context(), userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Created(c, result)
+}
+
+// GetTag retrieves a tag by ID.
+// @Summary Get a tag
+// @Tags tags
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Success 200 {object} types.APIResponse{data=transactionv1.Tag}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/tags/{id} [get]
+func (h *TagHandlers) GetTag(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse tag ID
+	tagID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.tagService.GetTag(c.Request.Context(), tagID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// ListTags lists the user's tags ordered by name.
+// @Summary List tags
+// @Tags tags
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 20, max: 100)"
+// @Success 200 {object} types.APIResponse{data=transactionv1.ListTagsResponse}
+// @Failure 401 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/tags [get]
+func (h *TagHandlers) ListTags(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	req := &transactionv1.ListTagsRequest{
+		Pagination: parsePaginationParamsProto(c),
+	}
+
+	// Call service
+	result, err := h.tagService.ListTags(c.Request.Context(), userID, req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// UpdateTag renames a tag.
+// @Summary Rename a tag
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Param request body transactionv1.UpdateTagRequest true "Tag update request"
+// @Success 200 {object} types.APIResponse{data=transactionv1.Tag}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 409 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/tags/{id} [put]
+func (h *TagHandlers) UpdateTag(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse tag ID
+	tagID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req transactionv1.UpdateTagRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Validate required fields
+	if req.Name == "" {
+		handler.BadRequest(c, apperrors.NewValidationError("name is required"))
+		return
+	}
+
+	// Call service
+	result, err := h.tagService.UpdateTag(c.Request.Context(), tagID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// DeleteTag deletes a tag and removes it from all transactions.
+// @Summary Delete a tag
+// @Tags tags
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Success 200 {object} types.APIResponse
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/tags/{id} [delete]
+func (h *TagHandlers) DeleteTag(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse tag ID
+	tagID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.tagService.DeleteTag(c.Request.Context(), tagID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
@@ -1,6 +1,9 @@
 package handlers
 
 import (
+	"bytes"
+	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -122,6 +125,8 @@ func (h *TransactionHandlers) GetTransaction(c *gin.Context) {
 // @Param min_amount query int false "Filter by minimum amount (in cents)"
 // @Param max_amount query int false "Filter by maximum amount (in cents)"
 // @Param search_note query string false "Search in note field"
+// @Param tag_ids query string false "Comma-separated tag IDs to filter"
+// @Param tag_match query string false "Match any (default) or all of tag_ids"
 // @Param sort_field query string false "Sort field (date, amount, created_at)"
 // @Param sort_order query string false "Sort order (asc, desc)"
 // @Success 200 {object} types.APIResponse{data=transactionv1.ListTransactionsResponse}
@@ -276,6 +281,7 @@ func (h *TransactionHandlers) GetAvailableYears(c *gin.Context) {
 // @Produce json
 // @Param year query int true "Year to get report for"
 // @Param wallet_ids query string false "Comma-separated wallet IDs to filter"
+// @Param include_transfers query bool false "Count transfers between wallets as income and expense (default: false)"
 // @Success 200 {object} types.APIResponse{data=transactionv1.GetFinancialReportResponse}
 // @Failure 400 {object} types.APIResponse
 // @Failure 401 {object} types.APIResponse
@@ -303,8 +309,10 @@ func (h *TransactionHandlers) GetFinancialReport(c *gin.Context) {
 	}
 
 	// Build request
+	includeTransfers, _ := strconv.ParseBool(c.Query("include_transfers"))
 	req := &transactionv1.GetFinancialReportRequest{
-		Year: int32(year),
+		Year:             int32(year),
+		IncludeTransfers: includeTransfers,
 	}
 
 	// Parse wallet_ids if provided
@@ -335,6 +343,7 @@ func (h *TransactionHandlers) GetFinancialReport(c *gin.Context) {
 // @Param end_date query int true "End date (Unix timestamp)"
 // @Param wallet_ids query string false "Comma-separated wallet IDs to filter"
 // @Param category_type query int false "Filter by category type (1=Income, 2=Expense)"
+// @Param include_transfers query bool false "Count transfers between wallets (default: false)"
 // @Success 200 {object} types.APIResponse{data=transactionv1.GetCategoryBreakdownResponse}
 // @Failure 400 {object} types.APIResponse
 // @Failure 401 {object} types.APIResponse
@@ -400,6 +409,7 @@ func (h *TransactionHandlers) GetCategoryBreakdown(c *gin.Context) {
 		categoryType := transactionv1.CategoryType(categoryTypeInt)
 		req.CategoryType = &categoryType
 	}
+	req.IncludeTransfers, _ = strconv.ParseBool(c.Query("include_transfers"))
 
 	// Call service
 	result, err := h.transactionService.GetCategoryBreakdown(c.Request.Context(), userID, req)
@@ -411,6 +421,125 @@ func (h *TransactionHandlers) GetCategoryBreakdown(c *gin.Context) {
 	handler.Success(c, result)
 }
 
+// GetTagBreakdown retrieves tag-wise income and expense for a date range.
+// @Summary Get tag breakdown for transactions
+// @Tags transactions
+// @Produce json
+// @Param start_date query int true "Start date (Unix timestamp)"
+// @Param end_date query int true "End date (Unix timestamp)"
+// @Param wallet_ids query string false "Comma-separated wallet IDs to filter"
+// @Param tag_ids query string false "Comma-separated tag IDs to include"
+// @Param include_transfers query bool false "Count transfers between wallets (default: false)"
+// @Success 200 {object} types.APIResponse{data=transactionv1.GetTagBreakdownResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/tag-breakdown [get]
+func (h *TransactionHandlers) GetTagBreakdown(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse start_date parameter
+	startDateStr := c.Query("start_date")
+	if startDateStr == "" {
+		handler.BadRequest(c, apperrors.NewValidationError("start_date parameter is required"))
+		return
+	}
+
+	startDate, err := strconv.ParseInt(startDateStr, 10, 64)
+	if err != nil {
+		handler.BadRequest(c, apperrors.NewValidationError("invalid start_date format"))
+		return
+	}
+
+	// Parse end_date parameter
+	endDateStr := c.Query("end_date")
+	if endDateStr == "" {
+		handler.BadRequest(c, apperrors.NewValidationError("end_date parameter is required"))
+		return
+	}
+
+	endDate, err := strconv.ParseInt(endDateStr, 10, 64)
+	if err != nil {
+		handler.BadRequest(c, apperrors.NewValidationError("invalid end_date format"))
+		return
+	}
+
+	req := &transactionv1.GetTagBreakdownRequest{
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	// Parse wallet_ids if provided
+	if walletIDsStr := c.Query("wallet_ids"); walletIDsStr != "" {
+		walletIDs, err := parseCommaSeparatedInt32(walletIDsStr)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid wallet_ids format"))
+			return
+		}
+		req.WalletIds = walletIDs
+	}
+
+	// Parse tag_ids if provided
+	if tagIDsStr := c.Query("tag_ids"); tagIDsStr != "" {
+		tagIDs, err := parseCommaSeparatedInt32(tagIDsStr)
+		if err != nil {
+			handler.BadRequest(c, apperrors.NewValidationError("invalid tag_ids format"))
+			return
+		}
+		req.TagIds = tagIDs
+	}
+	req.IncludeTransfers, _ = strconv.ParseBool(c.Query("include_transfers"))
+
+	// Call service
+	result, err := h.transactionService.GetTagBreakdown(c.Request.Context(), userID, req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// ExportQIF exports the transactions of a wallet as a QIF file.
+// @Summary Export wallet transactions as QIF
+// @Tags transactions
+// @Produce application/qif
+// @Param wallet_id query int true "Wallet ID"
+// @Success 200 {file} file "QIF file"
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/transactions/export/qif [get]
+func (h *TransactionHandlers) ExportQIF(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	walletID, err := strconv.ParseInt(c.Query("wallet_id"), 10, 32)
+	if err != nil || walletID <= 0 {
+		handler.BadRequest(c, apperrors.NewValidationError("wallet_id parameter is required"))
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.transactionService.ExportQIF(c.Request.Context(), userID, int32(walletID), &buf); err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("wallet-%d.qif", walletID)))
+	c.Data(http.StatusOK, "application/qif; charset=utf-8", buf.Bytes())
+}
+
 // Helper functions for parsing query parameters
 
 // parseTransactionFilter parses filter parameters from query string.
@@ -489,6 +618,27 @@ func parseTransactionFilter(c *gin.Context) *transactionv1.TransactionFilter {
 		filter.SearchNote = &searchNote
 	}
 
+	// Parse status (numeric enum value)
+	if statusStr := c.Query("status"); statusStr != "" {
+		if statusNum, err := strconv.ParseInt(statusStr, 10, 32); err == nil {
+			status := transactionv1.TransactionStatus(statusNum)
+			filter.Status = &status
+		}
+	}
+
+	// Parse tag_ids and tag_match ("any" or "all")
+	if tagIDsStr := c.Query("tag_ids"); tagIDsStr != "" {
+		if tagIDs, err := parseCommaSeparatedInt32(tagIDsStr); err == nil {
+			filter.TagIds = tagIDs
+		}
+	}
+	switch strings.ToLower(c.Query("tag_match")) {
+	case "all":
+		filter.TagMatch = transactionv1.TagMatchMode_TAG_MATCH_MODE_ALL
+	case "any":
+		filter.TagMatch = transactionv1.TagMatchMode_TAG_MATCH_MODE_ANY
+	}
+
 	return filter
 }
 
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"wealthjourney/domain/service"
+	apperrors "wealthjourney/pkg/errors"
 	"wealthjourney/pkg/handler"
 	"wealthjourney/protobuf/v1"
 )
@@ -206,7 +207,7 @@ func (h *UserHandlers) DeleteUser(c *gin.Context) {
 // @Tags users
 // @Accept json
 // @Produce json
-// @Param request body object{preferences:object{preferredCurrency:string}} true "User preferences update request"
+// @Param request body object{preferences:object{preferredCurrency:string,defaultCostBasisMethod:int}} true "User preferences update request"
 // @Success 200 {object} types.APIResponse{data=protobufv1.User}
 // @Failure 400 {object} types.APIResponse
 // @Failure 401 {object} types.APIResponse
@@ -223,7 +224,8 @@ func (h *UserHandlers) UpdatePreferences(c *gin.Context) {
 
 	var req struct {
 		Preferences *struct {
-			PreferredCurrency string `json:"preferredCurrency" binding:"required"`
+			PreferredCurrency      string             `json:"preferredCurrency"`
+			DefaultCostBasisMethod v1.CostBasisMethod `json:"defaultCostBasisMethod"`
 		} `json:"preferences" binding:"required"`
 	}
 
@@ -232,10 +234,17 @@ func (h *UserHandlers) UpdatePreferences(c *gin.Context) {
 		return
 	}
 
+	if req.Preferences.PreferredCurrency == "" &&
+		req.Preferences.DefaultCostBasisMethod == v1.CostBasisMethod_COST_BASIS_METHOD_UNSPECIFIED {
+		handler.BadRequest(c, apperrors.NewValidationError("preferredCurrency or defaultCostBasisMethod is required"))
+		return
+	}
+
 	// Build protobuf request
 	protoReq := &v1.UpdatePreferencesRequest{
 		Preferences: &v1.UserPreferences{
-			PreferredCurrency: req.Preferences.PreferredCurrency,
+			PreferredCurrency:      req.Preferences.PreferredCurrency,
+			DefaultCostBasisMethod: req.Preferences.DefaultCostBasisMethod,
 		},
 	}
 
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wealthjourney/pkg/handler"
+	protobufv1 "wealthjourney/protobuf/v1"
+)
+
+// parseReconciliationParams parses the wallet and reconciliation IDs from the URL.
+func parseReconciliationParams(c *gin.Context) (int32, int32, error) {
+	walletID, err := parseIDParam(c, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+	reconciliationID, err := parseIDParam(c, "reconciliationId")
+	if err != nil {
+		return 0, 0, err
+	}
+	return walletID, reconciliationID, nil
+}
+
+// StartReconciliation starts or resumes reconciling a wallet against a bank statement.
+// @Summary Start a wallet reconciliation
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path int true "Wallet ID"
+// @Param request body protobufv1.StartReconciliationRequest true "Statement date and ending balance"
+// @Success 200 {object} types.APIResponse{data=protobufv1.StartReconciliationResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/wallets/{id}/reconciliations [post]
+func (h *WalletHandlers) StartReconciliation(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse wallet ID
+	walletID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req protobufv1.StartReconciliationRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.walletService.StartReconciliation(c.Request.Context(), walletID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// ListReconciliations lists the reconciliation history of a wallet.
+// @Summary List wallet reconciliations
+// @Tags wallets
+// @Produce json
+// @Param id path int true "Wallet ID"
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Success 200 {object} types.APIResponse{data=protobufv1.ListReconciliationsResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/wallets/{id}/reconciliations [get]
+func (h *WalletHandlers) ListReconciliations(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	// Parse wallet ID
+	walletID, err := parseIDParam(c, "id")
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Parse pagination parameters
+	params := parsePaginationParams(c)
+	req := &protobufv1.ListReconciliationsRequest{
+		WalletId: walletID,
+		Pagination: &protobufv1.PaginationParams{
+			Page:     int32(params.Page),
+			PageSize: int32(params.PageSize),
+		},
+	}
+
+	// Call service
+	result, err := h.walletService.ListReconciliations(c.Request.Context(), walletID, userID, req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// GetReconciliation retrieves a reconciliation with the transactions that can be ticked.
+// @Summary Get a wallet reconciliation
+// @Tags wallets
+// @Produce json
+// @Param id path int true "Wallet ID"
+// @Param reconciliationId path int true "Reconciliation ID"
+// @Success 200 {object} types.APIResponse{data=protobufv1.GetReconciliationResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/wallets/{id}/reconciliations/{reconciliationId} [get]
+func (h *WalletHandlers) GetReconciliation(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	walletID, reconciliationID, err := parseReconciliationParams(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.walletService.GetReconciliation(c.Request.Context(), walletID, reconciliationID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// UpdateReconciliationTransactions ticks or unticks transactions in an open reconciliation.
+// @Summary Tick or untick reconciliation transactions
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path int true "Wallet ID"
+// @Param reconciliationId path int true "Reconciliation ID"
+// @Param request body protobufv1.UpdateReconciliationTransactionsRequest true "Transactions to clear or mark pending"
+// @Success 200 {object} types.APIResponse{data=protobufv1.UpdateReconciliationTransactionsResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 409 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/wallets/{id}/reconciliations/{reconciliationId}/transactions [post]
+func (h *WalletHandlers) UpdateReconciliationTransactions(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	walletID, reconciliationID, err := parseReconciliationParams(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Bind and validate request
+	var req protobufv1.UpdateReconciliationTransactionsRequest
+	if err := handler.BindAndValidate(c, &req); err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.walletService.UpdateReconciliationTransactions(c.Request.Context(), walletID, reconciliationID, userID, &req)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// FinishReconciliation reconciles and locks the cleared transactions.
+// @Summary Finish a wallet reconciliation
+// @Tags wallets
+// @Produce json
+// @Param id path int true "Wallet ID"
+// @Param reconciliationId path int true "Reconciliation ID"
+// @Success 200 {object} types.APIResponse{data=protobufv1.FinishReconciliationResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 409 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/wallets/{id}/reconciliations/{reconciliationId}/finish [post]
+func (h *WalletHandlers) FinishReconciliation(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	walletID, reconciliationID, err := parseReconciliationParams(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.walletService.FinishReconciliation(c.Request.Context(), walletID, reconciliationID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
+
+// CancelReconciliation abandons an open reconciliation.
+// @Summary Cancel a wallet reconciliation
+// @Tags wallets
+// @Produce json
+// @Param id path int true "Wallet ID"
+// @Param reconciliationId path int true "Reconciliation ID"
+// @Success 200 {object} types.APIResponse{data=protobufv1.CancelReconciliationResponse}
+// @Failure 400 {object} types.APIResponse
+// @Failure 401 {object} types.APIResponse
+// @Failure 404 {object} types.APIResponse
+// @Failure 409 {object} types.APIResponse
+// @Failure 500 {object} types.APIResponse
+// @Router /api/v1/wallets/{id}/reconciliations/{reconciliationId}/cancel [post]
+func (h *WalletHandlers) CancelReconciliation(c *gin.Context) {
+	// Get user ID from context
+	userID, ok := handler.GetUserID(c)
+	if !ok {
+		handler.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	walletID, reconciliationID, err := parseReconciliationParams(c)
+	if err != nil {
+		handler.BadRequest(c, err)
+		return
+	}
+
+	// Call service
+	result, err := h.walletService.CancelReconciliation(c.Request.Context(), walletID, reconciliationID, userID)
+	if err != nil {
+		handler.HandleError(c, err)
+		return
+	}
+
+	handler.Success(c, result)
+}
@@ -18,9 +18,11 @@ type Config struct {
 	Google       Google
 	RateLimit    RateLimit
 	YahooFinance YahooFinance
+	MarketData   MarketData
 	FX           FX
 	Import       Import
 	Storage      Storage
+	Notification Notification
 }
 
 type Server struct {
@@ -71,6 +73,10 @@ type YahooFinance struct {
 	RequestsPerMin   int
 }
 
+type MarketData struct {
+	PriceFixtureFile string // JSON price fixture served ahead of the live providers, for offline runs
+}
+
 type FX struct {
 	Enabled               bool
 	APIBaseURL            string
@@ -101,11 +107,38 @@ type Import struct {
 }
 
 type Storage struct {
-	Provider       string // "supabase" or "local" (for backward compatibility)
+	Provider       string // "supabase", "s3" or "local"
 	SupabaseURL    string
 	SupabaseAPIKey string
 	SupabaseBucket string
 	UploadDir      string // Local fallback directory
+	LocalDir       string // Root of the local filesystem provider, used for attachments without Supabase
+
+	// Local filesystem provider: files are served by the API under signed, expiring URLs
+	LocalBaseURL    string // URL the files are served under, e.g. "http://localhost:5000/api/v1/files"
+	LocalSigningKey string // HMAC key for file URLs; a random key is used when empty, so URLs don't survive restarts
+
+	// S3-compatible provider (AWS S3, MinIO, ...)
+	S3Endpoint        string // e.g. "https://s3.eu-west-1.amazonaws.com" or "http://localhost:9000"
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3UsePathStyle    bool // Address the bucket in the path instead of the host name, as MinIO expects
+}
+
+// Notification configures how budget alerts leave the app. The in-app inbox needs no
+// configuration.
+type Notification struct {
+	SMTPHost     string // Email delivery is disabled when empty
+	SMTPPort     int
+	SMTPUsername string // SMTP AUTH is skipped when empty
+	SMTPPassword string
+	SMTPFrom     string // Sender address, e.g. "WealthJourney <alerts@example.com>"
+
+	WebhookEnabled bool
+	WebhookSecret  string // Signs webhook payloads with HMAC-SHA256 when set
+	WebhookTimeout time.Duration
 }
 
 // Load loads configuration from environment variables
@@ -181,6 +214,15 @@ func Load() (*Config, error) {
 	duplicateThreshold, _ := strconv.ParseFloat(getEnv("IMPORT_DUPLICATE_THRESHOLD", "80"), 64)
 	undoWindowHours, _ := strconv.Atoi(getEnv("IMPORT_UNDO_WINDOW_HOURS", "24"))
 
+	// Storage settings
+	port := getEnv("PORT", "5000")
+	s3UsePathStyle, _ := strconv.ParseBool(getEnv("S3_USE_PATH_STYLE", "true"))
+
+	// Notification settings
+	smtpPort, _ := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+	webhookEnabled, _ := strconv.ParseBool(getEnv("WEBHOOK_ENABLED", "true"))
+	webhookTimeout, _ := time.ParseDuration(getEnv("WEBHOOK_TIMEOUT", "10s"))
+
 	// Validation settings
 	validationZeroAmountPolicy := getEnv("VALIDATION_ZERO_AMOUNT", "error") // "error", "warning", or "ignore"
 	validationLargeAmountThreshold, _ := strconv.ParseInt(getEnv("VALIDATION_LARGE_AMOUNT_THRESHOLD", "10000000000000"), 10, 64) // 1B VND
@@ -188,7 +230,7 @@ func Load() (*Config, error) {
 
 	cfg := &Config{
 		Server: Server{
-			Port:            getEnv("PORT", "5000"),
+			Port:            port,
 			ReadTimeout:     readTimeout,
 			WriteTimeout:    writeTimeout,
 			ShutdownTimeout: shutdownTimeout,
@@ -228,6 +270,9 @@ func Load() (*Config, error) {
 			FallbackToStale: yahooFallbackToStale,
 			RequestsPerMin:  yahooRequestsPerMin,
 		},
+		MarketData: MarketData{
+			PriceFixtureFile: getEnv("MARKET_DATA_PRICE_FIXTURE", ""),
+		},
 		FX: FX{
 			Enabled:            fxEnabled,
 			APIBaseURL:         getEnv("FX_API_BASE_URL", "https://api.exchangerate-api.com/v4"),
@@ -259,6 +304,28 @@ func Load() (*Config, error) {
 			SupabaseAPIKey: getEnv("SUPABASE_API_KEY", ""),
 			SupabaseBucket: getEnv("SUPABASE_BUCKET", "wealthjourney-uploads"),
 			UploadDir:      getEnv("UPLOAD_DIR", "/tmp/wealthjourney-uploads"),
+			LocalDir:       getEnv("LOCAL_STORAGE_DIR", "./data/storage"),
+
+			LocalBaseURL:    getEnv("LOCAL_STORAGE_BASE_URL", "http://localhost:"+port+"/api/v1/files"),
+			LocalSigningKey: getEnv("LOCAL_STORAGE_SIGNING_KEY", ""),
+
+			S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+			S3Region:          getEnv("S3_REGION", "us-east-1"),
+			S3Bucket:          getEnv("S3_BUCKET", "wealthjourney-uploads"),
+			S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+			S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+			S3UsePathStyle:    s3UsePathStyle,
+		},
+		Notification: Notification{
+			SMTPHost:     getEnv("SMTP_HOST", ""),
+			SMTPPort:     smtpPort,
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			SMTPFrom:     getEnv("SMTP_FROM", ""),
+
+			WebhookEnabled: webhookEnabled,
+			WebhookSecret:  getEnv("WEBHOOK_SECRET", ""),
+			WebhookTimeout: webhookTimeout,
 		},
 	}
 
@@ -303,6 +370,30 @@ func (c *Config) Validate() error {
 		return errors.New("RATE_LIMIT_REQUESTS_PER_MINUTE must be at least 1")
 	}
 
+	// Validate storage provider
+	switch c.Storage.Provider {
+	case "supabase", "local":
+	case "s3":
+		if c.Storage.S3Endpoint == "" || c.Storage.S3Bucket == "" {
+			return errors.New("S3_ENDPOINT and S3_BUCKET are required when STORAGE_PROVIDER is s3")
+		}
+		if c.Storage.S3AccessKeyID == "" || c.Storage.S3SecretAccessKey == "" {
+			return errors.New("S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY are required when STORAGE_PROVIDER is s3")
+		}
+	default:
+		return fmt.Errorf("unknown STORAGE_PROVIDER %q: expected supabase, s3 or local", c.Storage.Provider)
+	}
+
+	// Validate email delivery
+	if c.Notification.SMTPHost != "" {
+		if c.Notification.SMTPPort < 1 || c.Notification.SMTPPort > 65535 {
+			return errors.New("SMTP_PORT must be between 1 and 65535")
+		}
+		if c.Notification.SMTPFrom == "" {
+			return errors.New("SMTP_FROM is required when SMTP_HOST is set")
+		}
+	}
+
 	return nil
 }
 
@@ -36,3 +36,57 @@ func TestLoadConfig_SupabaseStorage(t *testing.T) {
 		t.Errorf("Expected SupabaseBucket to be 'test-bucket', got %s", cfg.Storage.SupabaseBucket)
 	}
 }
+
+func TestLoadConfig_S3Storage(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-12345")
+	t.Setenv("STORAGE_PROVIDER", "s3")
+	t.Setenv("S3_ENDPOINT", "http://localhost:9000")
+	t.Setenv("S3_BUCKET", "receipts")
+
+	if _, err := Load(); err == nil {
+		t.Fatal("Expected an error without S3 credentials")
+	}
+
+	t.Setenv("S3_ACCESS_KEY_ID", "minio")
+	t.Setenv("S3_SECRET_ACCESS_KEY", "minio-secret")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.Storage.S3Region != "us-east-1" || !cfg.Storage.S3UsePathStyle {
+		t.Errorf("Expected region us-east-1 with path-style addressing, got %s and %v", cfg.Storage.S3Region, cfg.Storage.S3UsePathStyle)
+	}
+
+	t.Setenv("STORAGE_PROVIDER", "ftp")
+	if _, err := Load(); err == nil {
+		t.Error("Expected an error for an unknown storage provider")
+	}
+}
+
+func TestLoadConfig_Notification(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-12345")
+	t.Setenv("STORAGE_PROVIDER", "local")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.Notification.SMTPHost != "" || !cfg.Notification.WebhookEnabled {
+		t.Errorf("Expected email disabled and webhooks enabled by default, got %q and %v", cfg.Notification.SMTPHost, cfg.Notification.WebhookEnabled)
+	}
+
+	t.Setenv("SMTP_HOST", "localhost")
+	if _, err := Load(); err == nil {
+		t.Fatal("Expected an error without SMTP_FROM")
+	}
+
+	t.Setenv("SMTP_FROM", "alerts@example.com")
+	t.Setenv("SMTP_PORT", "1025")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.Notification.SMTPPort != 1025 {
+		t.Errorf("Expected SMTP port 1025, got %d", cfg.Notification.SMTPPort)
+	}
+}
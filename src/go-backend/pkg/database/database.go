@@ -62,15 +62,35 @@ func New(cfg *config.Config) (*Database, error) {
 		&models.Wallet{},
 		&models.Category{},
 		&models.Transaction{},
+		&models.TransactionSplit{},
+		&models.Transfer{},
+		&models.Tag{},
+		&models.Attachment{},
 		&models.Budget{},
 		&models.BudgetItem{},
+		&models.BudgetItemCategory{},
+		&models.BudgetItemWallet{},
+		&models.BudgetMove{},
+		&models.BudgetEnvelopeSnapshot{},
+		&models.BudgetAlertRule{},
+		&models.BudgetAlert{},
+		&models.Notification{},
+		&models.BudgetTemplate{},
 		&models.Investment{},
 		&models.InvestmentTransaction{},
 		&models.InvestmentLot{},
+		&models.InvestmentSplitAdjustment{},
+		&models.InvestmentSellAllocation{},
+		&models.AllocationTarget{},
 		&models.MarketData{},
 		&models.PortfolioHistory{},
+		&models.PriceHistory{},
+		&models.BenchmarkPrice{},
 		&models.Session{},
 		&models.FXRate{},
+		&models.RecurringTransaction{},
+		&models.RecurringTransactionOccurrence{},
+		&models.WalletReconciliation{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
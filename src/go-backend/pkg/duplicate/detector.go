@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"wealthjourney/domain/models"
+	"wealthjourney/pkg/fx"
 	v1 "wealthjourney/protobuf/v1"
 )
 
@@ -92,11 +93,13 @@ func (d *Detector) findBestMatch(parsed *v1.ParsedTransaction, existingTxs []*mo
 	var bestMatch *DuplicateMatch
 
 	parsedDate := time.Unix(parsed.Date, 0)
-	parsedAmount := parsed.Amount.Amount
 	parsedDesc := parsed.Description
 	parsedRef := parsed.ReferenceNumber
 
 	for _, existing := range existingTxs {
+		// Parsed amounts are ×10000 while stored ones are in the currency's smallest unit
+		parsedAmount := fx.ParsedToStorageAmount(parsed.Amount.Amount, existing.Currency)
+
 		// Try each matching level in order of confidence
 		if match := d.level1Match(parsed, existing, parsedDate, parsedAmount, parsedRef); match != nil {
 			if bestMatch == nil || match.Confidence > bestMatch.Confidence {
@@ -206,7 +209,7 @@ func (d *Detector) level2Match(parsed *v1.ParsedTransaction, existing *models.Tr
 		ImportedTransaction: parsed,
 		ExistingTransaction: existing,
 		Confidence:          confidence,
-		MatchReason:         fmt.Sprintf("Strong match: same amount (%.2f), date within 1 day, %.0f%% description match", float64(parsedAmount)/10000, similarity),
+		MatchReason:         fmt.Sprintf("Strong match: same amount (%.2f), date within 1 day, %.0f%% description match", float64(parsedAmount)/float64(fx.GetDecimalMultiplier(existing.Currency)), similarity),
 	}
 }
 
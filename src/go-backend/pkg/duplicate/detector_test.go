@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"wealthjourney/domain/models"
+	"wealthjourney/pkg/fx"
 	v1 "wealthjourney/protobuf/v1"
 
 	"github.com/stretchr/testify/assert"
@@ -31,16 +32,17 @@ func createTestTransaction(id int32, walletID int32, amount int64, date time.Tim
 		ID:       id,
 		WalletID: walletID,
 		Amount:   amount,
+		Currency: "VND",
 		Date:     date,
 		Note:     description,
 	}
 }
 
-// Helper function to create test parsed transaction
+// Helper function to create test parsed transaction, amount is in VND and stored in parser format
 func createTestParsedTransaction(amount int64, date int64, description string, referenceNum string) *v1.ParsedTransaction {
 	return &v1.ParsedTransaction{
 		Amount: &v1.Money{
-			Amount:   amount,
+			Amount:   amount * fx.ParsedAmountScale,
 			Currency: "VND",
 		},
 		Date:            date,
@@ -223,6 +225,33 @@ func TestLevel1Match_ExactMatch(t *testing.T) {
 	assert.Contains(t, matches[0].MatchReason, "Exact match")
 }
 
+func TestLevel1Match_CentCurrency(t *testing.T) {
+	mockRepo := new(MockTransactionRepository)
+	detector := NewDetector(mockRepo)
+
+	testDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	walletID := int32(1)
+
+	// $123.45 is stored as 12345 cents and parsed as 1234500
+	existingTx := createTestTransaction(1, walletID, 12345, testDate, "PAYMENT TO STARBUCKS (Ref: REF123)", "")
+	existingTx.Currency = "USD"
+	parsedTx := &v1.ParsedTransaction{
+		Amount:          &v1.Money{Amount: 1234500, Currency: "USD"},
+		Date:            testDate.Unix(),
+		Description:     "PAYMENT TO STARBUCKS",
+		ReferenceNumber: "REF123",
+	}
+
+	mockRepo.On("FindByWalletAndDateRange", mock.Anything, walletID, mock.Anything, mock.Anything).
+		Return([]*models.Transaction{existingTx}, nil)
+
+	matches, err := detector.DetectDuplicates(context.Background(), walletID, []*v1.ParsedTransaction{parsedTx})
+
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, int32(99), matches[0].Confidence)
+}
+
 func TestLevel2Match_StrongMatch(t *testing.T) {
 	mockRepo := new(MockTransactionRepository)
 	detector := NewDetector(mockRepo)
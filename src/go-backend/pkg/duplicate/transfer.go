@@ -0,0 +1,143 @@
+package duplicate
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"wealthjourney/domain/models"
+	"wealthjourney/pkg/fx"
+	v1 "wealthjourney/protobuf/v1"
+)
+
+// TransferWindowDays is how many days apart the two sides of a transfer between the
+// user's own accounts may be booked, e.g. when the receiving bank credits a day later.
+const TransferWindowDays = 3
+
+// transferKeywords mark a description as a transfer between accounts.
+var transferKeywords = []string{"transfer", "xfer", "trf", "chuyen khoan", "chuyển khoản", "ck"}
+
+// TransferMatch is an imported row mirrored by a transaction of opposite sign in another
+// of the user's wallets: the debit and the credit of one transfer between two accounts.
+type TransferMatch struct {
+	ImportedTransaction    *v1.ParsedTransaction
+	CounterpartTransaction *models.Transaction
+	Confidence             int32
+	MatchReason            string
+}
+
+// DetectTransfers finds imported rows mirrored by a transaction of the same amount and
+// opposite sign, in the same currency, booked within TransferWindowDays in one of the
+// given wallets. Transactions that already are transfer legs are not considered. Each
+// row and each counterpart is matched at most once, closest dates first.
+func (d *Detector) DetectTransfers(ctx context.Context, walletIDs []int32, transactions []*v1.ParsedTransaction) ([]*TransferMatch, error) {
+	if len(transactions) == 0 || len(walletIDs) == 0 {
+		return []*TransferMatch{}, nil
+	}
+
+	var minDate, maxDate time.Time
+	for _, tx := range transactions {
+		txDate := time.Unix(tx.Date, 0)
+		if minDate.IsZero() || txDate.Before(minDate) {
+			minDate = txDate
+		}
+		if maxDate.IsZero() || txDate.After(maxDate) {
+			maxDate = txDate
+		}
+	}
+	searchStart := minDate.AddDate(0, 0, -TransferWindowDays)
+	searchEnd := maxDate.AddDate(0, 0, TransferWindowDays)
+
+	var candidates []*TransferMatch
+	for _, walletID := range walletIDs {
+		existingTxs, err := d.repo.FindByWalletAndDateRange(ctx, walletID, searchStart, searchEnd)
+		if err != nil {
+			return nil, err
+		}
+		for _, parsed := range transactions {
+			for _, existing := range existingTxs {
+				if match := transferMatch(parsed, existing); match != nil {
+					candidates = append(candidates, match)
+				}
+			}
+		}
+	}
+
+	// Best candidates first, so a row mirrored twice is linked to the closest counterpart
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+
+	matches := []*TransferMatch{}
+	matchedRows := make(map[*v1.ParsedTransaction]bool)
+	matchedCounterparts := make(map[int32]bool)
+	for _, candidate := range candidates {
+		if matchedRows[candidate.ImportedTransaction] || matchedCounterparts[candidate.CounterpartTransaction.ID] {
+			continue
+		}
+		matchedRows[candidate.ImportedTransaction] = true
+		matchedCounterparts[candidate.CounterpartTransaction.ID] = true
+		matches = append(matches, candidate)
+	}
+
+	// Report in the order of the imported rows
+	order := make(map[*v1.ParsedTransaction]int, len(transactions))
+	for i, tx := range transactions {
+		order[tx] = i
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return order[matches[i].ImportedTransaction] < order[matches[j].ImportedTransaction]
+	})
+
+	return matches, nil
+}
+
+// transferMatch matches a parsed row against a transaction of another wallet. Confidence
+// is 90 on the same day, 5 less per day apart, and 5 more when either description reads
+// like a transfer.
+func transferMatch(parsed *v1.ParsedTransaction, existing *models.Transaction) *TransferMatch {
+	if parsed.Amount == nil || existing.TransferID != nil {
+		return nil
+	}
+
+	amount := fx.ParsedToStorageAmount(parsed.Amount.Amount, existing.Currency)
+	if amount == 0 || existing.Amount != -amount {
+		return nil
+	}
+	if parsed.Amount.Currency != "" && existing.Currency != "" && parsed.Amount.Currency != existing.Currency {
+		return nil
+	}
+
+	daysApart := int(math.Round(math.Abs(time.Unix(parsed.Date, 0).Sub(existing.Date).Hours() / 24)))
+	if daysApart > TransferWindowDays {
+		return nil
+	}
+
+	confidence := int32(90 - 5*daysApart)
+	reason := fmt.Sprintf("Opposite amount in wallet %d, %d day(s) apart", existing.WalletID, daysApart)
+	if looksLikeTransfer(parsed.Description) || looksLikeTransfer(existing.Note) {
+		confidence += 5
+		reason += ", described as a transfer"
+	}
+
+	return &TransferMatch{
+		ImportedTransaction:    parsed,
+		CounterpartTransaction: existing,
+		Confidence:             confidence,
+		MatchReason:            reason,
+	}
+}
+
+// looksLikeTransfer reports whether a description mentions a transfer.
+func looksLikeTransfer(description string) bool {
+	words := " " + normalizeString(description) + " "
+	for _, keyword := range transferKeywords {
+		if strings.Contains(words, " "+keyword+" ") {
+			return true
+		}
+	}
+	return false
+}
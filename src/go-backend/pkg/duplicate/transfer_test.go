@@ -0,0 +1,116 @@
+package duplicate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/models"
+	v1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectTransfers(t *testing.T) {
+	day := time.Date(2024, 5, 10, 9, 0, 0, 0, time.UTC)
+	transferID := "already-linked"
+
+	// Parsed amounts are in parser format (×10000), stored ones in VND
+	parsed := []*v1.ParsedTransaction{
+		{RowNumber: 1, Date: day.Unix(), Amount: &v1.Money{Amount: -5000000000, Currency: "VND"}, Description: "CK sang tai khoan tiet kiem"},
+		{RowNumber: 2, Date: day.Unix(), Amount: &v1.Money{Amount: -1200000000, Currency: "VND"}, Description: "Grocery store"},
+		{RowNumber: 3, Date: day.Unix(), Amount: &v1.Money{Amount: 300000000, Currency: "VND"}, Description: "Refund"},
+	}
+
+	savings := []*models.Transaction{
+		// Credit of row 1, a day later and a same-day one: the same-day credit wins
+		{ID: 10, WalletID: 2, Amount: 500000, Currency: "VND", Date: day.AddDate(0, 0, 1), Note: "Incoming"},
+		{ID: 11, WalletID: 2, Amount: 500000, Currency: "VND", Date: day, Note: "Incoming"},
+		// Same sign as row 2
+		{ID: 12, WalletID: 2, Amount: -120000, Currency: "VND", Date: day},
+		// Mirrors row 3 but already is a transfer leg
+		{ID: 13, WalletID: 2, Amount: -30000, Currency: "VND", Date: day, TransferID: &transferID},
+	}
+	card := []*models.Transaction{
+		// Mirrors row 2 but too far apart
+		{ID: 20, WalletID: 3, Amount: 120000, Currency: "VND", Date: day.AddDate(0, 0, TransferWindowDays+1)},
+		// Mirrors row 3 in another currency
+		{ID: 21, WalletID: 3, Amount: -30000, Currency: "USD", Date: day},
+	}
+
+	repo := new(MockTransactionRepository)
+	repo.On("FindByWalletAndDateRange", mock.Anything, int32(2), mock.Anything, mock.Anything).Return(savings, nil)
+	repo.On("FindByWalletAndDateRange", mock.Anything, int32(3), mock.Anything, mock.Anything).Return(card, nil)
+
+	matches, err := NewDetector(repo).DetectTransfers(context.Background(), []int32{2, 3}, parsed)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+
+	assert.Equal(t, int32(1), matches[0].ImportedTransaction.RowNumber)
+	assert.Equal(t, int32(11), matches[0].CounterpartTransaction.ID)
+	assert.Equal(t, int32(95), matches[0].Confidence, "same day and described as a transfer")
+}
+
+func TestDetectTransfers_EachCounterpartOnce(t *testing.T) {
+	day := time.Date(2024, 5, 10, 9, 0, 0, 0, time.UTC)
+
+	parsed := []*v1.ParsedTransaction{
+		{RowNumber: 1, Date: day.AddDate(0, 0, -2).Unix(), Amount: &v1.Money{Amount: -1000000, Currency: "USD"}},
+		{RowNumber: 2, Date: day.Unix(), Amount: &v1.Money{Amount: -1000000, Currency: "USD"}},
+	}
+	other := []*models.Transaction{
+		{ID: 5, WalletID: 2, Amount: 10000, Currency: "USD", Date: day},
+	}
+
+	repo := new(MockTransactionRepository)
+	repo.On("FindByWalletAndDateRange", mock.Anything, int32(2), mock.Anything, mock.Anything).Return(other, nil)
+
+	matches, err := NewDetector(repo).DetectTransfers(context.Background(), []int32{2}, parsed)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, int32(2), matches[0].ImportedTransaction.RowNumber, "the closest row gets the counterpart")
+	assert.Equal(t, int32(90), matches[0].Confidence)
+}
+
+func TestDetectTransfers_CentCurrency(t *testing.T) {
+	day := time.Date(2024, 5, 10, 9, 0, 0, 0, time.UTC)
+
+	// $123.45 is parsed as 1234500 and stored as 12345 cents
+	parsed := []*v1.ParsedTransaction{
+		{RowNumber: 1, Date: day.Unix(), Amount: &v1.Money{Amount: -1234500, Currency: "USD"}, Description: "Transfer to savings"},
+	}
+	other := []*models.Transaction{
+		{ID: 7, WalletID: 2, Amount: 123, Currency: "USD", Date: day},
+		{ID: 8, WalletID: 2, Amount: 12345, Currency: "USD", Date: day},
+	}
+
+	repo := new(MockTransactionRepository)
+	repo.On("FindByWalletAndDateRange", mock.Anything, int32(2), mock.Anything, mock.Anything).Return(other, nil)
+
+	matches, err := NewDetector(repo).DetectTransfers(context.Background(), []int32{2}, parsed)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, int32(8), matches[0].CounterpartTransaction.ID)
+}
+
+func TestDetectTransfers_NoOtherWallets(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	parsed := []*v1.ParsedTransaction{
+		{RowNumber: 1, Date: time.Now().Unix(), Amount: &v1.Money{Amount: -10000, Currency: "VND"}},
+	}
+
+	matches, err := NewDetector(repo).DetectTransfers(context.Background(), nil, parsed)
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+	repo.AssertNotCalled(t, "FindByWalletAndDateRange")
+}
+
+func TestLooksLikeTransfer(t *testing.T) {
+	assert.True(t, looksLikeTransfer("Online Transfer to SAVINGS"))
+	assert.True(t, looksLikeTransfer("CK den 0123456789"))
+	assert.True(t, looksLikeTransfer("Chuyển khoản tiết kiệm"))
+	assert.False(t, looksLikeTransfer("BACKPACK store"))
+	assert.False(t, looksLikeTransfer(""))
+}
@@ -10,12 +10,30 @@ import (
 
 // Allowed MIME types for uploads
 var allowedMIMETypes = map[string][]string{
-	".csv":  {"text/csv", "text/plain", "application/csv"},
-	".xlsx": {"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "application/zip", "application/octet-stream"},
-	".xls":  {"application/vnd.ms-excel", "application/octet-stream"},
+	".csv":   {"text/csv", "text/plain", "application/csv"},
+	".xlsx":  {"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "application/zip", "application/octet-stream"},
+	".xls":   {"application/vnd.ms-excel", "application/octet-stream"},
+	".pdf":   {"application/pdf"},
+	".ofx":   {"text/plain", "text/xml", "application/x-ofx"}, // SGML (v1) or XML (v2)
+	".qfx":   {"text/plain", "text/xml", "application/x-ofx"},
+	".qif":   {"text/plain", "application/qif", "application/x-qif"},
+	".sta":   {"text/plain"},
+	".mt940": {"text/plain"},
+	".xml":   {"text/xml", "text/plain", "application/xml"},
+}
+
+// Allowed MIME types for transaction attachments (receipts and documents)
+var allowedAttachmentMIMETypes = map[string][]string{
 	".pdf":  {"application/pdf"},
+	".jpg":  {"image/jpeg"},
+	".jpeg": {"image/jpeg"},
+	".png":  {"image/png"},
+	".webp": {"image/webp"},
 }
 
+// MaxAttachmentSize is the largest receipt or document that can be attached to a transaction.
+const MaxAttachmentSize = 10 * 1024 * 1024 // 10MB
+
 // ValidateMIMEType validates the actual file content MIME type against expected types for the extension.
 // This prevents file type spoofing by reading the first 512 bytes and detecting the actual MIME type.
 func ValidateMIMEType(fileContent []byte, fileName string) error {
@@ -182,3 +200,41 @@ func SanitizeString(input string, maxLength int) string {
 
 	return strings.TrimSpace(result)
 }
+
+// ValidateAttachment validates a receipt or document attached to a transaction. It returns
+// the sanitized filename and the content type detected from the file content, which must
+// be an image or a PDF matching the extension.
+func ValidateAttachment(fileContent []byte, fileName string) (string, string, error) {
+	sanitizedName, err := SanitizeFileName(fileName)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid filename: %w", err)
+	}
+
+	if len(fileContent) == 0 {
+		return "", "", fmt.Errorf("file is empty")
+	}
+	if len(fileContent) > MaxAttachmentSize {
+		return "", "", fmt.Errorf("file too large: max size is %dMB for attachments", MaxAttachmentSize/(1024*1024))
+	}
+
+	ext := strings.ToLower(filepath.Ext(sanitizedName))
+	expectedMIMETypes, ok := allowedAttachmentMIMETypes[ext]
+	if !ok {
+		return "", "", fmt.Errorf("unsupported attachment type: %s. Supported: PDF, JPEG, PNG, WebP", ext)
+	}
+
+	sampleSize := 512
+	if len(fileContent) < sampleSize {
+		sampleSize = len(fileContent)
+	}
+	detectedMIME := strings.TrimSpace(strings.Split(http.DetectContentType(fileContent[:sampleSize]), ";")[0])
+
+	for _, expectedMIME := range expectedMIMETypes {
+		if detectedMIME == expectedMIME {
+			return sanitizedName, detectedMIME, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("file type mismatch: file has extension %s but content type is %s (expected: %v)",
+		ext, detectedMIME, expectedMIMETypes)
+}
@@ -0,0 +1,47 @@
+package fileupload
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestValidateAttachment(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\nrest of the image")
+	pdf := []byte("%PDF-1.4\nreceipt")
+
+	tests := []struct {
+		name         string
+		content      []byte
+		fileName     string
+		expectedType string
+		expectError  bool
+	}{
+		{"png receipt", png, "receipt.png", "image/png", false},
+		{"pdf invoice", pdf, "../invoice.PDF", "application/pdf", false},
+		{"extension does not match content", pdf, "receipt.png", "", true},
+		{"unsupported extension", []byte("hello"), "notes.txt", "", true},
+		{"empty file", nil, "receipt.png", "", true},
+		{"too large", append(png, bytes.Repeat([]byte{0}, MaxAttachmentSize)...), "receipt.png", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, contentType, err := ValidateAttachment(tt.content, tt.fileName)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error for %s, got none", tt.fileName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error for %s, got: %v", tt.fileName, err)
+			}
+			if contentType != tt.expectedType {
+				t.Errorf("Expected content type %s, got %s", tt.expectedType, contentType)
+			}
+			if name == "" || bytes.ContainsAny([]byte(name), "/\\") {
+				t.Errorf("Expected a sanitized filename, got %q", name)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"io"
 	"mime/multipart"
+	"os"
 	"testing"
 
 	"wealthjourney/pkg/storage"
@@ -101,6 +102,35 @@ func TestFileUploadService_UploadFromBytes(t *testing.T) {
 	}
 }
 
+func TestGetFileURL_LocalStorage(t *testing.T) {
+	previous := defaultService
+	defer func() { defaultService = previous }()
+	InitializeDefaultService(storage.NewLocalStorage(t.TempDir(), "", ""))
+
+	fileContent := []byte("test,data\n1,2")
+	result, err := UploadFileFromBytes(fileContent, "statement.csv", int64(len(fileContent)))
+	if err != nil {
+		t.Fatalf("UploadFileFromBytes failed: %v", err)
+	}
+
+	path, ext, err := GetFileURL(context.Background(), result.FileID)
+	if err != nil {
+		t.Fatalf("GetFileURL failed: %v", err)
+	}
+	if ext != ".csv" {
+		t.Errorf("Expected extension .csv, got %s", ext)
+	}
+
+	// The parsers read the returned path from the filesystem
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected a readable local path, got %s: %v", path, err)
+	}
+	if !bytes.Equal(data, fileContent) {
+		t.Errorf("Expected the uploaded content, got %q", data)
+	}
+}
+
 func TestFileUploadService_Cleanup(t *testing.T) {
 	deleteCalled := false
 	mockStorage := &mockStorageProvider{
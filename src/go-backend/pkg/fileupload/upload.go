@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	neturl "net/url"
 	"os"
 	"path/filepath"
 
@@ -16,6 +17,10 @@ const (
 	MaxCSVSize   = 10 * 1024 * 1024 // 10MB
 	MaxExcelSize = 10 * 1024 * 1024 // 10MB
 	MaxPDFSize   = 20 * 1024 * 1024 // 20MB
+	MaxOFXSize   = 10 * 1024 * 1024 // 10MB
+	MaxQIFSize   = 10 * 1024 * 1024 // 10MB
+	MaxMT940Size = 10 * 1024 * 1024 // 10MB
+	MaxCAMTSize  = 20 * 1024 * 1024 // 20MB (XML is verbose)
 	UploadDir    = "/tmp/wealthjourney-uploads"
 )
 
@@ -34,8 +39,15 @@ const (
 	FileTypeCSV   FileType = "csv"
 	FileTypeExcel FileType = "excel"
 	FileTypePDF   FileType = "pdf"
+	FileTypeOFX   FileType = "ofx"
+	FileTypeQIF   FileType = "qif"
+	FileTypeMT940 FileType = "mt940"
+	FileTypeCAMT  FileType = "camt"
 )
 
+// supportedExtensions lists the extensions an uploaded file may be stored with
+var supportedExtensions = []string{".csv", ".xlsx", ".xls", ".pdf", ".ofx", ".qfx", ".qif", ".sta", ".mt940", ".xml"}
+
 type UploadResult struct {
 	FileID   string
 	FileName string
@@ -145,7 +157,7 @@ func CleanupFile(fileID string) error {
 	// Use new service if initialized
 	if defaultService != nil {
 		// Try common extensions
-		for _, ext := range []string{".csv", ".xlsx", ".xls", ".pdf"} {
+		for _, ext := range supportedExtensions {
 			_ = defaultService.Cleanup(context.Background(), fileID, ext)
 		}
 		return nil
@@ -215,14 +227,14 @@ func DeleteFile(filePath string) error {
 
 // GetFileURL returns the URL for a file given its ID.
 // Returns (url, extension, error). URL can be either:
-// - A public/signed URL from Supabase storage
-// - A local file path (for backward compatibility)
+// - A public/signed URL from the storage provider
+// - A local file path, for local storage without a base URL and for backward compatibility
 func GetFileURL(ctx context.Context, fileID string) (string, string, error) {
 	fmt.Printf("[DEBUG] GetFileURL: Looking for file with ID: %s\n", fileID)
 	fmt.Printf("[DEBUG] GetFileURL: defaultService is nil: %v\n", defaultService == nil)
 
 	// Try common extensions
-	for _, ext := range []string{".csv", ".xlsx", ".xls", ".pdf"} {
+	for _, ext := range supportedExtensions {
 		if defaultService != nil {
 			// Use storage provider to get URL
 			storageKey := fmt.Sprintf("uploads/%s%s", fileID, ext)
@@ -230,7 +242,7 @@ func GetFileURL(ctx context.Context, fileID string) (string, string, error) {
 			url, err := defaultService.storage.GetURL(ctx, storageKey)
 			if err == nil {
 				fmt.Printf("[DEBUG] GetFileURL: Found file! URL: %s, ext: %s\n", url, ext)
-				return url, ext, nil
+				return localFilePath(url), ext, nil
 			}
 			fmt.Printf("[DEBUG] GetFileURL: Failed to get URL for %s: %v\n", storageKey, err)
 		} else {
@@ -247,3 +259,13 @@ func GetFileURL(ctx context.Context, fileID string) (string, string, error) {
 	fmt.Printf("[DEBUG] GetFileURL: File not found after trying all extensions\n")
 	return "", "", fmt.Errorf("file not found: %s", fileID)
 }
+
+// localFilePath turns a file:// URL, as returned by local storage without a base URL,
+// into the path the parsers read. Other URLs are returned unchanged.
+func localFilePath(fileURL string) string {
+	u, err := neturl.Parse(fileURL)
+	if err != nil || u.Scheme != "file" {
+		return fileURL
+	}
+	return filepath.FromSlash(u.Path)
+}
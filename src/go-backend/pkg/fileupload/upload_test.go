@@ -34,6 +34,12 @@ func TestValidateFileType(t *testing.T) {
 		{"statement.xlsx", FileTypeExcel, false},
 		{"statement.xls", FileTypeExcel, false},
 		{"statement.pdf", FileTypePDF, false},
+		{"statement.ofx", FileTypeOFX, false},
+		{"statement.QFX", FileTypeOFX, false},
+		{"export.qif", FileTypeQIF, false},
+		{"statement.sta", FileTypeMT940, false},
+		{"statement.MT940", FileTypeMT940, false},
+		{"camt053.xml", FileTypeCAMT, false},
 		{"statement.txt", "", true},
 		{"statement.doc", "", true},
 		{"statement", "", true},
@@ -16,8 +16,16 @@ func ValidateFileType(filename string) (FileType, error) {
 		return FileTypeExcel, nil
 	case ".pdf":
 		return FileTypePDF, nil
+	case ".ofx", ".qfx":
+		return FileTypeOFX, nil
+	case ".qif":
+		return FileTypeQIF, nil
+	case ".sta", ".mt940":
+		return FileTypeMT940, nil
+	case ".xml":
+		return FileTypeCAMT, nil
 	default:
-		return "", fmt.Errorf("unsupported file type: %s. Supported: CSV, Excel (.xlsx, .xls), PDF", ext)
+		return "", fmt.Errorf("unsupported file type: %s. Supported: CSV, Excel (.xlsx, .xls), PDF, OFX (.ofx, .qfx), QIF, MT940 (.sta, .mt940), CAMT.053 (.xml)", ext)
 	}
 }
 
@@ -31,6 +39,14 @@ func ValidateFileSize(size int64, fileType FileType) error {
 		maxSize = MaxExcelSize
 	case FileTypePDF:
 		maxSize = MaxPDFSize
+	case FileTypeOFX:
+		maxSize = MaxOFXSize
+	case FileTypeQIF:
+		maxSize = MaxQIFSize
+	case FileTypeMT940:
+		maxSize = MaxMT940Size
+	case FileTypeCAMT:
+		maxSize = MaxCAMTSize
 	default:
 		return fmt.Errorf("unknown file type")
 	}
@@ -142,3 +142,19 @@ func GetDecimalMultiplier(currency string) int64 {
 	}
 	return multiplier
 }
+
+// ParsedAmountScale is the scale of amounts in parser format (×10000 of the major unit)
+const ParsedAmountScale = 10000
+
+// ParsedToStorageAmount converts an amount from parser format to the smallest unit of the
+// currency, the format transactions are stored in.
+// For USD: 1234500 (123.45) becomes 12345 cents
+func ParsedToStorageAmount(amount int64, currency string) int64 {
+	return amount * GetDecimalMultiplier(currency) / ParsedAmountScale
+}
+
+// StorageToParsedAmount converts an amount in the smallest unit of the currency to parser
+// format, the inverse of ParsedToStorageAmount.
+func StorageToParsedAmount(amount int64, currency string) int64 {
+	return amount * ParsedAmountScale / GetDecimalMultiplier(currency)
+}
@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"wealthjourney/domain/service"
+)
+
+// BudgetPeriodCloseJob snapshots the envelopes of monthly and weekly budgets once their
+// period ends, so past periods stay stable and the next one carries a fixed amount.
+// Periods missed while the server was down are closed on the next run.
+type BudgetPeriodCloseJob struct {
+	budgetService service.BudgetService
+}
+
+// NewBudgetPeriodCloseJob creates a new budget period close job
+func NewBudgetPeriodCloseJob(budgetService service.BudgetService) *BudgetPeriodCloseJob {
+	return &BudgetPeriodCloseJob{
+		budgetService: budgetService,
+	}
+}
+
+// Run closes every budget period that ended before now
+func (j *BudgetPeriodCloseJob) Run(ctx context.Context) error {
+	closed, err := j.budgetService.CloseEndedPeriods(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if closed > 0 {
+		log.Printf("[JOB] Budget period close completed. Closed %d periods", closed)
+	}
+	return nil
+}
+
+// Start runs the job periodically
+func (j *BudgetPeriodCloseJob) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	if err := j.Run(ctx); err != nil {
+		log.Printf("[JOB] Initial budget period close failed: %v", err)
+	}
+
+	// Run periodically
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("[JOB] Budget period close job stopped")
+			return
+		case <-ticker.C:
+			if err := j.Run(ctx); err != nil {
+				log.Printf("[JOB] Budget period close failed: %v", err)
+			}
+		}
+	}
+}
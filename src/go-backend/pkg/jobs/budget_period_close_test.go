@@ -0,0 +1,50 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/service"
+	"wealthjourney/pkg/jobs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubPeriodCloser records the times it was asked to close periods at.
+type stubPeriodCloser struct {
+	service.BudgetService
+	calls []time.Time
+	err   error
+}
+
+func (s *stubPeriodCloser) CloseEndedPeriods(ctx context.Context, now time.Time) (int, error) {
+	s.calls = append(s.calls, now)
+	return len(s.calls), s.err
+}
+
+func TestBudgetPeriodCloseJob_Run(t *testing.T) {
+	closer := &stubPeriodCloser{}
+	job := jobs.NewBudgetPeriodCloseJob(closer)
+
+	before := time.Now()
+	assert.NoError(t, job.Run(context.Background()))
+	assert.Len(t, closer.calls, 1)
+	assert.False(t, closer.calls[0].Before(before), "periods are closed as of now")
+
+	closer.err = errors.New("database unavailable")
+	assert.Error(t, job.Run(context.Background()))
+}
+
+func TestBudgetPeriodCloseJob_StartRunsOnceBeforeStopping(t *testing.T) {
+	closer := &stubPeriodCloser{}
+	job := jobs.NewBudgetPeriodCloseJob(closer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Catches up right away, then stops with the context
+	job.Start(ctx, time.Hour)
+	assert.Len(t, closer.calls, 1)
+}
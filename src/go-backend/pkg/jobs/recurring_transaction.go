@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"wealthjourney/domain/service"
+)
+
+// RecurringTransactionJob creates the transactions of recurring schedules once they are
+// due. Every occurrence is recorded in a ledger, so restarts and concurrent runs never
+// create duplicates, and occurrences missed while the server was down are caught up on
+// the next run.
+type RecurringTransactionJob struct {
+	recurringService service.RecurringTransactionService
+}
+
+// NewRecurringTransactionJob creates a new recurring transaction job
+func NewRecurringTransactionJob(recurringService service.RecurringTransactionService) *RecurringTransactionJob {
+	return &RecurringTransactionJob{
+		recurringService: recurringService,
+	}
+}
+
+// Run creates every occurrence due at now
+func (j *RecurringTransactionJob) Run(ctx context.Context) error {
+	generated, err := j.recurringService.ProcessDueOccurrences(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if generated > 0 {
+		log.Printf("[JOB] Recurring transactions completed. Created %d transactions", generated)
+	}
+	return nil
+}
+
+// Start runs the job periodically
+func (j *RecurringTransactionJob) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	if err := j.Run(ctx); err != nil {
+		log.Printf("[JOB] Initial recurring transaction run failed: %v", err)
+	}
+
+	// Run periodically
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("[JOB] Recurring transaction job stopped")
+			return
+		case <-ticker.C:
+			if err := j.Run(ctx); err != nil {
+				log.Printf("[JOB] Recurring transaction run failed: %v", err)
+			}
+		}
+	}
+}
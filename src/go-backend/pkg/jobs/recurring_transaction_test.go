@@ -0,0 +1,50 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wealthjourney/domain/service"
+	"wealthjourney/pkg/jobs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubOccurrenceProcessor records the times it was asked to process occurrences at.
+type stubOccurrenceProcessor struct {
+	service.RecurringTransactionService
+	calls []time.Time
+	err   error
+}
+
+func (s *stubOccurrenceProcessor) ProcessDueOccurrences(ctx context.Context, now time.Time) (int, error) {
+	s.calls = append(s.calls, now)
+	return len(s.calls), s.err
+}
+
+func TestRecurringTransactionJob_Run(t *testing.T) {
+	processor := &stubOccurrenceProcessor{}
+	job := jobs.NewRecurringTransactionJob(processor)
+
+	before := time.Now()
+	assert.NoError(t, job.Run(context.Background()))
+	assert.Len(t, processor.calls, 1)
+	assert.False(t, processor.calls[0].Before(before), "occurrences are processed as of now")
+
+	processor.err = errors.New("database unavailable")
+	assert.Error(t, job.Run(context.Background()))
+}
+
+func TestRecurringTransactionJob_StartRunsOnceBeforeStopping(t *testing.T) {
+	processor := &stubOccurrenceProcessor{}
+	job := jobs.NewRecurringTransactionJob(processor)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Catches up right away, then stops with the context
+	job.Start(ctx, time.Hour)
+	assert.Len(t, processor.calls, 1)
+}
@@ -0,0 +1,16 @@
+package notification
+
+import "wealthjourney/pkg/config"
+
+// NewChannels creates the email and webhook channels enabled by the configuration. The
+// in-app inbox is stored in the database and registered by the services.
+func NewChannels(cfg config.Notification) map[string]Channel {
+	channels := make(map[string]Channel)
+	if cfg.SMTPHost != "" {
+		channels[ChannelEmail] = NewSMTPChannel(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom)
+	}
+	if cfg.WebhookEnabled {
+		channels[ChannelWebhook] = NewWebhookChannel(cfg.WebhookSecret, cfg.WebhookTimeout)
+	}
+	return channels
+}
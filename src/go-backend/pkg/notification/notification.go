@@ -0,0 +1,100 @@
+// Package notification delivers messages to users over pluggable channels such as
+// email and outgoing webhooks.
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Channel names, as stored with budget alert rules
+const (
+	ChannelInApp   = "in_app"
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+)
+
+// Message is a notification for one user. Each channel reads the recipient it needs.
+type Message struct {
+	Kind  string // What the message is about, e.g. "budget_alert"
+	Title string
+	Body  string
+	Data  map[string]string // Details for clients, such as the budget ID
+
+	UserID     int32  // Inbox owner, for the in-app channel
+	Email      string // Recipient address, for the email channel
+	WebhookURL string // Endpoint, for the webhook channel
+}
+
+// Channel delivers messages.
+type Channel interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Validator is implemented by channels that check a recipient before it is saved, such
+// as the webhook channel resolving its endpoint.
+type Validator interface {
+	Validate(ctx context.Context, msg *Message) error
+}
+
+// Dispatcher sends messages over the channels registered with it.
+type Dispatcher struct {
+	mu       sync.RWMutex
+	channels map[string]Channel
+}
+
+// NewDispatcher creates a dispatcher without channels.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{channels: make(map[string]Channel)}
+}
+
+// Register adds a channel under a name, replacing any channel registered before.
+func (d *Dispatcher) Register(name string, channel Channel) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.channels[name] = channel
+}
+
+// Has reports whether a channel is registered under the name.
+func (d *Dispatcher) Has(name string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	_, ok := d.channels[name]
+	return ok
+}
+
+// Validate checks the recipient of msg with the named channel. Channels that do not
+// validate recipients accept any.
+func (d *Dispatcher) Validate(ctx context.Context, name string, msg *Message) error {
+	d.mu.RLock()
+	channel, ok := d.channels[name]
+	d.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("notification channel %q is not configured", name)
+	}
+	if validator, ok := channel.(Validator); ok {
+		return validator.Validate(ctx, msg)
+	}
+	return nil
+}
+
+// Send delivers the message over each named channel. A failing or missing channel does
+// not keep the message from the others; their errors are returned together.
+func (d *Dispatcher) Send(ctx context.Context, names []string, msg *Message) error {
+	var errs []error
+	for _, name := range names {
+		d.mu.RLock()
+		channel, ok := d.channels[name]
+		d.mu.RUnlock()
+		if !ok {
+			errs = append(errs, fmt.Errorf("notification channel %q is not configured", name))
+			continue
+		}
+		if err := channel.Send(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
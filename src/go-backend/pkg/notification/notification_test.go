@@ -0,0 +1,78 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"wealthjourney/pkg/config"
+)
+
+type recordingChannel struct {
+	sent []*Message
+	err  error
+}
+
+func (c *recordingChannel) Send(ctx context.Context, msg *Message) error {
+	c.sent = append(c.sent, msg)
+	return c.err
+}
+
+func TestDispatcher_Send(t *testing.T) {
+	inbox := &recordingChannel{}
+	email := &recordingChannel{err: errors.New("mailbox full")}
+	dispatcher := NewDispatcher()
+	dispatcher.Register(ChannelInApp, inbox)
+	dispatcher.Register(ChannelEmail, email)
+
+	err := dispatcher.Send(context.Background(), []string{ChannelEmail, ChannelWebhook, ChannelInApp}, &Message{Title: "Alert"})
+	if err == nil {
+		t.Fatal("Expected the email and webhook errors")
+	}
+	if !strings.Contains(err.Error(), "mailbox full") || !strings.Contains(err.Error(), `"webhook" is not configured`) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(inbox.sent) != 1 || len(email.sent) != 1 {
+		t.Errorf("Expected every configured channel to be tried, got inbox %d and email %d", len(inbox.sent), len(email.sent))
+	}
+	if !dispatcher.Has(ChannelInApp) || dispatcher.Has(ChannelWebhook) {
+		t.Error("Has does not match the registered channels")
+	}
+}
+
+func TestDispatcher_Validate(t *testing.T) {
+	dispatcher := NewDispatcher()
+	dispatcher.Register(ChannelInApp, &recordingChannel{})
+	dispatcher.Register(ChannelWebhook, NewWebhookChannel("", time.Second))
+	ctx := context.Background()
+
+	if err := dispatcher.Validate(ctx, ChannelInApp, &Message{}); err != nil {
+		t.Errorf("Expected channels without validation to accept any recipient, got %v", err)
+	}
+	if err := dispatcher.Validate(ctx, ChannelWebhook, &Message{WebhookURL: "https://10.0.0.1/hook"}); err == nil {
+		t.Error("Expected the webhook channel to reject a private address")
+	}
+	if err := dispatcher.Validate(ctx, ChannelEmail, &Message{}); err == nil {
+		t.Error("Expected an error for a channel that is not configured")
+	}
+}
+
+func TestNewChannels(t *testing.T) {
+	channels := NewChannels(config.Notification{WebhookEnabled: true})
+	if _, ok := channels[ChannelEmail]; ok {
+		t.Error("Expected no email channel without SMTP_HOST")
+	}
+	if _, ok := channels[ChannelWebhook]; !ok {
+		t.Error("Expected a webhook channel")
+	}
+
+	channels = NewChannels(config.Notification{SMTPHost: "localhost", SMTPPort: 1025, SMTPFrom: "alerts@example.com"})
+	if _, ok := channels[ChannelEmail]; !ok {
+		t.Error("Expected an email channel with SMTP_HOST")
+	}
+	if _, ok := channels[ChannelWebhook]; ok {
+		t.Error("Expected no webhook channel when disabled")
+	}
+}
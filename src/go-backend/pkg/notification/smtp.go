@@ -0,0 +1,124 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// smtpTimeout bounds a delivery when the context has no deadline.
+const smtpTimeout = 30 * time.Second
+
+// SMTPChannel sends messages as plain text email. It upgrades the connection with
+// STARTTLS when the server offers it.
+type SMTPChannel struct {
+	host     string
+	addr     string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPChannel creates an email channel. SMTP AUTH is skipped without a username.
+func NewSMTPChannel(host string, port int, username, password, from string) *SMTPChannel {
+	return &SMTPChannel{
+		host:     host,
+		addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Send emails the message to msg.Email.
+func (c *SMTPChannel) Send(ctx context.Context, msg *Message) error {
+	if msg.Email == "" {
+		return errors.New("message has no email recipient")
+	}
+	to, err := mail.ParseAddress(msg.Email)
+	if err != nil {
+		return fmt.Errorf("invalid email recipient: %w", err)
+	}
+	from, err := mail.ParseAddress(c.from)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(smtpTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.host}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	if c.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.username, c.password, c.host)); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(buildEmail(from, to, msg, time.Now())); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// buildEmail renders the message as a UTF-8 plain text email.
+func buildEmail(from, to *mail.Address, msg *Message, date time.Time) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from.String() + "\r\n")
+	buf.WriteString("To: " + to.String() + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", msg.Title) + "\r\n")
+	buf.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	qp.Write([]byte(msg.Body))
+	qp.Close()
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,161 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSMTP is a minimal SMTP server on a loopback port. It accepts one mail per
+// session and records the envelope, the credentials and the data.
+type fakeSMTP struct {
+	listener net.Listener
+	mu       sync.Mutex
+	auth     string
+	from     string
+	to       []string
+	data     string
+	done     chan struct{}
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	f := &fakeSMTP{listener: listener, done: make(chan struct{})}
+	t.Cleanup(func() { listener.Close() })
+	go f.serve()
+	return f
+}
+
+func (f *fakeSMTP) port() int {
+	return f.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (f *fakeSMTP) serve() {
+	conn, err := f.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	defer close(f.done)
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+		f.mu.Lock()
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case strings.HasPrefix(cmd, "AUTH PLAIN"):
+			decoded, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(line[len("AUTH PLAIN"):]))
+			f.auth = string(decoded)
+			reply("235 Authentication successful")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			f.from = line[len("MAIL FROM:"):]
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			f.to = append(f.to, line[len("RCPT TO:"):])
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					f.mu.Unlock()
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			f.data = data.String()
+			reply("250 OK queued")
+		case cmd == "QUIT":
+			reply("221 Bye")
+			f.mu.Unlock()
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+		f.mu.Unlock()
+	}
+}
+
+func TestSMTPChannel_Send(t *testing.T) {
+	server := newFakeSMTP(t)
+	channel := NewSMTPChannel("127.0.0.1", server.port(), "alerts", "secret", "WealthJourney <alerts@example.com>")
+
+	err := channel.Send(context.Background(), &Message{
+		Kind:  "budget_alert",
+		Title: "Groceries reached 80% of its budget",
+		Body:  "You have spent 800.000 ₫ of 1.000.000 ₫.",
+		Email: "user@example.com",
+	})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	<-server.done
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.auth != "\x00alerts\x00secret" {
+		t.Errorf("Expected PLAIN credentials, got %q", server.auth)
+	}
+	if server.from != "<alerts@example.com>" {
+		t.Errorf("Expected sender <alerts@example.com>, got %s", server.from)
+	}
+	if len(server.to) != 1 || server.to[0] != "<user@example.com>" {
+		t.Errorf("Expected recipient <user@example.com>, got %v", server.to)
+	}
+	if !strings.Contains(server.data, "Subject: Groceries reached 80% of its budget\r\n") {
+		t.Errorf("Expected the title as subject, got %q", server.data)
+	}
+	if !strings.Contains(server.data, "Content-Type: text/plain; charset=UTF-8") {
+		t.Errorf("Expected a UTF-8 plain text body, got %q", server.data)
+	}
+}
+
+func TestSMTPChannel_SendRejectsBadRecipient(t *testing.T) {
+	channel := NewSMTPChannel("127.0.0.1", 1, "", "", "alerts@example.com")
+
+	if err := channel.Send(context.Background(), &Message{Title: "Alert"}); err == nil {
+		t.Error("Expected an error without a recipient")
+	}
+	if err := channel.Send(context.Background(), &Message{Title: "Alert", Email: "user@example.com\r\nBcc: other@example.com"}); err == nil {
+		t.Error("Expected an error for a recipient with a header injection")
+	}
+}
+
+func TestBuildEmail_EncodesSubject(t *testing.T) {
+	email := string(buildEmail(
+		&mail.Address{Address: "alerts@example.com"},
+		&mail.Address{Address: "user@example.com"},
+		&Message{Title: "Ăn uống\r\nBcc: other@example.com", Body: "Đã chi 80%"},
+		time.Date(2024, 5, 15, 9, 0, 0, 0, time.UTC),
+	))
+
+	if strings.Contains(email, "\r\nBcc:") {
+		t.Errorf("Expected the subject to be encoded, got %q", email)
+	}
+	if !strings.Contains(email, "Subject: =?utf-8?q?") {
+		t.Errorf("Expected a Q-encoded subject, got %q", email)
+	}
+}
@@ -0,0 +1,239 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SignatureHeader carries the HMAC-SHA256 of the payload, as "sha256=<hex>", when the
+// webhook channel has a secret.
+const SignatureHeader = "X-WealthJourney-Signature"
+
+// WebhookPayload is the JSON body posted to webhook endpoints.
+type WebhookPayload struct {
+	Kind   string            `json:"kind"`
+	Title  string            `json:"title"`
+	Body   string            `json:"body"`
+	Data   map[string]string `json:"data,omitempty"`
+	SentAt int64             `json:"sentAt"` // Unix timestamp
+}
+
+// nonPublicNetworks are the ranges, besides loopback, private, link-local, multicast
+// and unspecified addresses, that webhooks may not reach.
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",     // "This" network
+	"100.64.0.0/10", // Carrier-grade NAT
+	"192.0.0.0/24",  // IETF protocol assignments
+	"198.18.0.0/15", // Benchmarking
+	"240.0.0.0/4",   // Reserved
+	"64:ff9b::/96",  // NAT64, which can map onto private IPv4 addresses
+)
+
+// WebhookChannel posts messages as JSON to msg.WebhookURL. Endpoints must be https URLs
+// of hosts with public addresses only. The addresses are checked again when connecting,
+// so a host cannot be re-pointed at an internal service after it was saved, and
+// redirects are not followed.
+type WebhookChannel struct {
+	client     *http.Client
+	secret     string
+	lookup     func(ctx context.Context, host string) ([]net.IPAddr, error)
+	allowLocal bool // Accept http and non-public addresses, for tests against local servers
+}
+
+// NewWebhookChannel creates a webhook channel. Payloads are signed when secret is set.
+func NewWebhookChannel(secret string, timeout time.Duration) *WebhookChannel {
+	return newWebhookChannel(secret, timeout, false)
+}
+
+func newWebhookChannel(secret string, timeout time.Duration, allowLocal bool) *WebhookChannel {
+	c := &WebhookChannel{
+		secret:     secret,
+		lookup:     net.DefaultResolver.LookupIPAddr,
+		allowLocal: allowLocal,
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would connect on our behalf, past the address check
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		ips, err := c.resolve(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].String(), port))
+	}
+
+	c.client = &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return c
+}
+
+// Validate checks that msg.WebhookURL is a valid endpoint whose host resolves to public
+// addresses only, so it can be saved.
+func (c *WebhookChannel) Validate(ctx context.Context, msg *Message) error {
+	u, err := c.parseURL(msg.WebhookURL)
+	if err != nil {
+		return err
+	}
+	_, err = c.resolve(ctx, u.Hostname())
+	return err
+}
+
+// Send posts the message. Any response other than 2xx, including a redirect, is an error.
+func (c *WebhookChannel) Send(ctx context.Context, msg *Message) error {
+	if _, err := c.parseURL(msg.WebhookURL); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(WebhookPayload{
+		Kind:   msg.Kind,
+		Title:  msg.Title,
+		Body:   msg.Body,
+		Data:   msg.Data,
+		SentAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "WealthJourney-Webhook/1.0")
+	if c.secret != "" {
+		req.Header.Set(SignatureHeader, Sign(c.secret, body))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the signature header value of a payload.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateWebhookURL checks that a webhook endpoint is an absolute https URL and that
+// its host is not localhost or a non-public IP address. Hosts are not resolved; the
+// webhook channel checks their addresses when validating and when connecting.
+func ValidateWebhookURL(rawURL string) error {
+	u, err := parseWebhookURL(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return errors.New("webhook URL must use https")
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.New("webhook URL must point to a public address")
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return errors.New("webhook URL must point to a public address")
+	}
+	return nil
+}
+
+// IsPublicIP reports whether ip is a globally reachable unicast address.
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseURL checks an endpoint like ValidateWebhookURL, or only its form when local
+// endpoints are allowed.
+func (c *WebhookChannel) parseURL(rawURL string) (*url.URL, error) {
+	if c.allowLocal {
+		return parseWebhookURL(rawURL)
+	}
+	if err := ValidateWebhookURL(rawURL); err != nil {
+		return nil, err
+	}
+	return url.Parse(rawURL)
+}
+
+// resolve returns the addresses of host, failing when any of them is not public.
+func (c *WebhookChannel) resolve(ctx context.Context, host string) ([]net.IPAddr, error) {
+	ips, err := c.lookup(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("webhook host %s cannot be resolved: %w", host, err)
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("webhook host %s has no addresses", host)
+	}
+	if !c.allowLocal {
+		for _, ip := range ips {
+			if !IsPublicIP(ip.IP) {
+				return nil, errors.New("webhook URL must point to a public address")
+			}
+		}
+	}
+	return ips, nil
+}
+
+// parseWebhookURL parses an absolute http or https URL.
+func parseWebhookURL(rawURL string) (*url.URL, error) {
+	if rawURL == "" {
+		return nil, errors.New("webhook URL is required")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.New("webhook URL must be an absolute https URL")
+	}
+	return u, nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
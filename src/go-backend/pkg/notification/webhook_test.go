@@ -0,0 +1,143 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhookChannel_Send(t *testing.T) {
+	var received WebhookPayload
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		signature = r.Header.Get(SignatureHeader)
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected a JSON body, got %s", r.Header.Get("Content-Type"))
+		}
+		if signature != Sign("hook-secret", body) {
+			t.Errorf("Signature %s does not match the body", signature)
+		}
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Errorf("Invalid payload: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	channel := newWebhookChannel("hook-secret", 5*time.Second, true)
+	err := channel.Send(context.Background(), &Message{
+		Kind:       "budget_alert",
+		Title:      "Groceries reached 100% of its budget",
+		Body:       "You have spent 1.000.000 ₫ of 1.000.000 ₫.",
+		Data:       map[string]string{"budgetId": "3", "threshold": "100"},
+		WebhookURL: server.URL + "/hooks/budget",
+	})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	if received.Kind != "budget_alert" || received.Title != "Groceries reached 100% of its budget" {
+		t.Errorf("Unexpected payload: %+v", received)
+	}
+	if received.Data["threshold"] != "100" || received.SentAt == 0 {
+		t.Errorf("Expected data and send time in the payload, got %+v", received)
+	}
+	if signature == "" {
+		t.Error("Expected a signature")
+	}
+}
+
+func TestWebhookChannel_SendFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(SignatureHeader) != "" {
+			t.Error("Expected no signature without a secret")
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	channel := newWebhookChannel("", 5*time.Second, true)
+	if err := channel.Send(context.Background(), &Message{Title: "Alert", WebhookURL: server.URL}); err == nil {
+		t.Error("Expected an error for a 500 response")
+	}
+	if err := channel.Send(context.Background(), &Message{Title: "Alert"}); err == nil {
+		t.Error("Expected an error without a URL")
+	}
+	if err := channel.Send(context.Background(), &Message{Title: "Alert", WebhookURL: "file:///etc/passwd"}); err == nil {
+		t.Error("Expected an error for a non-http URL")
+	}
+}
+
+func TestWebhookChannel_DoesNotFollowRedirects(t *testing.T) {
+	reached := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+
+	channel := newWebhookChannel("", 5*time.Second, true)
+	if err := channel.Send(context.Background(), &Message{Title: "Alert", WebhookURL: server.URL}); err == nil {
+		t.Error("Expected an error for a redirect")
+	}
+	if reached {
+		t.Error("Expected the redirect not to be followed")
+	}
+}
+
+func TestWebhookChannel_RejectsNonPublicAddresses(t *testing.T) {
+	channel := NewWebhookChannel("", 5*time.Second)
+	resolved := map[string][]net.IPAddr{
+		"hooks.example.com":    {{IP: net.ParseIP("93.184.216.34")}},
+		"metadata.example.com": {{IP: net.ParseIP("169.254.169.254")}},
+		"mixed.example.com":    {{IP: net.ParseIP("93.184.216.34")}, {IP: net.ParseIP("10.0.0.5")}},
+	}
+	channel.lookup = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		return resolved[host], nil
+	}
+	ctx := context.Background()
+
+	if err := channel.Validate(ctx, &Message{WebhookURL: "https://hooks.example.com/budget"}); err != nil {
+		t.Errorf("Expected a public host to be valid, got %v", err)
+	}
+	for _, rawURL := range []string{
+		"http://hooks.example.com/budget",
+		"https://metadata.example.com/latest",
+		"https://mixed.example.com/",
+		"https://169.254.169.254/latest/meta-data",
+		"https://127.0.0.1:8080/",
+		"https://[::1]/",
+		"https://localhost/",
+		"https://unknown.example.com/",
+	} {
+		if err := channel.Validate(ctx, &Message{WebhookURL: rawURL}); err == nil {
+			t.Errorf("Expected %s to be rejected", rawURL)
+		}
+	}
+
+	// The address is checked again when connecting, after the host was saved
+	resolved["hooks.example.com"] = []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}}
+	err := channel.Send(ctx, &Message{Title: "Alert", WebhookURL: "https://hooks.example.com/budget"})
+	if err == nil || !strings.Contains(err.Error(), "public address") {
+		t.Errorf("Expected the connection to be refused, got %v", err)
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	if err := ValidateWebhookURL("https://hooks.example.com/budget"); err != nil {
+		t.Errorf("Expected a valid URL, got %v", err)
+	}
+	for _, rawURL := range []string{"", "hooks.example.com", "http://hooks.example.com", "https://192.168.1.10/", "https://app.localhost/"} {
+		if err := ValidateWebhookURL(rawURL); err == nil {
+			t.Errorf("Expected %q to be rejected", rawURL)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package parser
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CAMTParser handles parsing of ISO 20022 CAMT.053 bank-to-customer statements
+type CAMTParser struct {
+	filePath string
+	mapping  *ColumnMapping     // Detected from the statement (currency only)
+	balances *StatementBalances // Opening (OPBD) and closing (CLBD) booked balances
+}
+
+// The structs below match elements by local name, so every camt.053 version
+// (namespace) decodes the same way
+
+type camtDocument struct {
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStatement struct {
+	Currency string        `xml:"Acct>Ccy"`
+	Balances []camtBalance `xml:"Bal"`
+	Entries  []camtEntry   `xml:"Ntry"`
+}
+
+type camtBalance struct {
+	Code      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amount    camtAmount `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	Date      camtDate   `xml:"Dt"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type camtEntry struct {
+	Amount       camtAmount      `xml:"Amt"`
+	CdtDbtInd    string          `xml:"CdtDbtInd"`
+	Status       camtStatus      `xml:"Sts"`
+	BookingDate  camtDate        `xml:"BookgDt"`
+	ValueDate    camtDate        `xml:"ValDt"`
+	AcctSvcrRef  string          `xml:"AcctSvcrRef"`
+	Details      []camtTxDetails `xml:"NtryDtls>TxDtls"`
+	AddtlNtryInf string          `xml:"AddtlNtryInf"`
+}
+
+// camtStatus is plain text up to camt.053.001.04 and a code element after
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Code  string `xml:"Cd"`
+}
+
+type camtTxDetails struct {
+	EndToEndID   string      `xml:"Refs>EndToEndId"`
+	AcctSvcrRef  string      `xml:"Refs>AcctSvcrRef"`
+	Amount       *camtAmount `xml:"Amt"`
+	TxAmount     *camtAmount `xml:"AmtDtls>TxAmt>Amt"`
+	CdtDbtInd    string      `xml:"CdtDbtInd"`
+	Debtor       camtParty   `xml:"RltdPties>Dbtr"`
+	Creditor     camtParty   `xml:"RltdPties>Cdtr"`
+	Unstructured []string    `xml:"RmtInf>Ustrd"`
+	CreditorRef  []string    `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	AddtlTxInf   string      `xml:"AddtlTxInf"`
+}
+
+// camtParty carries the name directly up to camt.053.001.07 and under Pty after
+type camtParty struct {
+	Name      string `xml:"Nm"`
+	PartyName string `xml:"Pty>Nm"`
+}
+
+// NewCAMTParser creates a new CAMT.053 parser instance
+func NewCAMTParser(filePath string) *CAMTParser {
+	return &CAMTParser{filePath: filePath}
+}
+
+// Parse reads the statement and returns one row per booked entry, or per transaction
+// of a batch-booked entry
+func (p *CAMTParser) Parse() ([]*ParsedRow, error) {
+	// Read the file (supports both URLs and local paths)
+	data, err := FetchFileContent(context.Background(), p.filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return p.parseContent(data)
+}
+
+// GetDetectedMapping returns the mapping detected from the statement, which carries
+// the account currency
+func (p *CAMTParser) GetDetectedMapping() *ColumnMapping {
+	return p.mapping
+}
+
+// GetStatementBalances returns the opening and closing booked balances. A file with
+// several statements reports the first opening and the last closing.
+func (p *CAMTParser) GetStatementBalances() *StatementBalances {
+	return p.balances
+}
+
+func (p *CAMTParser) parseContent(data []byte) ([]*ParsedRow, error) {
+	var doc camtDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("not a CAMT.053 file: %w", err)
+	}
+	if len(doc.Statements) == 0 {
+		return nil, fmt.Errorf("not a CAMT.053 file: no statements found")
+	}
+
+	balances := &StatementBalances{}
+	var entries []*StatementEntry
+	for _, stmt := range doc.Statements {
+		// Banks report the previous closing (PRCD) when they have no opening booked
+		if balances.Opening == nil {
+			if bal := findCAMTBalance(stmt.Balances, "OPBD", "PRCD"); bal != nil {
+				amount, date, err := parseCAMTBalance(bal)
+				if err != nil {
+					return nil, err
+				}
+				balances.Opening, balances.OpeningDate = &amount, date
+				balances.Currency = strings.ToUpper(bal.Amount.Currency)
+			}
+		}
+		if bal := findCAMTBalance(stmt.Balances, "CLBD"); bal != nil {
+			amount, date, err := parseCAMTBalance(bal)
+			if err != nil {
+				return nil, err
+			}
+			balances.Closing, balances.ClosingDate = &amount, date
+		}
+		if balances.Currency == "" {
+			balances.Currency = strings.ToUpper(strings.TrimSpace(stmt.Currency))
+		}
+
+		for _, ntry := range stmt.Entries {
+			// Pending and informational entries are not on the account yet
+			if status := strings.TrimSpace(ntry.Status.Value + ntry.Status.Code); status != "" && status != "BOOK" {
+				continue
+			}
+			entries = append(entries, camtEntries(ntry)...)
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no booked entries found in CAMT.053 file")
+	}
+
+	p.mapping = statementMapping("YYYY-MM-DD", balances.Currency)
+	if balances.Opening != nil || balances.Closing != nil {
+		p.balances = balances
+	}
+	return statementEntryRows(entries, balances.Currency), nil
+}
+
+// findCAMTBalance returns the first balance with one of the codes, in order of preference
+func findCAMTBalance(balances []camtBalance, codes ...string) *camtBalance {
+	for _, code := range codes {
+		for i := range balances {
+			if strings.TrimSpace(balances[i].Code) == code {
+				return &balances[i]
+			}
+		}
+	}
+	return nil
+}
+
+func parseCAMTBalance(bal *camtBalance) (int64, time.Time, error) {
+	amount, err := parseCAMTAmount(bal.Amount.Value, bal.CdtDbtInd)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("invalid %s balance: %w", bal.Code, err)
+	}
+	date, err := parseCAMTDate(bal.Date)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("invalid %s balance date: %w", bal.Code, err)
+	}
+	return amount, date, nil
+}
+
+// camtEntries converts an entry to statement entries. A batch booking with amounts
+// per transaction becomes one entry per transaction; otherwise the entry amount is
+// used with the first transaction's details.
+func camtEntries(ntry camtEntry) []*StatementEntry {
+	base := &StatementEntry{Currency: strings.ToUpper(ntry.Amount.Currency), BankRef: ntry.AcctSvcrRef}
+	base.BookingDate, _ = parseCAMTDate(ntry.BookingDate)
+	base.ValueDate, _ = parseCAMTDate(ntry.ValueDate)
+	// CdtDbtInd already gives the direction of a reversal, so RvslInd is not applied
+	base.Amount, _ = parseCAMTAmount(ntry.Amount.Value, ntry.CdtDbtInd)
+
+	batch := len(ntry.Details) > 1
+	for _, tx := range ntry.Details {
+		if tx.amount() == nil {
+			batch = false
+		}
+	}
+
+	if !batch {
+		entry := *base
+		if len(ntry.Details) > 0 {
+			applyCAMTDetails(&entry, ntry.Details[0], ntry.CdtDbtInd)
+		}
+		if entry.Remittance == "" {
+			entry.Remittance = strings.TrimSpace(ntry.AddtlNtryInf)
+		}
+		return []*StatementEntry{&entry}
+	}
+
+	entries := make([]*StatementEntry, 0, len(ntry.Details))
+	for _, tx := range ntry.Details {
+		entry := *base
+		indicator := tx.CdtDbtInd
+		if indicator == "" {
+			indicator = ntry.CdtDbtInd
+		}
+		// An unreadable amount stays zero so the row fails validation
+		entry.Amount, _ = parseCAMTAmount(tx.amount().Value, indicator)
+		if tx.amount().Currency != "" {
+			entry.Currency = strings.ToUpper(tx.amount().Currency)
+		}
+		applyCAMTDetails(&entry, tx, indicator)
+		if entry.Remittance == "" {
+			entry.Remittance = strings.TrimSpace(ntry.AddtlNtryInf)
+		}
+		entries = append(entries, &entry)
+	}
+	return entries
+}
+
+// amount returns the transaction amount, which camt.053.001.02 nests under AmtDtls
+func (tx camtTxDetails) amount() *camtAmount {
+	if tx.Amount != nil {
+		return tx.Amount
+	}
+	return tx.TxAmount
+}
+
+// applyCAMTDetails copies references, counterparty and remittance information from
+// the transaction details
+func applyCAMTDetails(entry *StatementEntry, tx camtTxDetails, indicator string) {
+	entry.EndToEndRef = statementRef(tx.EndToEndID)
+	if ref := strings.TrimSpace(tx.AcctSvcrRef); ref != "" {
+		entry.BankRef = ref
+	}
+
+	// The counterparty of a debit is the creditor, of a credit the debtor
+	party := tx.Debtor
+	if strings.TrimSpace(indicator) == "DBIT" {
+		party = tx.Creditor
+	}
+	entry.Counterparty = strings.TrimSpace(party.Name)
+	if entry.Counterparty == "" {
+		entry.Counterparty = strings.TrimSpace(party.PartyName)
+	}
+
+	switch {
+	case len(trimLines(tx.Unstructured)) > 0:
+		entry.Remittance = strings.Join(trimLines(tx.Unstructured), " ")
+	case len(trimLines(tx.CreditorRef)) > 0:
+		entry.Remittance = strings.Join(trimLines(tx.CreditorRef), " ")
+	default:
+		entry.Remittance = strings.TrimSpace(tx.AddtlTxInf)
+	}
+}
+
+// parseCAMTAmount parses an unsigned amount and applies the credit/debit indicator
+func parseCAMTAmount(value, indicator string) (int64, error) {
+	amount, err := NewAmountParser(nil).Parse(strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	switch strings.TrimSpace(indicator) {
+	case "DBIT":
+		return -amount, nil
+	case "CRDT":
+		return amount, nil
+	default:
+		return 0, fmt.Errorf("invalid credit/debit indicator %q", indicator)
+	}
+}
+
+// parseCAMTDate parses an ISO date or date-time, keeping the date part
+func parseCAMTDate(date camtDate) (time.Time, error) {
+	value := strings.TrimSpace(date.Date)
+	if value == "" {
+		value = strings.TrimSpace(date.DateTime)
+	}
+	if len(value) < 10 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return NewDateParser("YYYY-MM-DD").Parse(value[:10])
+}
@@ -0,0 +1,226 @@
+package parser
+
+import (
+	"testing"
+	"time"
+)
+
+const sampleCAMT = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <GrpHdr><MsgId>MSG-1</MsgId><CreDtTm>2024-02-01T08:00:00+07:00</CreDtTm></GrpHdr>
+    <Stmt>
+      <Id>STMT-2024-01</Id>
+      <Acct><Id><Othr><Id>0011004123456</Id></Othr></Id><Ccy>VND</Ccy></Acct>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="VND">10000000</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Dt><Dt>2024-01-01</Dt></Dt>
+      </Bal>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="VND">11850000</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Dt><DtTm>2024-01-31T23:59:59+07:00</DtTm></Dt>
+      </Bal>
+      <Ntry>
+        <Amt Ccy="VND">150000</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2024-01-10</Dt></BookgDt>
+        <ValDt><Dt>2024-01-09</Dt></ValDt>
+        <AcctSvcrRef>FT24010000001</AcctSvcrRef>
+        <NtryDtls>
+          <TxDtls>
+            <Refs><EndToEndId>E2E-0001</EndToEndId></Refs>
+            <RltdPties>
+              <Dbtr><Pty><Nm>NGUYEN VAN A</Nm></Pty></Dbtr>
+              <Cdtr><Pty><Nm>HIGHLANDS COFFEE</Nm></Pty></Cdtr>
+            </RltdPties>
+            <RmtInf><Ustrd>Thanh toan</Ustrd><Ustrd>ca phe</Ustrd></RmtInf>
+          </TxDtls>
+        </NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="VND">2000000</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2024-01-15</Dt></BookgDt>
+        <ValDt><Dt>2024-01-15</Dt></ValDt>
+        <AcctSvcrRef>FT24015000002</AcctSvcrRef>
+        <NtryDtls>
+          <TxDtls>
+            <Refs><EndToEndId>NOTPROVIDED</EndToEndId></Refs>
+            <Amt Ccy="VND">1500000</Amt>
+            <CdtDbtInd>CRDT</CdtDbtInd>
+            <RltdPties><Dbtr><Pty><Nm>TRAN THI B</Nm></Pty></Dbtr></RltdPties>
+            <RmtInf><Strd><CdtrRefInf><Ref>INV-42</Ref></CdtrRefInf></Strd></RmtInf>
+          </TxDtls>
+          <TxDtls>
+            <Refs><EndToEndId>E2E-0003</EndToEndId></Refs>
+            <Amt Ccy="VND">500000</Amt>
+            <CdtDbtInd>CRDT</CdtDbtInd>
+            <RltdPties><Dbtr><Pty><Nm>LE VAN C</Nm></Pty></Dbtr></RltdPties>
+          </TxDtls>
+        </NtryDtls>
+        <AddtlNtryInf>Batch credit</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="VND">99000</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><Dt>2024-01-31</Dt></BookgDt>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>
+`
+
+// legacyCAMT uses camt.053.001.02 element layouts: plain-text status, party names
+// without Pty, transaction amounts under AmtDtls and PRCD instead of OPBD
+const legacyCAMT = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Acct><Id><IBAN>DE89370400440532013000</IBAN></Id></Acct>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>PRCD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">25.50</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Dt><Dt>2024-03-01</Dt></Dt>
+      </Bal>
+      <Ntry>
+        <Amt Ccy="EUR">42.15</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <RvslInd>true</RvslInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2024-03-04</Dt></BookgDt>
+        <ValDt><Dt>2024-03-04</Dt></ValDt>
+        <NtryDtls>
+          <TxDtls>
+            <AmtDtls><TxAmt><Amt Ccy="EUR">42.15</Amt></TxAmt></AmtDtls>
+            <RltdPties><Dbtr><Nm>Stadtwerke</Nm></Dbtr></RltdPties>
+          </TxDtls>
+        </NtryDtls>
+        <AddtlNtryInf>Ruecklastschrift</AddtlNtryInf>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>
+`
+
+func TestCAMTParser_Parse(t *testing.T) {
+	p := NewCAMTParser("")
+	rows, err := p.parseContent([]byte(sampleCAMT))
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 booked rows (batch split, pending skipped), got %d", len(rows))
+	}
+
+	coffee := rows[0]
+	if coffee.Amount != -1500000000 {
+		t.Errorf("expected debit -1500000000, got %d", coffee.Amount)
+	}
+	if coffee.Date.Day() != 10 || coffee.ValueDate.Day() != 9 {
+		t.Errorf("expected booking date 10 and value date 9, got %v / %v", coffee.Date, coffee.ValueDate)
+	}
+	if coffee.Counterparty != "HIGHLANDS COFFEE" {
+		t.Errorf("expected the creditor of a debit, got %q", coffee.Counterparty)
+	}
+	if coffee.OriginalDescription != "HIGHLANDS COFFEE - Thanh toan ca phe" {
+		t.Errorf("unexpected description %q", coffee.OriginalDescription)
+	}
+	if coffee.ReferenceNum != "E2E-0001" {
+		t.Errorf("expected the end-to-end reference, got %q", coffee.ReferenceNum)
+	}
+	if !coffee.IsValid {
+		t.Errorf("expected valid row, got errors %v", coffee.ValidationErrors)
+	}
+
+	first, second := rows[1], rows[2]
+	if first.Amount != 15000000000 || second.Amount != 5000000000 {
+		t.Errorf("expected batch amounts 15000000000 and 5000000000, got %d and %d", first.Amount, second.Amount)
+	}
+	if first.Counterparty != "TRAN THI B" {
+		t.Errorf("expected the debtor of a credit, got %q", first.Counterparty)
+	}
+	if first.OriginalDescription != "TRAN THI B - INV-42" {
+		t.Errorf("expected the structured reference as remittance, got %q", first.OriginalDescription)
+	}
+	if first.ReferenceNum != "FT24015000002" {
+		t.Errorf("expected the bank reference without an end-to-end reference, got %q", first.ReferenceNum)
+	}
+	if second.OriginalDescription != "LE VAN C - Batch credit" {
+		t.Errorf("expected the entry information as remittance, got %q", second.OriginalDescription)
+	}
+
+	if currency := p.GetDetectedMapping().Currency; currency != "VND" {
+		t.Errorf("expected VND, got %s", currency)
+	}
+	balances := p.GetStatementBalances()
+	if balances == nil || balances.Opening == nil || balances.Closing == nil {
+		t.Fatalf("expected opening and closing balances, got %+v", balances)
+	}
+	if *balances.Opening != 100000000000 || *balances.Closing != 118500000000 {
+		t.Errorf("unexpected balances %d / %d", *balances.Opening, *balances.Closing)
+	}
+	if balances.ClosingDate.Month() != time.January || balances.ClosingDate.Day() != 31 {
+		t.Errorf("expected closing date Jan 31, got %v", balances.ClosingDate)
+	}
+}
+
+func TestCAMTParser_LegacyLayout(t *testing.T) {
+	p := NewCAMTParser("")
+	rows, err := p.parseContent([]byte(legacyCAMT))
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+
+	row := rows[0]
+	if row.Amount != 421500 {
+		t.Errorf("expected the returned debit to be booked as a credit, got %d", row.Amount)
+	}
+	if row.Counterparty != "Stadtwerke" {
+		t.Errorf("expected the debtor name, got %q", row.Counterparty)
+	}
+	if row.OriginalDescription != "Stadtwerke - Ruecklastschrift" {
+		t.Errorf("unexpected description %q", row.OriginalDescription)
+	}
+
+	if currency := p.GetDetectedMapping().Currency; currency != "EUR" {
+		t.Errorf("expected EUR from the balance, got %s", currency)
+	}
+	balances := p.GetStatementBalances()
+	if balances == nil || balances.Opening == nil || *balances.Opening != -255000 {
+		t.Errorf("expected the previous closing as a debit opening balance, got %+v", balances)
+	}
+	if balances != nil && balances.Closing != nil {
+		t.Errorf("expected no closing balance")
+	}
+}
+
+func TestCAMTParser_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"not XML", "Date,Amount\n2024-01-01,10\n"},
+		{"other XML", "<Document><CstmrCdtTrfInitn/></Document>"},
+		{"only pending entries", `<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy="VND">1</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts>PDNG</Sts></Ntry></Stmt></BkToCstmrStmt></Document>`},
+		{"bad balance", `<Document><BkToCstmrStmt><Stmt><Bal><Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp><Amt Ccy="VND">1</Amt></Bal></Stmt></BkToCstmrStmt></Document>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCAMTParser("").parseContent([]byte(tt.content)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
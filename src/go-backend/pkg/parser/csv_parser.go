@@ -26,6 +26,7 @@ type ColumnMapping struct {
 	TypeColumn        int           // -1 if not present
 	CategoryColumn    int           // -1 if not present
 	ReferenceColumn   int           // -1 if not present
+	TagsColumn        int           // -1 if not present; see ParseTagList
 	DateFormat        string        // Optional: specific format to try first
 	Currency          string
 	AmountFormat      *AmountFormat // Optional: specific amount format
@@ -35,16 +36,28 @@ type ColumnMapping struct {
 type ParsedRow struct {
 	RowNumber           int
 	Date                time.Time
-	Amount              int64  // Smallest currency unit (×10000 for 4 decimal precision)
-	Description         string // Cleaned description
-	OriginalDescription string // Original description from file before cleaning
-	Type                string // "income" or "expense"
+	ValueDate           time.Time // Zero unless the statement reports it
+	Amount              int64     // Smallest currency unit (×10000 for 4 decimal precision)
+	Description         string    // Cleaned description
+	OriginalDescription string    // Original description from file before cleaning
+	Counterparty        string    // Empty unless the statement reports it
+	Type                string    // "income" or "expense"
 	CategoryID          int32
+	CategoryName        string        // Category named by the file (e.g. QIF), resolved by the import
+	Splits              []ParsedSplit // Category split lines, when the file has them
 	ReferenceNum        string
+	Tags                []string // Tag names from the tags column, resolved by the import
 	ValidationErrors    []ValidationError
 	IsValid             bool
 }
 
+// ParsedSplit represents a category split line of a parsed transaction
+type ParsedSplit struct {
+	CategoryName string
+	Memo         string
+	Amount       int64 // Signed like the parent (×10000)
+}
+
 // ValidationError represents a validation error for a specific field
 type ValidationError struct {
 	Field    string
@@ -189,6 +202,11 @@ func (p *CSVParser) parseRow(rowNumber int, row []string) *ParsedRow {
 		parsed.ReferenceNum = strings.TrimSpace(row[p.mapping.ReferenceColumn])
 	}
 
+	// Parse tags (if column exists)
+	if p.mapping.TagsColumn >= 0 && p.mapping.TagsColumn <= maxCol {
+		parsed.Tags = ParseTagList(row[p.mapping.TagsColumn])
+	}
+
 	// Parse category (if column exists)
 	if p.mapping.CategoryColumn >= 0 && p.mapping.CategoryColumn <= maxCol {
 		categoryStr := strings.TrimSpace(row[p.mapping.CategoryColumn])
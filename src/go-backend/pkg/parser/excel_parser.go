@@ -511,6 +511,11 @@ func (p *ExcelParser) parseRow(sheetName string, rowNumber int, row []string, ma
 		parsed.ReferenceNum = strings.TrimSpace(row[mapping.ReferenceColumn])
 	}
 
+	// Parse tags (if column exists)
+	if mapping.TagsColumn >= 0 && mapping.TagsColumn <= maxCol {
+		parsed.Tags = ParseTagList(row[mapping.TagsColumn])
+	}
+
 	// Apply business rules validation (if all required fields are parsed successfully)
 	if parsed.IsValid {
 		validationErrors := validator.ValidateTransaction(
@@ -707,6 +712,7 @@ func (p *ExcelParser) detectColumnsFromHeader(headerRow []string) *ColumnMapping
 		TypeColumn:        -1,
 		CategoryColumn:    -1,
 		ReferenceColumn:   -1,
+		TagsColumn:        -1,
 		Currency:          "VND", // Default to VND
 	}
 
@@ -0,0 +1,381 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MT940Parser handles parsing of SWIFT MT940 customer statements
+type MT940Parser struct {
+	filePath string
+	mapping  *ColumnMapping     // Detected from the statement (currency only)
+	balances *StatementBalances // Opening (:60F:) and closing (:62F:) balances
+}
+
+// mt940Field is a tagged field of an MT940 message, with continuation lines kept
+type mt940Field struct {
+	tag   string
+	lines []string
+}
+
+var (
+	// Field tag at the start of a line, e.g. :61: or :60F:
+	mt940TagPattern = regexp.MustCompile(`^:(\d{2}[A-Z]?):`)
+
+	// Statement line (:61:): value date, optional entry date (MMDD), debit/credit mark
+	// (R for reversals), optional funds code, amount, transaction type, customer
+	// reference and optional bank reference after //
+	mt940LinePattern = regexp.MustCompile(`^(\d{6})(\d{4})?(R?[CD])([A-Z])?(\d+,\d*)([NSF][A-Z0-9]{3})(.*?)(?://(.*))?$`)
+
+	// Subfield marker of the German structured :86: format, e.g. ?20 or ?32
+	mt940SubfieldPattern = regexp.MustCompile(`\?(\d{2})`)
+
+	// SEPA keyword in German remittance lines, e.g. EREF+ or SVWZ+
+	mt940SepaKeyPattern = regexp.MustCompile(`([A-Z]{4})\+`)
+)
+
+// mt940InfoKeys are the keys of the slash-structured :86: format
+var mt940InfoKeys = map[string]bool{
+	"EREF": true, "REMI": true, "NAME": true, "ORDP": true, "BENM": true, "CNTP": true,
+	"IBAN": true, "BIC": true, "ID": true, "PREF": true, "MARF": true, "CSID": true,
+	"RTRN": true, "ISDT": true, "ADDR": true, "TRCD": true, "PURP": true, "ULTC": true,
+	"ULTD": true, "CDTRREF": true, "CDTRREFTP": true, "CD": true, "ISSR": true,
+}
+
+// NewMT940Parser creates a new MT940 parser instance
+func NewMT940Parser(filePath string) *MT940Parser {
+	return &MT940Parser{filePath: filePath}
+}
+
+// Parse reads the statement and returns one row per statement line (:61:)
+func (p *MT940Parser) Parse() ([]*ParsedRow, error) {
+	// Read the file (supports both URLs and local paths)
+	data, err := FetchFileContent(context.Background(), p.filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return p.parseContent(string(data))
+}
+
+// GetDetectedMapping returns the mapping detected from the statement, which carries
+// the currency of its opening balance
+func (p *MT940Parser) GetDetectedMapping() *ColumnMapping {
+	return p.mapping
+}
+
+// GetStatementBalances returns the opening and closing balances of the statement. A
+// file with several statements reports the first opening and the last closing.
+func (p *MT940Parser) GetStatementBalances() *StatementBalances {
+	return p.balances
+}
+
+func (p *MT940Parser) parseContent(content string) ([]*ParsedRow, error) {
+	fields := splitMT940Fields(content)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("not an MT940 file: no tagged fields found")
+	}
+
+	// A malformed statement line keeps its place in entries (as nil) and is returned
+	// as an invalid row rather than dropped
+	var entries []*StatementEntry
+	var current *StatementEntry
+	balances := &StatementBalances{}
+	invalidRows := make(map[int]*ParsedRow)
+	var firstErr error
+
+	for _, field := range fields {
+		switch field.tag {
+		case "60F", "60M":
+			// Intermediate pages repeat :60M:, only the first opening balance counts
+			if balances.Opening == nil {
+				amount, date, currency, err := parseMT940Balance(field.lines[0])
+				if err != nil {
+					return nil, fmt.Errorf("invalid opening balance: %w", err)
+				}
+				balances.Opening, balances.OpeningDate, balances.Currency = &amount, date, currency
+			}
+			current = nil
+		case "62F", "62M":
+			amount, date, currency, err := parseMT940Balance(field.lines[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid closing balance: %w", err)
+			}
+			balances.Closing, balances.ClosingDate = &amount, date
+			if balances.Currency == "" {
+				balances.Currency = currency
+			}
+			current = nil
+		case "61":
+			entry, err := parseMT940Line(field.lines[0], balances.Currency)
+			if err != nil {
+				invalidRows[len(entries)] = invalidStatementRow(len(entries)+1, field.lines[0], err)
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+			entries = append(entries, entry)
+			current = entry
+		case "86":
+			// Information to account owner belongs to the statement line before it
+			if current != nil {
+				counterparty, remittance, endToEndRef := parseMT940Info(field.lines)
+				current.Counterparty, current.Remittance = counterparty, remittance
+				if endToEndRef != "" {
+					current.EndToEndRef = endToEndRef
+				}
+			}
+			current = nil
+		default:
+			current = nil
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no statement lines found in MT940 file")
+	}
+	if len(invalidRows) == len(entries) {
+		return nil, fmt.Errorf("no valid statement lines found in MT940 file: %w", firstErr)
+	}
+
+	p.mapping = statementMapping("YYMMDD", balances.Currency)
+	if balances.Opening != nil || balances.Closing != nil {
+		p.balances = balances
+	}
+
+	rows := make([]*ParsedRow, 0, len(entries))
+	for i, entry := range entries {
+		if invalid, ok := invalidRows[i]; ok {
+			rows = append(rows, invalid)
+			continue
+		}
+		rows = append(rows, statementEntryRow(i+1, entry, balances.Currency))
+	}
+	return rows, nil
+}
+
+// splitMT940Fields splits an MT940 message into its tagged fields. SWIFT block headers
+// ({1:...}{2:...}{4:) and trailers (-}) around the text block are skipped.
+func splitMT940Fields(content string) []*mt940Field {
+	var fields []*mt940Field
+	var current *mt940Field
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if i := strings.LastIndex(line, "{4:"); i >= 0 {
+			line = line[i+3:]
+		}
+		line = strings.TrimRight(line, " \r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed == "-" || strings.HasPrefix(trimmed, "-}") || strings.HasPrefix(trimmed, "{") {
+			continue
+		}
+
+		if match := mt940TagPattern.FindStringSubmatch(line); match != nil {
+			current = &mt940Field{tag: match[1], lines: []string{line[len(match[0]):]}}
+			fields = append(fields, current)
+			continue
+		}
+		if current != nil {
+			current.lines = append(current.lines, line)
+		}
+	}
+	return fields
+}
+
+// parseMT940Balance parses a balance field, e.g. C240131VND1250000,00
+func parseMT940Balance(value string) (int64, time.Time, string, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 11 || (value[0] != 'C' && value[0] != 'D') {
+		return 0, time.Time{}, "", fmt.Errorf("invalid balance %q", value)
+	}
+
+	date, err := NewDateParser("YYMMDD").Parse(value[1:7])
+	if err != nil {
+		return 0, time.Time{}, "", err
+	}
+	amount, err := parseSwiftAmount(value[10:])
+	if err != nil {
+		return 0, time.Time{}, "", err
+	}
+	if value[0] == 'D' {
+		amount = -amount
+	}
+	return amount, date, value[7:10], nil
+}
+
+// parseMT940Line parses the first line of a statement line field (:61:); the
+// supplementary details on the next line are not used
+func parseMT940Line(line string, currency string) (*StatementEntry, error) {
+	match := mt940LinePattern.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return nil, fmt.Errorf("invalid statement line %q", line)
+	}
+
+	valueDate, err := NewDateParser("YYMMDD").Parse(match[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid value date in %q: %w", line, err)
+	}
+	entry := &StatementEntry{
+		ValueDate:   valueDate,
+		BookingDate: valueDate,
+		Currency:    currency,
+		BankRef:     strings.TrimSpace(match[8]),
+	}
+
+	// The entry (booking) date has no year; it can fall in the year before or after
+	// the value date around new year
+	if match[2] != "" {
+		bookingDate, err := mt940EntryDate(valueDate, match[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid entry date in %q: %w", line, err)
+		}
+		entry.BookingDate = bookingDate
+	}
+
+	amount, err := parseSwiftAmount(match[5])
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount in %q: %w", line, err)
+	}
+	// D and RC (reversal of a credit) take money out of the account
+	if mark := match[3]; mark == "D" || mark == "RC" {
+		amount = -amount
+	}
+	entry.Amount = amount
+
+	// The customer reference stands in for the end-to-end reference unless the :86:
+	// carries one
+	entry.EndToEndRef = statementRef(match[7])
+	return entry, nil
+}
+
+// mt940EntryDate places an MMDD entry date in the year closest to the value date
+func mt940EntryDate(valueDate time.Time, mmdd string) (time.Time, error) {
+	dateParser := NewDateParser("")
+	var candidate time.Time
+	for _, year := range []int{valueDate.Year(), valueDate.Year() - 1, valueDate.Year() + 1} {
+		date, err := time.ParseInLocation("20060102", fmt.Sprintf("%04d%s", year, mmdd), dateParser.timezone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if candidate.IsZero() || absDuration(date.Sub(valueDate)) < absDuration(candidate.Sub(valueDate)) {
+			candidate = date
+		}
+	}
+	if err := dateParser.validateDate(candidate); err != nil {
+		return time.Time{}, err
+	}
+	return candidate, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// parseMT940Info extracts the counterparty, remittance information and end-to-end
+// reference from an information to account owner field (:86:). The German ?-subfield
+// and the slash-keyed (/EREF/.../REMI/...) layouts are structured; anything else is
+// taken as remittance text.
+func parseMT940Info(lines []string) (counterparty, remittance, endToEndRef string) {
+	joined := strings.Join(lines, "")
+	switch {
+	case mt940SubfieldPattern.MatchString(joined) && strings.Contains(joined, "?2"):
+		return parseMT940SubfieldInfo(joined)
+	case strings.HasPrefix(strings.TrimSpace(joined), "/") && strings.Count(joined, "/") >= 2:
+		return parseMT940SlashInfo(strings.TrimSpace(joined))
+	default:
+		return "", strings.TrimSpace(strings.Join(trimLines(lines), " ")), ""
+	}
+}
+
+// parseMT940SubfieldInfo parses the German layout: ?20-?29 and ?60-?63 carry the
+// remittance lines, ?32-?33 the counterparty name
+func parseMT940SubfieldInfo(info string) (counterparty, remittance, endToEndRef string) {
+	indexes := mt940SubfieldPattern.FindAllStringSubmatchIndex(info, -1)
+	var name, purpose strings.Builder
+	for i, idx := range indexes {
+		end := len(info)
+		if i+1 < len(indexes) {
+			end = indexes[i+1][0]
+		}
+		code, value := info[idx[2]:idx[3]], info[idx[1]:end]
+		switch {
+		case code == "32" || code == "33":
+			name.WriteString(value)
+		case (code >= "20" && code <= "29") || (code >= "60" && code <= "63"):
+			purpose.WriteString(value)
+		}
+	}
+
+	remittance = purpose.String()
+	// SEPA payments tag the purpose lines, e.g. EREF+ref SVWZ+text
+	if keys := mt940SepaKeyPattern.FindAllStringSubmatchIndex(remittance, -1); len(keys) > 0 && keys[0][0] == 0 {
+		tagged := make(map[string]string)
+		for i, key := range keys {
+			end := len(remittance)
+			if i+1 < len(keys) {
+				end = keys[i+1][0]
+			}
+			tagged[remittance[key[2]:key[3]]] = strings.TrimSpace(remittance[key[1]:end])
+		}
+		endToEndRef = statementRef(tagged["EREF"])
+		remittance = tagged["SVWZ"]
+	}
+	return strings.TrimSpace(name.String()), strings.TrimSpace(remittance), endToEndRef
+}
+
+// parseMT940SlashInfo parses the slash-keyed layout, e.g.
+// /EREF/ref/CNTP/account/bic/name/city/REMI/USTD//text/
+func parseMT940SlashInfo(info string) (counterparty, remittance, endToEndRef string) {
+	values := make(map[string]string)
+	key := ""
+	var value []string
+	flush := func() {
+		if key != "" {
+			values[key] = strings.Trim(strings.Join(value, "/"), "/ ")
+		}
+	}
+	for _, token := range strings.Split(info, "/") {
+		if mt940InfoKeys[token] {
+			flush()
+			key, value = token, nil
+			continue
+		}
+		value = append(value, token)
+	}
+	flush()
+
+	counterparty = values["NAME"]
+	if counterparty == "" {
+		// CNTP is account/BIC/name/city
+		if parts := strings.Split(values["CNTP"], "/"); len(parts) >= 3 {
+			counterparty = strings.TrimSpace(parts[2])
+		}
+	}
+	remittance = values["REMI"]
+	for _, prefix := range []string{"USTD//", "USTD/", "STRD/CUR/", "STRD/"} {
+		remittance = strings.TrimPrefix(remittance, prefix)
+	}
+	return counterparty, strings.TrimSpace(remittance), statementRef(values["EREF"])
+}
+
+func trimLines(lines []string) []string {
+	trimmed := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			trimmed = append(trimmed, line)
+		}
+	}
+	return trimmed
+}
+
+// parseSwiftAmount parses a SWIFT amount, which uses a comma as decimal separator and
+// no thousands separators
+func parseSwiftAmount(value string) (int64, error) {
+	return NewAmountParser(nil).Parse(strings.Replace(strings.TrimSpace(value), ",", ".", 1))
+}
@@ -0,0 +1,191 @@
+package parser
+
+import (
+	"testing"
+	"time"
+)
+
+const sampleMT940 = `{1:F01VCBKVNVXAXXX0000000000}{2:O9401200240131VCBKVNVXAXXX00000000002401311200N}{4:
+:20:STMT240131
+:25:0011004123456
+:28C:15/1
+:60F:C231229VND10000000,00
+:61:2312291230D150000,00NTRFNONREF//FT23363000001
+:86:/EREF/E2E-2023-0001/NAME/HIGHLANDS COFFEE/REMI/USTD//Thanh toan ca phe/
+:61:2401020102C2500000,NTRFPAYROLL-JAN//FT24002000002
+:86:Luong thang 1 ACME CO
+ LTD
+:61:240105D5000,00NMSC
+:62F:C240105VND12345000,00
+-}`
+
+const germanMT940 = `:20:STARTUMS
+:25:10020030/1234567890
+:28C:0
+:60F:D240201EUR100,50
+:61:2402050205RD42,15NDDTKREF+12345
+:86:105?00FOLGELASTSCHRIFT?20EREF+RE-2024-17?21SVWZ+Rechnung 17 Strom?22 Februar?32STADTWERKE MUENCHEN ?33GMBH
+:62F:D240205EUR142,65
+`
+
+func TestMT940Parser_Parse(t *testing.T) {
+	p := NewMT940Parser("")
+	rows, err := p.parseContent(sampleMT940)
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+
+	coffee := rows[0]
+	if coffee.Amount != -1500000000 {
+		t.Errorf("expected debit -1500000000, got %d", coffee.Amount)
+	}
+	if coffee.Date.Month() != time.December || coffee.Date.Day() != 30 {
+		t.Errorf("expected booking date Dec 30, got %v", coffee.Date)
+	}
+	if coffee.ValueDate.Day() != 29 {
+		t.Errorf("expected value date Dec 29, got %v", coffee.ValueDate)
+	}
+	if coffee.Counterparty != "HIGHLANDS COFFEE" {
+		t.Errorf("expected counterparty from /NAME/, got %q", coffee.Counterparty)
+	}
+	if coffee.OriginalDescription != "HIGHLANDS COFFEE - Thanh toan ca phe" {
+		t.Errorf("unexpected description %q", coffee.OriginalDescription)
+	}
+	if coffee.ReferenceNum != "E2E-2023-0001" {
+		t.Errorf("expected the end-to-end reference, got %q", coffee.ReferenceNum)
+	}
+	if !coffee.IsValid {
+		t.Errorf("expected valid row, got errors %v", coffee.ValidationErrors)
+	}
+
+	salary := rows[1]
+	if salary.Amount != 25000000000 {
+		t.Errorf("expected credit 25000000000, got %d", salary.Amount)
+	}
+	if salary.Date.Year() != 2024 {
+		t.Errorf("expected entry date in 2024, got %v", salary.Date)
+	}
+	if salary.Type != "income" {
+		t.Errorf("expected income, got %s", salary.Type)
+	}
+	if salary.OriginalDescription != "Luong thang 1 ACME CO LTD" {
+		t.Errorf("expected free text remittance, got %q", salary.OriginalDescription)
+	}
+	if salary.ReferenceNum != "PAYROLL-JAN" {
+		t.Errorf("expected the customer reference, got %q", salary.ReferenceNum)
+	}
+
+	fee := rows[2]
+	if !fee.Date.Equal(fee.ValueDate) {
+		t.Errorf("expected the value date without an entry date, got %v", fee.Date)
+	}
+	if fee.ReferenceNum != "" {
+		t.Errorf("expected no reference, got %q", fee.ReferenceNum)
+	}
+
+	if currency := p.GetDetectedMapping().Currency; currency != "VND" {
+		t.Errorf("expected VND, got %s", currency)
+	}
+	balances := p.GetStatementBalances()
+	if balances == nil || balances.Opening == nil || balances.Closing == nil {
+		t.Fatalf("expected opening and closing balances, got %+v", balances)
+	}
+	if *balances.Opening != 100000000000 || *balances.Closing != 123450000000 {
+		t.Errorf("unexpected balances %d / %d", *balances.Opening, *balances.Closing)
+	}
+	if balances.OpeningDate.Day() != 29 || balances.ClosingDate.Day() != 5 {
+		t.Errorf("unexpected balance dates %v / %v", balances.OpeningDate, balances.ClosingDate)
+	}
+}
+
+func TestMT940Parser_GermanSubfields(t *testing.T) {
+	p := NewMT940Parser("")
+	rows, err := p.parseContent(germanMT940)
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+
+	row := rows[0]
+	if row.Amount != 421500 {
+		t.Errorf("expected the reversed debit to be positive, got %d", row.Amount)
+	}
+	if row.Counterparty != "STADTWERKE MUENCHEN GMBH" {
+		t.Errorf("expected counterparty from ?32/?33, got %q", row.Counterparty)
+	}
+	if row.ReferenceNum != "RE-2024-17" {
+		t.Errorf("expected EREF+ reference, got %q", row.ReferenceNum)
+	}
+	if row.OriginalDescription != "STADTWERKE MUENCHEN GMBH - Rechnung 17 Strom Februar" {
+		t.Errorf("expected SVWZ+ remittance, got %q", row.OriginalDescription)
+	}
+	if currency := p.GetDetectedMapping().Currency; currency != "EUR" {
+		t.Errorf("expected EUR, got %s", currency)
+	}
+	if balances := p.GetStatementBalances(); *balances.Opening != -1005000 {
+		t.Errorf("expected a debit opening balance, got %d", *balances.Opening)
+	}
+}
+
+func TestMT940Parser_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"not MT940", "Date,Amount\n2024-01-01,10\n"},
+		{"no statement lines", ":20:X\n:60F:C240101VND0,\n:62F:C240101VND0,\n"},
+		{"bad statement line", ":20:X\n:61:garbage\n"},
+		{"bad opening balance", ":20:X\n:60F:X\n:61:240105D5000,00NMSC\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewMT940Parser("").parseContent(tt.content); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestMT940Parser_MalformedLine(t *testing.T) {
+	content := ":20:X\n:60F:C240101VND0,\n:61:240102D100,00NMSC\n:61:garbage\n:86:lost\n:61:240103C50,00NMSC\n:86:refund\n"
+	rows, err := NewMT940Parser("").parseContent(content)
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+
+	bad := rows[1]
+	if bad.IsValid || len(bad.ValidationErrors) == 0 {
+		t.Errorf("expected an invalid row with an error, got %+v", bad)
+	}
+	if bad.RowNumber != 2 || bad.OriginalDescription != "garbage" {
+		t.Errorf("unexpected invalid row %d %q", bad.RowNumber, bad.OriginalDescription)
+	}
+	if !rows[0].IsValid || !rows[2].IsValid {
+		t.Error("expected the other lines to stay valid")
+	}
+	if rows[2].OriginalDescription != "refund" {
+		t.Errorf("expected the info of the line after, got %q", rows[2].OriginalDescription)
+	}
+}
+
+func TestParseMT940Info(t *testing.T) {
+	counterparty, remittance, ref := parseMT940Info([]string{"/EREF/NOTPROVIDED/CNTP/VN123/BFTVVNVX/NGUYEN VAN A/HANOI/REMI/STRD/CUR/INV-9/"})
+	if counterparty != "NGUYEN VAN A" {
+		t.Errorf("expected the name from /CNTP/, got %q", counterparty)
+	}
+	if remittance != "INV-9" {
+		t.Errorf("expected the structured reference, got %q", remittance)
+	}
+	if ref != "" {
+		t.Errorf("expected a placeholder reference to be dropped, got %q", ref)
+	}
+}
@@ -0,0 +1,269 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"wealthjourney/pkg/validator"
+)
+
+// OFXParser handles parsing of OFX and QFX statement files, in both the SGML (v1)
+// and the XML (v2) flavour. Quicken's QFX is OFX with an extra institution block.
+type OFXParser struct {
+	filePath string
+	mapping  *ColumnMapping     // Detected from the statement (currency only)
+	balances *StatementBalances // Ledger balance, when reported
+}
+
+// ofxElement is a tag of an OFX document with the text following it
+type ofxElement struct {
+	name    string
+	closing bool
+	value   string
+}
+
+// ofxTransaction holds the fields of one STMTTRN aggregate
+type ofxTransaction struct {
+	fields map[string]string
+}
+
+// NewOFXParser creates a new OFX/QFX parser instance
+func NewOFXParser(filePath string) *OFXParser {
+	return &OFXParser{filePath: filePath}
+}
+
+// Parse reads the statement and returns one row per transaction
+func (p *OFXParser) Parse() ([]*ParsedRow, error) {
+	// Read the file (supports both URLs and local paths)
+	data, err := FetchFileContent(context.Background(), p.filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return p.parseContent(string(data))
+}
+
+// GetDetectedMapping returns the mapping detected from the statement, which carries
+// the statement currency (CURDEF)
+func (p *OFXParser) GetDetectedMapping() *ColumnMapping {
+	return p.mapping
+}
+
+// GetStatementBalances returns the ledger balance (LEDGERBAL) reported by the
+// statement, or nil when it has none
+func (p *OFXParser) GetStatementBalances() *StatementBalances {
+	return p.balances
+}
+
+func (p *OFXParser) parseContent(content string) ([]*ParsedRow, error) {
+	start := strings.Index(strings.ToUpper(content), "<OFX>")
+	if start < 0 {
+		return nil, fmt.Errorf("not an OFX file: missing <OFX> element")
+	}
+
+	var transactions []*ofxTransaction
+	var current *ofxTransaction
+	var ledger map[string]string
+	inLedger := false
+	currency := ""
+
+	// Leaf elements are not closed in SGML, so each value is the text following its
+	// tag; closing tags only matter for the aggregates tracked here
+	for _, el := range tokenizeOFX(content[start:]) {
+		switch {
+		case el.name == "STMTTRN":
+			if !el.closing {
+				current = &ofxTransaction{fields: make(map[string]string)}
+			} else if current != nil {
+				transactions = append(transactions, current)
+				current = nil
+			}
+		case el.name == "LEDGERBAL":
+			// A multi-account file reports one balance per account; the first is kept
+			inLedger = !el.closing && ledger == nil
+			if inLedger {
+				ledger = make(map[string]string)
+			}
+		case el.closing || el.value == "":
+			continue
+		case current != nil:
+			current.fields[el.name] = el.value
+		case inLedger:
+			ledger[el.name] = el.value
+		case el.name == "CURDEF" && currency == "":
+			currency = strings.ToUpper(el.value)
+		}
+	}
+
+	if len(transactions) == 0 {
+		return nil, fmt.Errorf("no transactions found in OFX file")
+	}
+
+	p.mapping = &ColumnMapping{
+		DateColumn:      -1,
+		AmountColumn:    -1,
+		DebitColumn:     -1,
+		CreditColumn:    -1,
+		TypeColumn:      -1,
+		CategoryColumn:  -1,
+		ReferenceColumn: -1,
+		TagsColumn:      -1,
+		DateFormat:      "YYYYMMDD",
+		Currency:        currency,
+	}
+	p.balances = p.parseLedgerBalance(ledger, currency)
+
+	rows := make([]*ParsedRow, 0, len(transactions))
+	for i, tx := range transactions {
+		rows = append(rows, p.parseTransaction(i+1, tx))
+	}
+	return rows, nil
+}
+
+func (p *OFXParser) parseTransaction(rowNumber int, tx *ofxTransaction) *ParsedRow {
+	parsed := &ParsedRow{
+		RowNumber:        rowNumber,
+		IsValid:          true,
+		ValidationErrors: []ValidationError{},
+	}
+
+	// Posting date, falling back to the date the user initiated the transaction
+	dateStr := tx.fields["DTPOSTED"]
+	if dateStr == "" {
+		dateStr = tx.fields["DTUSER"]
+	}
+	if dateStr == "" {
+		parsed.addError("date", "Date is required", "error")
+	} else if date, err := parseOFXDate(dateStr); err != nil {
+		parsed.addError("date", fmt.Sprintf("Invalid date format: %v", err), "error")
+	} else {
+		parsed.Date = date
+	}
+
+	if amountStr := tx.fields["TRNAMT"]; amountStr == "" {
+		parsed.addError("amount", "Amount is required", "error")
+	} else if amount, err := parseOFXAmount(amountStr); err != nil {
+		parsed.addError("amount", fmt.Sprintf("Invalid amount format: %v", err), "error")
+	} else {
+		parsed.Amount = amount
+	}
+
+	// Payee name with the memo appended, as banks often put the details in the memo
+	description := tx.fields["NAME"]
+	if memo := tx.fields["MEMO"]; memo != "" && memo != description {
+		if description != "" {
+			description += " - "
+		}
+		description += memo
+	}
+	parsed.OriginalDescription = description
+	if description == "" {
+		parsed.Description = "Imported Transaction"
+		parsed.addError("description", "Description is empty, using default", "info")
+	} else {
+		parsed.Description = NewDescriptionCleaner().Clean(description)
+	}
+
+	detector := NewTypeDetector()
+	parsed.Type = detector.DetectType(parsed.Description, parsed.Amount)
+
+	// FITID is unique per account, so it is the reference matched by duplicate detection
+	parsed.ReferenceNum = tx.fields["FITID"]
+	if parsed.ReferenceNum == "" {
+		parsed.addError("reference", "Transaction has no FITID; duplicates are matched by date and amount only", "warning")
+	}
+
+	// Apply business rules validation (if all required fields are parsed successfully)
+	if parsed.IsValid {
+		validationErrors := validator.ValidateTransaction(
+			parsed.Amount,
+			p.mapping.Currency,
+			parsed.Description,
+			parsed.Date,
+		)
+
+		for _, ve := range validationErrors {
+			parsed.addError(ve.Field, ve.Message, ve.Severity)
+		}
+	}
+
+	return parsed
+}
+
+// parseLedgerBalance reads the BALAMT and DTASOF of a LEDGERBAL aggregate
+func (p *OFXParser) parseLedgerBalance(ledger map[string]string, currency string) *StatementBalances {
+	if ledger == nil || ledger["BALAMT"] == "" {
+		return nil
+	}
+	amount, err := parseOFXAmount(ledger["BALAMT"])
+	if err != nil {
+		return nil
+	}
+
+	balances := &StatementBalances{Closing: &amount, Currency: currency}
+	if asOf, err := parseOFXDate(ledger["DTASOF"]); err == nil {
+		balances.ClosingDate = asOf
+	}
+	return balances
+}
+
+// tokenizeOFX splits an OFX body into its tags and the text following each. Header
+// lines, XML declarations and processing instructions are skipped.
+func tokenizeOFX(body string) []ofxElement {
+	var elements []ofxElement
+	for {
+		open := strings.IndexByte(body, '<')
+		if open < 0 {
+			return elements
+		}
+		end := strings.IndexByte(body[open:], '>')
+		if end < 0 {
+			return elements
+		}
+		tag := strings.TrimSpace(body[open+1 : open+end])
+		body = body[open+end+1:]
+
+		if tag == "" || tag[0] == '?' || tag[0] == '!' {
+			continue
+		}
+		el := ofxElement{}
+		if tag[0] == '/' {
+			el.closing = true
+			tag = tag[1:]
+		}
+		// Drop attributes, which OFX does not use but some exporters emit
+		if i := strings.IndexAny(tag, " \t\r\n"); i >= 0 {
+			tag = tag[:i]
+		}
+		el.name = strings.ToUpper(tag)
+
+		next := strings.IndexByte(body, '<')
+		if next < 0 {
+			next = len(body)
+		}
+		el.value = html.UnescapeString(strings.TrimSpace(body[:next]))
+		elements = append(elements, el)
+	}
+}
+
+// parseOFXDate parses an OFX datetime (YYYYMMDD[HHMMSS[.XXX]][[offset:TZ]]). Only the
+// calendar day is kept, as posted by the bank.
+func parseOFXDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid OFX date: %q", value)
+	}
+	return NewDateParser("YYYYMMDD").Parse(value[:8])
+}
+
+// parseOFXAmount parses an OFX amount. OFX v1 allows a comma as the decimal separator.
+func parseOFXAmount(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	return NewAmountParser(&AmountFormat{DecimalSeparator: ".", NegativePattern: "prefix"}).Parse(value)
+}
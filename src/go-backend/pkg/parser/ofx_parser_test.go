@@ -0,0 +1,224 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const sgmlOFX = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+SECURITY:NONE
+ENCODING:USASCII
+CHARSET:1252
+COMPRESSION:NONE
+OLDFILEUID:NONE
+NEWFILEUID:NONE
+
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>20240305120000<LANGUAGE>ENG</SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<TRNUID>1
+<STMTRS>
+<CURDEF>USD
+<BANKACCTFROM><BANKID>121000248<ACCTID>123456789<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20240201
+<DTEND>20240229
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240205120000.000[-5:EST]
+<TRNAMT>-42.15
+<FITID>2024020500001
+<NAME>WHOLE FOODS MARKET
+<MEMO>Groceries &amp; household
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240215
+<TRNAMT>2500,00
+<FITID>2024021500002
+<NAME>ACME PAYROLL
+</STMTTRN>
+</BANKTRANLIST>
+<LEDGERBAL><BALAMT>3457.85<DTASOF>20240229</LEDGERBAL>
+<AVAILBAL><BALAMT>3400.00<DTASOF>20240229</AVAILBAL>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`
+
+const xmlOFX = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <CREDITCARDMSGSRSV1>
+    <CCSTMTTRNRS>
+      <TRNUID>1</TRNUID>
+      <CCSTMTRS>
+        <CURDEF>vnd</CURDEF>
+        <CCACCTFROM><ACCTID>4111</ACCTID></CCACCTFROM>
+        <BANKTRANLIST>
+          <STMTTRN>
+            <TRNTYPE>DEBIT</TRNTYPE>
+            <DTUSER>20240110</DTUSER>
+            <TRNAMT>-150000</TRNAMT>
+            <NAME>Highlands Coffee</NAME>
+          </STMTTRN>
+        </BANKTRANLIST>
+        <LEDGERBAL>
+          <BALAMT>-1250000</BALAMT>
+          <DTASOF>20240131235959</DTASOF>
+        </LEDGERBAL>
+      </CCSTMTRS>
+    </CCSTMTTRNRS>
+  </CREDITCARDMSGSRSV1>
+</OFX>
+`
+
+func writeOFXFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	return path
+}
+
+func TestOFXParser_SGML(t *testing.T) {
+	p := NewOFXParser(writeOFXFile(t, "statement.qfx", sgmlOFX))
+
+	rows, err := p.Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	debit := rows[0]
+	if debit.Amount != -421500 {
+		t.Errorf("expected amount -421500, got %d", debit.Amount)
+	}
+	if debit.ReferenceNum != "2024020500001" {
+		t.Errorf("expected FITID as reference, got %q", debit.ReferenceNum)
+	}
+	if debit.OriginalDescription != "WHOLE FOODS MARKET - Groceries & household" {
+		t.Errorf("unexpected description %q", debit.OriginalDescription)
+	}
+	if debit.Date.Year() != 2024 || debit.Date.Month() != time.February || debit.Date.Day() != 5 {
+		t.Errorf("expected posting date 2024-02-05, got %v", debit.Date)
+	}
+	if debit.Type != "expense" {
+		t.Errorf("expected expense, got %s", debit.Type)
+	}
+	if !debit.IsValid {
+		t.Errorf("expected valid row, got errors %v", debit.ValidationErrors)
+	}
+
+	credit := rows[1]
+	if credit.Amount != 25000000 {
+		t.Errorf("expected comma decimal amount 25000000, got %d", credit.Amount)
+	}
+	if credit.Type != "income" {
+		t.Errorf("expected income, got %s", credit.Type)
+	}
+
+	if currency := p.GetDetectedMapping().Currency; currency != "USD" {
+		t.Errorf("expected USD from CURDEF, got %s", currency)
+	}
+	balances := p.GetStatementBalances()
+	if balances == nil || balances.Closing == nil {
+		t.Fatal("expected the ledger balance")
+	}
+	if *balances.Closing != 34578500 {
+		t.Errorf("expected ledger balance 34578500 (not the available balance), got %d", *balances.Closing)
+	}
+	if balances.Opening != nil {
+		t.Errorf("OFX reports no opening balance")
+	}
+	if balances.ClosingDate.Day() != 29 {
+		t.Errorf("expected balance date Feb 29, got %v", balances.ClosingDate)
+	}
+}
+
+func TestOFXParser_XML(t *testing.T) {
+	p := NewOFXParser(writeOFXFile(t, "statement.ofx", xmlOFX))
+
+	rows, err := p.Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+
+	row := rows[0]
+	if row.Amount != -1500000000 {
+		t.Errorf("expected amount -1500000000, got %d", row.Amount)
+	}
+	if row.Date.Day() != 10 {
+		t.Errorf("expected DTUSER when DTPOSTED is missing, got %v", row.Date)
+	}
+	if row.ReferenceNum != "" {
+		t.Errorf("expected no reference, got %q", row.ReferenceNum)
+	}
+	hasWarning := false
+	for _, ve := range row.ValidationErrors {
+		if ve.Field == "reference" && ve.Severity == "warning" {
+			hasWarning = true
+		}
+	}
+	if !hasWarning {
+		t.Errorf("expected a warning for the missing FITID, got %v", row.ValidationErrors)
+	}
+
+	if currency := p.GetDetectedMapping().Currency; currency != "VND" {
+		t.Errorf("expected VND, got %s", currency)
+	}
+	if balances := p.GetStatementBalances(); balances == nil || *balances.Closing != -12500000000 {
+		t.Errorf("expected a negative card balance, got %+v", balances)
+	}
+}
+
+func TestOFXParser_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"not OFX", "Date,Amount\n2024-01-01,10\n"},
+		{"no transactions", "<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><CURDEF>USD</STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewOFXParser(writeOFXFile(t, "statement.ofx", tt.content))
+			if _, err := p.Parse(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestOFXParser_InvalidTransaction(t *testing.T) {
+	content := `<OFX><CURDEF>USD<STMTTRN><FITID>1<NAME>No amount<DTPOSTED>2024</STMTTRN></OFX>`
+	p := NewOFXParser(writeOFXFile(t, "statement.ofx", content))
+
+	rows, err := p.Parse()
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rows) != 1 || rows[0].IsValid {
+		t.Fatalf("expected one invalid row, got %+v", rows)
+	}
+	fields := map[string]bool{}
+	for _, ve := range rows[0].ValidationErrors {
+		fields[ve.Field] = true
+	}
+	if !fields["date"] || !fields["amount"] {
+		t.Errorf("expected date and amount errors, got %v", rows[0].ValidationErrors)
+	}
+}
@@ -16,8 +16,15 @@ import (
 type PDFParser struct {
 	filePath      string
 	columnMapping *ColumnMapping
+	balances      *StatementBalances // Opening/closing balance lines of the statement, if any
 }
 
+// Labels of the opening and closing balance lines in statement headers and footers
+var (
+	pdfOpeningBalanceLabels = []string{"số dư đầu kỳ", "so du dau ky", "opening balance", "beginning balance"}
+	pdfClosingBalanceLabels = []string{"số dư cuối kỳ", "so du cuoi ky", "closing balance", "ending balance"}
+)
+
 // NewPDFParser creates a new PDF parser instance
 func NewPDFParser(filePath string, mapping *ColumnMapping) *PDFParser {
 	return &PDFParser{
@@ -252,6 +259,11 @@ func (p *PDFParser) Parse() ([]*ParsedRow, error) {
 		startRow = headerIndex + 1
 	}
 
+	// Balance lines sit above and below the transaction rows
+	for _, row := range tableRows {
+		p.recordStatementBalance(row.Cells)
+	}
+
 	// If no explicit column mapping, try to auto-detect columns from header
 	mapping := p.columnMapping
 	if mapping == nil && headerIndex >= 0 {
@@ -379,6 +391,11 @@ func (p *PDFParser) parseRow(rowNumber int, cells []string, mapping *ColumnMappi
 		parsed.ReferenceNum = strings.TrimSpace(cells[mapping.ReferenceColumn])
 	}
 
+	// Parse tags (if column exists)
+	if mapping.TagsColumn >= 0 && mapping.TagsColumn <= maxCol {
+		parsed.Tags = ParseTagList(cells[mapping.TagsColumn])
+	}
+
 	// Apply business rules validation (if all required fields are parsed successfully)
 	if parsed.IsValid {
 		validationErrors := validator.ValidateTransaction(
@@ -411,6 +428,7 @@ func (p *PDFParser) detectColumnsFromHeader(headerRow TableRow) *ColumnMapping {
 		TypeColumn:        -1,
 		CategoryColumn:    -1,
 		ReferenceColumn:   -1,
+		TagsColumn:        -1,
 		Currency:          extractedCurrency,
 	}
 
@@ -485,6 +503,55 @@ func (p *PDFParser) detectType(typeStr string, amount int64) string {
 	return detector.DetectType("", amount)
 }
 
+// GetStatementBalances returns the opening and closing balances printed on the
+// statement, or nil when it has neither
+func (p *PDFParser) GetStatementBalances() *StatementBalances {
+	return p.balances
+}
+
+// recordStatementBalance records the amount of an opening or closing balance line. The
+// first opening and the last closing line win, so multi-page statements that repeat
+// the balances per page report the statement period.
+func (p *PDFParser) recordStatementBalance(cells []string) {
+	rowText := strings.ToLower(strings.Join(cells, " "))
+	opening := containsAnyKeyword(rowText, pdfOpeningBalanceLabels)
+	closing := containsAnyKeyword(rowText, pdfClosingBalanceLabels)
+	if opening == closing {
+		return
+	}
+	if opening && p.balances != nil && p.balances.Opening != nil {
+		return
+	}
+
+	// The balance is the last amount on the line, either in its own cell or after the label
+	var amount int64
+	found := false
+	for i := len(cells) - 1; i >= 0 && !found; i-- {
+		cell := strings.TrimSpace(cells[i])
+		if idx := strings.LastIndex(cell, ":"); idx >= 0 {
+			cell = strings.TrimSpace(cell[idx+1:])
+		}
+		if cell == "" || !strings.ContainsAny(cell, "0123456789") {
+			continue
+		}
+		if parsed, err := p.parseAmount(cell); err == nil {
+			amount, found = parsed, true
+		}
+	}
+	if !found {
+		return
+	}
+
+	if p.balances == nil {
+		p.balances = &StatementBalances{}
+	}
+	if opening {
+		p.balances.Opening = &amount
+	} else {
+		p.balances.Closing = &amount
+	}
+}
+
 // isEmptyRow checks if a row is empty
 func (p *PDFParser) isEmptyRow(cells []string) bool {
 	for _, cell := range cells {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTableDetector_GroupElementsByRow(t *testing.T) {
@@ -77,6 +78,26 @@ func TestPDFParser_IsSummaryRow(t *testing.T) {
 	assert.False(t, parser.isSummaryRow([]string{"Coffee", "50,000"}))
 }
 
+func TestPDFParser_RecordStatementBalance(t *testing.T) {
+	parser := &PDFParser{}
+
+	parser.recordStatementBalance([]string{"Số dư đầu kỳ: 10,000,000"})
+	parser.recordStatementBalance([]string{"Coffee", "50,000"})
+	parser.recordStatementBalance([]string{"Số dư cuối kỳ", "", "12,500,000"})
+	parser.recordStatementBalance([]string{"Opening balance", "99,000"}) // Repeated on the next page
+
+	balances := parser.GetStatementBalances()
+	require.NotNil(t, balances)
+	require.NotNil(t, balances.Opening)
+	require.NotNil(t, balances.Closing)
+	assert.Equal(t, int64(100000000000), *balances.Opening, "the first opening balance is kept")
+	assert.Equal(t, int64(125000000000), *balances.Closing)
+
+	parser = &PDFParser{}
+	parser.recordStatementBalance([]string{"Closing balance", "n/a"})
+	assert.Nil(t, parser.GetStatementBalances(), "a balance line without an amount is ignored")
+}
+
 func TestPDFParser_DetectColumnsFromHeader(t *testing.T) {
 	parser := &PDFParser{}
 	headerRow := TableRow{
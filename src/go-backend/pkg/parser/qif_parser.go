@@ -0,0 +1,373 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"wealthjourney/pkg/validator"
+)
+
+// QIF account types read by the parser. Investment, account and list sections are skipped.
+const (
+	QIFTypeBank  = "Bank"
+	QIFTypeCCard = "CCard"
+)
+
+// QIFParser handles parsing of Quicken Interchange Format files, as exported by
+// Quicken, GnuCash or MoneyWiz
+type QIFParser struct {
+	filePath string
+	mapping  *ColumnMapping // Optional: DateFormat resolves DD/MM vs MM/DD, Currency sets the currency
+}
+
+// qifRecord holds the lines of one QIF transaction, keyed by field code
+type qifRecord struct {
+	fields map[byte]string
+	splits []qifSplit
+}
+
+// qifSplit holds the S (category), E (memo) and $ (amount) lines of a split
+type qifSplit struct {
+	category string
+	memo     string
+	amount   string
+}
+
+// NewQIFParser creates a new QIF parser instance
+func NewQIFParser(filePath string, mapping *ColumnMapping) *QIFParser {
+	return &QIFParser{
+		filePath: filePath,
+		mapping:  mapping,
+	}
+}
+
+// Parse reads the bank and credit card transactions of the file
+func (p *QIFParser) Parse() ([]*ParsedRow, error) {
+	// Read the file (supports both URLs and local paths)
+	data, err := FetchFileContent(context.Background(), p.filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return p.parseContent(string(data))
+}
+
+// GetDetectedMapping returns the mapping used for the file. QIF has no currency, so
+// it is the one given by the caller, or VND.
+func (p *QIFParser) GetDetectedMapping() *ColumnMapping {
+	return p.mapping
+}
+
+func (p *QIFParser) parseContent(content string) ([]*ParsedRow, error) {
+	records, err := readQIFRecords(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no bank or credit card transactions found in QIF file")
+	}
+
+	// Quicken writes MM/DD dates; a DD/MM preference has to come from the caller
+	dateFormat := "MM/DD/YYYY"
+	currency := "VND"
+	if p.mapping != nil {
+		if p.mapping.DateFormat != "" {
+			dateFormat = p.mapping.DateFormat
+		}
+		if p.mapping.Currency != "" {
+			currency = p.mapping.Currency
+		}
+	}
+	p.mapping = &ColumnMapping{
+		DateColumn:      -1,
+		AmountColumn:    -1,
+		DebitColumn:     -1,
+		CreditColumn:    -1,
+		TypeColumn:      -1,
+		CategoryColumn:  -1,
+		ReferenceColumn: -1,
+		TagsColumn:      -1,
+		DateFormat:      dateFormat,
+		Currency:        currency,
+	}
+	dayFirst := strings.HasPrefix(strings.ToUpper(dateFormat), "DD")
+
+	rows := make([]*ParsedRow, 0, len(records))
+	for i, record := range records {
+		rows = append(rows, p.parseRecord(i+1, record, dayFirst))
+	}
+	return rows, nil
+}
+
+func (p *QIFParser) parseRecord(rowNumber int, record *qifRecord, dayFirst bool) *ParsedRow {
+	parsed := &ParsedRow{
+		RowNumber:        rowNumber,
+		IsValid:          true,
+		ValidationErrors: []ValidationError{},
+	}
+
+	if dateStr := record.fields['D']; dateStr == "" {
+		parsed.addError("date", "Date is required", "error")
+	} else if date, err := parseQIFDate(dateStr, dayFirst); err != nil {
+		parsed.addError("date", fmt.Sprintf("Invalid date format: %v", err), "error")
+	} else {
+		parsed.Date = date
+	}
+
+	// Newer Quicken versions repeat the amount in U
+	amountStr := record.fields['T']
+	if amountStr == "" {
+		amountStr = record.fields['U']
+	}
+	if amountStr == "" {
+		parsed.addError("amount", "Amount is required", "error")
+	} else if amount, err := parseQIFAmount(amountStr); err != nil {
+		parsed.addError("amount", fmt.Sprintf("Invalid amount format: %v", err), "error")
+	} else {
+		parsed.Amount = amount
+	}
+
+	// QIF payees were entered by the user, so they are kept as written rather than
+	// cleaned like bank descriptions
+	parsed.Description = QIFNote(record.fields['P'], record.fields['M'])
+	parsed.OriginalDescription = parsed.Description
+	if parsed.Description == "" {
+		parsed.Description = "Imported Transaction"
+		parsed.addError("description", "Description is empty, using default", "info")
+	}
+
+	detector := NewTypeDetector()
+	parsed.Type = detector.DetectType(parsed.Description, parsed.Amount)
+
+	// N holds a check number, or a code such as ATM or DEP that is no reference
+	if number := record.fields['N']; strings.ContainsAny(number, "0123456789") {
+		parsed.ReferenceNum = number
+	}
+
+	if category := record.fields['L']; isQIFTransfer(category) {
+		parsed.addError("category", fmt.Sprintf("Transfer with account %s; no category is set", category), "info")
+	} else {
+		parsed.CategoryName = category
+	}
+
+	p.parseSplits(parsed, record.splits)
+
+	// Apply business rules validation (if all required fields are parsed successfully)
+	if parsed.IsValid {
+		validationErrors := validator.ValidateTransaction(
+			parsed.Amount,
+			p.mapping.Currency,
+			parsed.Description,
+			parsed.Date,
+		)
+
+		for _, ve := range validationErrors {
+			parsed.addError(ve.Field, ve.Message, ve.Severity)
+		}
+	}
+
+	return parsed
+}
+
+// parseSplits reads the split lines of a record. Splits that do not add up to the
+// transaction amount are dropped with a warning; a single split is its category.
+func (p *QIFParser) parseSplits(parsed *ParsedRow, lines []qifSplit) {
+	if len(lines) == 0 {
+		return
+	}
+
+	splits := make([]ParsedSplit, 0, len(lines))
+	var total int64
+	for i, line := range lines {
+		amount, err := parseQIFAmount(line.amount)
+		if err != nil {
+			parsed.addError("splits", fmt.Sprintf("Split %d has an invalid amount; splits are ignored", i+1), "warning")
+			return
+		}
+		split := ParsedSplit{Memo: line.memo, Amount: amount}
+		if !isQIFTransfer(line.category) {
+			split.CategoryName = line.category
+		}
+		total += amount
+		splits = append(splits, split)
+	}
+
+	if total != parsed.Amount {
+		parsed.addError("splits", "Split amounts do not add up to the transaction amount; splits are ignored", "warning")
+		return
+	}
+	if len(splits) == 1 {
+		if parsed.CategoryName == "" {
+			parsed.CategoryName = splits[0].CategoryName
+		}
+		return
+	}
+	parsed.Splits = splits
+}
+
+// readQIFRecords reads the transactions of the Bank and CCard sections of a QIF file
+func readQIFRecords(content string) ([]*qifRecord, error) {
+	var records []*qifRecord
+	var current *qifRecord
+	inSection := false
+	sawHeader := false
+
+	for _, line := range strings.Split(strings.TrimPrefix(content, "\ufeff"), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if line[0] == '!' {
+			header := strings.TrimSpace(line[1:])
+			sawHeader = true
+			if len(header) > 5 && strings.EqualFold(header[:5], "Type:") {
+				accountType := strings.TrimSpace(header[5:])
+				inSection = strings.EqualFold(accountType, QIFTypeBank) || strings.EqualFold(accountType, QIFTypeCCard)
+			} else if strings.EqualFold(header, "Account") {
+				// Account list entries follow, up to the next !Type header
+				inSection = false
+			}
+			current = nil
+			continue
+		}
+		if !inSection {
+			continue
+		}
+
+		code, value := line[0], strings.TrimSpace(line[1:])
+		if code == '^' {
+			if current != nil {
+				records = append(records, current)
+				current = nil
+			}
+			continue
+		}
+		if current == nil {
+			current = &qifRecord{fields: make(map[byte]string)}
+		}
+
+		switch code {
+		case 'S':
+			current.splits = append(current.splits, qifSplit{category: value})
+		case 'E', '$':
+			// A split usually starts with S, but uncategorized splits may start with E or $
+			n := len(current.splits)
+			if n == 0 || (code == 'E' && current.splits[n-1].memo != "") || (code == '$' && current.splits[n-1].amount != "") {
+				current.splits = append(current.splits, qifSplit{})
+				n++
+			}
+			if code == 'E' {
+				current.splits[n-1].memo = value
+			} else {
+				current.splits[n-1].amount = value
+			}
+		default:
+			current.fields[code] = value
+		}
+	}
+
+	// The last record may lack its terminating ^
+	if current != nil {
+		records = append(records, current)
+	}
+	if !sawHeader {
+		return nil, fmt.Errorf("not a QIF file: missing !Type header")
+	}
+	return records, nil
+}
+
+// QIFNote joins a payee and memo into one description, the memo on its own line,
+// so SplitQIFNote can separate them again on export
+func QIFNote(payee, memo string) string {
+	payee = strings.TrimSpace(payee)
+	memo = strings.TrimSpace(memo)
+	if memo == "" || memo == payee {
+		return payee
+	}
+	if payee == "" {
+		return memo
+	}
+	return payee + "\n" + memo
+}
+
+// SplitQIFNote separates a transaction note into the QIF payee and memo. Further
+// lines are joined into the memo, as QIF values are single-line.
+func SplitQIFNote(note string) (payee, memo string) {
+	lines := strings.Split(strings.ReplaceAll(note, "\r\n", "\n"), "\n")
+	payee = strings.TrimSpace(lines[0])
+	rest := make([]string, 0, len(lines)-1)
+	for _, line := range lines[1:] {
+		if line = strings.TrimSpace(line); line != "" {
+			rest = append(rest, line)
+		}
+	}
+	return payee, strings.Join(rest, " ")
+}
+
+// isQIFTransfer reports whether a category names a transfer account, e.g. [Savings]
+func isQIFTransfer(category string) bool {
+	return strings.HasPrefix(category, "[") && strings.HasSuffix(category, "]")
+}
+
+// parseQIFDate parses a QIF date. Quicken writes M/D/YY for the 1900s and M/D'YY for
+// the 2000s, padding with spaces; other exporters write four-digit years.
+func parseQIFDate(value string, dayFirst bool) (time.Time, error) {
+	normalized := strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+	apostrophe := strings.Contains(normalized, "'")
+	normalized = strings.ReplaceAll(normalized, "'", "/")
+
+	parts := strings.FieldsFunc(normalized, func(r rune) bool {
+		return r == '/' || r == '-' || r == '.'
+	})
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("invalid QIF date: %q", value)
+	}
+	var nums [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid QIF date: %q", value)
+		}
+		nums[i] = n
+	}
+
+	var year, month, day int
+	if len(parts[0]) == 4 {
+		year, month, day = nums[0], nums[1], nums[2]
+	} else {
+		month, day, year = nums[0], nums[1], nums[2]
+		if dayFirst {
+			month, day = day, month
+		}
+		if len(parts[2]) <= 2 {
+			switch {
+			case apostrophe:
+				year += 2000
+			case year <= time.Now().Year()%100:
+				year += 2000
+			default:
+				year += 1900
+			}
+		}
+	}
+
+	dateParser := NewDateParser("")
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, dateParser.timezone)
+	if date.Year() != year || date.Month() != time.Month(month) || date.Day() != day {
+		return time.Time{}, fmt.Errorf("invalid QIF date: %q", value)
+	}
+	if err := dateParser.validateDate(date); err != nil {
+		return time.Time{}, err
+	}
+	return date, nil
+}
+
+// parseQIFAmount parses a QIF amount, written with a dot decimal separator and
+// optional comma thousands separators
+func parseQIFAmount(value string) (int64, error) {
+	return NewAmountParser(nil).Parse(value)
+}
@@ -0,0 +1,283 @@
+package parser
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const quickenQIF = `!Option:AutoSwitch
+!Account
+NChecking
+TBank
+^
+!Clear:AutoSwitch
+!Type:Bank
+D1/ 5'24
+T-1,234.56
+N1042
+PLandlord
+MJanuary rent
+LHousing:Rent
+^
+D01/08/2024
+U-85.40
+T-85.40
+NATM
+PCo.op Mart
+LGroceries
+SGroceries
+EFood
+$-60.40
+SHousehold
+$-25.00
+^
+D01/10/2024
+T500.00
+PTransfer from savings
+L[Savings]
+^
+!Type:Cat
+NGroceries
+E
+^
+`
+
+func TestQIFParser_Bank(t *testing.T) {
+	p := NewQIFParser("", nil)
+
+	rows, err := p.parseContent(quickenQIF)
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows (account and category lists skipped), got %d", len(rows))
+	}
+
+	rent := rows[0]
+	if rent.Amount != -12345600 {
+		t.Errorf("expected amount -12345600, got %d", rent.Amount)
+	}
+	if rent.Date.Year() != 2024 || rent.Date.Month() != time.January || rent.Date.Day() != 5 {
+		t.Errorf("expected 2024-01-05 from the apostrophe year, got %v", rent.Date)
+	}
+	if rent.Description != "Landlord\nJanuary rent" {
+		t.Errorf("unexpected description %q", rent.Description)
+	}
+	if rent.CategoryName != "Housing:Rent" {
+		t.Errorf("expected category Housing:Rent, got %q", rent.CategoryName)
+	}
+	if rent.ReferenceNum != "1042" {
+		t.Errorf("expected check number as reference, got %q", rent.ReferenceNum)
+	}
+	if !rent.IsValid {
+		t.Errorf("expected valid row, got errors %v", rent.ValidationErrors)
+	}
+
+	groceries := rows[1]
+	if groceries.ReferenceNum != "" {
+		t.Errorf("ATM is not a reference, got %q", groceries.ReferenceNum)
+	}
+	wantSplits := []ParsedSplit{
+		{CategoryName: "Groceries", Memo: "Food", Amount: -604000},
+		{CategoryName: "Household", Amount: -250000},
+	}
+	if !reflect.DeepEqual(groceries.Splits, wantSplits) {
+		t.Errorf("expected splits %+v, got %+v", wantSplits, groceries.Splits)
+	}
+
+	transfer := rows[2]
+	if transfer.CategoryName != "" {
+		t.Errorf("a transfer account is not a category, got %q", transfer.CategoryName)
+	}
+	if transfer.Type != "income" {
+		t.Errorf("expected income, got %s", transfer.Type)
+	}
+	if currency := p.GetDetectedMapping().Currency; currency != "VND" {
+		t.Errorf("expected VND default currency, got %s", currency)
+	}
+}
+
+func TestQIFParser_CCardDayFirst(t *testing.T) {
+	content := "!Type:CCard\nD15/02/2024\nT-42.50\nPBookshop\nSBooks\n$-42.50\n"
+	p := NewQIFParser("", &ColumnMapping{DateFormat: "DD/MM/YYYY", Currency: "EUR"})
+
+	rows, err := p.parseContent(content)
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row without a closing ^, got %d", len(rows))
+	}
+	row := rows[0]
+	if row.Date.Month() != time.February || row.Date.Day() != 15 {
+		t.Errorf("expected 15 Feb, got %v", row.Date)
+	}
+	if row.CategoryName != "Books" || row.Splits != nil {
+		t.Errorf("expected a single split to become the category, got %q %+v", row.CategoryName, row.Splits)
+	}
+	if currency := p.GetDetectedMapping().Currency; currency != "EUR" {
+		t.Errorf("expected EUR, got %s", currency)
+	}
+}
+
+func TestQIFParser_InvalidSplits(t *testing.T) {
+	content := "!Type:Bank\nD01/08/2024\nT-100.00\nPShop\nSFood\n$-60.00\nSHome\n$-30.00\n^\n"
+
+	rows, err := NewQIFParser("", nil).parseContent(content)
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+	if rows[0].Splits != nil {
+		t.Errorf("expected splits not adding up to be dropped, got %+v", rows[0].Splits)
+	}
+	hasWarning := false
+	for _, ve := range rows[0].ValidationErrors {
+		if ve.Field == "splits" && ve.Severity == "warning" {
+			hasWarning = true
+		}
+	}
+	if !hasWarning {
+		t.Errorf("expected a splits warning, got %v", rows[0].ValidationErrors)
+	}
+}
+
+func TestQIFParser_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"no header", "D01/08/2024\nT-100.00\n^\n"},
+		{"investment only", "!Type:Invst\nD01/08/2024\nNBuy\nYAAPL\n^\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewQIFParser("", nil).parseContent(tt.content); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestParseQIFDate(t *testing.T) {
+	tests := []struct {
+		value    string
+		dayFirst bool
+		want     string
+		wantErr  bool
+	}{
+		{"1/ 5'24", false, "2024-01-05", false},
+		{"12/31/99", false, "1999-12-31", false},
+		{"03/04/2023", false, "2023-03-04", false},
+		{"03/04/2023", true, "2023-04-03", false},
+		{"2023-04-03", true, "2023-04-03", false},
+		{"02/30/2024", false, "", true},
+		{"tomorrow", false, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseQIFDate(tt.value, tt.dayFirst)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseQIFDate(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Format("2006-01-02") != tt.want {
+				t.Errorf("parseQIFDate(%q) = %s, want %s", tt.value, got.Format("2006-01-02"), tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteQIF_RoundTrip(t *testing.T) {
+	p := NewQIFParser("", nil)
+	rows, err := p.parseContent(quickenQIF)
+	if err != nil {
+		t.Fatalf("parseContent() error = %v", err)
+	}
+
+	transactions := make([]*QIFTransaction, 0, len(rows))
+	for _, row := range rows {
+		payee, memo := SplitQIFNote(row.Description)
+		transactions = append(transactions, &QIFTransaction{
+			Date:     row.Date,
+			Amount:   row.Amount,
+			Payee:    payee,
+			Memo:     memo,
+			Category: row.CategoryName,
+			Number:   row.ReferenceNum,
+			Splits:   row.Splits,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := WriteQIF(&buf, QIFTypeBank, transactions); err != nil {
+		t.Fatalf("WriteQIF() error = %v", err)
+	}
+
+	want := `!Type:Bank
+D01/05/2024
+T-1234.56
+N1042
+PLandlord
+MJanuary rent
+LHousing:Rent
+^
+D01/08/2024
+T-85.40
+PCo.op Mart
+LGroceries
+SGroceries
+EFood
+$-60.40
+SHousehold
+$-25.00
+^
+D01/10/2024
+T500.00
+PTransfer from savings
+^
+`
+	if buf.String() != want {
+		t.Errorf("WriteQIF() =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	reread, err := NewQIFParser("", nil).parseContent(buf.String())
+	if err != nil {
+		t.Fatalf("parseContent() of written file error = %v", err)
+	}
+	for i := range rows {
+		if !rows[i].Date.Equal(reread[i].Date) || rows[i].Amount != reread[i].Amount ||
+			rows[i].Description != reread[i].Description || rows[i].CategoryName != reread[i].CategoryName ||
+			!reflect.DeepEqual(rows[i].Splits, reread[i].Splits) {
+			t.Errorf("row %d changed on round trip: %+v != %+v", i+1, rows[i], reread[i])
+		}
+	}
+}
+
+func TestFormatQIFAmount(t *testing.T) {
+	tests := map[int64]string{
+		-12345600: "-1234.56",
+		5000000:   "500.00",
+		12345:     "1.2345",
+		0:         "0.00",
+	}
+	for amount, want := range tests {
+		if got := formatQIFAmount(amount); got != want {
+			t.Errorf("formatQIFAmount(%d) = %s, want %s", amount, got, want)
+		}
+	}
+}
+
+func TestSplitQIFNote(t *testing.T) {
+	payee, memo := SplitQIFNote(QIFNote("Landlord", "January rent"))
+	if payee != "Landlord" || memo != "January rent" {
+		t.Errorf("got payee %q memo %q", payee, memo)
+	}
+
+	payee, memo = SplitQIFNote("Lunch\nwith the team\nat noon")
+	if payee != "Lunch" || memo != "with the team at noon" {
+		t.Errorf("got payee %q memo %q", payee, memo)
+	}
+}
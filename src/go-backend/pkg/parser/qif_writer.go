@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// QIFTransaction is a transaction written to a QIF file. Amounts use the ParsedRow
+// unit (×10000), so a file written from parsed rows reads back to the same rows.
+type QIFTransaction struct {
+	Date     time.Time
+	Amount   int64
+	Payee    string
+	Memo     string
+	Category string
+	Number   string
+	Splits   []ParsedSplit
+}
+
+// WriteQIF writes transactions as a QIF section of the given account type
+// (QIFTypeBank or QIFTypeCCard). Dates are written as MM/DD/YYYY.
+func WriteQIF(w io.Writer, accountType string, transactions []*QIFTransaction) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("!Type:" + accountType + "\n")
+
+	for _, tx := range transactions {
+		writeQIFLine(bw, 'D', formatQIFDate(tx.Date))
+		writeQIFLine(bw, 'T', formatQIFAmount(tx.Amount))
+		writeQIFLine(bw, 'N', tx.Number)
+		writeQIFLine(bw, 'P', tx.Payee)
+		writeQIFLine(bw, 'M', tx.Memo)
+		writeQIFLine(bw, 'L', tx.Category)
+		for _, split := range tx.Splits {
+			// S starts a split, so it is written even for an uncategorized one
+			bw.WriteString("S" + qifValue(split.CategoryName) + "\n")
+			writeQIFLine(bw, 'E', split.Memo)
+			writeQIFLine(bw, '$', formatQIFAmount(split.Amount))
+		}
+		bw.WriteString("^\n")
+	}
+
+	return bw.Flush()
+}
+
+// writeQIFLine writes a field line, omitting empty values
+func writeQIFLine(bw *bufio.Writer, code byte, value string) {
+	value = qifValue(value)
+	if value == "" {
+		return
+	}
+	bw.WriteByte(code)
+	bw.WriteString(value)
+	bw.WriteByte('\n')
+}
+
+// qifValue flattens a value onto one line, as QIF fields cannot span lines
+func qifValue(value string) string {
+	return strings.TrimSpace(strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(value))
+}
+
+// formatQIFDate formats a date in the timezone the parser reads dates in
+func formatQIFDate(date time.Time) string {
+	return date.In(NewDateParser("").timezone).Format("01/02/2006")
+}
+
+// formatQIFAmount formats a ×10000 amount with at least two decimals and without
+// rounding away any precision
+func formatQIFAmount(amount int64) string {
+	value := decimal.New(amount, -4)
+	if value.Equal(value.Round(2)) {
+		return value.StringFixed(2)
+	}
+	return value.String()
+}
@@ -0,0 +1,14 @@
+package parser
+
+import "time"
+
+// StatementBalances holds the balances a statement reports for its period, so an
+// import can check them against the parsed rows. Amounts use the ParsedRow unit
+// (×10000); a nil amount means the statement does not report it.
+type StatementBalances struct {
+	Opening     *int64
+	OpeningDate time.Time
+	Closing     *int64
+	ClosingDate time.Time
+	Currency    string
+}
@@ -0,0 +1,141 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"wealthjourney/pkg/validator"
+)
+
+// StatementEntry is a booked entry of a structured bank statement (MT940 or CAMT.053)
+type StatementEntry struct {
+	BookingDate  time.Time
+	ValueDate    time.Time
+	Amount       int64 // Signed by the debit/credit mark (×10000)
+	Currency     string
+	Counterparty string // Payee of a debit, payer of a credit
+	Remittance   string // Remittance information (payment details)
+	EndToEndRef  string // End-to-end reference set by the initiating party
+	BankRef      string // Reference of the account servicing bank
+}
+
+// notProvidedRefs are placeholders banks put in reference fields
+var notProvidedRefs = map[string]bool{
+	"NONREF":       true,
+	"NOTPROVIDED":  true,
+	"NOT PROVIDED": true,
+}
+
+// statementEntryRows converts the entries of a structured statement to parsed rows
+func statementEntryRows(entries []*StatementEntry, currency string) []*ParsedRow {
+	rows := make([]*ParsedRow, 0, len(entries))
+	for i, entry := range entries {
+		rows = append(rows, statementEntryRow(i+1, entry, currency))
+	}
+	return rows
+}
+
+func statementEntryRow(rowNumber int, entry *StatementEntry, currency string) *ParsedRow {
+	parsed := &ParsedRow{
+		RowNumber:        rowNumber,
+		Date:             entry.BookingDate,
+		ValueDate:        entry.ValueDate,
+		Amount:           entry.Amount,
+		Counterparty:     entry.Counterparty,
+		IsValid:          true,
+		ValidationErrors: []ValidationError{},
+	}
+
+	// Banks book on the value date when no separate booking date is given
+	if parsed.Date.IsZero() {
+		parsed.Date = entry.ValueDate
+	}
+	if parsed.Date.IsZero() {
+		parsed.addError("date", "Date is required", "error")
+	}
+
+	description := entry.Counterparty
+	if entry.Remittance != "" && entry.Remittance != description {
+		if description != "" {
+			description += " - "
+		}
+		description += entry.Remittance
+	}
+	parsed.OriginalDescription = description
+	if description == "" {
+		parsed.Description = "Imported Transaction"
+		parsed.addError("description", "Description is empty, using default", "info")
+	} else {
+		parsed.Description = NewDescriptionCleaner().Clean(description)
+	}
+
+	detector := NewTypeDetector()
+	parsed.Type = detector.DetectType(parsed.Description, parsed.Amount)
+
+	// The end-to-end reference is stable across banks, so duplicates match on it first
+	parsed.ReferenceNum = statementRef(entry.EndToEndRef)
+	if parsed.ReferenceNum == "" {
+		parsed.ReferenceNum = statementRef(entry.BankRef)
+	}
+
+	if entry.Currency != "" && currency != "" && entry.Currency != currency {
+		parsed.addError("currency", fmt.Sprintf("Entry is in %s, the statement in %s", entry.Currency, currency), "warning")
+	}
+
+	// Apply business rules validation (if all required fields are parsed successfully)
+	if parsed.IsValid {
+		validationErrors := validator.ValidateTransaction(
+			parsed.Amount,
+			currency,
+			parsed.Description,
+			parsed.Date,
+		)
+
+		for _, ve := range validationErrors {
+			parsed.addError(ve.Field, ve.Message, ve.Severity)
+		}
+	}
+
+	return parsed
+}
+
+// invalidStatementRow returns the row of a statement line that could not be read, with
+// the line kept as its description
+func invalidStatementRow(rowNumber int, line string, err error) *ParsedRow {
+	parsed := &ParsedRow{
+		RowNumber:           rowNumber,
+		OriginalDescription: strings.TrimSpace(line),
+		Description:         strings.TrimSpace(line),
+		IsValid:             true,
+		ValidationErrors:    []ValidationError{},
+	}
+	parsed.addError("line", fmt.Sprintf("Invalid statement line: %v", err), "error")
+	return parsed
+}
+
+// statementRef returns the reference, or "" for a placeholder
+func statementRef(ref string) string {
+	ref = strings.TrimSpace(ref)
+	if notProvidedRefs[strings.ToUpper(ref)] {
+		return ""
+	}
+	return ref
+}
+
+// statementMapping returns the mapping of a structured statement, which only carries
+// its currency
+func statementMapping(dateFormat, currency string) *ColumnMapping {
+	return &ColumnMapping{
+		DateColumn:      -1,
+		AmountColumn:    -1,
+		DebitColumn:     -1,
+		CreditColumn:    -1,
+		TypeColumn:      -1,
+		CategoryColumn:  -1,
+		ReferenceColumn: -1,
+		TagsColumn:      -1,
+		DateFormat:      dateFormat,
+		Currency:        currency,
+	}
+}
@@ -0,0 +1,25 @@
+package parser
+
+import "strings"
+
+// ParseTagList splits a tags cell such as "business, trip-2026-japan" into tag names.
+// Names may be separated by commas, semicolons or pipes; blanks and case-insensitive
+// duplicates are dropped and inner whitespace is collapsed.
+func ParseTagList(cell string) []string {
+	fields := strings.FieldsFunc(cell, func(r rune) bool {
+		return r == ',' || r == ';' || r == '|'
+	})
+
+	var tags []string
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		name := strings.Join(strings.Fields(field), " ")
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		tags = append(tags, name)
+	}
+	return tags
+}
@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTagList(t *testing.T) {
+	tests := []struct {
+		name     string
+		cell     string
+		expected []string
+	}{
+		{name: "empty", cell: "  ", expected: nil},
+		{name: "single", cell: "business", expected: []string{"business"}},
+		{name: "mixed separators", cell: "business; trip-2026-japan | reimbursable", expected: []string{"business", "trip-2026-japan", "reimbursable"}},
+		{name: "blanks and inner whitespace", cell: ",  team   lunch ,,", expected: []string{"team lunch"}},
+		{name: "duplicates ignoring case", cell: "Business,business,BUSINESS", expected: []string{"Business"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseTagList(tt.cell))
+		})
+	}
+}
+
+func TestCSVParser_TagsColumn(t *testing.T) {
+	mapping := &ColumnMapping{
+		DateColumn:        0,
+		AmountColumn:      1,
+		DescriptionColumn: 2,
+		TypeColumn:        -1,
+		CategoryColumn:    -1,
+		ReferenceColumn:   -1,
+		TagsColumn:        3,
+		Currency:          "VND",
+	}
+	parser := NewCSVParser("", mapping)
+
+	row := parser.parseRow(2, []string{"15/01/2024", "-150000", "Sushi dinner", "trip-2026-japan, reimbursable"})
+	assert.Equal(t, []string{"trip-2026-japan", "reimbursable"}, row.Tags)
+
+	row = parser.parseRow(3, []string{"15/01/2024", "-150000", "Coffee"})
+	assert.Nil(t, row.Tags, "a short row has no tags")
+}
@@ -0,0 +1,72 @@
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"wealthjourney/pkg/yahoo"
+)
+
+// fileQuote is one entry of a price fixture file
+type fileQuote struct {
+	Currency      string  `json:"currency"`
+	Price         float64 `json:"price"` // Major currency unit (e.g. 189.5 USD)
+	ChangePercent float64 `json:"changePercent"`
+}
+
+// FileProvider serves fixed prices read from a JSON file, so the price pipeline can
+// run offline in tests and local development. The file maps symbols to quotes:
+//
+//	{"AAPL": {"currency": "USD", "price": 189.5, "changePercent": 0.4}}
+type FileProvider struct {
+	quotes map[string]fileQuote
+}
+
+// NewFileProvider loads a price fixture file
+func NewFileProvider(path string) (*FileProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price fixture: %w", err)
+	}
+
+	var quotes map[string]fileQuote
+	if err := json.Unmarshal(data, &quotes); err != nil {
+		return nil, fmt.Errorf("failed to parse price fixture %s: %w", path, err)
+	}
+
+	provider := &FileProvider{quotes: make(map[string]fileQuote, len(quotes))}
+	for symbol, quote := range quotes {
+		provider.quotes[strings.ToUpper(symbol)] = quote
+	}
+	return provider, nil
+}
+
+// Name identifies the provider
+func (p *FileProvider) Name() string {
+	return "file"
+}
+
+// FetchQuote returns the fixture price of a symbol
+func (p *FileProvider) FetchQuote(ctx context.Context, symbol, currency string) (*Quote, error) {
+	quote, ok := p.quotes[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not in the price fixture", ErrSymbolNotFound, symbol)
+	}
+
+	quoteCurrency := quote.Currency
+	if quoteCurrency == "" {
+		quoteCurrency = currency
+	}
+
+	return &Quote{
+		Symbol:        symbol,
+		Currency:      quoteCurrency,
+		Price:         yahoo.ToSmallestCurrencyUnitByCurrency(quote.Price, quoteCurrency),
+		ChangePercent: quote.ChangePercent,
+		Timestamp:     time.Now(),
+	}, nil
+}
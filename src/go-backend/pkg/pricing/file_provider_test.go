@@ -0,0 +1,48 @@
+package pricing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFixture(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestFileProvider_FetchQuote(t *testing.T) {
+	provider, err := NewFileProvider(writeFixture(t, `{
+		"aapl": {"currency": "USD", "price": 189.5, "changePercent": 0.4},
+		"VNM.VN": {"price": 69800}
+	}`))
+	require.NoError(t, err)
+
+	quote, err := provider.FetchQuote(context.Background(), "AAPL", "USD")
+	require.NoError(t, err)
+	assert.Equal(t, int64(18950), quote.Price)
+	assert.Equal(t, "USD", quote.Currency)
+	assert.Equal(t, 0.4, quote.ChangePercent)
+
+	quote, err = provider.FetchQuote(context.Background(), "VNM.VN", "VND")
+	require.NoError(t, err)
+	assert.Equal(t, int64(69800), quote.Price)
+	assert.Equal(t, "VND", quote.Currency, "the requested currency when the fixture has none")
+
+	_, err = provider.FetchQuote(context.Background(), "MSFT", "USD")
+	assert.ErrorIs(t, err, ErrSymbolNotFound)
+}
+
+func TestNewFileProvider_Invalid(t *testing.T) {
+	_, err := NewFileProvider(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+
+	_, err = NewFileProvider(writeFixture(t, `["AAPL"]`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Errors returned by price providers. Providers wrap their own errors with these so the
+// registry can tell a missing symbol from an outage.
+var (
+	ErrSymbolNotFound = errors.New("symbol not found")
+	ErrRateLimited    = errors.New("rate limited by price provider")
+
+	// ErrProviderUnavailable is recorded for providers skipped while cooling down
+	ErrProviderUnavailable = errors.New("price provider cooling down")
+)
+
+// Quote is the latest price of a symbol as published by a provider
+type Quote struct {
+	Symbol        string
+	Currency      string  // Empty when the provider does not report one
+	Price         int64   // Smallest currency unit, per the market unit of the provider (e.g. tael for VND gold)
+	ChangePercent float64 // 24h change, 0 when not published
+	Timestamp     time.Time
+	Provider      string // Name of the provider that answered
+}
+
+// PriceProvider defines the interface for fetching market prices from one source
+type PriceProvider interface {
+	// Name identifies the provider in routes and health reports
+	Name() string
+
+	// FetchQuote retrieves the latest price of a symbol
+	// Returns an error wrapping ErrSymbolNotFound when the provider does not know the symbol
+	FetchQuote(ctx context.Context, symbol, currency string) (*Quote, error)
+}
+
+// ChainError is returned when no provider of a route could price a symbol
+// It wraps the error of every provider tried, so errors.Is works on any of them
+type ChainError struct {
+	Symbol string
+	Errors []error
+}
+
+func (e *ChainError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("no price provider for %s", e.Symbol)
+	}
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("all price providers failed for %s: %s", e.Symbol, strings.Join(msgs, "; "))
+}
+
+func (e *ChainError) Unwrap() []error {
+	return e.Errors
+}
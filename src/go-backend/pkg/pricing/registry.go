@@ -0,0 +1,237 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"wealthjourney/pkg/yahoo"
+	investmentv1 "wealthjourney/protobuf/v1"
+)
+
+// Health tracking defaults
+const (
+	DefaultFailureThreshold = 3               // Consecutive failures before a provider is skipped
+	DefaultCooldown         = 5 * time.Minute // How long a failing provider is skipped
+)
+
+// ProviderHealth reports the recent outcome of the calls made to a provider
+type ProviderHealth struct {
+	Name                string
+	Healthy             bool
+	ConsecutiveFailures int
+	LastError           string
+	LastSuccess         time.Time
+	LastFailure         time.Time
+	CooldownUntil       time.Time // Provider is skipped until then
+}
+
+// routeKey selects a provider chain; an unspecified type or empty exchange matches any
+type routeKey struct {
+	investmentType investmentv1.InvestmentType
+	exchange       string
+}
+
+type registeredProvider struct {
+	provider  PriceProvider
+	throttler *yahoo.Throttler // Optional
+	health    ProviderHealth
+}
+
+// Registry routes price lookups to an ordered chain of providers, falling back to the
+// next one when a provider fails. Providers failing repeatedly, or rate limiting us,
+// are skipped for a cooldown period.
+type Registry struct {
+	mu               sync.Mutex
+	providers        map[string]*registeredProvider
+	order            []string // Registration order, for health reports
+	routes           map[routeKey][]string
+	defaultRoute     []string
+	failureThreshold int
+	cooldown         time.Duration
+	now              func() time.Time
+}
+
+// NewRegistry creates an empty registry. A provider is skipped for cooldown after
+// failureThreshold consecutive failures, or right away when it rate limits us.
+func NewRegistry(failureThreshold int, cooldown time.Duration) *Registry {
+	if failureThreshold < 1 {
+		failureThreshold = DefaultFailureThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCooldown
+	}
+
+	return &Registry{
+		providers:        make(map[string]*registeredProvider),
+		routes:           make(map[routeKey][]string),
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		now:              time.Now,
+	}
+}
+
+// Register adds a provider, replacing any provider of the same name. Calls to it wait
+// on throttler first when one is given.
+func (r *Registry) Register(provider PriceProvider, throttler *yahoo.Throttler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := provider.Name()
+	if _, exists := r.providers[name]; !exists {
+		r.order = append(r.order, name)
+	}
+	r.providers[name] = &registeredProvider{
+		provider:  provider,
+		throttler: throttler,
+		health:    ProviderHealth{Name: name},
+	}
+}
+
+// Route sets the providers tried, in order, for an investment type listed on an
+// exchange. An empty exchange matches any exchange and an unspecified type any type.
+func (r *Registry) Route(investmentType investmentv1.InvestmentType, exchange string, providerNames ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.routes[routeKey{investmentType: investmentType, exchange: strings.ToUpper(exchange)}] = providerNames
+}
+
+// SetDefaultRoute sets the providers tried when no route matches.
+func (r *Registry) SetDefaultRoute(providerNames ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.defaultRoute = providerNames
+}
+
+// Chain returns the providers tried for a symbol, most specific route first:
+// type and exchange, then type, then exchange, then the default route.
+func (r *Registry) Chain(investmentType investmentv1.InvestmentType, symbol string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	exchange := ExchangeForSymbol(symbol)
+	keys := []routeKey{
+		{investmentType: investmentType, exchange: exchange},
+		{investmentType: investmentType},
+		{investmentType: investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED, exchange: exchange},
+	}
+	for _, key := range keys {
+		if names, ok := r.routes[key]; ok {
+			return names
+		}
+	}
+	return r.defaultRoute
+}
+
+// GetQuote fetches the price of a symbol from the first provider of its chain that
+// answers. Providers cooling down are skipped; when every provider fails a
+// *ChainError wrapping each failure is returned.
+func (r *Registry) GetQuote(ctx context.Context, investmentType investmentv1.InvestmentType, symbol, currency string) (*Quote, error) {
+	chainErr := &ChainError{Symbol: symbol}
+
+	for _, name := range r.Chain(investmentType, symbol) {
+		p, until := r.available(name)
+		if p == nil {
+			if until.IsZero() {
+				chainErr.Errors = append(chainErr.Errors, fmt.Errorf("%s: provider not registered", name))
+			} else {
+				chainErr.Errors = append(chainErr.Errors, fmt.Errorf("%s: %w until %s", name, ErrProviderUnavailable, until.Format(time.RFC3339)))
+			}
+			continue
+		}
+
+		if p.throttler != nil {
+			if err := p.throttler.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("rate limit wait failed: %w", err)
+			}
+		}
+
+		quote, err := p.provider.FetchQuote(ctx, symbol, currency)
+		if err == nil {
+			r.recordSuccess(p)
+			quote.Provider = name
+			return quote, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		r.recordFailure(p, err)
+		chainErr.Errors = append(chainErr.Errors, fmt.Errorf("%s: %w", name, err))
+	}
+
+	return nil, chainErr
+}
+
+// Health returns the health of every provider, in registration order.
+func (r *Registry) Health() []ProviderHealth {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	result := make([]ProviderHealth, 0, len(r.order))
+	for _, name := range r.order {
+		health := r.providers[name].health
+		health.Healthy = health.ConsecutiveFailures < r.failureThreshold && !now.Before(health.CooldownUntil)
+		result = append(result, health)
+	}
+	return result
+}
+
+// available returns the named provider unless it is cooling down, in which case the
+// end of the cooldown is returned instead.
+func (r *Registry) available(name string) (*registeredProvider, time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, time.Time{}
+	}
+	if r.now().Before(p.health.CooldownUntil) {
+		return nil, p.health.CooldownUntil
+	}
+	return p, time.Time{}
+}
+
+func (r *Registry) recordSuccess(p *registeredProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p.health.ConsecutiveFailures = 0
+	p.health.CooldownUntil = time.Time{}
+	p.health.LastSuccess = r.now()
+}
+
+// recordFailure counts a failed call. An unknown symbol says nothing about the health
+// of the provider and is not counted.
+func (r *Registry) recordFailure(p *registeredProvider, err error) {
+	if errors.Is(err, ErrSymbolNotFound) {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	p.health.ConsecutiveFailures++
+	p.health.LastError = err.Error()
+	p.health.LastFailure = now
+	if errors.Is(err, ErrRateLimited) || p.health.ConsecutiveFailures >= r.failureThreshold {
+		p.health.CooldownUntil = now.Add(r.cooldown)
+	}
+}
+
+// ExchangeForSymbol returns the exchange suffix of a Yahoo style symbol
+// (e.g. "VN" for "VNM.VN"), or "" for symbols without one.
+func ExchangeForSymbol(symbol string) string {
+	i := strings.LastIndex(symbol, ".")
+	if i < 0 || i == len(symbol)-1 {
+		return ""
+	}
+	return strings.ToUpper(symbol[i+1:])
+}
@@ -0,0 +1,161 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"wealthjourney/pkg/yahoo"
+	investmentv1 "wealthjourney/protobuf/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvider answers with a fixed price or error and counts its calls.
+type fakeProvider struct {
+	name  string
+	price int64
+	err   error
+	calls int
+}
+
+func (p *fakeProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeProvider) FetchQuote(ctx context.Context, symbol, currency string) (*Quote, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &Quote{Symbol: symbol, Currency: currency, Price: p.price}, nil
+}
+
+func newTestRegistry(now *time.Time, providers ...*fakeProvider) *Registry {
+	registry := NewRegistry(2, time.Minute)
+	registry.now = func() time.Time { return *now }
+	names := make([]string, len(providers))
+	for i, p := range providers {
+		registry.Register(p, nil)
+		names[i] = p.name
+	}
+	registry.SetDefaultRoute(names...)
+	return registry
+}
+
+func TestRegistry_Chain(t *testing.T) {
+	registry := NewRegistry(0, 0)
+	registry.SetDefaultRoute("yahoo")
+	registry.Route(investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_VND, "", "vang247")
+	registry.Route(investmentv1.InvestmentType_INVESTMENT_TYPE_UNSPECIFIED, "vn", "ssi", "yahoo")
+	registry.Route(investmentv1.InvestmentType_INVESTMENT_TYPE_ETF, "VN", "file")
+
+	stock := investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK
+	assert.Equal(t, []string{"yahoo"}, registry.Chain(stock, "AAPL"))
+	assert.Equal(t, []string{"ssi", "yahoo"}, registry.Chain(stock, "VNM.VN"), "routed by exchange")
+	assert.Equal(t, []string{"file"}, registry.Chain(investmentv1.InvestmentType_INVESTMENT_TYPE_ETF, "E1VFVN30.VN"), "type and exchange first")
+	assert.Equal(t, []string{"vang247"}, registry.Chain(investmentv1.InvestmentType_INVESTMENT_TYPE_GOLD_VND, "SJL1L10"))
+}
+
+func TestExchangeForSymbol(t *testing.T) {
+	assert.Equal(t, "VN", ExchangeForSymbol("VNM.VN"))
+	assert.Equal(t, "L", ExchangeForSymbol("VOD.l"))
+	assert.Equal(t, "", ExchangeForSymbol("BTC-USD"))
+	assert.Equal(t, "", ExchangeForSymbol("ODD."))
+}
+
+func TestRegistry_GetQuote_FallsBack(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	primary := &fakeProvider{name: "primary", err: fmt.Errorf("%w: 429", ErrRateLimited)}
+	secondary := &fakeProvider{name: "secondary", price: 18950}
+	registry := newTestRegistry(&now, primary, secondary)
+
+	quote, err := registry.GetQuote(context.Background(), investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "AAPL", "USD")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(18950), quote.Price)
+	assert.Equal(t, "secondary", quote.Provider)
+
+	// A rate limited provider cools down at once
+	_, err = registry.GetQuote(context.Background(), investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "AAPL", "USD")
+	require.NoError(t, err)
+	assert.Equal(t, 1, primary.calls, "skipped while cooling down")
+
+	health := registry.Health()
+	require.Len(t, health, 2)
+	assert.False(t, health[0].Healthy)
+	assert.Equal(t, now.Add(time.Minute), health[0].CooldownUntil)
+	assert.Contains(t, health[0].LastError, "429")
+	assert.True(t, health[1].Healthy)
+	assert.Equal(t, now, health[1].LastSuccess)
+
+	// Tried again once the cooldown is over
+	now = now.Add(2 * time.Minute)
+	primary.err = nil
+	quote, err = registry.GetQuote(context.Background(), investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "AAPL", "USD")
+	require.NoError(t, err)
+	assert.Equal(t, "primary", quote.Provider)
+	assert.True(t, registry.Health()[0].Healthy)
+}
+
+func TestRegistry_GetQuote_CoolsDownAfterRepeatedFailures(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	flaky := &fakeProvider{name: "flaky", err: errors.New("connection reset")}
+	registry := newTestRegistry(&now, flaky)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := registry.GetQuote(ctx, investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "AAPL", "USD")
+		require.Error(t, err)
+	}
+
+	assert.Equal(t, 2, flaky.calls, "skipped after the failure threshold")
+	_, err := registry.GetQuote(ctx, investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "AAPL", "USD")
+	var chainErr *ChainError
+	require.ErrorAs(t, err, &chainErr)
+	assert.ErrorIs(t, err, ErrProviderUnavailable)
+	assert.Equal(t, 2, registry.Health()[0].ConsecutiveFailures)
+}
+
+func TestRegistry_GetQuote_UnknownSymbol(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	first := &fakeProvider{name: "first", err: fmt.Errorf("%w: NOPE", ErrSymbolNotFound)}
+	second := &fakeProvider{name: "second", err: fmt.Errorf("%w: NOPE", ErrSymbolNotFound)}
+	registry := newTestRegistry(&now, first, second)
+
+	for i := 0; i < 3; i++ {
+		_, err := registry.GetQuote(context.Background(), investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "NOPE", "USD")
+		assert.ErrorIs(t, err, ErrSymbolNotFound)
+	}
+
+	assert.Equal(t, 3, first.calls, "an unknown symbol is not a provider failure")
+	assert.Equal(t, 3, second.calls)
+	assert.True(t, registry.Health()[0].Healthy)
+}
+
+func TestRegistry_GetQuote_Throttled(t *testing.T) {
+	registry := NewRegistry(0, 0)
+	registry.Register(&fakeProvider{name: "slow", price: 100}, yahoo.NewThrottler(60))
+	registry.SetDefaultRoute("slow")
+
+	_, err := registry.GetQuote(context.Background(), investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "AAPL", "USD")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = registry.GetQuote(ctx, investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "AAPL", "USD")
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the second call waits a second for the throttler")
+}
+
+func TestRegistry_GetQuote_NoRoute(t *testing.T) {
+	registry := NewRegistry(0, 0)
+	registry.SetDefaultRoute("missing")
+
+	_, err := registry.GetQuote(context.Background(), investmentv1.InvestmentType_INVESTMENT_TYPE_STOCK, "AAPL", "USD")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing: provider not registered")
+}
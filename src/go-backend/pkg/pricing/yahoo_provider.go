@@ -0,0 +1,54 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wealthjourney/pkg/yahoo"
+)
+
+// YahooProvider implements PriceProvider using the Yahoo Finance chart API.
+// Calls already wait on the global Yahoo throttler, so it is registered without one.
+type YahooProvider struct {
+	getQuote func(ctx context.Context, symbol string) (*yahoo.QuoteResult, error)
+}
+
+// NewYahooProvider creates a new Yahoo Finance price provider
+func NewYahooProvider() *YahooProvider {
+	return &YahooProvider{getQuote: yahoo.GetQuote}
+}
+
+// Name identifies the provider
+func (p *YahooProvider) Name() string {
+	return "yahoo"
+}
+
+// FetchQuote retrieves the latest price of a symbol from Yahoo Finance
+func (p *YahooProvider) FetchQuote(ctx context.Context, symbol, currency string) (*Quote, error) {
+	quote, err := p.getQuote(ctx, symbol)
+	if err != nil {
+		switch {
+		case errors.Is(err, yahoo.ErrSymbolNotFound):
+			return nil, fmt.Errorf("%w: %w", ErrSymbolNotFound, err)
+		case errors.Is(err, yahoo.ErrRateLimited):
+			return nil, fmt.Errorf("%w: %w", ErrRateLimited, err)
+		}
+		return nil, fmt.Errorf("failed to fetch quote for %s: %w", symbol, err)
+	}
+
+	// Use quote's currency if available, otherwise fall back to the requested currency
+	quoteCurrency := quote.Currency
+	if quoteCurrency == "" {
+		quoteCurrency = currency
+	}
+
+	return &Quote{
+		Symbol:        quote.Symbol,
+		Currency:      quoteCurrency,
+		Price:         yahoo.ToSmallestCurrencyUnitByCurrency(quote.RegularMarketPrice, quoteCurrency),
+		ChangePercent: quote.RegularMarketChangePercent,
+		Timestamp:     time.Now(),
+	}, nil
+}
@@ -0,0 +1,176 @@
+// Package returns computes money-weighted (XIRR) and time-weighted (TWR) rates of return.
+package returns
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// ErrNoSolution is returned when the cash flows have no internal rate of return,
+// e.g. when they are all of the same sign.
+var ErrNoSolution = errors.New("cash flows have no internal rate of return")
+
+const (
+	daysPerYear   = 365.0
+	xirrTolerance = 1e-9
+	xirrMaxIter   = 100
+)
+
+// CashFlow is money moving between the investor and the portfolio.
+// Negative amounts are money invested, positive amounts are money received.
+type CashFlow struct {
+	Date   time.Time
+	Amount float64
+}
+
+// Valuation is the market value of the portfolio at Date, before any cash flow on that instant.
+type Valuation struct {
+	Date  time.Time
+	Value float64
+}
+
+// XIRR returns the annualized internal rate of return of irregular cash flows
+// (0.1 = 10% per year). The final market value must be included as a positive flow.
+func XIRR(flows []CashFlow) (float64, error) {
+	if len(flows) < 2 {
+		return 0, ErrNoSolution
+	}
+
+	hasNegative, hasPositive := false, false
+	for _, f := range flows {
+		hasNegative = hasNegative || f.Amount < 0
+		hasPositive = hasPositive || f.Amount > 0
+	}
+	if !hasNegative || !hasPositive {
+		return 0, ErrNoSolution
+	}
+
+	first := flows[0].Date
+	for _, f := range flows {
+		if f.Date.Before(first) {
+			first = f.Date
+		}
+	}
+	years := make([]float64, len(flows))
+	for i, f := range flows {
+		years[i] = f.Date.Sub(first).Hours() / 24 / daysPerYear
+	}
+
+	npv := func(rate float64) float64 {
+		var sum float64
+		for i, f := range flows {
+			sum += f.Amount / math.Pow(1+rate, years[i])
+		}
+		return sum
+	}
+	derivative := func(rate float64) float64 {
+		var sum float64
+		for i, f := range flows {
+			sum -= years[i] * f.Amount / math.Pow(1+rate, years[i]+1)
+		}
+		return sum
+	}
+
+	// Newton's method converges quickly for typical portfolios
+	rate := 0.1
+	for i := 0; i < xirrMaxIter; i++ {
+		value, slope := npv(rate), derivative(rate)
+		if math.Abs(value) < xirrTolerance {
+			return rate, nil
+		}
+		if slope == 0 || math.IsNaN(slope) {
+			break
+		}
+		next := rate - value/slope
+		if next <= -1 || math.IsNaN(next) || math.IsInf(next, 0) {
+			break
+		}
+		if math.Abs(next-rate) < xirrTolerance {
+			return next, nil
+		}
+		rate = next
+	}
+
+	// Fall back to bisection, which always converges once the root is bracketed
+	low, high := -0.999999, 1.0
+	for npv(low)*npv(high) > 0 {
+		high *= 10
+		if high > 1e6 {
+			return 0, ErrNoSolution
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		mid := (low + high) / 2
+		value := npv(mid)
+		if math.Abs(value) < xirrTolerance || (high-low)/2 < xirrTolerance {
+			return mid, nil
+		}
+		if npv(low)*value < 0 {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+	return (low + high) / 2, nil
+}
+
+// TimeWeighted returns the cumulative time-weighted return (0.1 = 10%) between the
+// first and last valuation. flows uses the CashFlow sign convention (negative = invested).
+//
+// Each sub-period between two consecutive valuations is measured with the Modified
+// Dietz method, so flows between valuations are weighted by how long they were in
+// the portfolio. A flow dated exactly on a valuation belongs to the sub-period that
+// starts there. Sub-periods with nothing invested are skipped.
+func TimeWeighted(valuations []Valuation, flows []CashFlow) (float64, error) {
+	if len(valuations) < 2 {
+		return 0, ErrNoSolution
+	}
+
+	sorted := make([]Valuation, len(valuations))
+	copy(sorted, valuations)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	growth := 1.0
+	measured := false
+	for i := 0; i+1 < len(sorted); i++ {
+		start, end := sorted[i], sorted[i+1]
+		length := end.Date.Sub(start.Date).Seconds()
+
+		var net, weighted float64
+		for _, f := range flows {
+			if f.Date.Before(start.Date) || !f.Date.Before(end.Date) {
+				continue
+			}
+			contribution := -f.Amount // Money into the portfolio
+			weight := 1.0
+			if length > 0 {
+				weight = end.Date.Sub(f.Date).Seconds() / length
+			}
+			net += contribution
+			weighted += weight * contribution
+		}
+
+		base := start.Value + weighted
+		if base <= 0 {
+			continue
+		}
+		growth *= 1 + (end.Value-start.Value-net)/base
+		measured = true
+	}
+
+	if !measured {
+		return 0, ErrNoSolution
+	}
+	return growth - 1, nil
+}
+
+// Annualize converts a cumulative return over the given number of days into a
+// yearly rate. Periods shorter than a year are not annualized.
+func Annualize(cumulative float64, days float64) float64 {
+	if days < daysPerYear || cumulative <= -1 {
+		return cumulative
+	}
+	return math.Pow(1+cumulative, daysPerYear/days) - 1
+}
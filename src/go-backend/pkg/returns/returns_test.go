@@ -0,0 +1,131 @@
+package returns
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestXIRR(t *testing.T) {
+	tests := []struct {
+		name  string
+		flows []CashFlow
+		want  float64
+	}{
+		{
+			name: "ten percent over exactly one year",
+			flows: []CashFlow{
+				{Date: day(2023, time.January, 1), Amount: -1000},
+				{Date: day(2024, time.January, 1), Amount: 1100},
+			},
+			want: 0.1,
+		},
+		{
+			// Reference value from the spreadsheet XIRR function
+			name: "irregular flows",
+			flows: []CashFlow{
+				{Date: day(2008, time.January, 1), Amount: -10000},
+				{Date: day(2008, time.March, 1), Amount: 2750},
+				{Date: day(2008, time.October, 30), Amount: 4250},
+				{Date: day(2009, time.February, 15), Amount: 3250},
+				{Date: day(2009, time.April, 1), Amount: 2750},
+			},
+			want: 0.373362535,
+		},
+		{
+			name: "loss",
+			flows: []CashFlow{
+				{Date: day(2023, time.January, 1), Amount: -1000},
+				{Date: day(2023, time.July, 1), Amount: -1000},
+				{Date: day(2024, time.January, 1), Amount: 1500},
+			},
+			want: -0.322058,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := XIRR(tt.flows)
+			if err != nil {
+				t.Fatalf("XIRR() error = %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-4 {
+				t.Errorf("XIRR() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXIRR_NoSolution(t *testing.T) {
+	_, err := XIRR([]CashFlow{
+		{Date: day(2023, time.January, 1), Amount: -1000},
+		{Date: day(2024, time.January, 1), Amount: -100},
+	})
+	if err != ErrNoSolution {
+		t.Errorf("expected ErrNoSolution, got %v", err)
+	}
+}
+
+func TestTimeWeighted_IgnoresFlowTiming(t *testing.T) {
+	// The portfolio grows 10% and then 10% again; a large deposit before the
+	// second half must not change the time-weighted return.
+	valuations := []Valuation{
+		{Date: day(2023, time.January, 1), Value: 0},
+		{Date: day(2023, time.July, 1), Value: 1100},
+		{Date: day(2024, time.January, 1), Value: 12100},
+	}
+	flows := []CashFlow{
+		{Date: day(2023, time.January, 1), Amount: -1000},
+		{Date: day(2023, time.July, 1), Amount: -9900},
+	}
+
+	got, err := TimeWeighted(valuations, flows)
+	if err != nil {
+		t.Fatalf("TimeWeighted() error = %v", err)
+	}
+	if math.Abs(got-0.21) > 1e-9 {
+		t.Errorf("TimeWeighted() = %v, want 0.21", got)
+	}
+}
+
+func TestTimeWeighted_WithdrawalsAndModifiedDietz(t *testing.T) {
+	// Half of the holding is sold mid-period at cost: no gain, no loss
+	valuations := []Valuation{
+		{Date: day(2023, time.January, 1), Value: 1000},
+		{Date: day(2023, time.December, 31), Value: 500},
+	}
+	flows := []CashFlow{
+		{Date: day(2023, time.July, 2), Amount: 500},
+	}
+
+	got, err := TimeWeighted(valuations, flows)
+	if err != nil {
+		t.Fatalf("TimeWeighted() error = %v", err)
+	}
+	if math.Abs(got) > 1e-9 {
+		t.Errorf("TimeWeighted() = %v, want 0", got)
+	}
+}
+
+func TestTimeWeighted_NothingInvested(t *testing.T) {
+	_, err := TimeWeighted([]Valuation{
+		{Date: day(2023, time.January, 1), Value: 0},
+		{Date: day(2024, time.January, 1), Value: 0},
+	}, nil)
+	if err != ErrNoSolution {
+		t.Errorf("expected ErrNoSolution, got %v", err)
+	}
+}
+
+func TestAnnualize(t *testing.T) {
+	if got := Annualize(0.21, 730); math.Abs(got-0.1) > 1e-3 {
+		t.Errorf("Annualize(0.21, 730) = %v, want ~0.1", got)
+	}
+	if got := Annualize(0.05, 90); got != 0.05 {
+		t.Errorf("periods under a year should not be annualized, got %v", got)
+	}
+}
@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"fmt"
+
+	"wealthjourney/pkg/config"
+)
+
+// NewProvider creates the storage provider selected by STORAGE_PROVIDER.
+func NewProvider(cfg config.Storage) (StorageProvider, error) {
+	switch cfg.Provider {
+	case "supabase":
+		if cfg.SupabaseURL == "" {
+			return nil, fmt.Errorf("SUPABASE_URL is required for the supabase storage provider")
+		}
+		return NewSupabaseStorage(cfg.SupabaseURL, cfg.SupabaseAPIKey, cfg.SupabaseBucket), nil
+	case "s3":
+		return NewS3Storage(cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKeyID, cfg.S3SecretAccessKey, cfg.S3UsePathStyle)
+	case "local":
+		return NewLocalStorage(cfg.LocalDir, cfg.LocalBaseURL, cfg.LocalSigningKey), nil
+	default:
+		return nil, fmt.Errorf("unknown storage provider: %s", cfg.Provider)
+	}
+}
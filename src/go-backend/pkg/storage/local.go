@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Compile-time check to ensure LocalStorage implements StorageProvider.
+var _ StorageProvider = (*LocalStorage)(nil)
+
+// LocalURLExpiry is how long a signed local file URL stays valid, like Supabase signed URLs.
+const LocalURLExpiry = 24 * time.Hour
+
+// ErrInvalidSignature is returned for a local file URL that is forged, tampered with or expired.
+var ErrInvalidSignature = errors.New("invalid or expired file URL")
+
+// LocalStorage implements StorageProvider on the local filesystem, for self-hosting,
+// development and tests without a Supabase account. With a base URL, GetURL returns
+// HMAC-signed expiring URLs that the API serves after checking them with Resolve.
+type LocalStorage struct {
+	rootDir    string           // Directory the keys are relative to
+	baseURL    string           // URL prefix the files are served under; file:// URLs are returned when empty
+	signingKey []byte           // HMAC key for URLs
+	now        func() time.Time // Clock, replaced in tests
+}
+
+// NewLocalStorage creates a new local filesystem storage provider rooted at rootDir. When
+// signingKey is empty a random key is used, so URLs don't survive a restart.
+func NewLocalStorage(rootDir, baseURL, signingKey string) *LocalStorage {
+	key := []byte(signingKey)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			panic(fmt.Sprintf("failed to generate local storage signing key: %v", err))
+		}
+	}
+
+	return &LocalStorage{
+		rootDir:    rootDir,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		signingKey: key,
+		now:        time.Now,
+	}
+}
+
+// Upload writes a file under the root directory, creating parent directories as needed.
+func (s *LocalStorage) Upload(ctx context.Context, file io.Reader, key string, contentType string) (*UploadResult, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	dst, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer dst.Close()
+
+	size, err := io.Copy(dst, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fileURL, err := s.GetURL(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UploadResult{
+		URL:      fileURL,
+		Key:      key,
+		Size:     size,
+		MimeType: contentType,
+	}, nil
+}
+
+// Delete removes a file. Deleting a missing file is not an error.
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+// GetURL returns a signed URL for a stored file that expires after LocalURLExpiry, or an
+// error if the file does not exist.
+func (s *LocalStorage) GetURL(ctx context.Context, key string) (string, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(filePath); err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("file not found: %s", key)
+		}
+		return "", fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	if s.baseURL == "" {
+		absPath, err := filepath.Abs(filePath)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve file path: %w", err)
+		}
+		return (&url.URL{Scheme: "file", Path: filepath.ToSlash(absPath)}).String(), nil
+	}
+
+	expires := strconv.FormatInt(s.now().Add(LocalURLExpiry).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(key, expires))
+	return s.baseURL + "/" + escapeKey(key) + "?" + query.Encode(), nil
+}
+
+// Resolve checks the expiry and signature of a URL returned by GetURL and returns the path
+// of the file. Returns ErrInvalidSignature for bad or expired URLs.
+func (s *LocalStorage) Resolve(key, expires, signature string) (string, error) {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > expiresAt {
+		return "", ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(key, expires))) {
+		return "", ErrInvalidSignature
+	}
+	return s.path(key)
+}
+
+// sign computes the URL signature of a key and expiry.
+func (s *LocalStorage) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path maps a key to a path under the root directory, rejecting keys that escape it.
+func (s *LocalStorage) path(key string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("storage key cannot be empty")
+	}
+
+	filePath := filepath.Join(s.rootDir, filepath.FromSlash(key))
+	rel, err := filepath.Rel(s.rootDir, filePath)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key: %s", key)
+	}
+	return filePath, nil
+}
+
+// escapeKey escapes each segment of a key for use in a URL path.
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalStorage_FileURLWithoutBaseURL(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	storage := NewLocalStorage(rootDir, "", "")
+
+	result, err := storage.Upload(ctx, bytes.NewReader([]byte("receipt")), "attachments/7/receipt.png", "image/png")
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if !strings.HasPrefix(result.URL, "file://") {
+		t.Errorf("expected a file URL, got %s", result.URL)
+	}
+
+	content, err := os.ReadFile(filepath.Join(rootDir, "attachments", "7", "receipt.png"))
+	if err != nil || string(content) != "receipt" {
+		t.Errorf("file not written under the root directory: %v", err)
+	}
+}
+
+func TestLocalStorage_SignedURLs(t *testing.T) {
+	ctx := context.Background()
+	storage := NewLocalStorage(t.TempDir(), "http://localhost:5000/api/v1/files/", "signing-key")
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	storage.now = func() time.Time { return now }
+
+	result, err := storage.Upload(ctx, strings.NewReader("data"), "uploads/a b.csv", "text/csv")
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if !strings.HasPrefix(result.URL, "http://localhost:5000/api/v1/files/uploads/a%20b.csv?") {
+		t.Fatalf("unexpected URL: %s", result.URL)
+	}
+
+	u, _ := url.Parse(result.URL)
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	if _, err := storage.Resolve("uploads/a b.csv", expires, signature); err != nil {
+		t.Errorf("a fresh URL should resolve, got: %v", err)
+	}
+	if _, err := storage.Resolve("uploads/other.csv", expires, signature); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("the signature must cover the key, got: %v", err)
+	}
+	if _, err := NewLocalStorage(t.TempDir(), "http://x", "other-key").Resolve("uploads/a b.csv", expires, signature); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("the signature must depend on the key, got: %v", err)
+	}
+
+	now = now.Add(LocalURLExpiry + time.Second)
+	if _, err := storage.Resolve("uploads/a b.csv", expires, signature); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("an expired URL must not resolve, got: %v", err)
+	}
+}
+
+func TestLocalStorage_RejectsKeysOutsideRoot(t *testing.T) {
+	ctx := context.Background()
+	storage := NewLocalStorage(t.TempDir(), "", "")
+
+	for _, key := range []string{"", "../escape.txt", "a/../../escape.txt"} {
+		if _, err := storage.Upload(ctx, strings.NewReader("x"), key, "text/plain"); err == nil {
+			t.Errorf("Upload with key %q should fail", key)
+		}
+	}
+}
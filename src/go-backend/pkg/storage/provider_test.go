@@ -1,8 +1,11 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -46,3 +49,89 @@ func TestUploadResult_Structure(t *testing.T) {
 		t.Error("Key should not be empty")
 	}
 }
+
+// testProviderContract checks the behaviour every StorageProvider must have. URLs returned
+// by the provider must be downloadable with a plain GET.
+func testProviderContract(t *testing.T, provider StorageProvider) {
+	ctx := context.Background()
+	content := []byte("%PDF-1.4 receipt")
+	key := "contract/user 7/receipt.pdf"
+
+	t.Run("Upload", func(t *testing.T) {
+		result, err := provider.Upload(ctx, bytes.NewReader(content), key, "application/pdf")
+		if err != nil {
+			t.Fatalf("Upload failed: %v", err)
+		}
+		if result.Key != key {
+			t.Errorf("Expected key %s, got %s", key, result.Key)
+		}
+		if result.Size != int64(len(content)) {
+			t.Errorf("Expected size %d, got %d", len(content), result.Size)
+		}
+		if result.MimeType != "application/pdf" {
+			t.Errorf("Expected MIME type application/pdf, got %s", result.MimeType)
+		}
+		if result.URL == "" {
+			t.Error("URL should not be empty")
+		}
+	})
+
+	t.Run("GetURL", func(t *testing.T) {
+		fileURL, err := provider.GetURL(ctx, key)
+		if err != nil {
+			t.Fatalf("GetURL failed: %v", err)
+		}
+
+		resp, err := http.Get(fileURL)
+		if err != nil {
+			t.Fatalf("Download failed: %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK || !bytes.Equal(body, content) {
+			t.Errorf("Expected the uploaded content, got status %d and %q", resp.StatusCode, body)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := provider.Delete(ctx, key); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if _, err := provider.GetURL(ctx, key); err == nil {
+			t.Error("GetURL should fail for a deleted file")
+		}
+	})
+
+	t.Run("Delete Non-existent", func(t *testing.T) {
+		if err := provider.Delete(ctx, "contract/missing.pdf"); err != nil {
+			t.Errorf("Delete non-existent file should not error, got: %v", err)
+		}
+	})
+}
+
+func TestLocalStorage_Contract(t *testing.T) {
+	var provider *LocalStorage
+	server := httptest.NewServer(http.StripPrefix("/files/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filePath, err := provider.Resolve(r.URL.Path, r.URL.Query().Get("expires"), r.URL.Query().Get("signature"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		http.ServeFile(w, r, filePath)
+	})))
+	defer server.Close()
+
+	provider = NewLocalStorage(t.TempDir(), server.URL+"/files", "contract-signing-key")
+	testProviderContract(t, provider)
+}
+
+func TestS3Storage_Contract(t *testing.T) {
+	server := httptest.NewServer(newFakeS3(t, "AKIDCONTRACT"))
+	defer server.Close()
+
+	provider, err := NewS3Storage(server.URL, "us-east-1", "receipts", "AKIDCONTRACT", "contract-secret", true)
+	if err != nil {
+		t.Fatalf("NewS3Storage failed: %v", err)
+	}
+	testProviderContract(t, provider)
+}
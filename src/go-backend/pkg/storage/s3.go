@@ -0,0 +1,277 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Compile-time check to ensure S3Storage implements StorageProvider.
+var _ StorageProvider = (*S3Storage)(nil)
+
+// S3URLExpiry is how long a presigned S3 URL stays valid, like Supabase signed URLs.
+const S3URLExpiry = 24 * time.Hour
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3TimeFormat      = "20060102T150405Z"
+	s3DateFormat      = "20060102"
+)
+
+// S3Storage implements StorageProvider for S3-compatible object storage (AWS S3, MinIO, ...).
+// Requests are signed with AWS Signature Version 4.
+type S3Storage struct {
+	endpoint        *url.URL // e.g. "https://s3.eu-west-1.amazonaws.com" or "http://localhost:9000"
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	usePathStyle    bool // "endpoint/bucket/key" instead of "bucket.endpoint/key"
+	client          *http.Client
+	now             func() time.Time // Clock, replaced in tests
+}
+
+// NewS3Storage creates a new S3-compatible storage provider.
+func NewS3Storage(endpoint, region, bucket, accessKeyID, secretAccessKey string, usePathStyle bool) (*S3Storage, error) {
+	endpointURL, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil || endpointURL.Scheme == "" || endpointURL.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint: %q", endpoint)
+	}
+
+	return &S3Storage{
+		endpoint:        endpointURL,
+		region:          region,
+		bucket:          bucket,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		usePathStyle:    usePathStyle,
+		client:          &http.Client{},
+		now:             time.Now,
+	}, nil
+}
+
+// Upload uploads a file to the bucket.
+func (s *S3Storage) Upload(ctx context.Context, file io.Reader, key string, contentType string) (*UploadResult, error) {
+	fileContent, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file content: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(fileContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.signRequest(req, sha256Hex(fileContent))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("upload failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return &UploadResult{
+		URL:      s.presignGet(key),
+		Key:      key,
+		Size:     int64(len(fileContent)),
+		MimeType: contentType,
+	}, nil
+}
+
+// Delete removes a file from the bucket. Deleting a missing file is not an error.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create delete request: %w", err)
+	}
+	s.signRequest(req, sha256Hex(nil))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// S3 answers 204 for missing keys too; 404 is accepted for other implementations
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("delete failed with status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// GetURL returns a presigned URL for a file that expires after S3URLExpiry, or an error if
+// the file does not exist.
+func (s *S3Storage) GetURL(ctx context.Context, key string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key).String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create head request: %w", err)
+	}
+	s.signRequest(req, sha256Hex(nil))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to check file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("file not found: %s", key)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("checking file failed with status %d", resp.StatusCode)
+	}
+
+	return s.presignGet(key), nil
+}
+
+// objectURL returns the URL of an object, addressing the bucket by path or host name.
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	path := "/" + key
+	if s.usePathStyle {
+		path = "/" + s.bucket + path
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	u.RawPath = s3URIEncode(u.Path, false)
+	return &u
+}
+
+// signRequest adds the Signature Version 4 headers to a request.
+func (s *S3Storage) signRequest(req *http.Request, payloadHash string) {
+	now := s.now().UTC()
+	amzDate := now.Format(s3TimeFormat)
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := s.scope(now)
+	signature := s.signature(now, amzDate, scope, canonicalRequest)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// presignGet returns a GET URL for a key carrying its Signature Version 4 in the query.
+func (s *S3Storage) presignGet(key string) string {
+	now := s.now().UTC()
+	amzDate := now.Format(s3TimeFormat)
+	scope := s.scope(now)
+	u := s.objectURL(key)
+
+	query := map[string]string{
+		"X-Amz-Algorithm":     s3Algorithm,
+		"X-Amz-Credential":    s.accessKeyID + "/" + scope,
+		"X-Amz-Date":          amzDate,
+		"X-Amz-Expires":       strconv.Itoa(int(S3URLExpiry.Seconds())),
+		"X-Amz-SignedHeaders": "host",
+	}
+	canonicalQuery := s3CanonicalQuery(query)
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		s3UnsignedPayload,
+	}, "\n")
+
+	signature := s.signature(now, amzDate, scope, canonicalRequest)
+	u.RawQuery = canonicalQuery + "&X-Amz-Signature=" + signature
+	return u.String()
+}
+
+// scope returns the credential scope of a request made at t.
+func (s *S3Storage) scope(t time.Time) string {
+	return t.Format(s3DateFormat) + "/" + s.region + "/s3/aws4_request"
+}
+
+// signature signs a canonical request with a key derived from the secret, date and region.
+func (s *S3Storage) signature(t time.Time, amzDate, scope, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), t.Format(s3DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// s3CanonicalQuery encodes query parameters sorted by name, as Signature Version 4 requires.
+func s3CanonicalQuery(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = s3URIEncode(name, true) + "=" + s3URIEncode(params[name], true)
+	}
+	return strings.Join(pairs, "&")
+}
+
+// s3URIEncode percent-encodes everything but unreserved characters and, unless encodeSlash
+// is set, slashes.
+func s3URIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -186,6 +186,14 @@ func GetMimeType(filename string) string {
 		return "application/vnd.ms-excel"
 	case ".pdf":
 		return "application/pdf"
+	case ".ofx", ".qfx":
+		return "application/x-ofx"
+	case ".qif":
+		return "application/qif"
+	case ".sta", ".mt940":
+		return "text/plain"
+	case ".xml":
+		return "application/xml"
 	default:
 		return "application/octet-stream"
 	}
@@ -12,6 +12,7 @@ import (
 var (
 	ErrSymbolNotFound  = fmt.Errorf("symbol not found")
 	ErrInvalidResponse = fmt.Errorf("invalid API response")
+	ErrRateLimited     = fmt.Errorf("rate limited by Yahoo Finance")
 )
 
 // QuoteData holds parsed quote data from Yahoo Finance
@@ -0,0 +1,180 @@
+package yahoo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HistoricalPrice is a daily OHLC bar
+// Open, High and Low fall back to Close when the API leaves them empty
+type HistoricalPrice struct {
+	Date   time.Time // Start of the trading day (UTC)
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume int64
+}
+
+// HistoricalPrices holds the daily bars of a symbol, oldest first
+type HistoricalPrices struct {
+	Symbol   string
+	Currency string
+	Prices   []HistoricalPrice
+}
+
+// yahooHistoryResponse represents the daily series of the Yahoo Finance v8 chart API
+type yahooHistoryResponse struct {
+	Chart struct {
+		Result []struct {
+			Meta struct {
+				Currency string `json:"currency"`
+				Symbol   string `json:"symbol"`
+			} `json:"meta"`
+			Timestamp  []int64 `json:"timestamp"`
+			Indicators struct {
+				Quote []struct {
+					Open   []*float64 `json:"open"`
+					High   []*float64 `json:"high"`
+					Low    []*float64 `json:"low"`
+					Close  []*float64 `json:"close"` // null on days without trading
+					Volume []*int64   `json:"volume"`
+				} `json:"quote"`
+			} `json:"indicators"`
+		} `json:"result"`
+		Error *struct {
+			Code        string `json:"code"`
+			Description string `json:"description"`
+		} `json:"error"`
+	} `json:"chart"`
+}
+
+// buildHistoryURL constructs the chart API URL for daily prices between from and to
+func buildHistoryURL(symbol string, from, to time.Time) string {
+	baseURL := "https://query1.finance.yahoo.com/v8/finance/chart/" + url.PathEscape(symbol)
+	values := url.Values{}
+	values.Set("interval", "1d")
+	values.Set("period1", strconv.FormatInt(from.Unix(), 10))
+	values.Set("period2", strconv.FormatInt(to.Unix(), 10))
+	return fmt.Sprintf("%s?%s", baseURL, values.Encode())
+}
+
+// GetHistoricalPrices fetches daily OHLC prices for a symbol between from and to
+// Uses Yahoo Finance v8 chart API with rate limiting (no authentication required)
+func GetHistoricalPrices(ctx context.Context, symbol string, from, to time.Time) (*HistoricalPrices, error) {
+	symbol = strings.TrimSpace(symbol)
+	if symbol == "" {
+		return nil, fmt.Errorf("symbol cannot be empty")
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("from must be before to")
+	}
+
+	// Respect rate limiting
+	if err := GetGlobalThrottler().Wait(ctx); err != nil {
+		return nil, fmt.Errorf("rate limit wait failed: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, "GET", buildHistoryURL(symbol, from, to), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrSymbolNotFound
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, ErrRateLimited
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return parseHistoryResponse(body)
+}
+
+// parseHistoryResponse extracts the daily bars from a chart API response body
+func parseHistoryResponse(body []byte) (*HistoricalPrices, error) {
+	var historyResp yahooHistoryResponse
+	if err := json.Unmarshal(body, &historyResp); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+
+	// Check for API errors
+	if historyResp.Chart.Error != nil {
+		return nil, fmt.Errorf("API error: %s - %s", historyResp.Chart.Error.Code, historyResp.Chart.Error.Description)
+	}
+
+	// Check if we got results
+	if len(historyResp.Chart.Result) == 0 {
+		return nil, ErrSymbolNotFound
+	}
+
+	result := historyResp.Chart.Result[0]
+	history := &HistoricalPrices{
+		Symbol:   result.Meta.Symbol,
+		Currency: result.Meta.Currency,
+	}
+	if len(result.Indicators.Quote) == 0 {
+		return history, nil
+	}
+
+	quote := result.Indicators.Quote[0]
+	if len(quote.Close) != len(result.Timestamp) {
+		return nil, ErrInvalidResponse
+	}
+
+	for i, ts := range result.Timestamp {
+		if quote.Close[i] == nil || *quote.Close[i] <= 0 {
+			continue
+		}
+		closePrice := *quote.Close[i]
+		t := time.Unix(ts, 0).UTC()
+		price := HistoricalPrice{
+			Date:  time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC),
+			Open:  seriesValue(quote.Open, i, closePrice),
+			High:  seriesValue(quote.High, i, closePrice),
+			Low:   seriesValue(quote.Low, i, closePrice),
+			Close: closePrice,
+		}
+		if i < len(quote.Volume) && quote.Volume[i] != nil {
+			price.Volume = *quote.Volume[i]
+		}
+		history.Prices = append(history.Prices, price)
+	}
+
+	return history, nil
+}
+
+// seriesValue returns the i-th value of an optional price series, or fallback when it is missing
+func seriesValue(series []*float64, i int, fallback float64) float64 {
+	if i >= len(series) || series[i] == nil || *series[i] <= 0 {
+		return fallback
+	}
+	return *series[i]
+}
@@ -0,0 +1,110 @@
+package yahoo
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestBuildHistoryURL(t *testing.T) {
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	parsedURL, err := url.Parse(buildHistoryURL("^GSPC", from, to))
+	if err != nil {
+		t.Fatalf("Failed to parse URL: %v", err)
+	}
+
+	if parsedURL.Path != "/v8/finance/chart/^GSPC" {
+		t.Errorf("Path = %s, want /v8/finance/chart/^GSPC", parsedURL.Path)
+	}
+	query := parsedURL.Query()
+	if query.Get("interval") != "1d" {
+		t.Errorf("interval = %s, want 1d", query.Get("interval"))
+	}
+	if query.Get("period1") != "1704067200" || query.Get("period2") != "1706745600" {
+		t.Errorf("period = %s..%s, want 1704067200..1706745600", query.Get("period1"), query.Get("period2"))
+	}
+	if query.Get("range") != "" {
+		t.Errorf("range should not be set with an explicit period, got %s", query.Get("range"))
+	}
+}
+
+func TestParseHistoryResponse(t *testing.T) {
+	body := []byte(`{"chart":{"result":[{
+		"meta":{"currency":"USD","symbol":"SPY"},
+		"timestamp":[1704205800,1704292200,1704378600],
+		"indicators":{"quote":[{"close":[472.65,null,467.28]}]}
+	}],"error":null}}`)
+
+	history, err := parseHistoryResponse(body)
+	if err != nil {
+		t.Fatalf("parseHistoryResponse() error = %v", err)
+	}
+
+	if history.Symbol != "SPY" || history.Currency != "USD" {
+		t.Errorf("got %s/%s, want SPY/USD", history.Symbol, history.Currency)
+	}
+	if len(history.Prices) != 2 {
+		t.Fatalf("got %d prices, want 2 (null closes skipped)", len(history.Prices))
+	}
+	if want := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC); !history.Prices[0].Date.Equal(want) {
+		t.Errorf("Date = %v, want %v", history.Prices[0].Date, want)
+	}
+	if history.Prices[1].Close != 467.28 {
+		t.Errorf("Close = %v, want 467.28", history.Prices[1].Close)
+	}
+}
+
+func TestParseHistoryResponse_OHLC(t *testing.T) {
+	body := []byte(`{"chart":{"result":[{
+		"meta":{"currency":"VND","symbol":"VNM.VN"},
+		"timestamp":[1704164400,1704250800],
+		"indicators":{"quote":[{
+			"open":[70000,null],
+			"high":[71500,null],
+			"low":[69800,null],
+			"close":[71000,70500],
+			"volume":[1250000,null]
+		}]}
+	}],"error":null}}`)
+
+	history, err := parseHistoryResponse(body)
+	if err != nil {
+		t.Fatalf("parseHistoryResponse() error = %v", err)
+	}
+	if len(history.Prices) != 2 {
+		t.Fatalf("got %d prices, want 2", len(history.Prices))
+	}
+
+	want := HistoricalPrice{Date: history.Prices[0].Date, Open: 70000, High: 71500, Low: 69800, Close: 71000, Volume: 1250000}
+	if history.Prices[0] != want {
+		t.Errorf("Prices[0] = %+v, want %+v", history.Prices[0], want)
+	}
+
+	// Missing open/high/low fall back to the close
+	want = HistoricalPrice{Date: history.Prices[1].Date, Open: 70500, High: 70500, Low: 70500, Close: 70500}
+	if history.Prices[1] != want {
+		t.Errorf("Prices[1] = %+v, want %+v", history.Prices[1], want)
+	}
+}
+
+func TestParseHistoryResponse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "API error", body: `{"chart":{"result":null,"error":{"code":"Not Found","description":"No data found"}}}`},
+		{name: "no result", body: `{"chart":{"result":[],"error":null}}`},
+		{name: "mismatched series", body: `{"chart":{"result":[{"timestamp":[1,2],"indicators":{"quote":[{"close":[1.0]}]}}]}}`},
+		{name: "invalid JSON", body: `{`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseHistoryResponse([]byte(tt.body)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
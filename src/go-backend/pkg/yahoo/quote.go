@@ -101,6 +101,9 @@ func GetQuote(ctx context.Context, symbol string) (*QuoteResult, error) {
 	if resp.StatusCode == http.StatusNotFound {
 		return nil, ErrSymbolNotFound
 	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, ErrRateLimited
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
@@ -21,6 +21,162 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Time window a budget tracks spending over
+type BudgetPeriod int32
+
+const (
+	BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED BudgetPeriod = 0 // No period: a checklist that tracks no spending
+	BudgetPeriod_BUDGET_PERIOD_MONTHLY     BudgetPeriod = 1 // Calendar month
+	BudgetPeriod_BUDGET_PERIOD_WEEKLY      BudgetPeriod = 2 // Week starting on Monday
+	BudgetPeriod_BUDGET_PERIOD_CUSTOM      BudgetPeriod = 3 // From startDate to endDate
+)
+
+// Enum value maps for BudgetPeriod.
+var (
+	BudgetPeriod_name = map[int32]string{
+		0: "BUDGET_PERIOD_UNSPECIFIED",
+		1: "BUDGET_PERIOD_MONTHLY",
+		2: "BUDGET_PERIOD_WEEKLY",
+		3: "BUDGET_PERIOD_CUSTOM",
+	}
+	BudgetPeriod_value = map[string]int32{
+		"BUDGET_PERIOD_UNSPECIFIED": 0,
+		"BUDGET_PERIOD_MONTHLY":     1,
+		"BUDGET_PERIOD_WEEKLY":      2,
+		"BUDGET_PERIOD_CUSTOM":      3,
+	}
+)
+
+func (x BudgetPeriod) Enum() *BudgetPeriod {
+	p := new(BudgetPeriod)
+	*p = x
+	return p
+}
+
+func (x BudgetPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BudgetPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_v1_budget_proto_enumTypes[0].Descriptor()
+}
+
+func (BudgetPeriod) Type() protoreflect.EnumType {
+	return &file_protobuf_v1_budget_proto_enumTypes[0]
+}
+
+func (x BudgetPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BudgetPeriod.Descriptor instead.
+func (BudgetPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{0}
+}
+
+// What happens to an envelope's balance when its period closes
+type BudgetRolloverPolicy int32
+
+const (
+	BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_NONE         BudgetRolloverPolicy = 0 // Start every period from the allocation
+	BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_ALL    BudgetRolloverPolicy = 1 // Carry leftovers and overspending into the next period
+	BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_CARRY_CAPPED BudgetRolloverPolicy = 2 // Carry at most rolloverCap either way
+)
+
+// Enum value maps for BudgetRolloverPolicy.
+var (
+	BudgetRolloverPolicy_name = map[int32]string{
+		0: "BUDGET_ROLLOVER_POLICY_NONE",
+		1: "BUDGET_ROLLOVER_POLICY_CARRY_ALL",
+		2: "BUDGET_ROLLOVER_POLICY_CARRY_CAPPED",
+	}
+	BudgetRolloverPolicy_value = map[string]int32{
+		"BUDGET_ROLLOVER_POLICY_NONE":         0,
+		"BUDGET_ROLLOVER_POLICY_CARRY_ALL":    1,
+		"BUDGET_ROLLOVER_POLICY_CARRY_CAPPED": 2,
+	}
+)
+
+func (x BudgetRolloverPolicy) Enum() *BudgetRolloverPolicy {
+	p := new(BudgetRolloverPolicy)
+	*p = x
+	return p
+}
+
+func (x BudgetRolloverPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BudgetRolloverPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_v1_budget_proto_enumTypes[1].Descriptor()
+}
+
+func (BudgetRolloverPolicy) Type() protoreflect.EnumType {
+	return &file_protobuf_v1_budget_proto_enumTypes[1]
+}
+
+func (x BudgetRolloverPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BudgetRolloverPolicy.Descriptor instead.
+func (BudgetRolloverPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{1}
+}
+
+// Where a budget alert is delivered
+type BudgetAlertChannel int32
+
+const (
+	BudgetAlertChannel_BUDGET_ALERT_CHANNEL_UNSPECIFIED BudgetAlertChannel = 0
+	BudgetAlertChannel_BUDGET_ALERT_CHANNEL_IN_APP      BudgetAlertChannel = 1 // The user's notification inbox
+	BudgetAlertChannel_BUDGET_ALERT_CHANNEL_EMAIL       BudgetAlertChannel = 2 // The user's email address
+	BudgetAlertChannel_BUDGET_ALERT_CHANNEL_WEBHOOK     BudgetAlertChannel = 3 // A POST to the rule's webhookUrl
+)
+
+// Enum value maps for BudgetAlertChannel.
+var (
+	BudgetAlertChannel_name = map[int32]string{
+		0: "BUDGET_ALERT_CHANNEL_UNSPECIFIED",
+		1: "BUDGET_ALERT_CHANNEL_IN_APP",
+		2: "BUDGET_ALERT_CHANNEL_EMAIL",
+		3: "BUDGET_ALERT_CHANNEL_WEBHOOK",
+	}
+	BudgetAlertChannel_value = map[string]int32{
+		"BUDGET_ALERT_CHANNEL_UNSPECIFIED": 0,
+		"BUDGET_ALERT_CHANNEL_IN_APP":      1,
+		"BUDGET_ALERT_CHANNEL_EMAIL":       2,
+		"BUDGET_ALERT_CHANNEL_WEBHOOK":     3,
+	}
+)
+
+func (x BudgetAlertChannel) Enum() *BudgetAlertChannel {
+	p := new(BudgetAlertChannel)
+	*p = x
+	return p
+}
+
+func (x BudgetAlertChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BudgetAlertChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_v1_budget_proto_enumTypes[2].Descriptor()
+}
+
+func (BudgetAlertChannel) Type() protoreflect.EnumType {
+	return &file_protobuf_v1_budget_proto_enumTypes[2]
+}
+
+func (x BudgetAlertChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BudgetAlertChannel.Descriptor instead.
+func (BudgetAlertChannel) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{2}
+}
+
 // Budget message
 type Budget struct {
 	state         protoimpl.MessageState
@@ -34,9 +190,18 @@ type Budget struct {
 	CreatedAt int64  `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt int64  `protobuf:"varint,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	// Conversion fields (populated when user's preferred currency differs from budget currency)
-	Currency        string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`               // Original currency of the budget
-	DisplayTotal    *Money `protobuf:"bytes,8,opt,name=displayTotal,proto3" json:"displayTotal,omitempty"`       // Total in user's preferred currency
-	DisplayCurrency string `protobuf:"bytes,9,opt,name=displayCurrency,proto3" json:"displayCurrency,omitempty"` // User's preferred currency code
+	Currency        string       `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`               // Original currency of the budget
+	DisplayTotal    *Money       `protobuf:"bytes,8,opt,name=displayTotal,proto3" json:"displayTotal,omitempty"`       // Total in user's preferred currency
+	DisplayCurrency string       `protobuf:"bytes,9,opt,name=displayCurrency,proto3" json:"displayCurrency,omitempty"` // User's preferred currency code
+	Period          BudgetPeriod `protobuf:"varint,10,opt,name=period,proto3,enum=wealthjourney.budget.v1.BudgetPeriod" json:"period,omitempty"`
+	StartDate       int64        `protobuf:"varint,11,opt,name=startDate,proto3" json:"startDate,omitempty"` // Custom period start, unix timestamp
+	EndDate         int64        `protobuf:"varint,12,opt,name=endDate,proto3" json:"endDate,omitempty"`     // Custom period end, unix timestamp
+	// Spending of the current period, in the budget currency. Unset for budgets without a period.
+	PeriodStart int64   `protobuf:"varint,13,opt,name=periodStart,proto3" json:"periodStart,omitempty"`  // Unix timestamp
+	PeriodEnd   int64   `protobuf:"varint,14,opt,name=periodEnd,proto3" json:"periodEnd,omitempty"`      // Unix timestamp
+	Spent       *Money  `protobuf:"bytes,15,opt,name=spent,proto3" json:"spent,omitempty"`               // Sum of the items' spending
+	Remaining   *Money  `protobuf:"bytes,16,opt,name=remaining,proto3" json:"remaining,omitempty"`       // Total - spent, negative when overspent
+	PercentUsed float64 `protobuf:"fixed64,17,opt,name=percentUsed,proto3" json:"percentUsed,omitempty"` // Spent as a percentage of the total
 }
 
 func (x *Budget) Reset() {
@@ -134,6 +299,62 @@ func (x *Budget) GetDisplayCurrency() string {
 	return ""
 }
 
+func (x *Budget) GetPeriod() BudgetPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+func (x *Budget) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *Budget) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *Budget) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *Budget) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *Budget) GetSpent() *Money {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *Budget) GetRemaining() *Money {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *Budget) GetPercentUsed() float64 {
+	if x != nil {
+		return x.PercentUsed
+	}
+	return 0
+}
+
 // BudgetItem message
 type BudgetItem struct {
 	state         protoimpl.MessageState
@@ -148,9 +369,18 @@ type BudgetItem struct {
 	CreatedAt int64  `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt int64  `protobuf:"varint,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	// Conversion fields (populated when user's preferred currency differs from budget item currency)
-	Currency        string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                // Original currency of the budget item
-	DisplayTotal    *Money `protobuf:"bytes,9,opt,name=displayTotal,proto3" json:"displayTotal,omitempty"`        // Total in user's preferred currency
-	DisplayCurrency string `protobuf:"bytes,10,opt,name=displayCurrency,proto3" json:"displayCurrency,omitempty"` // User's preferred currency code
+	Currency        string  `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                // Original currency of the budget item
+	DisplayTotal    *Money  `protobuf:"bytes,9,opt,name=displayTotal,proto3" json:"displayTotal,omitempty"`        // Total in user's preferred currency
+	DisplayCurrency string  `protobuf:"bytes,10,opt,name=displayCurrency,proto3" json:"displayCurrency,omitempty"` // User's preferred currency code
+	CategoryIds     []int32 `protobuf:"varint,11,rep,packed,name=categoryIds,proto3" json:"categoryIds,omitempty"` // Expense categories the item tracks
+	WalletIds       []int32 `protobuf:"varint,12,rep,packed,name=walletIds,proto3" json:"walletIds,omitempty"`     // Wallets the item tracks, all wallets when empty
+	// Spending of the budget's current period, in the budget currency. Unset for items
+	// without categories or budgets without a period.
+	Spent          *Money               `protobuf:"bytes,13,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining      *Money               `protobuf:"bytes,14,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	PercentUsed    float64              `protobuf:"fixed64,15,opt,name=percentUsed,proto3" json:"percentUsed,omitempty"`
+	RolloverPolicy BudgetRolloverPolicy `protobuf:"varint,16,opt,name=rolloverPolicy,proto3,enum=wealthjourney.budget.v1.BudgetRolloverPolicy" json:"rolloverPolicy,omitempty"`
+	RolloverCap    *Money               `protobuf:"bytes,17,opt,name=rolloverCap,proto3" json:"rolloverCap,omitempty"` // Used by the capped policy
 }
 
 func (x *BudgetItem) Reset() {
@@ -255,6 +485,55 @@ func (x *BudgetItem) GetDisplayCurrency() string {
 	return ""
 }
 
+func (x *BudgetItem) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *BudgetItem) GetWalletIds() []int32 {
+	if x != nil {
+		return x.WalletIds
+	}
+	return nil
+}
+
+func (x *BudgetItem) GetSpent() *Money {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *BudgetItem) GetRemaining() *Money {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *BudgetItem) GetPercentUsed() float64 {
+	if x != nil {
+		return x.PercentUsed
+	}
+	return 0
+}
+
+func (x *BudgetItem) GetRolloverPolicy() BudgetRolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_NONE
+}
+
+func (x *BudgetItem) GetRolloverCap() *Money {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return nil
+}
+
 // GetBudget request
 type GetBudgetRequest struct {
 	state         protoimpl.MessageState
@@ -357,9 +636,12 @@ type CreateBudgetRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Total *Money                     `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
-	Items []*CreateBudgetItemRequest `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Name      string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Total     *Money                     `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Items     []*CreateBudgetItemRequest `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Period    BudgetPeriod               `protobuf:"varint,4,opt,name=period,proto3,enum=wealthjourney.budget.v1.BudgetPeriod" json:"period,omitempty"`
+	StartDate int64                      `protobuf:"varint,5,opt,name=startDate,proto3" json:"startDate,omitempty"` // Required for a custom period
+	EndDate   int64                      `protobuf:"varint,6,opt,name=endDate,proto3" json:"endDate,omitempty"`     // Required for a custom period
 }
 
 func (x *CreateBudgetRequest) Reset() {
@@ -415,15 +697,39 @@ func (x *CreateBudgetRequest) GetItems() []*CreateBudgetItemRequest {
 	return nil
 }
 
+func (x *CreateBudgetRequest) GetPeriod() BudgetPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+func (x *CreateBudgetRequest) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *CreateBudgetRequest) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
 // UpdateBudget request
 type UpdateBudgetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BudgetId int32  `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Total    *Money `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	BudgetId  int32         `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	Name      string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Total     *Money        `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Period    *BudgetPeriod `protobuf:"varint,4,opt,name=period,proto3,enum=wealthjourney.budget.v1.BudgetPeriod,oneof" json:"period,omitempty"`
+	StartDate *int64        `protobuf:"varint,5,opt,name=startDate,proto3,oneof" json:"startDate,omitempty"`
+	EndDate   *int64        `protobuf:"varint,6,opt,name=endDate,proto3,oneof" json:"endDate,omitempty"`
 }
 
 func (x *UpdateBudgetRequest) Reset() {
@@ -479,6 +785,27 @@ func (x *UpdateBudgetRequest) GetTotal() *Money {
 	return nil
 }
 
+func (x *UpdateBudgetRequest) GetPeriod() BudgetPeriod {
+	if x != nil && x.Period != nil {
+		return *x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+func (x *UpdateBudgetRequest) GetStartDate() int64 {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return 0
+}
+
+func (x *UpdateBudgetRequest) GetEndDate() int64 {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return 0
+}
+
 // DeleteBudget request
 type DeleteBudgetRequest struct {
 	state         protoimpl.MessageState
@@ -581,9 +908,13 @@ type CreateBudgetItemRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BudgetId int32  `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Total    *Money `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	BudgetId       int32                `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	Name           string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Total          *Money               `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	CategoryIds    []int32              `protobuf:"varint,4,rep,packed,name=categoryIds,proto3" json:"categoryIds,omitempty"`
+	WalletIds      []int32              `protobuf:"varint,5,rep,packed,name=walletIds,proto3" json:"walletIds,omitempty"`
+	RolloverPolicy BudgetRolloverPolicy `protobuf:"varint,6,opt,name=rolloverPolicy,proto3,enum=wealthjourney.budget.v1.BudgetRolloverPolicy" json:"rolloverPolicy,omitempty"`
+	RolloverCap    *Money               `protobuf:"bytes,7,opt,name=rolloverCap,proto3" json:"rolloverCap,omitempty"`
 }
 
 func (x *CreateBudgetItemRequest) Reset() {
@@ -639,17 +970,51 @@ func (x *CreateBudgetItemRequest) GetTotal() *Money {
 	return nil
 }
 
+func (x *CreateBudgetItemRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CreateBudgetItemRequest) GetWalletIds() []int32 {
+	if x != nil {
+		return x.WalletIds
+	}
+	return nil
+}
+
+func (x *CreateBudgetItemRequest) GetRolloverPolicy() BudgetRolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_NONE
+}
+
+func (x *CreateBudgetItemRequest) GetRolloverCap() *Money {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return nil
+}
+
 // UpdateBudgetItem request
 type UpdateBudgetItemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BudgetId int32  `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
-	ItemId   int32  `protobuf:"varint,2,opt,name=itemId,proto3" json:"itemId,omitempty"`
-	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Total    *Money `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
-	Checked  bool   `protobuf:"varint,5,opt,name=checked,proto3" json:"checked,omitempty"`
+	BudgetId        int32                 `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	ItemId          int32                 `protobuf:"varint,2,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	Name            string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Total           *Money                `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Checked         bool                  `protobuf:"varint,5,opt,name=checked,proto3" json:"checked,omitempty"`
+	CategoryIds     []int32               `protobuf:"varint,6,rep,packed,name=categoryIds,proto3" json:"categoryIds,omitempty"` // Replaces the categories when set
+	WalletIds       []int32               `protobuf:"varint,7,rep,packed,name=walletIds,proto3" json:"walletIds,omitempty"`     // Replaces the wallets when set
+	ClearCategories bool                  `protobuf:"varint,8,opt,name=clearCategories,proto3" json:"clearCategories,omitempty"`
+	ClearWallets    bool                  `protobuf:"varint,9,opt,name=clearWallets,proto3" json:"clearWallets,omitempty"` // Track all wallets again
+	RolloverPolicy  *BudgetRolloverPolicy `protobuf:"varint,10,opt,name=rolloverPolicy,proto3,enum=wealthjourney.budget.v1.BudgetRolloverPolicy,oneof" json:"rolloverPolicy,omitempty"`
+	RolloverCap     *Money                `protobuf:"bytes,11,opt,name=rolloverCap,proto3" json:"rolloverCap,omitempty"` // Replaces the cap when set
 }
 
 func (x *UpdateBudgetItemRequest) Reset() {
@@ -719,6 +1084,48 @@ func (x *UpdateBudgetItemRequest) GetChecked() bool {
 	return false
 }
 
+func (x *UpdateBudgetItemRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *UpdateBudgetItemRequest) GetWalletIds() []int32 {
+	if x != nil {
+		return x.WalletIds
+	}
+	return nil
+}
+
+func (x *UpdateBudgetItemRequest) GetClearCategories() bool {
+	if x != nil {
+		return x.ClearCategories
+	}
+	return false
+}
+
+func (x *UpdateBudgetItemRequest) GetClearWallets() bool {
+	if x != nil {
+		return x.ClearWallets
+	}
+	return false
+}
+
+func (x *UpdateBudgetItemRequest) GetRolloverPolicy() BudgetRolloverPolicy {
+	if x != nil && x.RolloverPolicy != nil {
+		return *x.RolloverPolicy
+	}
+	return BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_NONE
+}
+
+func (x *UpdateBudgetItemRequest) GetRolloverCap() *Money {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return nil
+}
+
 // DeleteBudgetItem request
 type DeleteBudgetItemRequest struct {
 	state         protoimpl.MessageState
@@ -1407,190 +1814,3052 @@ func (x *DeleteBudgetItemResponse) GetTimestamp() string {
 	return ""
 }
 
-var File_protobuf_v1_budget_proto protoreflect.FileDescriptor
+// BudgetEnvelope is a budget item's balance for one period:
+// available = allocated + carriedIn + movedIn - spent
+type BudgetEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_protobuf_v1_budget_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75,
-	0x64, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x77, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x1a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x31,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x02, 0x0a, 0x06,
-	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
-	0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xe2,
-	0x02, 0x0a, 0x0a, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x22, 0x2e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
-	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7b,
-	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x31, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x33,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x49, 0x64, 0x22, 0x7f, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34,
+	BudgetItemId   int32                `protobuf:"varint,1,opt,name=budgetItemId,proto3" json:"budgetItemId,omitempty"`
+	Name           string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Allocated      *Money               `protobuf:"bytes,3,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	CarriedIn      *Money               `protobuf:"bytes,4,opt,name=carriedIn,proto3" json:"carriedIn,omitempty"` // From the previous period, negative when overspent
+	MovedIn        *Money               `protobuf:"bytes,5,opt,name=movedIn,proto3" json:"movedIn,omitempty"`     // Net money moved in, negative when moved out
+	Spent          *Money               `protobuf:"bytes,6,opt,name=spent,proto3" json:"spent,omitempty"`
+	Available      *Money               `protobuf:"bytes,7,opt,name=available,proto3" json:"available,omitempty"`
+	CarriedOut     *Money               `protobuf:"bytes,8,opt,name=carriedOut,proto3" json:"carriedOut,omitempty"` // Into the next period, set once closed
+	RolloverPolicy BudgetRolloverPolicy `protobuf:"varint,9,opt,name=rolloverPolicy,proto3,enum=wealthjourney.budget.v1.BudgetRolloverPolicy" json:"rolloverPolicy,omitempty"`
+}
+
+func (x *BudgetEnvelope) Reset() {
+	*x = BudgetEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetEnvelope) ProtoMessage() {}
+
+func (x *BudgetEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetEnvelope.ProtoReflect.Descriptor instead.
+func (*BudgetEnvelope) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BudgetEnvelope) GetBudgetItemId() int32 {
+	if x != nil {
+		return x.BudgetItemId
+	}
+	return 0
+}
+
+func (x *BudgetEnvelope) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BudgetEnvelope) GetAllocated() *Money {
+	if x != nil {
+		return x.Allocated
+	}
+	return nil
+}
+
+func (x *BudgetEnvelope) GetCarriedIn() *Money {
+	if x != nil {
+		return x.CarriedIn
+	}
+	return nil
+}
+
+func (x *BudgetEnvelope) GetMovedIn() *Money {
+	if x != nil {
+		return x.MovedIn
+	}
+	return nil
+}
+
+func (x *BudgetEnvelope) GetSpent() *Money {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *BudgetEnvelope) GetAvailable() *Money {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+func (x *BudgetEnvelope) GetCarriedOut() *Money {
+	if x != nil {
+		return x.CarriedOut
+	}
+	return nil
+}
+
+func (x *BudgetEnvelope) GetRolloverPolicy() BudgetRolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_NONE
+}
+
+// BudgetMove is money moved from one envelope to another
+type BudgetMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BudgetId    int32  `protobuf:"varint,2,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	FromItemId  int32  `protobuf:"varint,3,opt,name=fromItemId,proto3" json:"fromItemId,omitempty"`
+	ToItemId    int32  `protobuf:"varint,4,opt,name=toItemId,proto3" json:"toItemId,omitempty"`
+	Amount      *Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	PeriodStart int64  `protobuf:"varint,6,opt,name=periodStart,proto3" json:"periodStart,omitempty"` // Period the money moved in
+	Note        string `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *BudgetMove) Reset() {
+	*x = BudgetMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetMove) ProtoMessage() {}
+
+func (x *BudgetMove) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetMove.ProtoReflect.Descriptor instead.
+func (*BudgetMove) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BudgetMove) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BudgetMove) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *BudgetMove) GetFromItemId() int32 {
+	if x != nil {
+		return x.FromItemId
+	}
+	return 0
+}
+
+func (x *BudgetMove) GetToItemId() int32 {
+	if x != nil {
+		return x.ToItemId
+	}
+	return 0
+}
+
+func (x *BudgetMove) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *BudgetMove) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *BudgetMove) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *BudgetMove) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// GetBudgetEnvelopes request
+type GetBudgetEnvelopesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId int32 `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	Date     int64 `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"` // Any time in the period, defaults to now
+}
+
+func (x *GetBudgetEnvelopesRequest) Reset() {
+	*x = GetBudgetEnvelopesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBudgetEnvelopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetEnvelopesRequest) ProtoMessage() {}
+
+func (x *GetBudgetEnvelopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetEnvelopesRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetEnvelopesRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetBudgetEnvelopesRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *GetBudgetEnvelopesRequest) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+// GetBudgetEnvelopes response. Closed periods are served from their snapshot.
+type GetBudgetEnvelopesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message     string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PeriodStart int64             `protobuf:"varint,3,opt,name=periodStart,proto3" json:"periodStart,omitempty"`
+	PeriodEnd   int64             `protobuf:"varint,4,opt,name=periodEnd,proto3" json:"periodEnd,omitempty"`
+	Closed      bool              `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	Envelopes   []*BudgetEnvelope `protobuf:"bytes,6,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
+	Timestamp   string            `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetBudgetEnvelopesResponse) Reset() {
+	*x = GetBudgetEnvelopesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBudgetEnvelopesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetEnvelopesResponse) ProtoMessage() {}
+
+func (x *GetBudgetEnvelopesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetEnvelopesResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetEnvelopesResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetBudgetEnvelopesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetBudgetEnvelopesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetBudgetEnvelopesResponse) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *GetBudgetEnvelopesResponse) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *GetBudgetEnvelopesResponse) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *GetBudgetEnvelopesResponse) GetEnvelopes() []*BudgetEnvelope {
+	if x != nil {
+		return x.Envelopes
+	}
+	return nil
+}
+
+func (x *GetBudgetEnvelopesResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// MoveBudgetMoney request
+type MoveBudgetMoneyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId   int32  `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	FromItemId int32  `protobuf:"varint,2,opt,name=fromItemId,proto3" json:"fromItemId,omitempty"`
+	ToItemId   int32  `protobuf:"varint,3,opt,name=toItemId,proto3" json:"toItemId,omitempty"`
+	Amount     *Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note       string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *MoveBudgetMoneyRequest) Reset() {
+	*x = MoveBudgetMoneyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveBudgetMoneyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveBudgetMoneyRequest) ProtoMessage() {}
+
+func (x *MoveBudgetMoneyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveBudgetMoneyRequest.ProtoReflect.Descriptor instead.
+func (*MoveBudgetMoneyRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MoveBudgetMoneyRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *MoveBudgetMoneyRequest) GetFromItemId() int32 {
+	if x != nil {
+		return x.FromItemId
+	}
+	return 0
+}
+
+func (x *MoveBudgetMoneyRequest) GetToItemId() int32 {
+	if x != nil {
+		return x.ToItemId
+	}
+	return 0
+}
+
+func (x *MoveBudgetMoneyRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *MoveBudgetMoneyRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// MoveBudgetMoney response
+type MoveBudgetMoneyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *BudgetMove `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *MoveBudgetMoneyResponse) Reset() {
+	*x = MoveBudgetMoneyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveBudgetMoneyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveBudgetMoneyResponse) ProtoMessage() {}
+
+func (x *MoveBudgetMoneyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveBudgetMoneyResponse.ProtoReflect.Descriptor instead.
+func (*MoveBudgetMoneyResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MoveBudgetMoneyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MoveBudgetMoneyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MoveBudgetMoneyResponse) GetData() *BudgetMove {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MoveBudgetMoneyResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// ListBudgetMoves request
+type ListBudgetMovesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId int32 `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	Date     int64 `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"` // Any time in the period, defaults to now
+}
+
+func (x *ListBudgetMovesRequest) Reset() {
+	*x = ListBudgetMovesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBudgetMovesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetMovesRequest) ProtoMessage() {}
+
+func (x *ListBudgetMovesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetMovesRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetMovesRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListBudgetMovesRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *ListBudgetMovesRequest) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+// ListBudgetMoves response
+type ListBudgetMovesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Moves     []*BudgetMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	Timestamp string        `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ListBudgetMovesResponse) Reset() {
+	*x = ListBudgetMovesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBudgetMovesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetMovesResponse) ProtoMessage() {}
+
+func (x *ListBudgetMovesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetMovesResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetMovesResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListBudgetMovesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListBudgetMovesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListBudgetMovesResponse) GetMoves() []*BudgetMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *ListBudgetMovesResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// BudgetAlertRule notifies when the spending of a category-linked budget item crosses
+// a percentage of its total. Each threshold fires at most once per period.
+type BudgetAlertRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BudgetId     int32                `protobuf:"varint,2,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	BudgetItemId int32                `protobuf:"varint,3,opt,name=budgetItemId,proto3" json:"budgetItemId,omitempty"`
+	Thresholds   []int32              `protobuf:"varint,4,rep,packed,name=thresholds,proto3" json:"thresholds,omitempty"` // Percentages of the item total, ascending
+	Channels     []BudgetAlertChannel `protobuf:"varint,5,rep,packed,name=channels,proto3,enum=wealthjourney.budget.v1.BudgetAlertChannel" json:"channels,omitempty"`
+	WebhookUrl   string               `protobuf:"bytes,6,opt,name=webhookUrl,proto3" json:"webhookUrl,omitempty"` // Required by the webhook channel; https, public hosts only
+	Enabled      bool                 `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt    int64                `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt    int64                `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *BudgetAlertRule) Reset() {
+	*x = BudgetAlertRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetAlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetAlertRule) ProtoMessage() {}
+
+func (x *BudgetAlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetAlertRule.ProtoReflect.Descriptor instead.
+func (*BudgetAlertRule) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BudgetAlertRule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BudgetAlertRule) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *BudgetAlertRule) GetBudgetItemId() int32 {
+	if x != nil {
+		return x.BudgetItemId
+	}
+	return 0
+}
+
+func (x *BudgetAlertRule) GetThresholds() []int32 {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *BudgetAlertRule) GetChannels() []BudgetAlertChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *BudgetAlertRule) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *BudgetAlertRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BudgetAlertRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *BudgetAlertRule) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// CreateBudgetAlertRule request
+type CreateBudgetAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId     int32                `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	BudgetItemId int32                `protobuf:"varint,2,opt,name=budgetItemId,proto3" json:"budgetItemId,omitempty"`
+	Thresholds   []int32              `protobuf:"varint,3,rep,packed,name=thresholds,proto3" json:"thresholds,omitempty"`                                             // Defaults to 50, 80 and 100
+	Channels     []BudgetAlertChannel `protobuf:"varint,4,rep,packed,name=channels,proto3,enum=wealthjourney.budget.v1.BudgetAlertChannel" json:"channels,omitempty"` // Defaults to the in-app inbox
+	WebhookUrl   string               `protobuf:"bytes,5,opt,name=webhookUrl,proto3" json:"webhookUrl,omitempty"`
+}
+
+func (x *CreateBudgetAlertRuleRequest) Reset() {
+	*x = CreateBudgetAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBudgetAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateBudgetAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateBudgetAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateBudgetAlertRuleRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *CreateBudgetAlertRuleRequest) GetBudgetItemId() int32 {
+	if x != nil {
+		return x.BudgetItemId
+	}
+	return 0
+}
+
+func (x *CreateBudgetAlertRuleRequest) GetThresholds() []int32 {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *CreateBudgetAlertRuleRequest) GetChannels() []BudgetAlertChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *CreateBudgetAlertRuleRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+// CreateBudgetAlertRule response
+type CreateBudgetAlertRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *BudgetAlertRule `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string           `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *CreateBudgetAlertRuleResponse) Reset() {
+	*x = CreateBudgetAlertRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBudgetAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetAlertRuleResponse) ProtoMessage() {}
+
+func (x *CreateBudgetAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateBudgetAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateBudgetAlertRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateBudgetAlertRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateBudgetAlertRuleResponse) GetData() *BudgetAlertRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateBudgetAlertRuleResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// ListBudgetAlertRules request
+type ListBudgetAlertRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId int32 `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+}
+
+func (x *ListBudgetAlertRulesRequest) Reset() {
+	*x = ListBudgetAlertRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBudgetAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListBudgetAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBudgetAlertRulesRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+// ListBudgetAlertRules response
+type ListBudgetAlertRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rules     []*BudgetAlertRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Timestamp string             `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ListBudgetAlertRulesResponse) Reset() {
+	*x = ListBudgetAlertRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBudgetAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListBudgetAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListBudgetAlertRulesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListBudgetAlertRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListBudgetAlertRulesResponse) GetRules() []*BudgetAlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListBudgetAlertRulesResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// UpdateBudgetAlertRule request. Empty thresholds or channels keep the current ones.
+type UpdateBudgetAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId   int32                `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	RuleId     int32                `protobuf:"varint,2,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+	Thresholds []int32              `protobuf:"varint,3,rep,packed,name=thresholds,proto3" json:"thresholds,omitempty"`
+	Channels   []BudgetAlertChannel `protobuf:"varint,4,rep,packed,name=channels,proto3,enum=wealthjourney.budget.v1.BudgetAlertChannel" json:"channels,omitempty"`
+	WebhookUrl *string              `protobuf:"bytes,5,opt,name=webhookUrl,proto3,oneof" json:"webhookUrl,omitempty"`
+	Enabled    *bool                `protobuf:"varint,6,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+}
+
+func (x *UpdateBudgetAlertRuleRequest) Reset() {
+	*x = UpdateBudgetAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBudgetAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetAlertRuleRequest) ProtoMessage() {}
+
+func (x *UpdateBudgetAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateBudgetAlertRuleRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *UpdateBudgetAlertRuleRequest) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *UpdateBudgetAlertRuleRequest) GetThresholds() []int32 {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *UpdateBudgetAlertRuleRequest) GetChannels() []BudgetAlertChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *UpdateBudgetAlertRuleRequest) GetWebhookUrl() string {
+	if x != nil && x.WebhookUrl != nil {
+		return *x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *UpdateBudgetAlertRuleRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// UpdateBudgetAlertRule response
+type UpdateBudgetAlertRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *BudgetAlertRule `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string           `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *UpdateBudgetAlertRuleResponse) Reset() {
+	*x = UpdateBudgetAlertRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBudgetAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetAlertRuleResponse) ProtoMessage() {}
+
+func (x *UpdateBudgetAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateBudgetAlertRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateBudgetAlertRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateBudgetAlertRuleResponse) GetData() *BudgetAlertRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateBudgetAlertRuleResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// DeleteBudgetAlertRule request
+type DeleteBudgetAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId int32 `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	RuleId   int32 `protobuf:"varint,2,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+}
+
+func (x *DeleteBudgetAlertRuleRequest) Reset() {
+	*x = DeleteBudgetAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBudgetAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteBudgetAlertRuleRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *DeleteBudgetAlertRuleRequest) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+// DeleteBudgetAlertRule response
+type DeleteBudgetAlertRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *DeleteBudgetAlertRuleResponse) Reset() {
+	*x = DeleteBudgetAlertRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBudgetAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteBudgetAlertRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteBudgetAlertRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteBudgetAlertRuleResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// Allocation and actual spending of a budget item in one month. Variance is the
+// allocation minus the spending, negative when the item is over budget.
+type BudgetVarianceRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetItemId int32   `protobuf:"varint,1,opt,name=budgetItemId,proto3" json:"budgetItemId,omitempty"`
+	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MonthStart   int64   `protobuf:"varint,3,opt,name=monthStart,proto3" json:"monthStart,omitempty"`
+	Allocated    *Money  `protobuf:"bytes,4,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Actual       *Money  `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	Variance     *Money  `protobuf:"bytes,6,opt,name=variance,proto3" json:"variance,omitempty"`
+	PercentUsed  float64 `protobuf:"fixed64,7,opt,name=percentUsed,proto3" json:"percentUsed,omitempty"`
+}
+
+func (x *BudgetVarianceRow) Reset() {
+	*x = BudgetVarianceRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetVarianceRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetVarianceRow) ProtoMessage() {}
+
+func (x *BudgetVarianceRow) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetVarianceRow.ProtoReflect.Descriptor instead.
+func (*BudgetVarianceRow) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BudgetVarianceRow) GetBudgetItemId() int32 {
+	if x != nil {
+		return x.BudgetItemId
+	}
+	return 0
+}
+
+func (x *BudgetVarianceRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BudgetVarianceRow) GetMonthStart() int64 {
+	if x != nil {
+		return x.MonthStart
+	}
+	return 0
+}
+
+func (x *BudgetVarianceRow) GetAllocated() *Money {
+	if x != nil {
+		return x.Allocated
+	}
+	return nil
+}
+
+func (x *BudgetVarianceRow) GetActual() *Money {
+	if x != nil {
+		return x.Actual
+	}
+	return nil
+}
+
+func (x *BudgetVarianceRow) GetVariance() *Money {
+	if x != nil {
+		return x.Variance
+	}
+	return nil
+}
+
+func (x *BudgetVarianceRow) GetPercentUsed() float64 {
+	if x != nil {
+		return x.PercentUsed
+	}
+	return 0
+}
+
+// Spending in one month on an expense category no budget item tracks
+type UnbudgetedSpending struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MonthStart   int64  `protobuf:"varint,1,opt,name=monthStart,proto3" json:"monthStart,omitempty"`
+	CategoryId   int32  `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	CategoryName string `protobuf:"bytes,3,opt,name=categoryName,proto3" json:"categoryName,omitempty"`
+	Actual       *Money `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty"`
+}
+
+func (x *UnbudgetedSpending) Reset() {
+	*x = UnbudgetedSpending{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbudgetedSpending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbudgetedSpending) ProtoMessage() {}
+
+func (x *UnbudgetedSpending) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbudgetedSpending.ProtoReflect.Descriptor instead.
+func (*UnbudgetedSpending) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnbudgetedSpending) GetMonthStart() int64 {
+	if x != nil {
+		return x.MonthStart
+	}
+	return 0
+}
+
+func (x *UnbudgetedSpending) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UnbudgetedSpending) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *UnbudgetedSpending) GetActual() *Money {
+	if x != nil {
+		return x.Actual
+	}
+	return nil
+}
+
+// Totals of a budget in one month. Unbudgeted spending is not part of the variance.
+type BudgetVarianceMonth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MonthStart int64  `protobuf:"varint,1,opt,name=monthStart,proto3" json:"monthStart,omitempty"`
+	Allocated  *Money `protobuf:"bytes,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Actual     *Money `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	Variance   *Money `protobuf:"bytes,4,opt,name=variance,proto3" json:"variance,omitempty"`
+	Unbudgeted *Money `protobuf:"bytes,5,opt,name=unbudgeted,proto3" json:"unbudgeted,omitempty"`
+}
+
+func (x *BudgetVarianceMonth) Reset() {
+	*x = BudgetVarianceMonth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetVarianceMonth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetVarianceMonth) ProtoMessage() {}
+
+func (x *BudgetVarianceMonth) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetVarianceMonth.ProtoReflect.Descriptor instead.
+func (*BudgetVarianceMonth) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BudgetVarianceMonth) GetMonthStart() int64 {
+	if x != nil {
+		return x.MonthStart
+	}
+	return 0
+}
+
+func (x *BudgetVarianceMonth) GetAllocated() *Money {
+	if x != nil {
+		return x.Allocated
+	}
+	return nil
+}
+
+func (x *BudgetVarianceMonth) GetActual() *Money {
+	if x != nil {
+		return x.Actual
+	}
+	return nil
+}
+
+func (x *BudgetVarianceMonth) GetVariance() *Money {
+	if x != nil {
+		return x.Variance
+	}
+	return nil
+}
+
+func (x *BudgetVarianceMonth) GetUnbudgeted() *Money {
+	if x != nil {
+		return x.Unbudgeted
+	}
+	return nil
+}
+
+// GetBudgetVarianceReport request
+type GetBudgetVarianceReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId          int32 `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	StartMonth        int64 `protobuf:"varint,2,opt,name=startMonth,proto3" json:"startMonth,omitempty"`               // Any time in the first month, defaults to five months before endMonth
+	EndMonth          int64 `protobuf:"varint,3,opt,name=endMonth,proto3" json:"endMonth,omitempty"`                   // Any time in the last month, defaults to now
+	PreferredCurrency bool  `protobuf:"varint,4,opt,name=preferredCurrency,proto3" json:"preferredCurrency,omitempty"` // Report in the user's preferred currency instead of the budget currency
+}
+
+func (x *GetBudgetVarianceReportRequest) Reset() {
+	*x = GetBudgetVarianceReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBudgetVarianceReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetVarianceReportRequest) ProtoMessage() {}
+
+func (x *GetBudgetVarianceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetVarianceReportRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetVarianceReportRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetBudgetVarianceReportRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *GetBudgetVarianceReportRequest) GetStartMonth() int64 {
+	if x != nil {
+		return x.StartMonth
+	}
+	return 0
+}
+
+func (x *GetBudgetVarianceReportRequest) GetEndMonth() int64 {
+	if x != nil {
+		return x.EndMonth
+	}
+	return 0
+}
+
+func (x *GetBudgetVarianceReportRequest) GetPreferredCurrency() bool {
+	if x != nil {
+		return x.PreferredCurrency
+	}
+	return false
+}
+
+// GetBudgetVarianceReport response, rows ordered by month then by item
+type GetBudgetVarianceReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Currency   string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Rows       []*BudgetVarianceRow   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	Unbudgeted []*UnbudgetedSpending  `protobuf:"bytes,5,rep,name=unbudgeted,proto3" json:"unbudgeted,omitempty"`
+	Months     []*BudgetVarianceMonth `protobuf:"bytes,6,rep,name=months,proto3" json:"months,omitempty"`
+	Timestamp  string                 `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetBudgetVarianceReportResponse) Reset() {
+	*x = GetBudgetVarianceReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBudgetVarianceReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetVarianceReportResponse) ProtoMessage() {}
+
+func (x *GetBudgetVarianceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetVarianceReportResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetVarianceReportResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetBudgetVarianceReportResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetBudgetVarianceReportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetBudgetVarianceReportResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetBudgetVarianceReportResponse) GetRows() []*BudgetVarianceRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *GetBudgetVarianceReportResponse) GetUnbudgeted() []*UnbudgetedSpending {
+	if x != nil {
+		return x.Unbudgeted
+	}
+	return nil
+}
+
+func (x *GetBudgetVarianceReportResponse) GetMonths() []*BudgetVarianceMonth {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *GetBudgetVarianceReportResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// How the allocations of a copied budget or applied template are set
+type BudgetAllocationOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Allocate to each category-linked item its average monthly spending over the three
+	// months before the new period. Items without spending keep their allocation.
+	SeedFromSpending bool    `protobuf:"varint,1,opt,name=seedFromSpending,proto3" json:"seedFromSpending,omitempty"`
+	AdjustPercent    float64 `protobuf:"fixed64,2,opt,name=adjustPercent,proto3" json:"adjustPercent,omitempty"` // Applied last, e.g. 10 adds 10% and -5 removes 5%
+}
+
+func (x *BudgetAllocationOptions) Reset() {
+	*x = BudgetAllocationOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetAllocationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetAllocationOptions) ProtoMessage() {}
+
+func (x *BudgetAllocationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetAllocationOptions.ProtoReflect.Descriptor instead.
+func (*BudgetAllocationOptions) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BudgetAllocationOptions) GetSeedFromSpending() bool {
+	if x != nil {
+		return x.SeedFromSpending
+	}
+	return false
+}
+
+func (x *BudgetAllocationOptions) GetAdjustPercent() float64 {
+	if x != nil {
+		return x.AdjustPercent
+	}
+	return 0
+}
+
+// An item of a budget template
+type BudgetTemplateItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Total          *Money               `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	CategoryIds    []int32              `protobuf:"varint,3,rep,packed,name=categoryIds,proto3" json:"categoryIds,omitempty"`
+	WalletIds      []int32              `protobuf:"varint,4,rep,packed,name=walletIds,proto3" json:"walletIds,omitempty"`
+	RolloverPolicy BudgetRolloverPolicy `protobuf:"varint,5,opt,name=rolloverPolicy,proto3,enum=wealthjourney.budget.v1.BudgetRolloverPolicy" json:"rolloverPolicy,omitempty"`
+	RolloverCap    *Money               `protobuf:"bytes,6,opt,name=rolloverCap,proto3" json:"rolloverCap,omitempty"`
+}
+
+func (x *BudgetTemplateItem) Reset() {
+	*x = BudgetTemplateItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetTemplateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetTemplateItem) ProtoMessage() {}
+
+func (x *BudgetTemplateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetTemplateItem.ProtoReflect.Descriptor instead.
+func (*BudgetTemplateItem) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BudgetTemplateItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BudgetTemplateItem) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *BudgetTemplateItem) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *BudgetTemplateItem) GetWalletIds() []int32 {
+	if x != nil {
+		return x.WalletIds
+	}
+	return nil
+}
+
+func (x *BudgetTemplateItem) GetRolloverPolicy() BudgetRolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return BudgetRolloverPolicy_BUDGET_ROLLOVER_POLICY_NONE
+}
+
+func (x *BudgetTemplateItem) GetRolloverCap() *Money {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return nil
+}
+
+// A reusable budget plan. Templates with a custom period keep no dates; they are given
+// when the template is applied.
+type BudgetTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Total     *Money                `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Period    BudgetPeriod          `protobuf:"varint,4,opt,name=period,proto3,enum=wealthjourney.budget.v1.BudgetPeriod" json:"period,omitempty"`
+	Items     []*BudgetTemplateItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt int64                 `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64                 `protobuf:"varint,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *BudgetTemplate) Reset() {
+	*x = BudgetTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetTemplate) ProtoMessage() {}
+
+func (x *BudgetTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetTemplate.ProtoReflect.Descriptor instead.
+func (*BudgetTemplate) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BudgetTemplate) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BudgetTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BudgetTemplate) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *BudgetTemplate) GetPeriod() BudgetPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+func (x *BudgetTemplate) GetItems() []*BudgetTemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BudgetTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *BudgetTemplate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// CopyBudgetToPeriod request. With dates, the copy gets a custom period. Without, the
+// copy gets a custom period for the period that follows: the next period of the same
+// length for a custom budget, the next month or week for a monthly or weekly one.
+type CopyBudgetToPeriodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId   int32                    `protobuf:"varint,1,opt,name=budgetId,proto3" json:"budgetId,omitempty"`
+	Name       string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Defaults to the name of the budget
+	StartDate  int64                    `protobuf:"varint,3,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate    int64                    `protobuf:"varint,4,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Allocation *BudgetAllocationOptions `protobuf:"bytes,5,opt,name=allocation,proto3" json:"allocation,omitempty"`
+}
+
+func (x *CopyBudgetToPeriodRequest) Reset() {
+	*x = CopyBudgetToPeriodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyBudgetToPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyBudgetToPeriodRequest) ProtoMessage() {}
+
+func (x *CopyBudgetToPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyBudgetToPeriodRequest.ProtoReflect.Descriptor instead.
+func (*CopyBudgetToPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CopyBudgetToPeriodRequest) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *CopyBudgetToPeriodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CopyBudgetToPeriodRequest) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *CopyBudgetToPeriodRequest) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *CopyBudgetToPeriodRequest) GetAllocation() *BudgetAllocationOptions {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+// CopyBudgetToPeriod response
+type CopyBudgetToPeriodResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *Budget `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string  `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *CopyBudgetToPeriodResponse) Reset() {
+	*x = CopyBudgetToPeriodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyBudgetToPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyBudgetToPeriodResponse) ProtoMessage() {}
+
+func (x *CopyBudgetToPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyBudgetToPeriodResponse.ProtoReflect.Descriptor instead.
+func (*CopyBudgetToPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CopyBudgetToPeriodResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CopyBudgetToPeriodResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CopyBudgetToPeriodResponse) GetData() *Budget {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CopyBudgetToPeriodResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// CreateBudgetTemplate request. With sourceBudgetId, the total, period and items are
+// taken from that budget and the name defaults to its name; items must then be empty.
+type CreateBudgetTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SourceBudgetId int32                 `protobuf:"varint,2,opt,name=sourceBudgetId,proto3" json:"sourceBudgetId,omitempty"`
+	Total          *Money                `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Period         BudgetPeriod          `protobuf:"varint,4,opt,name=period,proto3,enum=wealthjourney.budget.v1.BudgetPeriod" json:"period,omitempty"`
+	Items          []*BudgetTemplateItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CreateBudgetTemplateRequest) Reset() {
+	*x = CreateBudgetTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBudgetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetTemplateRequest) ProtoMessage() {}
+
+func (x *CreateBudgetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateBudgetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateBudgetTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBudgetTemplateRequest) GetSourceBudgetId() int32 {
+	if x != nil {
+		return x.SourceBudgetId
+	}
+	return 0
+}
+
+func (x *CreateBudgetTemplateRequest) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *CreateBudgetTemplateRequest) GetPeriod() BudgetPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+func (x *CreateBudgetTemplateRequest) GetItems() []*BudgetTemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// CreateBudgetTemplate response
+type CreateBudgetTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *BudgetTemplate `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string          `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *CreateBudgetTemplateResponse) Reset() {
+	*x = CreateBudgetTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBudgetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetTemplateResponse) ProtoMessage() {}
+
+func (x *CreateBudgetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateBudgetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateBudgetTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateBudgetTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateBudgetTemplateResponse) GetData() *BudgetTemplate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateBudgetTemplateResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// ListBudgetTemplates request
+type ListBudgetTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBudgetTemplatesRequest) Reset() {
+	*x = ListBudgetTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBudgetTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetTemplatesRequest) ProtoMessage() {}
+
+func (x *ListBudgetTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{49}
+}
+
+// ListBudgetTemplates response, ordered by name
+type ListBudgetTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Templates []*BudgetTemplate `protobuf:"bytes,3,rep,name=templates,proto3" json:"templates,omitempty"`
+	Timestamp string            `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ListBudgetTemplatesResponse) Reset() {
+	*x = ListBudgetTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBudgetTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetTemplatesResponse) ProtoMessage() {}
+
+func (x *ListBudgetTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListBudgetTemplatesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListBudgetTemplatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListBudgetTemplatesResponse) GetTemplates() []*BudgetTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListBudgetTemplatesResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// GetBudgetTemplate request
+type GetBudgetTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateId int32 `protobuf:"varint,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+}
+
+func (x *GetBudgetTemplateRequest) Reset() {
+	*x = GetBudgetTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBudgetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetTemplateRequest) ProtoMessage() {}
+
+func (x *GetBudgetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetBudgetTemplateRequest) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+// GetBudgetTemplate response
+type GetBudgetTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *BudgetTemplate `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string          `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetBudgetTemplateResponse) Reset() {
+	*x = GetBudgetTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBudgetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetTemplateResponse) ProtoMessage() {}
+
+func (x *GetBudgetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetBudgetTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetBudgetTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetBudgetTemplateResponse) GetData() *BudgetTemplate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetBudgetTemplateResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// DeleteBudgetTemplate request
+type DeleteBudgetTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateId int32 `protobuf:"varint,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+}
+
+func (x *DeleteBudgetTemplateRequest) Reset() {
+	*x = DeleteBudgetTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBudgetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteBudgetTemplateRequest) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+// DeleteBudgetTemplate response
+type DeleteBudgetTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *DeleteBudgetTemplateResponse) Reset() {
+	*x = DeleteBudgetTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBudgetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteBudgetTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteBudgetTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteBudgetTemplateResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// ApplyBudgetTemplate request. Dates are required for templates with a custom period
+// and turn other templates into a custom budget.
+type ApplyBudgetTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateId int32                    `protobuf:"varint,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+	Name       string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Defaults to the name of the template
+	StartDate  int64                    `protobuf:"varint,3,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate    int64                    `protobuf:"varint,4,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Allocation *BudgetAllocationOptions `protobuf:"bytes,5,opt,name=allocation,proto3" json:"allocation,omitempty"`
+}
+
+func (x *ApplyBudgetTemplateRequest) Reset() {
+	*x = ApplyBudgetTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBudgetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBudgetTemplateRequest) ProtoMessage() {}
+
+func (x *ApplyBudgetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBudgetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ApplyBudgetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ApplyBudgetTemplateRequest) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *ApplyBudgetTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyBudgetTemplateRequest) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *ApplyBudgetTemplateRequest) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *ApplyBudgetTemplateRequest) GetAllocation() *BudgetAllocationOptions {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+// ApplyBudgetTemplate response
+type ApplyBudgetTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *Budget `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string  `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ApplyBudgetTemplateResponse) Reset() {
+	*x = ApplyBudgetTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_budget_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBudgetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBudgetTemplateResponse) ProtoMessage() {}
+
+func (x *ApplyBudgetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_budget_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBudgetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ApplyBudgetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_budget_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ApplyBudgetTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyBudgetTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApplyBudgetTemplateResponse) GetData() *Budget {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ApplyBudgetTemplateResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+var File_protobuf_v1_budget_proto protoreflect.FileDescriptor
+
+var file_protobuf_v1_budget_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x1a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x05, 0x0a, 0x06,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3d,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x45, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x22, 0xd1, 0x05, 0x0a, 0x0a,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x34, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x40, 0x0a,
+	0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x22,
+	0x2e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22,
+	0x5f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x9e, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x46, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0xa6, 0x02, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x42, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x48, 0x00, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x31, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x33, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x22, 0xd8, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34,
 	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
 	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0xb1, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74,
-	0x65, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0xed, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x39, 0x0a, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67,
-	0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x68, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75,
-	0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa5,
-	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x77,
 	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa5, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x22, 0xf0, 0x03,
+	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12,
+	0x5a, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x4d, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22,
+	0x9a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xed, 0x01, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x9d, 0x01, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x9d, 0x01, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x68, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
 	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa5,
-	0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
 	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
 	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
 	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
@@ -1600,102 +4869,750 @@ var file_protobuf_v1_budget_proto_rawDesc = []byte{
 	0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74,
 	0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6c, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa5, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6c,
+	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x89, 0x04, 0x0a,
+	0x0e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64,
+	0x49, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65,
+	0x64, 0x49, 0x6e, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x34, 0x0a,
+	0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x55, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x80, 0x02, 0x0a, 0x0a, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4b, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52,
+	0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xbc, 0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x76,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x74, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x17, 0x4d, 0x6f, 0x76, 0x65,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x48,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f,
+	0x76, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0xc0, 0x02, 0x0a, 0x0f, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x22, 0xaf,
+	0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x39, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x1c,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x3e, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x9a,
+	0x02, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x1d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x52, 0x0a,
+	0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0x71, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x32, 0xef, 0x0a, 0x0a, 0x0d, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x75,
-	0x64, 0x67, 0x65, 0x74, 0x12, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2a, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x12,
-	0x81, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12,
-	0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
-	0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x77,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0xbf, 0x02, 0x0a, 0x11, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x6f, 0x77, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x36, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x65, 0x64, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x22, 0xa7, 0x02, 0x0a, 0x13, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x36, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x75, 0x6e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x75, 0x6e, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x65, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e,
+	0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x2c,
+	0x0a, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xe2, 0x02, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x3e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x4b, 0x0a, 0x0a, 0x75, 0x6e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x65, 0x64, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x0a, 0x75, 0x6e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x65, 0x64, 0x12, 0x44, 0x0a,
+	0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x06, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x6b, 0x0a, 0x17, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x73, 0x65, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xb7,
+	0x02, 0x0a, 0x12, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12,
+	0x55, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x43, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x22, 0xa8, 0x02, 0x0a, 0x0e, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x41, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x70, 0x79, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x54, 0x6f, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x1a,
+	0x43, 0x6f, 0x70, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x91, 0x02, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x41, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3a, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xda, 0x01, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x50, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0x7c, 0x0a, 0x0c, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x55,
+	0x44, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x55, 0x44,
+	0x47, 0x45, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48,
+	0x4c, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x50,
+	0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f,
+	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x03, 0x2a, 0x86, 0x01, 0x0a, 0x14, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x4c,
+	0x4f, 0x56, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x4f, 0x4c,
+	0x4c, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x41, 0x52,
+	0x52, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x42, 0x55, 0x44, 0x47,
+	0x45, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x43, 0x41, 0x52, 0x52, 0x59, 0x5f, 0x43, 0x41, 0x50, 0x50, 0x45, 0x44, 0x10,
+	0x02, 0x2a, 0x9d, 0x01, 0x0a, 0x12, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x55, 0x44, 0x47,
+	0x45, 0x54, 0x5f, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f,
+	0x0a, 0x1b, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x10, 0x01, 0x12,
+	0x1e, 0x0a, 0x1a, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12,
+	0x20, 0x0a, 0x1c, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x10,
+	0x03, 0x32, 0xc3, 0x1e, 0x0a, 0x0d, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x12, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
+	0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x12, 0x81, 0x01, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x77,
 	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64,
 	0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67,
-	0x65, 0x74, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75,
-	0x64, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0x92, 0x01,
-	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x2c,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12,
+	0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73,
+	0x12, 0x87, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x12, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x3a, 0x01, 0x2a, 0x1a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x12,
+	0x8f, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x12, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
 	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x1a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
-	0x64, 0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64,
+	0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0xa4, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d,
+	0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x1a, 0x29, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x7d, 0x12, 0xaa, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
 	0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x49, 0x64, 0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67,
-	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
-	0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x49, 0x64, 0x7d, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x10, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30,
+	0x74, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x7d, 0x12, 0xab, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
 	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
-	0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x1a, 0x29,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0xab, 0x01, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x2f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f,
+	0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f,
+	0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12,
+	0xa8, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f,
+	0x76, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f,
+	0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f,
-	0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x7d, 0x12, 0xaa, 0x01, 0x0a, 0x10, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30,
+	0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d,
+	0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0xc2, 0x01, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a,
+	0x1a, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x7d, 0x12, 0xbf, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x35, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x31, 0x2a, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x7d, 0x12, 0xb9, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x37, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0xa9, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x70, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x6f,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x70, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54,
+	0x6f, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x70, 0x79, 0x12, 0xa8, 0x01, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2d, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x33,
 	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0xa9, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
 	0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75,
-	0x64, 0x67, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69,
-	0x74, 0x65, 0x6d, 0x49, 0x64, 0x7d, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x2a, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x7b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x7d, 0x12, 0xb8, 0x01, 0x0a,
+	0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x7d, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1710,74 +5627,201 @@ func file_protobuf_v1_budget_proto_rawDescGZIP() []byte {
 	return file_protobuf_v1_budget_proto_rawDescData
 }
 
-var file_protobuf_v1_budget_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_protobuf_v1_budget_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_protobuf_v1_budget_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_protobuf_v1_budget_proto_goTypes = []interface{}{
-	(*Budget)(nil),                   // 0: wealthjourney.budget.v1.Budget
-	(*BudgetItem)(nil),               // 1: wealthjourney.budget.v1.BudgetItem
-	(*GetBudgetRequest)(nil),         // 2: wealthjourney.budget.v1.GetBudgetRequest
-	(*ListBudgetsRequest)(nil),       // 3: wealthjourney.budget.v1.ListBudgetsRequest
-	(*CreateBudgetRequest)(nil),      // 4: wealthjourney.budget.v1.CreateBudgetRequest
-	(*UpdateBudgetRequest)(nil),      // 5: wealthjourney.budget.v1.UpdateBudgetRequest
-	(*DeleteBudgetRequest)(nil),      // 6: wealthjourney.budget.v1.DeleteBudgetRequest
-	(*GetBudgetItemsRequest)(nil),    // 7: wealthjourney.budget.v1.GetBudgetItemsRequest
-	(*CreateBudgetItemRequest)(nil),  // 8: wealthjourney.budget.v1.CreateBudgetItemRequest
-	(*UpdateBudgetItemRequest)(nil),  // 9: wealthjourney.budget.v1.UpdateBudgetItemRequest
-	(*DeleteBudgetItemRequest)(nil),  // 10: wealthjourney.budget.v1.DeleteBudgetItemRequest
-	(*GetBudgetResponse)(nil),        // 11: wealthjourney.budget.v1.GetBudgetResponse
-	(*ListBudgetsResponse)(nil),      // 12: wealthjourney.budget.v1.ListBudgetsResponse
-	(*CreateBudgetResponse)(nil),     // 13: wealthjourney.budget.v1.CreateBudgetResponse
-	(*UpdateBudgetResponse)(nil),     // 14: wealthjourney.budget.v1.UpdateBudgetResponse
-	(*DeleteBudgetResponse)(nil),     // 15: wealthjourney.budget.v1.DeleteBudgetResponse
-	(*GetBudgetItemsResponse)(nil),   // 16: wealthjourney.budget.v1.GetBudgetItemsResponse
-	(*CreateBudgetItemResponse)(nil), // 17: wealthjourney.budget.v1.CreateBudgetItemResponse
-	(*UpdateBudgetItemResponse)(nil), // 18: wealthjourney.budget.v1.UpdateBudgetItemResponse
-	(*DeleteBudgetItemResponse)(nil), // 19: wealthjourney.budget.v1.DeleteBudgetItemResponse
-	(*Money)(nil),                    // 20: wealthjourney.common.v1.Money
-	(*PaginationParams)(nil),         // 21: wealthjourney.common.v1.PaginationParams
-	(*PaginationResult)(nil),         // 22: wealthjourney.common.v1.PaginationResult
+	(BudgetPeriod)(0),                       // 0: wealthjourney.budget.v1.BudgetPeriod
+	(BudgetRolloverPolicy)(0),               // 1: wealthjourney.budget.v1.BudgetRolloverPolicy
+	(BudgetAlertChannel)(0),                 // 2: wealthjourney.budget.v1.BudgetAlertChannel
+	(*Budget)(nil),                          // 3: wealthjourney.budget.v1.Budget
+	(*BudgetItem)(nil),                      // 4: wealthjourney.budget.v1.BudgetItem
+	(*GetBudgetRequest)(nil),                // 5: wealthjourney.budget.v1.GetBudgetRequest
+	(*ListBudgetsRequest)(nil),              // 6: wealthjourney.budget.v1.ListBudgetsRequest
+	(*CreateBudgetRequest)(nil),             // 7: wealthjourney.budget.v1.CreateBudgetRequest
+	(*UpdateBudgetRequest)(nil),             // 8: wealthjourney.budget.v1.UpdateBudgetRequest
+	(*DeleteBudgetRequest)(nil),             // 9: wealthjourney.budget.v1.DeleteBudgetRequest
+	(*GetBudgetItemsRequest)(nil),           // 10: wealthjourney.budget.v1.GetBudgetItemsRequest
+	(*CreateBudgetItemRequest)(nil),         // 11: wealthjourney.budget.v1.CreateBudgetItemRequest
+	(*UpdateBudgetItemRequest)(nil),         // 12: wealthjourney.budget.v1.UpdateBudgetItemRequest
+	(*DeleteBudgetItemRequest)(nil),         // 13: wealthjourney.budget.v1.DeleteBudgetItemRequest
+	(*GetBudgetResponse)(nil),               // 14: wealthjourney.budget.v1.GetBudgetResponse
+	(*ListBudgetsResponse)(nil),             // 15: wealthjourney.budget.v1.ListBudgetsResponse
+	(*CreateBudgetResponse)(nil),            // 16: wealthjourney.budget.v1.CreateBudgetResponse
+	(*UpdateBudgetResponse)(nil),            // 17: wealthjourney.budget.v1.UpdateBudgetResponse
+	(*DeleteBudgetResponse)(nil),            // 18: wealthjourney.budget.v1.DeleteBudgetResponse
+	(*GetBudgetItemsResponse)(nil),          // 19: wealthjourney.budget.v1.GetBudgetItemsResponse
+	(*CreateBudgetItemResponse)(nil),        // 20: wealthjourney.budget.v1.CreateBudgetItemResponse
+	(*UpdateBudgetItemResponse)(nil),        // 21: wealthjourney.budget.v1.UpdateBudgetItemResponse
+	(*DeleteBudgetItemResponse)(nil),        // 22: wealthjourney.budget.v1.DeleteBudgetItemResponse
+	(*BudgetEnvelope)(nil),                  // 23: wealthjourney.budget.v1.BudgetEnvelope
+	(*BudgetMove)(nil),                      // 24: wealthjourney.budget.v1.BudgetMove
+	(*GetBudgetEnvelopesRequest)(nil),       // 25: wealthjourney.budget.v1.GetBudgetEnvelopesRequest
+	(*GetBudgetEnvelopesResponse)(nil),      // 26: wealthjourney.budget.v1.GetBudgetEnvelopesResponse
+	(*MoveBudgetMoneyRequest)(nil),          // 27: wealthjourney.budget.v1.MoveBudgetMoneyRequest
+	(*MoveBudgetMoneyResponse)(nil),         // 28: wealthjourney.budget.v1.MoveBudgetMoneyResponse
+	(*ListBudgetMovesRequest)(nil),          // 29: wealthjourney.budget.v1.ListBudgetMovesRequest
+	(*ListBudgetMovesResponse)(nil),         // 30: wealthjourney.budget.v1.ListBudgetMovesResponse
+	(*BudgetAlertRule)(nil),                 // 31: wealthjourney.budget.v1.BudgetAlertRule
+	(*CreateBudgetAlertRuleRequest)(nil),    // 32: wealthjourney.budget.v1.CreateBudgetAlertRuleRequest
+	(*CreateBudgetAlertRuleResponse)(nil),   // 33: wealthjourney.budget.v1.CreateBudgetAlertRuleResponse
+	(*ListBudgetAlertRulesRequest)(nil),     // 34: wealthjourney.budget.v1.ListBudgetAlertRulesRequest
+	(*ListBudgetAlertRulesResponse)(nil),    // 35: wealthjourney.budget.v1.ListBudgetAlertRulesResponse
+	(*UpdateBudgetAlertRuleRequest)(nil),    // 36: wealthjourney.budget.v1.UpdateBudgetAlertRuleRequest
+	(*UpdateBudgetAlertRuleResponse)(nil),   // 37: wealthjourney.budget.v1.UpdateBudgetAlertRuleResponse
+	(*DeleteBudgetAlertRuleRequest)(nil),    // 38: wealthjourney.budget.v1.DeleteBudgetAlertRuleRequest
+	(*DeleteBudgetAlertRuleResponse)(nil),   // 39: wealthjourney.budget.v1.DeleteBudgetAlertRuleResponse
+	(*BudgetVarianceRow)(nil),               // 40: wealthjourney.budget.v1.BudgetVarianceRow
+	(*UnbudgetedSpending)(nil),              // 41: wealthjourney.budget.v1.UnbudgetedSpending
+	(*BudgetVarianceMonth)(nil),             // 42: wealthjourney.budget.v1.BudgetVarianceMonth
+	(*GetBudgetVarianceReportRequest)(nil),  // 43: wealthjourney.budget.v1.GetBudgetVarianceReportRequest
+	(*GetBudgetVarianceReportResponse)(nil), // 44: wealthjourney.budget.v1.GetBudgetVarianceReportResponse
+	(*BudgetAllocationOptions)(nil),         // 45: wealthjourney.budget.v1.BudgetAllocationOptions
+	(*BudgetTemplateItem)(nil),              // 46: wealthjourney.budget.v1.BudgetTemplateItem
+	(*BudgetTemplate)(nil),                  // 47: wealthjourney.budget.v1.BudgetTemplate
+	(*CopyBudgetToPeriodRequest)(nil),       // 48: wealthjourney.budget.v1.CopyBudgetToPeriodRequest
+	(*CopyBudgetToPeriodResponse)(nil),      // 49: wealthjourney.budget.v1.CopyBudgetToPeriodResponse
+	(*CreateBudgetTemplateRequest)(nil),     // 50: wealthjourney.budget.v1.CreateBudgetTemplateRequest
+	(*CreateBudgetTemplateResponse)(nil),    // 51: wealthjourney.budget.v1.CreateBudgetTemplateResponse
+	(*ListBudgetTemplatesRequest)(nil),      // 52: wealthjourney.budget.v1.ListBudgetTemplatesRequest
+	(*ListBudgetTemplatesResponse)(nil),     // 53: wealthjourney.budget.v1.ListBudgetTemplatesResponse
+	(*GetBudgetTemplateRequest)(nil),        // 54: wealthjourney.budget.v1.GetBudgetTemplateRequest
+	(*GetBudgetTemplateResponse)(nil),       // 55: wealthjourney.budget.v1.GetBudgetTemplateResponse
+	(*DeleteBudgetTemplateRequest)(nil),     // 56: wealthjourney.budget.v1.DeleteBudgetTemplateRequest
+	(*DeleteBudgetTemplateResponse)(nil),    // 57: wealthjourney.budget.v1.DeleteBudgetTemplateResponse
+	(*ApplyBudgetTemplateRequest)(nil),      // 58: wealthjourney.budget.v1.ApplyBudgetTemplateRequest
+	(*ApplyBudgetTemplateResponse)(nil),     // 59: wealthjourney.budget.v1.ApplyBudgetTemplateResponse
+	(*Money)(nil),                           // 60: wealthjourney.common.v1.Money
+	(*PaginationParams)(nil),                // 61: wealthjourney.common.v1.PaginationParams
+	(*PaginationResult)(nil),                // 62: wealthjourney.common.v1.PaginationResult
 }
 var file_protobuf_v1_budget_proto_depIdxs = []int32{
-	20, // 0: wealthjourney.budget.v1.Budget.total:type_name -> wealthjourney.common.v1.Money
-	20, // 1: wealthjourney.budget.v1.Budget.displayTotal:type_name -> wealthjourney.common.v1.Money
-	20, // 2: wealthjourney.budget.v1.BudgetItem.total:type_name -> wealthjourney.common.v1.Money
-	20, // 3: wealthjourney.budget.v1.BudgetItem.displayTotal:type_name -> wealthjourney.common.v1.Money
-	21, // 4: wealthjourney.budget.v1.ListBudgetsRequest.pagination:type_name -> wealthjourney.common.v1.PaginationParams
-	20, // 5: wealthjourney.budget.v1.CreateBudgetRequest.total:type_name -> wealthjourney.common.v1.Money
-	8,  // 6: wealthjourney.budget.v1.CreateBudgetRequest.items:type_name -> wealthjourney.budget.v1.CreateBudgetItemRequest
-	20, // 7: wealthjourney.budget.v1.UpdateBudgetRequest.total:type_name -> wealthjourney.common.v1.Money
-	20, // 8: wealthjourney.budget.v1.CreateBudgetItemRequest.total:type_name -> wealthjourney.common.v1.Money
-	20, // 9: wealthjourney.budget.v1.UpdateBudgetItemRequest.total:type_name -> wealthjourney.common.v1.Money
-	0,  // 10: wealthjourney.budget.v1.GetBudgetResponse.data:type_name -> wealthjourney.budget.v1.Budget
-	0,  // 11: wealthjourney.budget.v1.ListBudgetsResponse.budgets:type_name -> wealthjourney.budget.v1.Budget
-	22, // 12: wealthjourney.budget.v1.ListBudgetsResponse.pagination:type_name -> wealthjourney.common.v1.PaginationResult
-	0,  // 13: wealthjourney.budget.v1.CreateBudgetResponse.data:type_name -> wealthjourney.budget.v1.Budget
-	0,  // 14: wealthjourney.budget.v1.UpdateBudgetResponse.data:type_name -> wealthjourney.budget.v1.Budget
-	1,  // 15: wealthjourney.budget.v1.GetBudgetItemsResponse.items:type_name -> wealthjourney.budget.v1.BudgetItem
-	1,  // 16: wealthjourney.budget.v1.CreateBudgetItemResponse.data:type_name -> wealthjourney.budget.v1.BudgetItem
-	1,  // 17: wealthjourney.budget.v1.UpdateBudgetItemResponse.data:type_name -> wealthjourney.budget.v1.BudgetItem
-	2,  // 18: wealthjourney.budget.v1.BudgetService.GetBudget:input_type -> wealthjourney.budget.v1.GetBudgetRequest
-	3,  // 19: wealthjourney.budget.v1.BudgetService.ListBudgets:input_type -> wealthjourney.budget.v1.ListBudgetsRequest
-	4,  // 20: wealthjourney.budget.v1.BudgetService.CreateBudget:input_type -> wealthjourney.budget.v1.CreateBudgetRequest
-	5,  // 21: wealthjourney.budget.v1.BudgetService.UpdateBudget:input_type -> wealthjourney.budget.v1.UpdateBudgetRequest
-	6,  // 22: wealthjourney.budget.v1.BudgetService.DeleteBudget:input_type -> wealthjourney.budget.v1.DeleteBudgetRequest
-	7,  // 23: wealthjourney.budget.v1.BudgetService.GetBudgetItems:input_type -> wealthjourney.budget.v1.GetBudgetItemsRequest
-	8,  // 24: wealthjourney.budget.v1.BudgetService.CreateBudgetItem:input_type -> wealthjourney.budget.v1.CreateBudgetItemRequest
-	9,  // 25: wealthjourney.budget.v1.BudgetService.UpdateBudgetItem:input_type -> wealthjourney.budget.v1.UpdateBudgetItemRequest
-	10, // 26: wealthjourney.budget.v1.BudgetService.DeleteBudgetItem:input_type -> wealthjourney.budget.v1.DeleteBudgetItemRequest
-	11, // 27: wealthjourney.budget.v1.BudgetService.GetBudget:output_type -> wealthjourney.budget.v1.GetBudgetResponse
-	12, // 28: wealthjourney.budget.v1.BudgetService.ListBudgets:output_type -> wealthjourney.budget.v1.ListBudgetsResponse
-	13, // 29: wealthjourney.budget.v1.BudgetService.CreateBudget:output_type -> wealthjourney.budget.v1.CreateBudgetResponse
-	14, // 30: wealthjourney.budget.v1.BudgetService.UpdateBudget:output_type -> wealthjourney.budget.v1.UpdateBudgetResponse
-	15, // 31: wealthjourney.budget.v1.BudgetService.DeleteBudget:output_type -> wealthjourney.budget.v1.DeleteBudgetResponse
-	16, // 32: wealthjourney.budget.v1.BudgetService.GetBudgetItems:output_type -> wealthjourney.budget.v1.GetBudgetItemsResponse
-	17, // 33: wealthjourney.budget.v1.BudgetService.CreateBudgetItem:output_type -> wealthjourney.budget.v1.CreateBudgetItemResponse
-	18, // 34: wealthjourney.budget.v1.BudgetService.UpdateBudgetItem:output_type -> wealthjourney.budget.v1.UpdateBudgetItemResponse
-	19, // 35: wealthjourney.budget.v1.BudgetService.DeleteBudgetItem:output_type -> wealthjourney.budget.v1.DeleteBudgetItemResponse
-	27, // [27:36] is the sub-list for method output_type
-	18, // [18:27] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	60, // 0: wealthjourney.budget.v1.Budget.total:type_name -> wealthjourney.common.v1.Money
+	60, // 1: wealthjourney.budget.v1.Budget.displayTotal:type_name -> wealthjourney.common.v1.Money
+	0,  // 2: wealthjourney.budget.v1.Budget.period:type_name -> wealthjourney.budget.v1.BudgetPeriod
+	60, // 3: wealthjourney.budget.v1.Budget.spent:type_name -> wealthjourney.common.v1.Money
+	60, // 4: wealthjourney.budget.v1.Budget.remaining:type_name -> wealthjourney.common.v1.Money
+	60, // 5: wealthjourney.budget.v1.BudgetItem.total:type_name -> wealthjourney.common.v1.Money
+	60, // 6: wealthjourney.budget.v1.BudgetItem.displayTotal:type_name -> wealthjourney.common.v1.Money
+	60, // 7: wealthjourney.budget.v1.BudgetItem.spent:type_name -> wealthjourney.common.v1.Money
+	60, // 8: wealthjourney.budget.v1.BudgetItem.remaining:type_name -> wealthjourney.common.v1.Money
+	1,  // 9: wealthjourney.budget.v1.BudgetItem.rolloverPolicy:type_name -> wealthjourney.budget.v1.BudgetRolloverPolicy
+	60, // 10: wealthjourney.budget.v1.BudgetItem.rolloverCap:type_name -> wealthjourney.common.v1.Money
+	61, // 11: wealthjourney.budget.v1.ListBudgetsRequest.pagination:type_name -> wealthjourney.common.v1.PaginationParams
+	60, // 12: wealthjourney.budget.v1.CreateBudgetRequest.total:type_name -> wealthjourney.common.v1.Money
+	11, // 13: wealthjourney.budget.v1.CreateBudgetRequest.items:type_name -> wealthjourney.budget.v1.CreateBudgetItemRequest
+	0,  // 14: wealthjourney.budget.v1.CreateBudgetRequest.period:type_name -> wealthjourney.budget.v1.BudgetPeriod
+	60, // 15: wealthjourney.budget.v1.UpdateBudgetRequest.total:type_name -> wealthjourney.common.v1.Money
+	0,  // 16: wealthjourney.budget.v1.UpdateBudgetRequest.period:type_name -> wealthjourney.budget.v1.BudgetPeriod
+	60, // 17: wealthjourney.budget.v1.CreateBudgetItemRequest.total:type_name -> wealthjourney.common.v1.Money
+	1,  // 18: wealthjourney.budget.v1.CreateBudgetItemRequest.rolloverPolicy:type_name -> wealthjourney.budget.v1.BudgetRolloverPolicy
+	60, // 19: wealthjourney.budget.v1.CreateBudgetItemRequest.rolloverCap:type_name -> wealthjourney.common.v1.Money
+	60, // 20: wealthjourney.budget.v1.UpdateBudgetItemRequest.total:type_name -> wealthjourney.common.v1.Money
+	1,  // 21: wealthjourney.budget.v1.UpdateBudgetItemRequest.rolloverPolicy:type_name -> wealthjourney.budget.v1.BudgetRolloverPolicy
+	60, // 22: wealthjourney.budget.v1.UpdateBudgetItemRequest.rolloverCap:type_name -> wealthjourney.common.v1.Money
+	3,  // 23: wealthjourney.budget.v1.GetBudgetResponse.data:type_name -> wealthjourney.budget.v1.Budget
+	3,  // 24: wealthjourney.budget.v1.ListBudgetsResponse.budgets:type_name -> wealthjourney.budget.v1.Budget
+	62, // 25: wealthjourney.budget.v1.ListBudgetsResponse.pagination:type_name -> wealthjourney.common.v1.PaginationResult
+	3,  // 26: wealthjourney.budget.v1.CreateBudgetResponse.data:type_name -> wealthjourney.budget.v1.Budget
+	3,  // 27: wealthjourney.budget.v1.UpdateBudgetResponse.data:type_name -> wealthjourney.budget.v1.Budget
+	4,  // 28: wealthjourney.budget.v1.GetBudgetItemsResponse.items:type_name -> wealthjourney.budget.v1.BudgetItem
+	4,  // 29: wealthjourney.budget.v1.CreateBudgetItemResponse.data:type_name -> wealthjourney.budget.v1.BudgetItem
+	4,  // 30: wealthjourney.budget.v1.UpdateBudgetItemResponse.data:type_name -> wealthjourney.budget.v1.BudgetItem
+	60, // 31: wealthjourney.budget.v1.BudgetEnvelope.allocated:type_name -> wealthjourney.common.v1.Money
+	60, // 32: wealthjourney.budget.v1.BudgetEnvelope.carriedIn:type_name -> wealthjourney.common.v1.Money
+	60, // 33: wealthjourney.budget.v1.BudgetEnvelope.movedIn:type_name -> wealthjourney.common.v1.Money
+	60, // 34: wealthjourney.budget.v1.BudgetEnvelope.spent:type_name -> wealthjourney.common.v1.Money
+	60, // 35: wealthjourney.budget.v1.BudgetEnvelope.available:type_name -> wealthjourney.common.v1.Money
+	60, // 36: wealthjourney.budget.v1.BudgetEnvelope.carriedOut:type_name -> wealthjourney.common.v1.Money
+	1,  // 37: wealthjourney.budget.v1.BudgetEnvelope.rolloverPolicy:type_name -> wealthjourney.budget.v1.BudgetRolloverPolicy
+	60, // 38: wealthjourney.budget.v1.BudgetMove.amount:type_name -> wealthjourney.common.v1.Money
+	23, // 39: wealthjourney.budget.v1.GetBudgetEnvelopesResponse.envelopes:type_name -> wealthjourney.budget.v1.BudgetEnvelope
+	60, // 40: wealthjourney.budget.v1.MoveBudgetMoneyRequest.amount:type_name -> wealthjourney.common.v1.Money
+	24, // 41: wealthjourney.budget.v1.MoveBudgetMoneyResponse.data:type_name -> wealthjourney.budget.v1.BudgetMove
+	24, // 42: wealthjourney.budget.v1.ListBudgetMovesResponse.moves:type_name -> wealthjourney.budget.v1.BudgetMove
+	2,  // 43: wealthjourney.budget.v1.BudgetAlertRule.channels:type_name -> wealthjourney.budget.v1.BudgetAlertChannel
+	2,  // 44: wealthjourney.budget.v1.CreateBudgetAlertRuleRequest.channels:type_name -> wealthjourney.budget.v1.BudgetAlertChannel
+	31, // 45: wealthjourney.budget.v1.CreateBudgetAlertRuleResponse.data:type_name -> wealthjourney.budget.v1.BudgetAlertRule
+	31, // 46: wealthjourney.budget.v1.ListBudgetAlertRulesResponse.rules:type_name -> wealthjourney.budget.v1.BudgetAlertRule
+	2,  // 47: wealthjourney.budget.v1.UpdateBudgetAlertRuleRequest.channels:type_name -> wealthjourney.budget.v1.BudgetAlertChannel
+	31, // 48: wealthjourney.budget.v1.UpdateBudgetAlertRuleResponse.data:type_name -> wealthjourney.budget.v1.BudgetAlertRule
+	60, // 49: wealthjourney.budget.v1.BudgetVarianceRow.allocated:type_name -> wealthjourney.common.v1.Money
+	60, // 50: wealthjourney.budget.v1.BudgetVarianceRow.actual:type_name -> wealthjourney.common.v1.Money
+	60, // 51: wealthjourney.budget.v1.BudgetVarianceRow.variance:type_name -> wealthjourney.common.v1.Money
+	60, // 52: wealthjourney.budget.v1.UnbudgetedSpending.actual:type_name -> wealthjourney.common.v1.Money
+	60, // 53: wealthjourney.budget.v1.BudgetVarianceMonth.allocated:type_name -> wealthjourney.common.v1.Money
+	60, // 54: wealthjourney.budget.v1.BudgetVarianceMonth.actual:type_name -> wealthjourney.common.v1.Money
+	60, // 55: wealthjourney.budget.v1.BudgetVarianceMonth.variance:type_name -> wealthjourney.common.v1.Money
+	60, // 56: wealthjourney.budget.v1.BudgetVarianceMonth.unbudgeted:type_name -> wealthjourney.common.v1.Money
+	40, // 57: wealthjourney.budget.v1.GetBudgetVarianceReportResponse.rows:type_name -> wealthjourney.budget.v1.BudgetVarianceRow
+	41, // 58: wealthjourney.budget.v1.GetBudgetVarianceReportResponse.unbudgeted:type_name -> wealthjourney.budget.v1.UnbudgetedSpending
+	42, // 59: wealthjourney.budget.v1.GetBudgetVarianceReportResponse.months:type_name -> wealthjourney.budget.v1.BudgetVarianceMonth
+	60, // 60: wealthjourney.budget.v1.BudgetTemplateItem.total:type_name -> wealthjourney.common.v1.Money
+	1,  // 61: wealthjourney.budget.v1.BudgetTemplateItem.rolloverPolicy:type_name -> wealthjourney.budget.v1.BudgetRolloverPolicy
+	60, // 62: wealthjourney.budget.v1.BudgetTemplateItem.rolloverCap:type_name -> wealthjourney.common.v1.Money
+	60, // 63: wealthjourney.budget.v1.BudgetTemplate.total:type_name -> wealthjourney.common.v1.Money
+	0,  // 64: wealthjourney.budget.v1.BudgetTemplate.period:type_name -> wealthjourney.budget.v1.BudgetPeriod
+	46, // 65: wealthjourney.budget.v1.BudgetTemplate.items:type_name -> wealthjourney.budget.v1.BudgetTemplateItem
+	45, // 66: wealthjourney.budget.v1.CopyBudgetToPeriodRequest.allocation:type_name -> wealthjourney.budget.v1.BudgetAllocationOptions
+	3,  // 67: wealthjourney.budget.v1.CopyBudgetToPeriodResponse.data:type_name -> wealthjourney.budget.v1.Budget
+	60, // 68: wealthjourney.budget.v1.CreateBudgetTemplateRequest.total:type_name -> wealthjourney.common.v1.Money
+	0,  // 69: wealthjourney.budget.v1.CreateBudgetTemplateRequest.period:type_name -> wealthjourney.budget.v1.BudgetPeriod
+	46, // 70: wealthjourney.budget.v1.CreateBudgetTemplateRequest.items:type_name -> wealthjourney.budget.v1.BudgetTemplateItem
+	47, // 71: wealthjourney.budget.v1.CreateBudgetTemplateResponse.data:type_name -> wealthjourney.budget.v1.BudgetTemplate
+	47, // 72: wealthjourney.budget.v1.ListBudgetTemplatesResponse.templates:type_name -> wealthjourney.budget.v1.BudgetTemplate
+	47, // 73: wealthjourney.budget.v1.GetBudgetTemplateResponse.data:type_name -> wealthjourney.budget.v1.BudgetTemplate
+	45, // 74: wealthjourney.budget.v1.ApplyBudgetTemplateRequest.allocation:type_name -> wealthjourney.budget.v1.BudgetAllocationOptions
+	3,  // 75: wealthjourney.budget.v1.ApplyBudgetTemplateResponse.data:type_name -> wealthjourney.budget.v1.Budget
+	5,  // 76: wealthjourney.budget.v1.BudgetService.GetBudget:input_type -> wealthjourney.budget.v1.GetBudgetRequest
+	6,  // 77: wealthjourney.budget.v1.BudgetService.ListBudgets:input_type -> wealthjourney.budget.v1.ListBudgetsRequest
+	7,  // 78: wealthjourney.budget.v1.BudgetService.CreateBudget:input_type -> wealthjourney.budget.v1.CreateBudgetRequest
+	8,  // 79: wealthjourney.budget.v1.BudgetService.UpdateBudget:input_type -> wealthjourney.budget.v1.UpdateBudgetRequest
+	9,  // 80: wealthjourney.budget.v1.BudgetService.DeleteBudget:input_type -> wealthjourney.budget.v1.DeleteBudgetRequest
+	10, // 81: wealthjourney.budget.v1.BudgetService.GetBudgetItems:input_type -> wealthjourney.budget.v1.GetBudgetItemsRequest
+	11, // 82: wealthjourney.budget.v1.BudgetService.CreateBudgetItem:input_type -> wealthjourney.budget.v1.CreateBudgetItemRequest
+	12, // 83: wealthjourney.budget.v1.BudgetService.UpdateBudgetItem:input_type -> wealthjourney.budget.v1.UpdateBudgetItemRequest
+	13, // 84: wealthjourney.budget.v1.BudgetService.DeleteBudgetItem:input_type -> wealthjourney.budget.v1.DeleteBudgetItemRequest
+	25, // 85: wealthjourney.budget.v1.BudgetService.GetBudgetEnvelopes:input_type -> wealthjourney.budget.v1.GetBudgetEnvelopesRequest
+	27, // 86: wealthjourney.budget.v1.BudgetService.MoveBudgetMoney:input_type -> wealthjourney.budget.v1.MoveBudgetMoneyRequest
+	29, // 87: wealthjourney.budget.v1.BudgetService.ListBudgetMoves:input_type -> wealthjourney.budget.v1.ListBudgetMovesRequest
+	32, // 88: wealthjourney.budget.v1.BudgetService.CreateBudgetAlertRule:input_type -> wealthjourney.budget.v1.CreateBudgetAlertRuleRequest
+	34, // 89: wealthjourney.budget.v1.BudgetService.ListBudgetAlertRules:input_type -> wealthjourney.budget.v1.ListBudgetAlertRulesRequest
+	36, // 90: wealthjourney.budget.v1.BudgetService.UpdateBudgetAlertRule:input_type -> wealthjourney.budget.v1.UpdateBudgetAlertRuleRequest
+	38, // 91: wealthjourney.budget.v1.BudgetService.DeleteBudgetAlertRule:input_type -> wealthjourney.budget.v1.DeleteBudgetAlertRuleRequest
+	43, // 92: wealthjourney.budget.v1.BudgetService.GetBudgetVarianceReport:input_type -> wealthjourney.budget.v1.GetBudgetVarianceReportRequest
+	48, // 93: wealthjourney.budget.v1.BudgetService.CopyBudgetToPeriod:input_type -> wealthjourney.budget.v1.CopyBudgetToPeriodRequest
+	50, // 94: wealthjourney.budget.v1.BudgetService.CreateBudgetTemplate:input_type -> wealthjourney.budget.v1.CreateBudgetTemplateRequest
+	52, // 95: wealthjourney.budget.v1.BudgetService.ListBudgetTemplates:input_type -> wealthjourney.budget.v1.ListBudgetTemplatesRequest
+	54, // 96: wealthjourney.budget.v1.BudgetService.GetBudgetTemplate:input_type -> wealthjourney.budget.v1.GetBudgetTemplateRequest
+	56, // 97: wealthjourney.budget.v1.BudgetService.DeleteBudgetTemplate:input_type -> wealthjourney.budget.v1.DeleteBudgetTemplateRequest
+	58, // 98: wealthjourney.budget.v1.BudgetService.ApplyBudgetTemplate:input_type -> wealthjourney.budget.v1.ApplyBudgetTemplateRequest
+	14, // 99: wealthjourney.budget.v1.BudgetService.GetBudget:output_type -> wealthjourney.budget.v1.GetBudgetResponse
+	15, // 100: wealthjourney.budget.v1.BudgetService.ListBudgets:output_type -> wealthjourney.budget.v1.ListBudgetsResponse
+	16, // 101: wealthjourney.budget.v1.BudgetService.CreateBudget:output_type -> wealthjourney.budget.v1.CreateBudgetResponse
+	17, // 102: wealthjourney.budget.v1.BudgetService.UpdateBudget:output_type -> wealthjourney.budget.v1.UpdateBudgetResponse
+	18, // 103: wealthjourney.budget.v1.BudgetService.DeleteBudget:output_type -> wealthjourney.budget.v1.DeleteBudgetResponse
+	19, // 104: wealthjourney.budget.v1.BudgetService.GetBudgetItems:output_type -> wealthjourney.budget.v1.GetBudgetItemsResponse
+	20, // 105: wealthjourney.budget.v1.BudgetService.CreateBudgetItem:output_type -> wealthjourney.budget.v1.CreateBudgetItemResponse
+	21, // 106: wealthjourney.budget.v1.BudgetService.UpdateBudgetItem:output_type -> wealthjourney.budget.v1.UpdateBudgetItemResponse
+	22, // 107: wealthjourney.budget.v1.BudgetService.DeleteBudgetItem:output_type -> wealthjourney.budget.v1.DeleteBudgetItemResponse
+	26, // 108: wealthjourney.budget.v1.BudgetService.GetBudgetEnvelopes:output_type -> wealthjourney.budget.v1.GetBudgetEnvelopesResponse
+	28, // 109: wealthjourney.budget.v1.BudgetService.MoveBudgetMoney:output_type -> wealthjourney.budget.v1.MoveBudgetMoneyResponse
+	30, // 110: wealthjourney.budget.v1.BudgetService.ListBudgetMoves:output_type -> wealthjourney.budget.v1.ListBudgetMovesResponse
+	33, // 111: wealthjourney.budget.v1.BudgetService.CreateBudgetAlertRule:output_type -> wealthjourney.budget.v1.CreateBudgetAlertRuleResponse
+	35, // 112: wealthjourney.budget.v1.BudgetService.ListBudgetAlertRules:output_type -> wealthjourney.budget.v1.ListBudgetAlertRulesResponse
+	37, // 113: wealthjourney.budget.v1.BudgetService.UpdateBudgetAlertRule:output_type -> wealthjourney.budget.v1.UpdateBudgetAlertRuleResponse
+	39, // 114: wealthjourney.budget.v1.BudgetService.DeleteBudgetAlertRule:output_type -> wealthjourney.budget.v1.DeleteBudgetAlertRuleResponse
+	44, // 115: wealthjourney.budget.v1.BudgetService.GetBudgetVarianceReport:output_type -> wealthjourney.budget.v1.GetBudgetVarianceReportResponse
+	49, // 116: wealthjourney.budget.v1.BudgetService.CopyBudgetToPeriod:output_type -> wealthjourney.budget.v1.CopyBudgetToPeriodResponse
+	51, // 117: wealthjourney.budget.v1.BudgetService.CreateBudgetTemplate:output_type -> wealthjourney.budget.v1.CreateBudgetTemplateResponse
+	53, // 118: wealthjourney.budget.v1.BudgetService.ListBudgetTemplates:output_type -> wealthjourney.budget.v1.ListBudgetTemplatesResponse
+	55, // 119: wealthjourney.budget.v1.BudgetService.GetBudgetTemplate:output_type -> wealthjourney.budget.v1.GetBudgetTemplateResponse
+	57, // 120: wealthjourney.budget.v1.BudgetService.DeleteBudgetTemplate:output_type -> wealthjourney.budget.v1.DeleteBudgetTemplateResponse
+	59, // 121: wealthjourney.budget.v1.BudgetService.ApplyBudgetTemplate:output_type -> wealthjourney.budget.v1.ApplyBudgetTemplateResponse
+	99, // [99:122] is the sub-list for method output_type
+	76, // [76:99] is the sub-list for method input_type
+	76, // [76:76] is the sub-list for extension type_name
+	76, // [76:76] is the sub-list for extension extendee
+	0,  // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_v1_budget_proto_init() }
@@ -1799,8 +5843,200 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BudgetItem); i {
+		file_protobuf_v1_budget_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBudgetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBudgetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBudgetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBudgetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBudgetItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBudgetItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBudgetItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBudgetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBudgetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBudgetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBudgetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetItemsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBudgetItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1811,8 +6047,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBudgetRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBudgetItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1823,8 +6059,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBudgetsRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBudgetItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1835,8 +6071,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBudgetRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1847,8 +6083,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBudgetRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetMove); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1859,8 +6095,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBudgetRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetEnvelopesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1871,8 +6107,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBudgetItemsRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetEnvelopesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1883,8 +6119,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBudgetItemRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveBudgetMoneyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1895,8 +6131,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBudgetItemRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveBudgetMoneyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1907,8 +6143,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBudgetItemRequest); i {
+		file_protobuf_v1_budget_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBudgetMovesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1919,8 +6155,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBudgetResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBudgetMovesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1931,8 +6167,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBudgetsResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetAlertRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1943,8 +6179,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBudgetResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBudgetAlertRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1955,8 +6191,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBudgetResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBudgetAlertRuleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1967,8 +6203,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBudgetResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBudgetAlertRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1979,8 +6215,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBudgetItemsResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBudgetAlertRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1991,8 +6227,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBudgetItemResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBudgetAlertRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2003,8 +6239,8 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBudgetItemResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBudgetAlertRuleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2015,8 +6251,260 @@ func file_protobuf_v1_budget_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_budget_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBudgetItemResponse); i {
+		file_protobuf_v1_budget_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBudgetAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBudgetAlertRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetVarianceRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbudgetedSpending); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetVarianceMonth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetVarianceReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetVarianceReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetAllocationOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetTemplateItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyBudgetToPeriodRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyBudgetToPeriodResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBudgetTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBudgetTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBudgetTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBudgetTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBudgetTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBudgetTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBudgetTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBudgetTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_budget_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBudgetTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2028,18 +6516,22 @@ func file_protobuf_v1_budget_proto_init() {
 			}
 		}
 	}
+	file_protobuf_v1_budget_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_protobuf_v1_budget_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_protobuf_v1_budget_proto_msgTypes[33].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_v1_budget_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      3,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_protobuf_v1_budget_proto_goTypes,
 		DependencyIndexes: file_protobuf_v1_budget_proto_depIdxs,
+		EnumInfos:         file_protobuf_v1_budget_proto_enumTypes,
 		MessageInfos:      file_protobuf_v1_budget_proto_msgTypes,
 	}.Build()
 	File_protobuf_v1_budget_proto = out.File
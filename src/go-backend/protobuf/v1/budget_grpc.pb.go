@@ -19,15 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BudgetService_GetBudget_FullMethodName        = "/wealthjourney.budget.v1.BudgetService/GetBudget"
-	BudgetService_ListBudgets_FullMethodName      = "/wealthjourney.budget.v1.BudgetService/ListBudgets"
-	BudgetService_CreateBudget_FullMethodName     = "/wealthjourney.budget.v1.BudgetService/CreateBudget"
-	BudgetService_UpdateBudget_FullMethodName     = "/wealthjourney.budget.v1.BudgetService/UpdateBudget"
-	BudgetService_DeleteBudget_FullMethodName     = "/wealthjourney.budget.v1.BudgetService/DeleteBudget"
-	BudgetService_GetBudgetItems_FullMethodName   = "/wealthjourney.budget.v1.BudgetService/GetBudgetItems"
-	BudgetService_CreateBudgetItem_FullMethodName = "/wealthjourney.budget.v1.BudgetService/CreateBudgetItem"
-	BudgetService_UpdateBudgetItem_FullMethodName = "/wealthjourney.budget.v1.BudgetService/UpdateBudgetItem"
-	BudgetService_DeleteBudgetItem_FullMethodName = "/wealthjourney.budget.v1.BudgetService/DeleteBudgetItem"
+	BudgetService_GetBudget_FullMethodName               = "/wealthjourney.budget.v1.BudgetService/GetBudget"
+	BudgetService_ListBudgets_FullMethodName             = "/wealthjourney.budget.v1.BudgetService/ListBudgets"
+	BudgetService_CreateBudget_FullMethodName            = "/wealthjourney.budget.v1.BudgetService/CreateBudget"
+	BudgetService_UpdateBudget_FullMethodName            = "/wealthjourney.budget.v1.BudgetService/UpdateBudget"
+	BudgetService_DeleteBudget_FullMethodName            = "/wealthjourney.budget.v1.BudgetService/DeleteBudget"
+	BudgetService_GetBudgetItems_FullMethodName          = "/wealthjourney.budget.v1.BudgetService/GetBudgetItems"
+	BudgetService_CreateBudgetItem_FullMethodName        = "/wealthjourney.budget.v1.BudgetService/CreateBudgetItem"
+	BudgetService_UpdateBudgetItem_FullMethodName        = "/wealthjourney.budget.v1.BudgetService/UpdateBudgetItem"
+	BudgetService_DeleteBudgetItem_FullMethodName        = "/wealthjourney.budget.v1.BudgetService/DeleteBudgetItem"
+	BudgetService_GetBudgetEnvelopes_FullMethodName      = "/wealthjourney.budget.v1.BudgetService/GetBudgetEnvelopes"
+	BudgetService_MoveBudgetMoney_FullMethodName         = "/wealthjourney.budget.v1.BudgetService/MoveBudgetMoney"
+	BudgetService_ListBudgetMoves_FullMethodName         = "/wealthjourney.budget.v1.BudgetService/ListBudgetMoves"
+	BudgetService_CreateBudgetAlertRule_FullMethodName   = "/wealthjourney.budget.v1.BudgetService/CreateBudgetAlertRule"
+	BudgetService_ListBudgetAlertRules_FullMethodName    = "/wealthjourney.budget.v1.BudgetService/ListBudgetAlertRules"
+	BudgetService_UpdateBudgetAlertRule_FullMethodName   = "/wealthjourney.budget.v1.BudgetService/UpdateBudgetAlertRule"
+	BudgetService_DeleteBudgetAlertRule_FullMethodName   = "/wealthjourney.budget.v1.BudgetService/DeleteBudgetAlertRule"
+	BudgetService_GetBudgetVarianceReport_FullMethodName = "/wealthjourney.budget.v1.BudgetService/GetBudgetVarianceReport"
+	BudgetService_CopyBudgetToPeriod_FullMethodName      = "/wealthjourney.budget.v1.BudgetService/CopyBudgetToPeriod"
+	BudgetService_CreateBudgetTemplate_FullMethodName    = "/wealthjourney.budget.v1.BudgetService/CreateBudgetTemplate"
+	BudgetService_ListBudgetTemplates_FullMethodName     = "/wealthjourney.budget.v1.BudgetService/ListBudgetTemplates"
+	BudgetService_GetBudgetTemplate_FullMethodName       = "/wealthjourney.budget.v1.BudgetService/GetBudgetTemplate"
+	BudgetService_DeleteBudgetTemplate_FullMethodName    = "/wealthjourney.budget.v1.BudgetService/DeleteBudgetTemplate"
+	BudgetService_ApplyBudgetTemplate_FullMethodName     = "/wealthjourney.budget.v1.BudgetService/ApplyBudgetTemplate"
 )
 
 // BudgetServiceClient is the client API for BudgetService service.
@@ -52,6 +66,34 @@ type BudgetServiceClient interface {
 	UpdateBudgetItem(ctx context.Context, in *UpdateBudgetItemRequest, opts ...grpc.CallOption) (*UpdateBudgetItemResponse, error)
 	// Delete a budget item
 	DeleteBudgetItem(ctx context.Context, in *DeleteBudgetItemRequest, opts ...grpc.CallOption) (*DeleteBudgetItemResponse, error)
+	// Get the envelopes of a budget for a period
+	GetBudgetEnvelopes(ctx context.Context, in *GetBudgetEnvelopesRequest, opts ...grpc.CallOption) (*GetBudgetEnvelopesResponse, error)
+	// Move money between two envelopes of a budget in the current period
+	MoveBudgetMoney(ctx context.Context, in *MoveBudgetMoneyRequest, opts ...grpc.CallOption) (*MoveBudgetMoneyResponse, error)
+	// List the money moved between the envelopes of a budget in a period
+	ListBudgetMoves(ctx context.Context, in *ListBudgetMovesRequest, opts ...grpc.CallOption) (*ListBudgetMovesResponse, error)
+	// Create an alert rule that notifies when a budget item's spending crosses thresholds
+	CreateBudgetAlertRule(ctx context.Context, in *CreateBudgetAlertRuleRequest, opts ...grpc.CallOption) (*CreateBudgetAlertRuleResponse, error)
+	// List the alert rules of a budget
+	ListBudgetAlertRules(ctx context.Context, in *ListBudgetAlertRulesRequest, opts ...grpc.CallOption) (*ListBudgetAlertRulesResponse, error)
+	// Update an alert rule
+	UpdateBudgetAlertRule(ctx context.Context, in *UpdateBudgetAlertRuleRequest, opts ...grpc.CallOption) (*UpdateBudgetAlertRuleResponse, error)
+	// Delete an alert rule
+	DeleteBudgetAlertRule(ctx context.Context, in *DeleteBudgetAlertRuleRequest, opts ...grpc.CallOption) (*DeleteBudgetAlertRuleResponse, error)
+	// Compare the allocation of each budget item with its actual spending, month by month
+	GetBudgetVarianceReport(ctx context.Context, in *GetBudgetVarianceReportRequest, opts ...grpc.CallOption) (*GetBudgetVarianceReportResponse, error)
+	// Create a new budget with the items of a budget, for another period
+	CopyBudgetToPeriod(ctx context.Context, in *CopyBudgetToPeriodRequest, opts ...grpc.CallOption) (*CopyBudgetToPeriodResponse, error)
+	// Save a reusable budget template, from a budget or from items
+	CreateBudgetTemplate(ctx context.Context, in *CreateBudgetTemplateRequest, opts ...grpc.CallOption) (*CreateBudgetTemplateResponse, error)
+	// List the budget templates of the user
+	ListBudgetTemplates(ctx context.Context, in *ListBudgetTemplatesRequest, opts ...grpc.CallOption) (*ListBudgetTemplatesResponse, error)
+	// Get a budget template
+	GetBudgetTemplate(ctx context.Context, in *GetBudgetTemplateRequest, opts ...grpc.CallOption) (*GetBudgetTemplateResponse, error)
+	// Delete a budget template
+	DeleteBudgetTemplate(ctx context.Context, in *DeleteBudgetTemplateRequest, opts ...grpc.CallOption) (*DeleteBudgetTemplateResponse, error)
+	// Create a new budget from a template
+	ApplyBudgetTemplate(ctx context.Context, in *ApplyBudgetTemplateRequest, opts ...grpc.CallOption) (*ApplyBudgetTemplateResponse, error)
 }
 
 type budgetServiceClient struct {
@@ -143,6 +185,132 @@ func (c *budgetServiceClient) DeleteBudgetItem(ctx context.Context, in *DeleteBu
 	return out, nil
 }
 
+func (c *budgetServiceClient) GetBudgetEnvelopes(ctx context.Context, in *GetBudgetEnvelopesRequest, opts ...grpc.CallOption) (*GetBudgetEnvelopesResponse, error) {
+	out := new(GetBudgetEnvelopesResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetBudgetEnvelopes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) MoveBudgetMoney(ctx context.Context, in *MoveBudgetMoneyRequest, opts ...grpc.CallOption) (*MoveBudgetMoneyResponse, error) {
+	out := new(MoveBudgetMoneyResponse)
+	err := c.cc.Invoke(ctx, BudgetService_MoveBudgetMoney_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ListBudgetMoves(ctx context.Context, in *ListBudgetMovesRequest, opts ...grpc.CallOption) (*ListBudgetMovesResponse, error) {
+	out := new(ListBudgetMovesResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ListBudgetMoves_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) CreateBudgetAlertRule(ctx context.Context, in *CreateBudgetAlertRuleRequest, opts ...grpc.CallOption) (*CreateBudgetAlertRuleResponse, error) {
+	out := new(CreateBudgetAlertRuleResponse)
+	err := c.cc.Invoke(ctx, BudgetService_CreateBudgetAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ListBudgetAlertRules(ctx context.Context, in *ListBudgetAlertRulesRequest, opts ...grpc.CallOption) (*ListBudgetAlertRulesResponse, error) {
+	out := new(ListBudgetAlertRulesResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ListBudgetAlertRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) UpdateBudgetAlertRule(ctx context.Context, in *UpdateBudgetAlertRuleRequest, opts ...grpc.CallOption) (*UpdateBudgetAlertRuleResponse, error) {
+	out := new(UpdateBudgetAlertRuleResponse)
+	err := c.cc.Invoke(ctx, BudgetService_UpdateBudgetAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) DeleteBudgetAlertRule(ctx context.Context, in *DeleteBudgetAlertRuleRequest, opts ...grpc.CallOption) (*DeleteBudgetAlertRuleResponse, error) {
+	out := new(DeleteBudgetAlertRuleResponse)
+	err := c.cc.Invoke(ctx, BudgetService_DeleteBudgetAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetBudgetVarianceReport(ctx context.Context, in *GetBudgetVarianceReportRequest, opts ...grpc.CallOption) (*GetBudgetVarianceReportResponse, error) {
+	out := new(GetBudgetVarianceReportResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetBudgetVarianceReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) CopyBudgetToPeriod(ctx context.Context, in *CopyBudgetToPeriodRequest, opts ...grpc.CallOption) (*CopyBudgetToPeriodResponse, error) {
+	out := new(CopyBudgetToPeriodResponse)
+	err := c.cc.Invoke(ctx, BudgetService_CopyBudgetToPeriod_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) CreateBudgetTemplate(ctx context.Context, in *CreateBudgetTemplateRequest, opts ...grpc.CallOption) (*CreateBudgetTemplateResponse, error) {
+	out := new(CreateBudgetTemplateResponse)
+	err := c.cc.Invoke(ctx, BudgetService_CreateBudgetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ListBudgetTemplates(ctx context.Context, in *ListBudgetTemplatesRequest, opts ...grpc.CallOption) (*ListBudgetTemplatesResponse, error) {
+	out := new(ListBudgetTemplatesResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ListBudgetTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetBudgetTemplate(ctx context.Context, in *GetBudgetTemplateRequest, opts ...grpc.CallOption) (*GetBudgetTemplateResponse, error) {
+	out := new(GetBudgetTemplateResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetBudgetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) DeleteBudgetTemplate(ctx context.Context, in *DeleteBudgetTemplateRequest, opts ...grpc.CallOption) (*DeleteBudgetTemplateResponse, error) {
+	out := new(DeleteBudgetTemplateResponse)
+	err := c.cc.Invoke(ctx, BudgetService_DeleteBudgetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ApplyBudgetTemplate(ctx context.Context, in *ApplyBudgetTemplateRequest, opts ...grpc.CallOption) (*ApplyBudgetTemplateResponse, error) {
+	out := new(ApplyBudgetTemplateResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ApplyBudgetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BudgetServiceServer is the server API for BudgetService service.
 // All implementations must embed UnimplementedBudgetServiceServer
 // for forward compatibility
@@ -165,6 +333,34 @@ type BudgetServiceServer interface {
 	UpdateBudgetItem(context.Context, *UpdateBudgetItemRequest) (*UpdateBudgetItemResponse, error)
 	// Delete a budget item
 	DeleteBudgetItem(context.Context, *DeleteBudgetItemRequest) (*DeleteBudgetItemResponse, error)
+	// Get the envelopes of a budget for a period
+	GetBudgetEnvelopes(context.Context, *GetBudgetEnvelopesRequest) (*GetBudgetEnvelopesResponse, error)
+	// Move money between two envelopes of a budget in the current period
+	MoveBudgetMoney(context.Context, *MoveBudgetMoneyRequest) (*MoveBudgetMoneyResponse, error)
+	// List the money moved between the envelopes of a budget in a period
+	ListBudgetMoves(context.Context, *ListBudgetMovesRequest) (*ListBudgetMovesResponse, error)
+	// Create an alert rule that notifies when a budget item's spending crosses thresholds
+	CreateBudgetAlertRule(context.Context, *CreateBudgetAlertRuleRequest) (*CreateBudgetAlertRuleResponse, error)
+	// List the alert rules of a budget
+	ListBudgetAlertRules(context.Context, *ListBudgetAlertRulesRequest) (*ListBudgetAlertRulesResponse, error)
+	// Update an alert rule
+	UpdateBudgetAlertRule(context.Context, *UpdateBudgetAlertRuleRequest) (*UpdateBudgetAlertRuleResponse, error)
+	// Delete an alert rule
+	DeleteBudgetAlertRule(context.Context, *DeleteBudgetAlertRuleRequest) (*DeleteBudgetAlertRuleResponse, error)
+	// Compare the allocation of each budget item with its actual spending, month by month
+	GetBudgetVarianceReport(context.Context, *GetBudgetVarianceReportRequest) (*GetBudgetVarianceReportResponse, error)
+	// Create a new budget with the items of a budget, for another period
+	CopyBudgetToPeriod(context.Context, *CopyBudgetToPeriodRequest) (*CopyBudgetToPeriodResponse, error)
+	// Save a reusable budget template, from a budget or from items
+	CreateBudgetTemplate(context.Context, *CreateBudgetTemplateRequest) (*CreateBudgetTemplateResponse, error)
+	// List the budget templates of the user
+	ListBudgetTemplates(context.Context, *ListBudgetTemplatesRequest) (*ListBudgetTemplatesResponse, error)
+	// Get a budget template
+	GetBudgetTemplate(context.Context, *GetBudgetTemplateRequest) (*GetBudgetTemplateResponse, error)
+	// Delete a budget template
+	DeleteBudgetTemplate(context.Context, *DeleteBudgetTemplateRequest) (*DeleteBudgetTemplateResponse, error)
+	// Create a new budget from a template
+	ApplyBudgetTemplate(context.Context, *ApplyBudgetTemplateRequest) (*ApplyBudgetTemplateResponse, error)
 	mustEmbedUnimplementedBudgetServiceServer()
 }
 
@@ -199,6 +395,48 @@ func (UnimplementedBudgetServiceServer) UpdateBudgetItem(context.Context, *Updat
 func (UnimplementedBudgetServiceServer) DeleteBudgetItem(context.Context, *DeleteBudgetItemRequest) (*DeleteBudgetItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudgetItem not implemented")
 }
+func (UnimplementedBudgetServiceServer) GetBudgetEnvelopes(context.Context, *GetBudgetEnvelopesRequest) (*GetBudgetEnvelopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetEnvelopes not implemented")
+}
+func (UnimplementedBudgetServiceServer) MoveBudgetMoney(context.Context, *MoveBudgetMoneyRequest) (*MoveBudgetMoneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBudgetMoney not implemented")
+}
+func (UnimplementedBudgetServiceServer) ListBudgetMoves(context.Context, *ListBudgetMovesRequest) (*ListBudgetMovesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBudgetMoves not implemented")
+}
+func (UnimplementedBudgetServiceServer) CreateBudgetAlertRule(context.Context, *CreateBudgetAlertRuleRequest) (*CreateBudgetAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBudgetAlertRule not implemented")
+}
+func (UnimplementedBudgetServiceServer) ListBudgetAlertRules(context.Context, *ListBudgetAlertRulesRequest) (*ListBudgetAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBudgetAlertRules not implemented")
+}
+func (UnimplementedBudgetServiceServer) UpdateBudgetAlertRule(context.Context, *UpdateBudgetAlertRuleRequest) (*UpdateBudgetAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBudgetAlertRule not implemented")
+}
+func (UnimplementedBudgetServiceServer) DeleteBudgetAlertRule(context.Context, *DeleteBudgetAlertRuleRequest) (*DeleteBudgetAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudgetAlertRule not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetBudgetVarianceReport(context.Context, *GetBudgetVarianceReportRequest) (*GetBudgetVarianceReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetVarianceReport not implemented")
+}
+func (UnimplementedBudgetServiceServer) CopyBudgetToPeriod(context.Context, *CopyBudgetToPeriodRequest) (*CopyBudgetToPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyBudgetToPeriod not implemented")
+}
+func (UnimplementedBudgetServiceServer) CreateBudgetTemplate(context.Context, *CreateBudgetTemplateRequest) (*CreateBudgetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBudgetTemplate not implemented")
+}
+func (UnimplementedBudgetServiceServer) ListBudgetTemplates(context.Context, *ListBudgetTemplatesRequest) (*ListBudgetTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBudgetTemplates not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetBudgetTemplate(context.Context, *GetBudgetTemplateRequest) (*GetBudgetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetTemplate not implemented")
+}
+func (UnimplementedBudgetServiceServer) DeleteBudgetTemplate(context.Context, *DeleteBudgetTemplateRequest) (*DeleteBudgetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudgetTemplate not implemented")
+}
+func (UnimplementedBudgetServiceServer) ApplyBudgetTemplate(context.Context, *ApplyBudgetTemplateRequest) (*ApplyBudgetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyBudgetTemplate not implemented")
+}
 func (UnimplementedBudgetServiceServer) mustEmbedUnimplementedBudgetServiceServer() {}
 
 // UnsafeBudgetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -374,6 +612,258 @@ func _BudgetService_DeleteBudgetItem_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BudgetService_GetBudgetEnvelopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetEnvelopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetEnvelopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetBudgetEnvelopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetEnvelopes(ctx, req.(*GetBudgetEnvelopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_MoveBudgetMoney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveBudgetMoneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).MoveBudgetMoney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_MoveBudgetMoney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).MoveBudgetMoney(ctx, req.(*MoveBudgetMoneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ListBudgetMoves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetMovesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ListBudgetMoves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ListBudgetMoves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ListBudgetMoves(ctx, req.(*ListBudgetMovesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_CreateBudgetAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBudgetAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).CreateBudgetAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_CreateBudgetAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).CreateBudgetAlertRule(ctx, req.(*CreateBudgetAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ListBudgetAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ListBudgetAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ListBudgetAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ListBudgetAlertRules(ctx, req.(*ListBudgetAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_UpdateBudgetAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBudgetAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).UpdateBudgetAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_UpdateBudgetAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).UpdateBudgetAlertRule(ctx, req.(*UpdateBudgetAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_DeleteBudgetAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).DeleteBudgetAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_DeleteBudgetAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).DeleteBudgetAlertRule(ctx, req.(*DeleteBudgetAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetBudgetVarianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetVarianceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetVarianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetBudgetVarianceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetVarianceReport(ctx, req.(*GetBudgetVarianceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_CopyBudgetToPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyBudgetToPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).CopyBudgetToPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_CopyBudgetToPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).CopyBudgetToPeriod(ctx, req.(*CopyBudgetToPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_CreateBudgetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBudgetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).CreateBudgetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_CreateBudgetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).CreateBudgetTemplate(ctx, req.(*CreateBudgetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ListBudgetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ListBudgetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ListBudgetTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ListBudgetTemplates(ctx, req.(*ListBudgetTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetBudgetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetBudgetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetTemplate(ctx, req.(*GetBudgetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_DeleteBudgetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).DeleteBudgetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_DeleteBudgetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).DeleteBudgetTemplate(ctx, req.(*DeleteBudgetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ApplyBudgetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyBudgetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ApplyBudgetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ApplyBudgetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ApplyBudgetTemplate(ctx, req.(*ApplyBudgetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BudgetService_ServiceDesc is the grpc.ServiceDesc for BudgetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -417,6 +907,62 @@ var BudgetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBudgetItem",
 			Handler:    _BudgetService_DeleteBudgetItem_Handler,
 		},
+		{
+			MethodName: "GetBudgetEnvelopes",
+			Handler:    _BudgetService_GetBudgetEnvelopes_Handler,
+		},
+		{
+			MethodName: "MoveBudgetMoney",
+			Handler:    _BudgetService_MoveBudgetMoney_Handler,
+		},
+		{
+			MethodName: "ListBudgetMoves",
+			Handler:    _BudgetService_ListBudgetMoves_Handler,
+		},
+		{
+			MethodName: "CreateBudgetAlertRule",
+			Handler:    _BudgetService_CreateBudgetAlertRule_Handler,
+		},
+		{
+			MethodName: "ListBudgetAlertRules",
+			Handler:    _BudgetService_ListBudgetAlertRules_Handler,
+		},
+		{
+			MethodName: "UpdateBudgetAlertRule",
+			Handler:    _BudgetService_UpdateBudgetAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteBudgetAlertRule",
+			Handler:    _BudgetService_DeleteBudgetAlertRule_Handler,
+		},
+		{
+			MethodName: "GetBudgetVarianceReport",
+			Handler:    _BudgetService_GetBudgetVarianceReport_Handler,
+		},
+		{
+			MethodName: "CopyBudgetToPeriod",
+			Handler:    _BudgetService_CopyBudgetToPeriod_Handler,
+		},
+		{
+			MethodName: "CreateBudgetTemplate",
+			Handler:    _BudgetService_CreateBudgetTemplate_Handler,
+		},
+		{
+			MethodName: "ListBudgetTemplates",
+			Handler:    _BudgetService_ListBudgetTemplates_Handler,
+		},
+		{
+			MethodName: "GetBudgetTemplate",
+			Handler:    _BudgetService_GetBudgetTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteBudgetTemplate",
+			Handler:    _BudgetService_DeleteBudgetTemplate_Handler,
+		},
+		{
+			MethodName: "ApplyBudgetTemplate",
+			Handler:    _BudgetService_ApplyBudgetTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protobuf/v1/budget.proto",
@@ -99,6 +99,65 @@ func (InvestmentType) EnumDescriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{0}
 }
 
+// Cost-basis method deciding which lots a sell consumes
+type CostBasisMethod int32
+
+const (
+	CostBasisMethod_COST_BASIS_METHOD_UNSPECIFIED  CostBasisMethod = 0 // Falls back to FIFO
+	CostBasisMethod_COST_BASIS_METHOD_FIFO         CostBasisMethod = 1 // Oldest lots first
+	CostBasisMethod_COST_BASIS_METHOD_LIFO         CostBasisMethod = 2 // Newest lots first
+	CostBasisMethod_COST_BASIS_METHOD_HIFO         CostBasisMethod = 3 // Highest cost lots first
+	CostBasisMethod_COST_BASIS_METHOD_AVERAGE      CostBasisMethod = 4 // Weighted average cost of all open lots (Vietnamese brokers)
+	CostBasisMethod_COST_BASIS_METHOD_SPECIFIC_LOT CostBasisMethod = 5 // Lots chosen on every sell via lotSelections
+)
+
+// Enum value maps for CostBasisMethod.
+var (
+	CostBasisMethod_name = map[int32]string{
+		0: "COST_BASIS_METHOD_UNSPECIFIED",
+		1: "COST_BASIS_METHOD_FIFO",
+		2: "COST_BASIS_METHOD_LIFO",
+		3: "COST_BASIS_METHOD_HIFO",
+		4: "COST_BASIS_METHOD_AVERAGE",
+		5: "COST_BASIS_METHOD_SPECIFIC_LOT",
+	}
+	CostBasisMethod_value = map[string]int32{
+		"COST_BASIS_METHOD_UNSPECIFIED":  0,
+		"COST_BASIS_METHOD_FIFO":         1,
+		"COST_BASIS_METHOD_LIFO":         2,
+		"COST_BASIS_METHOD_HIFO":         3,
+		"COST_BASIS_METHOD_AVERAGE":      4,
+		"COST_BASIS_METHOD_SPECIFIC_LOT": 5,
+	}
+)
+
+func (x CostBasisMethod) Enum() *CostBasisMethod {
+	p := new(CostBasisMethod)
+	*p = x
+	return p
+}
+
+func (x CostBasisMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CostBasisMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_v1_investment_proto_enumTypes[1].Descriptor()
+}
+
+func (CostBasisMethod) Type() protoreflect.EnumType {
+	return &file_protobuf_v1_investment_proto_enumTypes[1]
+}
+
+func (x CostBasisMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CostBasisMethod.Descriptor instead.
+func (CostBasisMethod) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{1}
+}
+
 type InvestmentTransactionType int32
 
 const (
@@ -138,11 +197,11 @@ func (x InvestmentTransactionType) String() string {
 }
 
 func (InvestmentTransactionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_v1_investment_proto_enumTypes[1].Descriptor()
+	return file_protobuf_v1_investment_proto_enumTypes[2].Descriptor()
 }
 
 func (InvestmentTransactionType) Type() protoreflect.EnumType {
-	return &file_protobuf_v1_investment_proto_enumTypes[1]
+	return &file_protobuf_v1_investment_proto_enumTypes[2]
 }
 
 func (x InvestmentTransactionType) Number() protoreflect.EnumNumber {
@@ -151,7 +210,166 @@ func (x InvestmentTransactionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InvestmentTransactionType.Descriptor instead.
 func (InvestmentTransactionType) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{1}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{2}
+}
+
+// Holding period of a disposal for capital gains purposes
+type HoldingPeriod int32
+
+const (
+	HoldingPeriod_HOLDING_PERIOD_UNSPECIFIED HoldingPeriod = 0 // Acquisition date unknown
+	HoldingPeriod_HOLDING_PERIOD_SHORT_TERM  HoldingPeriod = 1 // Held one year or less
+	HoldingPeriod_HOLDING_PERIOD_LONG_TERM   HoldingPeriod = 2 // Held more than one year
+)
+
+// Enum value maps for HoldingPeriod.
+var (
+	HoldingPeriod_name = map[int32]string{
+		0: "HOLDING_PERIOD_UNSPECIFIED",
+		1: "HOLDING_PERIOD_SHORT_TERM",
+		2: "HOLDING_PERIOD_LONG_TERM",
+	}
+	HoldingPeriod_value = map[string]int32{
+		"HOLDING_PERIOD_UNSPECIFIED": 0,
+		"HOLDING_PERIOD_SHORT_TERM":  1,
+		"HOLDING_PERIOD_LONG_TERM":   2,
+	}
+)
+
+func (x HoldingPeriod) Enum() *HoldingPeriod {
+	p := new(HoldingPeriod)
+	*p = x
+	return p
+}
+
+func (x HoldingPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HoldingPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_v1_investment_proto_enumTypes[3].Descriptor()
+}
+
+func (HoldingPeriod) Type() protoreflect.EnumType {
+	return &file_protobuf_v1_investment_proto_enumTypes[3]
+}
+
+func (x HoldingPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldingPeriod.Descriptor instead.
+func (HoldingPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{3}
+}
+
+// Period over which returns are measured
+type ReturnPeriod int32
+
+const (
+	ReturnPeriod_RETURN_PERIOD_UNSPECIFIED     ReturnPeriod = 0
+	ReturnPeriod_RETURN_PERIOD_YTD             ReturnPeriod = 1 // Since January 1st of the current year
+	ReturnPeriod_RETURN_PERIOD_ONE_YEAR        ReturnPeriod = 2
+	ReturnPeriod_RETURN_PERIOD_THREE_YEARS     ReturnPeriod = 3
+	ReturnPeriod_RETURN_PERIOD_SINCE_INCEPTION ReturnPeriod = 4 // Since the first transaction
+)
+
+// Enum value maps for ReturnPeriod.
+var (
+	ReturnPeriod_name = map[int32]string{
+		0: "RETURN_PERIOD_UNSPECIFIED",
+		1: "RETURN_PERIOD_YTD",
+		2: "RETURN_PERIOD_ONE_YEAR",
+		3: "RETURN_PERIOD_THREE_YEARS",
+		4: "RETURN_PERIOD_SINCE_INCEPTION",
+	}
+	ReturnPeriod_value = map[string]int32{
+		"RETURN_PERIOD_UNSPECIFIED":     0,
+		"RETURN_PERIOD_YTD":             1,
+		"RETURN_PERIOD_ONE_YEAR":        2,
+		"RETURN_PERIOD_THREE_YEARS":     3,
+		"RETURN_PERIOD_SINCE_INCEPTION": 4,
+	}
+)
+
+func (x ReturnPeriod) Enum() *ReturnPeriod {
+	p := new(ReturnPeriod)
+	*p = x
+	return p
+}
+
+func (x ReturnPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReturnPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_v1_investment_proto_enumTypes[4].Descriptor()
+}
+
+func (ReturnPeriod) Type() protoreflect.EnumType {
+	return &file_protobuf_v1_investment_proto_enumTypes[4]
+}
+
+func (x ReturnPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReturnPeriod.Descriptor instead.
+func (ReturnPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{4}
+}
+
+// AllocationDimension is what allocation target weights are defined on
+type AllocationDimension int32
+
+const (
+	AllocationDimension_ALLOCATION_DIMENSION_UNSPECIFIED AllocationDimension = 0
+	AllocationDimension_ALLOCATION_DIMENSION_TYPE        AllocationDimension = 1 // By InvestmentType
+	AllocationDimension_ALLOCATION_DIMENSION_SYMBOL      AllocationDimension = 2 // By investment symbol
+	AllocationDimension_ALLOCATION_DIMENSION_ASSET_CLASS AllocationDimension = 3 // By the user's asset class tag on investments
+)
+
+// Enum value maps for AllocationDimension.
+var (
+	AllocationDimension_name = map[int32]string{
+		0: "ALLOCATION_DIMENSION_UNSPECIFIED",
+		1: "ALLOCATION_DIMENSION_TYPE",
+		2: "ALLOCATION_DIMENSION_SYMBOL",
+		3: "ALLOCATION_DIMENSION_ASSET_CLASS",
+	}
+	AllocationDimension_value = map[string]int32{
+		"ALLOCATION_DIMENSION_UNSPECIFIED": 0,
+		"ALLOCATION_DIMENSION_TYPE":        1,
+		"ALLOCATION_DIMENSION_SYMBOL":      2,
+		"ALLOCATION_DIMENSION_ASSET_CLASS": 3,
+	}
+)
+
+func (x AllocationDimension) Enum() *AllocationDimension {
+	p := new(AllocationDimension)
+	*p = x
+	return p
+}
+
+func (x AllocationDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllocationDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_v1_investment_proto_enumTypes[5].Descriptor()
+}
+
+func (AllocationDimension) Type() protoreflect.EnumType {
+	return &file_protobuf_v1_investment_proto_enumTypes[5]
+}
+
+func (x AllocationDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllocationDimension.Descriptor instead.
+func (AllocationDimension) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{5}
 }
 
 // Investment represents an individual holding within an investment wallet
@@ -177,17 +395,19 @@ type Investment struct {
 	CreatedAt            int64          `protobuf:"varint,15,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt            int64          `protobuf:"varint,16,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	// Conversion fields (populated when user's preferred currency differs from investment currency)
-	DisplayTotalCost     *Money `protobuf:"bytes,17,opt,name=displayTotalCost,proto3" json:"displayTotalCost,omitempty"`         // Total cost in user's preferred currency
-	DisplayCurrentValue  *Money `protobuf:"bytes,18,opt,name=displayCurrentValue,proto3" json:"displayCurrentValue,omitempty"`   // Current value in user's preferred currency
-	DisplayUnrealizedPnl *Money `protobuf:"bytes,19,opt,name=displayUnrealizedPnl,proto3" json:"displayUnrealizedPnl,omitempty"` // Unrealized PNL in user's preferred currency
-	DisplayRealizedPnl   *Money `protobuf:"bytes,20,opt,name=displayRealizedPnl,proto3" json:"displayRealizedPnl,omitempty"`     // Realized PNL in user's preferred currency
-	DisplayCurrency      string `protobuf:"bytes,21,opt,name=displayCurrency,proto3" json:"displayCurrency,omitempty"`           // User's preferred currency code
-	TotalDividends       int64  `protobuf:"varint,22,opt,name=totalDividends,proto3" json:"totalDividends,omitempty"`            // Total dividends received
-	WalletName           string `protobuf:"bytes,23,opt,name=walletName,proto3" json:"walletName,omitempty"`                     // Name of the wallet (for display in "All Wallets" view)
-	PurchaseUnit         string `protobuf:"bytes,24,opt,name=purchaseUnit,proto3" json:"purchaseUnit,omitempty"`                 // User's purchase unit for display ("tael", "kg", "oz", "gram")
-	DisplayCurrentPrice  *Money `protobuf:"bytes,25,opt,name=displayCurrentPrice,proto3" json:"displayCurrentPrice,omitempty"`   // Current price in user's preferred currency
-	DisplayAverageCost   *Money `protobuf:"bytes,26,opt,name=displayAverageCost,proto3" json:"displayAverageCost,omitempty"`     // Average cost in user's preferred currency
-	IsCustom             bool   `protobuf:"varint,27,opt,name=isCustom,proto3" json:"isCustom,omitempty"`                        // True if manual entry without market data validation
+	DisplayTotalCost     *Money          `protobuf:"bytes,17,opt,name=displayTotalCost,proto3" json:"displayTotalCost,omitempty"`                                                 // Total cost in user's preferred currency
+	DisplayCurrentValue  *Money          `protobuf:"bytes,18,opt,name=displayCurrentValue,proto3" json:"displayCurrentValue,omitempty"`                                           // Current value in user's preferred currency
+	DisplayUnrealizedPnl *Money          `protobuf:"bytes,19,opt,name=displayUnrealizedPnl,proto3" json:"displayUnrealizedPnl,omitempty"`                                         // Unrealized PNL in user's preferred currency
+	DisplayRealizedPnl   *Money          `protobuf:"bytes,20,opt,name=displayRealizedPnl,proto3" json:"displayRealizedPnl,omitempty"`                                             // Realized PNL in user's preferred currency
+	DisplayCurrency      string          `protobuf:"bytes,21,opt,name=displayCurrency,proto3" json:"displayCurrency,omitempty"`                                                   // User's preferred currency code
+	TotalDividends       int64           `protobuf:"varint,22,opt,name=totalDividends,proto3" json:"totalDividends,omitempty"`                                                    // Total dividends received
+	WalletName           string          `protobuf:"bytes,23,opt,name=walletName,proto3" json:"walletName,omitempty"`                                                             // Name of the wallet (for display in "All Wallets" view)
+	PurchaseUnit         string          `protobuf:"bytes,24,opt,name=purchaseUnit,proto3" json:"purchaseUnit,omitempty"`                                                         // User's purchase unit for display ("tael", "kg", "oz", "gram")
+	DisplayCurrentPrice  *Money          `protobuf:"bytes,25,opt,name=displayCurrentPrice,proto3" json:"displayCurrentPrice,omitempty"`                                           // Current price in user's preferred currency
+	DisplayAverageCost   *Money          `protobuf:"bytes,26,opt,name=displayAverageCost,proto3" json:"displayAverageCost,omitempty"`                                             // Average cost in user's preferred currency
+	IsCustom             bool            `protobuf:"varint,27,opt,name=isCustom,proto3" json:"isCustom,omitempty"`                                                                // True if manual entry without market data validation
+	CostBasisMethod      CostBasisMethod `protobuf:"varint,28,opt,name=costBasisMethod,proto3,enum=wealthjourney.investment.v1.CostBasisMethod" json:"costBasisMethod,omitempty"` // How sells pick lots (UNSPECIFIED = FIFO)
+	AssetClass           string          `protobuf:"bytes,29,opt,name=assetClass,proto3" json:"assetClass,omitempty"`                                                             // User-defined asset class tag (e.g. "Emerging markets"), used by allocation targets
 }
 
 func (x *Investment) Reset() {
@@ -411,6 +631,20 @@ func (x *Investment) GetIsCustom() bool {
 	return false
 }
 
+func (x *Investment) GetCostBasisMethod() CostBasisMethod {
+	if x != nil {
+		return x.CostBasisMethod
+	}
+	return CostBasisMethod_COST_BASIS_METHOD_UNSPECIFIED
+}
+
+func (x *Investment) GetAssetClass() string {
+	if x != nil {
+		return x.AssetClass
+	}
+	return ""
+}
+
 // InvestmentTransaction represents a buy or sell transaction
 type InvestmentTransaction struct {
 	state         protoimpl.MessageState
@@ -437,6 +671,9 @@ type InvestmentTransaction struct {
 	DisplayCost     *Money `protobuf:"bytes,16,opt,name=displayCost,proto3" json:"displayCost,omitempty"`         // Total cost in user's preferred currency
 	DisplayFees     *Money `protobuf:"bytes,17,opt,name=displayFees,proto3" json:"displayFees,omitempty"`         // Fees in user's preferred currency
 	DisplayCurrency string `protobuf:"bytes,18,opt,name=displayCurrency,proto3" json:"displayCurrency,omitempty"` // User's preferred currency code
+	// Split ratio (SPLIT transactions only): numerator new units for every denominator old units
+	SplitNumerator   int32 `protobuf:"varint,19,opt,name=splitNumerator,proto3" json:"splitNumerator,omitempty"`
+	SplitDenominator int32 `protobuf:"varint,20,opt,name=splitDenominator,proto3" json:"splitDenominator,omitempty"`
 }
 
 func (x *InvestmentTransaction) Reset() {
@@ -597,6 +834,76 @@ func (x *InvestmentTransaction) GetDisplayCurrency() string {
 	return ""
 }
 
+func (x *InvestmentTransaction) GetSplitNumerator() int32 {
+	if x != nil {
+		return x.SplitNumerator
+	}
+	return 0
+}
+
+func (x *InvestmentTransaction) GetSplitDenominator() int32 {
+	if x != nil {
+		return x.SplitDenominator
+	}
+	return 0
+}
+
+// Lot and quantity picked for a sell (specific-lot identification)
+type LotSelection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LotId    int32 `protobuf:"varint,1,opt,name=lotId,proto3" json:"lotId,omitempty"`
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Quantity to sell from this lot, in smallest units
+}
+
+func (x *LotSelection) Reset() {
+	*x = LotSelection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LotSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotSelection) ProtoMessage() {}
+
+func (x *LotSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotSelection.ProtoReflect.Descriptor instead.
+func (*LotSelection) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LotSelection) GetLotId() int32 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+func (x *LotSelection) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 // Portfolio summary for dashboard
 type PortfolioSummary struct {
 	state         protoimpl.MessageState
@@ -621,12 +928,13 @@ type PortfolioSummary struct {
 	DisplayCurrency      string                   `protobuf:"bytes,15,opt,name=displayCurrency,proto3" json:"displayCurrency,omitempty"`           // User's preferred currency code
 	TopPerformers        []*InvestmentPerformance `protobuf:"bytes,16,rep,name=topPerformers,proto3" json:"topPerformers,omitempty"`               // Top 3 performing investments
 	WorstPerformers      []*InvestmentPerformance `protobuf:"bytes,17,rep,name=worstPerformers,proto3" json:"worstPerformers,omitempty"`           // Bottom 3 performing investments
+	Returns              *PerformanceReturns      `protobuf:"bytes,18,opt,name=returns,proto3" json:"returns,omitempty"`                           // XIRR and TWR, set when a return period is requested
 }
 
 func (x *PortfolioSummary) Reset() {
 	*x = PortfolioSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[2]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -639,7 +947,7 @@ func (x *PortfolioSummary) String() string {
 func (*PortfolioSummary) ProtoMessage() {}
 
 func (x *PortfolioSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[2]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +960,7 @@ func (x *PortfolioSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortfolioSummary.ProtoReflect.Descriptor instead.
 func (*PortfolioSummary) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{2}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PortfolioSummary) GetTotalValue() int64 {
@@ -774,6 +1082,13 @@ func (x *PortfolioSummary) GetWorstPerformers() []*InvestmentPerformance {
 	return nil
 }
 
+func (x *PortfolioSummary) GetReturns() *PerformanceReturns {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
 type InvestmentByType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -787,7 +1102,7 @@ type InvestmentByType struct {
 func (x *InvestmentByType) Reset() {
 	*x = InvestmentByType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[3]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -800,7 +1115,7 @@ func (x *InvestmentByType) String() string {
 func (*InvestmentByType) ProtoMessage() {}
 
 func (x *InvestmentByType) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[3]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +1128,7 @@ func (x *InvestmentByType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvestmentByType.ProtoReflect.Descriptor instead.
 func (*InvestmentByType) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{3}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InvestmentByType) GetType() InvestmentType {
@@ -856,7 +1171,7 @@ type InvestmentPerformance struct {
 func (x *InvestmentPerformance) Reset() {
 	*x = InvestmentPerformance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[4]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -869,7 +1184,7 @@ func (x *InvestmentPerformance) String() string {
 func (*InvestmentPerformance) ProtoMessage() {}
 
 func (x *InvestmentPerformance) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[4]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1197,7 @@ func (x *InvestmentPerformance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvestmentPerformance.ProtoReflect.Descriptor instead.
 func (*InvestmentPerformance) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{4}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InvestmentPerformance) GetInvestmentId() int32 {
@@ -950,12 +1265,13 @@ type HistoricalPortfolioValue struct {
 	Timestamp         int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                // Unix timestamp when snapshot was taken
 	TotalValue        int64  `protobuf:"varint,2,opt,name=totalValue,proto3" json:"totalValue,omitempty"`              // Total portfolio value in base currency
 	DisplayTotalValue *Money `protobuf:"bytes,3,opt,name=displayTotalValue,proto3" json:"displayTotalValue,omitempty"` // Value in user's preferred currency
+	BenchmarkValue    *Money `protobuf:"bytes,4,opt,name=benchmarkValue,proto3" json:"benchmarkValue,omitempty"`       // Benchmark rebased to the first displayTotalValue; unset without a benchmark
 }
 
 func (x *HistoricalPortfolioValue) Reset() {
 	*x = HistoricalPortfolioValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[5]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -968,7 +1284,7 @@ func (x *HistoricalPortfolioValue) String() string {
 func (*HistoricalPortfolioValue) ProtoMessage() {}
 
 func (x *HistoricalPortfolioValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[5]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1297,7 @@ func (x *HistoricalPortfolioValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalPortfolioValue.ProtoReflect.Descriptor instead.
 func (*HistoricalPortfolioValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{5}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HistoricalPortfolioValue) GetTimestamp() int64 {
@@ -1005,22 +1321,30 @@ func (x *HistoricalPortfolioValue) GetDisplayTotalValue() *Money {
 	return nil
 }
 
+func (x *HistoricalPortfolioValue) GetBenchmarkValue() *Money {
+	if x != nil {
+		return x.BenchmarkValue
+	}
+	return nil
+}
+
 // GetHistoricalPortfolioValuesRequest for fetching historical data
 type GetHistoricalPortfolioValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WalletId   int32          `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`                                                     // 0 or omitted = all investment wallets
-	TypeFilter InvestmentType `protobuf:"varint,2,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by investment type
-	Days       int32          `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`                                                             // Number of days of history (default: 30, max: 365)
-	Points     int32          `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`                                                         // Number of data points to return (default: 10, max: 100)
+	WalletId        int32          `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`                                                     // 0 or omitted = all investment wallets
+	TypeFilter      InvestmentType `protobuf:"varint,2,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by investment type
+	Days            int32          `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`                                                             // Number of days of history (default: 30, max: 365)
+	Points          int32          `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`                                                         // Number of data points to return (default: 10, max: 100)
+	BenchmarkSymbol string         `protobuf:"bytes,5,opt,name=benchmarkSymbol,proto3" json:"benchmarkSymbol,omitempty"`                                        // Optional benchmark to compare against (e.g. "VNINDEX", "SPY", "XAU")
 }
 
 func (x *GetHistoricalPortfolioValuesRequest) Reset() {
 	*x = GetHistoricalPortfolioValuesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[6]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1033,7 +1357,7 @@ func (x *GetHistoricalPortfolioValuesRequest) String() string {
 func (*GetHistoricalPortfolioValuesRequest) ProtoMessage() {}
 
 func (x *GetHistoricalPortfolioValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[6]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1370,7 @@ func (x *GetHistoricalPortfolioValuesRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetHistoricalPortfolioValuesRequest.ProtoReflect.Descriptor instead.
 func (*GetHistoricalPortfolioValuesRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{6}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetHistoricalPortfolioValuesRequest) GetWalletId() int32 {
@@ -1077,35 +1401,46 @@ func (x *GetHistoricalPortfolioValuesRequest) GetPoints() int32 {
 	return 0
 }
 
-// GetHistoricalPortfolioValuesResponse with historical portfolio values
-type GetHistoricalPortfolioValuesResponse struct {
+func (x *GetHistoricalPortfolioValuesRequest) GetBenchmarkSymbol() string {
+	if x != nil {
+		return x.BenchmarkSymbol
+	}
+	return ""
+}
+
+// BenchmarkComparison compares the portfolio series with holding a benchmark over the same period
+type BenchmarkComparison struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string                      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data      []*HistoricalPortfolioValue `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	Timestamp string                      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Symbol          string  `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`                     // Benchmark as requested (e.g. "XAU")
+	Ticker          string  `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`                     // Yahoo Finance ticker the prices come from (e.g. "GC=F")
+	Currency        string  `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                 // Quote currency of the benchmark
+	StartPrice      float64 `protobuf:"fixed64,4,opt,name=startPrice,proto3" json:"startPrice,omitempty"`           // Benchmark close at the first point
+	EndPrice        float64 `protobuf:"fixed64,5,opt,name=endPrice,proto3" json:"endPrice,omitempty"`               // Benchmark close at the last point
+	PortfolioReturn float64 `protobuf:"fixed64,6,opt,name=portfolioReturn,proto3" json:"portfolioReturn,omitempty"` // Portfolio return net of contributions (0.1 = 10%)
+	BenchmarkReturn float64 `protobuf:"fixed64,7,opt,name=benchmarkReturn,proto3" json:"benchmarkReturn,omitempty"` // Benchmark price return (0.1 = 10%)
+	Alpha           float64 `protobuf:"fixed64,8,opt,name=alpha,proto3" json:"alpha,omitempty"`                     // portfolioReturn - benchmarkReturn
 }
 
-func (x *GetHistoricalPortfolioValuesResponse) Reset() {
-	*x = GetHistoricalPortfolioValuesResponse{}
+func (x *BenchmarkComparison) Reset() {
+	*x = BenchmarkComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[7]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHistoricalPortfolioValuesResponse) String() string {
+func (x *BenchmarkComparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricalPortfolioValuesResponse) ProtoMessage() {}
+func (*BenchmarkComparison) ProtoMessage() {}
 
-func (x *GetHistoricalPortfolioValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[7]
+func (x *BenchmarkComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,45 +1451,153 @@ func (x *GetHistoricalPortfolioValuesResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricalPortfolioValuesResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoricalPortfolioValuesResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BenchmarkComparison.ProtoReflect.Descriptor instead.
+func (*BenchmarkComparison) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetHistoricalPortfolioValuesResponse) GetSuccess() bool {
+func (x *BenchmarkComparison) GetSymbol() string {
 	if x != nil {
-		return x.Success
+		return x.Symbol
 	}
-	return false
+	return ""
 }
 
-func (x *GetHistoricalPortfolioValuesResponse) GetMessage() string {
+func (x *BenchmarkComparison) GetTicker() string {
 	if x != nil {
-		return x.Message
+		return x.Ticker
 	}
 	return ""
 }
 
-func (x *GetHistoricalPortfolioValuesResponse) GetData() []*HistoricalPortfolioValue {
+func (x *BenchmarkComparison) GetCurrency() string {
 	if x != nil {
-		return x.Data
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *GetHistoricalPortfolioValuesResponse) GetTimestamp() string {
+func (x *BenchmarkComparison) GetStartPrice() float64 {
 	if x != nil {
-		return x.Timestamp
+		return x.StartPrice
 	}
-	return ""
+	return 0
 }
 
-// GoldTypeCode represents a gold type available for investment
-// Used for frontend selection of gold investments
-type GoldTypeCode struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *BenchmarkComparison) GetEndPrice() float64 {
+	if x != nil {
+		return x.EndPrice
+	}
+	return 0
+}
+
+func (x *BenchmarkComparison) GetPortfolioReturn() float64 {
+	if x != nil {
+		return x.PortfolioReturn
+	}
+	return 0
+}
+
+func (x *BenchmarkComparison) GetBenchmarkReturn() float64 {
+	if x != nil {
+		return x.BenchmarkReturn
+	}
+	return 0
+}
+
+func (x *BenchmarkComparison) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+// GetHistoricalPortfolioValuesResponse with historical portfolio values
+type GetHistoricalPortfolioValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string                      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      []*HistoricalPortfolioValue `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Timestamp string                      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Benchmark *BenchmarkComparison        `protobuf:"bytes,5,opt,name=benchmark,proto3" json:"benchmark,omitempty"` // Set when benchmarkSymbol was requested
+}
+
+func (x *GetHistoricalPortfolioValuesResponse) Reset() {
+	*x = GetHistoricalPortfolioValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHistoricalPortfolioValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoricalPortfolioValuesResponse) ProtoMessage() {}
+
+func (x *GetHistoricalPortfolioValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoricalPortfolioValuesResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoricalPortfolioValuesResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetHistoricalPortfolioValuesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetHistoricalPortfolioValuesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetHistoricalPortfolioValuesResponse) GetData() []*HistoricalPortfolioValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetHistoricalPortfolioValuesResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *GetHistoricalPortfolioValuesResponse) GetBenchmark() *BenchmarkComparison {
+	if x != nil {
+		return x.Benchmark
+	}
+	return nil
+}
+
+// GoldTypeCode represents a gold type available for investment
+// Used for frontend selection of gold investments
+type GoldTypeCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	Code       string  `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`               // e.g., "SJL1L10", "XAU"
 	Name       string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Display name (e.g., "SJC 1L-10L", "Gold World")
@@ -1167,7 +1610,7 @@ type GoldTypeCode struct {
 func (x *GoldTypeCode) Reset() {
 	*x = GoldTypeCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[8]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1180,7 +1623,7 @@ func (x *GoldTypeCode) String() string {
 func (*GoldTypeCode) ProtoMessage() {}
 
 func (x *GoldTypeCode) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[8]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,7 +1636,7 @@ func (x *GoldTypeCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoldTypeCode.ProtoReflect.Descriptor instead.
 func (*GoldTypeCode) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{8}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GoldTypeCode) GetCode() string {
@@ -1250,7 +1693,7 @@ type GetGoldTypeCodesRequest struct {
 func (x *GetGoldTypeCodesRequest) Reset() {
 	*x = GetGoldTypeCodesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[9]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1263,7 +1706,7 @@ func (x *GetGoldTypeCodesRequest) String() string {
 func (*GetGoldTypeCodesRequest) ProtoMessage() {}
 
 func (x *GetGoldTypeCodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[9]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,7 +1719,7 @@ func (x *GetGoldTypeCodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGoldTypeCodesRequest.ProtoReflect.Descriptor instead.
 func (*GetGoldTypeCodesRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{9}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetGoldTypeCodesRequest) GetCurrency() string {
@@ -1300,7 +1743,7 @@ type GetGoldTypeCodesResponse struct {
 func (x *GetGoldTypeCodesResponse) Reset() {
 	*x = GetGoldTypeCodesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[10]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1313,7 +1756,7 @@ func (x *GetGoldTypeCodesResponse) String() string {
 func (*GetGoldTypeCodesResponse) ProtoMessage() {}
 
 func (x *GetGoldTypeCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[10]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1326,7 +1769,7 @@ func (x *GetGoldTypeCodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGoldTypeCodesResponse.ProtoReflect.Descriptor instead.
 func (*GetGoldTypeCodesResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{10}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetGoldTypeCodesResponse) GetSuccess() bool {
@@ -1366,7 +1809,7 @@ type SilverTypeCode struct {
 func (x *SilverTypeCode) Reset() {
 	*x = SilverTypeCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[11]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1379,7 +1822,7 @@ func (x *SilverTypeCode) String() string {
 func (*SilverTypeCode) ProtoMessage() {}
 
 func (x *SilverTypeCode) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[11]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1835,7 @@ func (x *SilverTypeCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SilverTypeCode.ProtoReflect.Descriptor instead.
 func (*SilverTypeCode) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{11}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SilverTypeCode) GetCode() string {
@@ -1435,7 +1878,7 @@ type GetSilverTypeCodesRequest struct {
 func (x *GetSilverTypeCodesRequest) Reset() {
 	*x = GetSilverTypeCodesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[12]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1448,7 +1891,7 @@ func (x *GetSilverTypeCodesRequest) String() string {
 func (*GetSilverTypeCodesRequest) ProtoMessage() {}
 
 func (x *GetSilverTypeCodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[12]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,7 +1904,7 @@ func (x *GetSilverTypeCodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSilverTypeCodesRequest.ProtoReflect.Descriptor instead.
 func (*GetSilverTypeCodesRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{12}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetSilverTypeCodesRequest) GetCurrency() string {
@@ -1485,7 +1928,7 @@ type GetSilverTypeCodesResponse struct {
 func (x *GetSilverTypeCodesResponse) Reset() {
 	*x = GetSilverTypeCodesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[13]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1498,7 +1941,7 @@ func (x *GetSilverTypeCodesResponse) String() string {
 func (*GetSilverTypeCodesResponse) ProtoMessage() {}
 
 func (x *GetSilverTypeCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[13]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,7 +1954,7 @@ func (x *GetSilverTypeCodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSilverTypeCodesResponse.ProtoReflect.Descriptor instead.
 func (*GetSilverTypeCodesResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{13}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetSilverTypeCodesResponse) GetSuccess() bool {
@@ -1549,7 +1992,7 @@ type GetMarketPriceRequest struct {
 func (x *GetMarketPriceRequest) Reset() {
 	*x = GetMarketPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[14]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1562,7 +2005,7 @@ func (x *GetMarketPriceRequest) String() string {
 func (*GetMarketPriceRequest) ProtoMessage() {}
 
 func (x *GetMarketPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[14]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,7 +2018,7 @@ func (x *GetMarketPriceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarketPriceRequest.ProtoReflect.Descriptor instead.
 func (*GetMarketPriceRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{14}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetMarketPriceRequest) GetSymbol() string {
@@ -1614,7 +2057,7 @@ type GetMarketPriceResponse struct {
 func (x *GetMarketPriceResponse) Reset() {
 	*x = GetMarketPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[15]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1627,7 +2070,7 @@ func (x *GetMarketPriceResponse) String() string {
 func (*GetMarketPriceResponse) ProtoMessage() {}
 
 func (x *GetMarketPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[15]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,7 +2083,7 @@ func (x *GetMarketPriceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarketPriceResponse.ProtoReflect.Descriptor instead.
 func (*GetMarketPriceResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{15}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetMarketPriceResponse) GetSuccess() bool {
@@ -1690,7 +2133,7 @@ type PriceItem struct {
 func (x *PriceItem) Reset() {
 	*x = PriceItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[16]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1703,7 +2146,7 @@ func (x *PriceItem) String() string {
 func (*PriceItem) ProtoMessage() {}
 
 func (x *PriceItem) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[16]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,7 +2159,7 @@ func (x *PriceItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PriceItem.ProtoReflect.Descriptor instead.
 func (*PriceItem) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{16}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PriceItem) GetTypeCode() string {
@@ -1785,7 +2228,7 @@ type GetMarketPricesRequest struct {
 func (x *GetMarketPricesRequest) Reset() {
 	*x = GetMarketPricesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[17]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1798,7 +2241,7 @@ func (x *GetMarketPricesRequest) String() string {
 func (*GetMarketPricesRequest) ProtoMessage() {}
 
 func (x *GetMarketPricesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[17]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1811,7 +2254,7 @@ func (x *GetMarketPricesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarketPricesRequest.ProtoReflect.Descriptor instead.
 func (*GetMarketPricesRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{17}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{19}
 }
 
 // GetMarketPricesResponse returns all gold and silver prices
@@ -1830,7 +2273,7 @@ type GetMarketPricesResponse struct {
 func (x *GetMarketPricesResponse) Reset() {
 	*x = GetMarketPricesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[18]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1843,7 +2286,7 @@ func (x *GetMarketPricesResponse) String() string {
 func (*GetMarketPricesResponse) ProtoMessage() {}
 
 func (x *GetMarketPricesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[18]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,7 +2299,7 @@ func (x *GetMarketPricesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarketPricesResponse.ProtoReflect.Descriptor instead.
 func (*GetMarketPricesResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{18}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetMarketPricesResponse) GetSuccess() bool {
@@ -1912,7 +2355,7 @@ type MarketPrice struct {
 func (x *MarketPrice) Reset() {
 	*x = MarketPrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[19]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1925,7 +2368,7 @@ func (x *MarketPrice) String() string {
 func (*MarketPrice) ProtoMessage() {}
 
 func (x *MarketPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[19]
+	mi := &file_protobuf_v1_investment_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1938,7 +2381,7 @@ func (x *MarketPrice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketPrice.ProtoReflect.Descriptor instead.
 func (*MarketPrice) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{19}
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MarketPrice) GetSymbol() string {
@@ -1990,34 +2433,36 @@ func (x *MarketPrice) GetDisplayUnit() string {
 	return ""
 }
 
-// Request/Response messages
-type ListInvestmentsRequest struct {
+// GetPriceHistoryRequest - Daily prices of a symbol between two dates
+type GetPriceHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WalletId   int32             `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`
-	Pagination *PaginationParams `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	TypeFilter InvestmentType    `protobuf:"varint,3,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by type
+	Symbol    string         `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Currency  string         `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Type      InvestmentType `protobuf:"varint,3,opt,name=type,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"type,omitempty"` // For routing to correct API
+	StartDate int64          `protobuf:"varint,4,opt,name=startDate,proto3" json:"startDate,omitempty"`                                       // Unix seconds; defaults to one year ago
+	EndDate   int64          `protobuf:"varint,5,opt,name=endDate,proto3" json:"endDate,omitempty"`                                           // Unix seconds; defaults to now
 }
 
-func (x *ListInvestmentsRequest) Reset() {
-	*x = ListInvestmentsRequest{}
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[20]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListInvestmentsRequest) String() string {
+func (x *GetPriceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvestmentsRequest) ProtoMessage() {}
+func (*GetPriceHistoryRequest) ProtoMessage() {}
 
-func (x *ListInvestmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[20]
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2028,61 +2473,78 @@ func (x *ListInvestmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvestmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListInvestmentsRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListInvestmentsRequest) GetWalletId() int32 {
+func (x *GetPriceHistoryRequest) GetSymbol() string {
 	if x != nil {
-		return x.WalletId
+		return x.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (x *ListInvestmentsRequest) GetPagination() *PaginationParams {
+func (x *GetPriceHistoryRequest) GetCurrency() string {
 	if x != nil {
-		return x.Pagination
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *ListInvestmentsRequest) GetTypeFilter() InvestmentType {
+func (x *GetPriceHistoryRequest) GetType() InvestmentType {
 	if x != nil {
-		return x.TypeFilter
+		return x.Type
 	}
 	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
 }
 
-type ListInvestmentsResponse struct {
+func (x *GetPriceHistoryRequest) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+// PriceBar - Daily OHLC prices in the storage unit of the investment type
+type PriceBar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success    bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message    string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data       []*Investment     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	Timestamp  string            `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Pagination *PaginationResult `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Date   int64  `protobuf:"varint,1,opt,name=date,proto3" json:"date,omitempty"` // Unix seconds, start of the day (UTC)
+	Open   int64  `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"` // Smallest currency unit
+	High   int64  `protobuf:"varint,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low    int64  `protobuf:"varint,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close  int64  `protobuf:"varint,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume int64  `protobuf:"varint,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Source string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"` // "yahoo" or "quote" (recorded from live prices)
 }
 
-func (x *ListInvestmentsResponse) Reset() {
-	*x = ListInvestmentsResponse{}
+func (x *PriceBar) Reset() {
+	*x = PriceBar{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[21]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListInvestmentsResponse) String() string {
+func (x *PriceBar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvestmentsResponse) ProtoMessage() {}
+func (*PriceBar) ProtoMessage() {}
 
-func (x *ListInvestmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[21]
+func (x *PriceBar) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2093,121 +2555,91 @@ func (x *ListInvestmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvestmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListInvestmentsResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use PriceBar.ProtoReflect.Descriptor instead.
+func (*PriceBar) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListInvestmentsResponse) GetSuccess() bool {
+func (x *PriceBar) GetDate() int64 {
 	if x != nil {
-		return x.Success
+		return x.Date
 	}
-	return false
+	return 0
 }
 
-func (x *ListInvestmentsResponse) GetMessage() string {
+func (x *PriceBar) GetOpen() int64 {
 	if x != nil {
-		return x.Message
+		return x.Open
 	}
-	return ""
+	return 0
 }
 
-func (x *ListInvestmentsResponse) GetData() []*Investment {
+func (x *PriceBar) GetHigh() int64 {
 	if x != nil {
-		return x.Data
+		return x.High
 	}
-	return nil
+	return 0
 }
 
-func (x *ListInvestmentsResponse) GetTimestamp() string {
+func (x *PriceBar) GetLow() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.Low
 	}
-	return ""
+	return 0
 }
 
-func (x *ListInvestmentsResponse) GetPagination() *PaginationResult {
+func (x *PriceBar) GetClose() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.Close
 	}
-	return nil
+	return 0
 }
 
-type GetInvestmentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *GetInvestmentRequest) Reset() {
-	*x = GetInvestmentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetInvestmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetInvestmentRequest) ProtoMessage() {}
-
-func (x *GetInvestmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PriceBar) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetInvestmentRequest.ProtoReflect.Descriptor instead.
-func (*GetInvestmentRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{22}
+	return 0
 }
 
-func (x *GetInvestmentRequest) GetId() int32 {
+func (x *PriceBar) GetSource() string {
 	if x != nil {
-		return x.Id
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-type GetInvestmentResponse struct {
+// GetPriceHistoryResponse - Daily prices, oldest first
+type GetPriceHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Success   bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data      *Investment `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp string      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Symbol    string      `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Currency  string      `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Data      []*PriceBar `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Timestamp string      `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *GetInvestmentResponse) Reset() {
-	*x = GetInvestmentResponse{}
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[23]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInvestmentResponse) String() string {
+func (x *GetPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInvestmentResponse) ProtoMessage() {}
+func (*GetPriceHistoryResponse) ProtoMessage() {}
 
-func (x *GetInvestmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[23]
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,87 +2650,77 @@ func (x *GetInvestmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInvestmentResponse.ProtoReflect.Descriptor instead.
-func (*GetInvestmentResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetInvestmentResponse) GetSuccess() bool {
+func (x *GetPriceHistoryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetInvestmentResponse) GetMessage() string {
+func (x *GetPriceHistoryResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetInvestmentResponse) GetData() *Investment {
+func (x *GetPriceHistoryResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryResponse) GetData() []*PriceBar {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *GetInvestmentResponse) GetTimestamp() string {
+func (x *GetPriceHistoryResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-// CreateInvestmentRequest creates a new investment holding.
-//
-// For market-based investments (isCustom=false, default):
-// - Symbol must exist in market data APIs (Yahoo Finance, vang.today)
-// - Currency is validated against market data
-// - Current price fetched from market APIs
-//
-// For custom investments (isCustom=true):
-// - Symbol can be any user-defined string
-// - Currency must be provided manually
-// - Current price defaults to 0 (can be set manually later via UpdateInvestment)
-type CreateInvestmentRequest struct {
+// GetPriceProviderHealthRequest - Health of the market price providers
+type GetPriceProviderHealthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	WalletId        int32          `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`
-	Symbol          string         `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Name            string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type            InvestmentType `protobuf:"varint,4,opt,name=type,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"type,omitempty"`
-	InitialQuantity int64          `protobuf:"varint,5,opt,name=initialQuantity,proto3" json:"initialQuantity,omitempty"`
-	InitialCost     int64          `protobuf:"varint,6,opt,name=initialCost,proto3" json:"initialCost,omitempty"` // Total cost in smallest currency unit (cents for USD, dong for VND)
-	Currency        string         `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	// Optional: Provide decimal values for convenience (server converts to int64)
-	// If provided, these take precedence over initialQuantity/initialCost
-	InitialQuantityDecimal float64 `protobuf:"fixed64,8,opt,name=initialQuantityDecimal,proto3" json:"initialQuantityDecimal,omitempty"`
-	InitialCostDecimal     float64 `protobuf:"fixed64,9,opt,name=initialCostDecimal,proto3" json:"initialCostDecimal,omitempty"` // Total cost as decimal (e.g., 1500.50 for $1,500.50)
-	IsCustom               bool    `protobuf:"varint,10,opt,name=isCustom,proto3" json:"isCustom,omitempty"`                     // If true, skip market data validation and allow currentPrice=0
-	PurchaseUnit           string  `protobuf:"bytes,13,opt,name=purchaseUnit,proto3" json:"purchaseUnit,omitempty"`              // User's input unit ("tael", "kg", "oz", "gram")
 }
 
-func (x *CreateInvestmentRequest) Reset() {
-	*x = CreateInvestmentRequest{}
+func (x *GetPriceProviderHealthRequest) Reset() {
+	*x = GetPriceProviderHealthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[24]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateInvestmentRequest) String() string {
+func (x *GetPriceProviderHealthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvestmentRequest) ProtoMessage() {}
+func (*GetPriceProviderHealthRequest) ProtoMessage() {}
 
-func (x *CreateInvestmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[24]
+func (x *GetPriceProviderHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2309,116 +2731,136 @@ func (x *CreateInvestmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvestmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateInvestmentRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetPriceProviderHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceProviderHealthRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateInvestmentRequest) GetWalletId() int32 {
-	if x != nil {
-		return x.WalletId
-	}
-	return 0
+// PriceProviderHealth - Recent outcome of the calls made to one price provider
+type PriceProviderHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Healthy             bool   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	ConsecutiveFailures int32  `protobuf:"varint,3,opt,name=consecutiveFailures,proto3" json:"consecutiveFailures,omitempty"`
+	LastError           string `protobuf:"bytes,4,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	LastSuccess         int64  `protobuf:"varint,5,opt,name=lastSuccess,proto3" json:"lastSuccess,omitempty"`     // Unix timestamp
+	LastFailure         int64  `protobuf:"varint,6,opt,name=lastFailure,proto3" json:"lastFailure,omitempty"`     // Unix timestamp
+	CooldownUntil       int64  `protobuf:"varint,7,opt,name=cooldownUntil,proto3" json:"cooldownUntil,omitempty"` // Unix timestamp; skipped until then
 }
 
-func (x *CreateInvestmentRequest) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
+func (x *PriceProviderHealth) Reset() {
+	*x = PriceProviderHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateInvestmentRequest) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *PriceProviderHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceProviderHealth) ProtoMessage() {}
+
+func (x *PriceProviderHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateInvestmentRequest) GetType() InvestmentType {
+// Deprecated: Use PriceProviderHealth.ProtoReflect.Descriptor instead.
+func (*PriceProviderHealth) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PriceProviderHealth) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
-	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateInvestmentRequest) GetInitialQuantity() int64 {
+func (x *PriceProviderHealth) GetHealthy() bool {
 	if x != nil {
-		return x.InitialQuantity
+		return x.Healthy
 	}
-	return 0
+	return false
 }
 
-func (x *CreateInvestmentRequest) GetInitialCost() int64 {
+func (x *PriceProviderHealth) GetConsecutiveFailures() int32 {
 	if x != nil {
-		return x.InitialCost
+		return x.ConsecutiveFailures
 	}
 	return 0
 }
 
-func (x *CreateInvestmentRequest) GetCurrency() string {
+func (x *PriceProviderHealth) GetLastError() string {
 	if x != nil {
-		return x.Currency
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *CreateInvestmentRequest) GetInitialQuantityDecimal() float64 {
+func (x *PriceProviderHealth) GetLastSuccess() int64 {
 	if x != nil {
-		return x.InitialQuantityDecimal
+		return x.LastSuccess
 	}
 	return 0
 }
 
-func (x *CreateInvestmentRequest) GetInitialCostDecimal() float64 {
+func (x *PriceProviderHealth) GetLastFailure() int64 {
 	if x != nil {
-		return x.InitialCostDecimal
+		return x.LastFailure
 	}
 	return 0
 }
 
-func (x *CreateInvestmentRequest) GetIsCustom() bool {
-	if x != nil {
-		return x.IsCustom
-	}
-	return false
-}
-
-func (x *CreateInvestmentRequest) GetPurchaseUnit() string {
+func (x *PriceProviderHealth) GetCooldownUntil() int64 {
 	if x != nil {
-		return x.PurchaseUnit
+		return x.CooldownUntil
 	}
-	return ""
+	return 0
 }
 
-type CreateInvestmentResponse struct {
+// GetPriceProviderHealthResponse - Health of each registered provider
+type GetPriceProviderHealthResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data      *Investment `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp string      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Success   bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      []*PriceProviderHealth `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Timestamp string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *CreateInvestmentResponse) Reset() {
-	*x = CreateInvestmentResponse{}
+func (x *GetPriceProviderHealthResponse) Reset() {
+	*x = GetPriceProviderHealthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[25]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateInvestmentResponse) String() string {
+func (x *GetPriceProviderHealthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvestmentResponse) ProtoMessage() {}
+func (*GetPriceProviderHealthResponse) ProtoMessage() {}
 
-func (x *CreateInvestmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[25]
+func (x *GetPriceProviderHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2429,66 +2871,67 @@ func (x *CreateInvestmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvestmentResponse.ProtoReflect.Descriptor instead.
-func (*CreateInvestmentResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetPriceProviderHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceProviderHealthResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateInvestmentResponse) GetSuccess() bool {
+func (x *GetPriceProviderHealthResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateInvestmentResponse) GetMessage() string {
+func (x *GetPriceProviderHealthResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateInvestmentResponse) GetData() *Investment {
+func (x *GetPriceProviderHealthResponse) GetData() []*PriceProviderHealth {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *CreateInvestmentResponse) GetTimestamp() string {
+func (x *GetPriceProviderHealthResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-type UpdateInvestmentRequest struct {
+// Request/Response messages
+type ListInvestmentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CurrentPrice int64  `protobuf:"varint,3,opt,name=currentPrice,proto3" json:"currentPrice,omitempty"` // Manual price override
+	WalletId   int32             `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`
+	Pagination *PaginationParams `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TypeFilter InvestmentType    `protobuf:"varint,3,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by type
 }
 
-func (x *UpdateInvestmentRequest) Reset() {
-	*x = UpdateInvestmentRequest{}
+func (x *ListInvestmentsRequest) Reset() {
+	*x = ListInvestmentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[26]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateInvestmentRequest) String() string {
+func (x *ListInvestmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInvestmentRequest) ProtoMessage() {}
+func (*ListInvestmentsRequest) ProtoMessage() {}
 
-func (x *UpdateInvestmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[26]
+func (x *ListInvestmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2499,60 +2942,61 @@ func (x *UpdateInvestmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInvestmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInvestmentRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListInvestmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvestmentsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpdateInvestmentRequest) GetId() int32 {
+func (x *ListInvestmentsRequest) GetWalletId() int32 {
 	if x != nil {
-		return x.Id
+		return x.WalletId
 	}
 	return 0
 }
 
-func (x *UpdateInvestmentRequest) GetName() string {
+func (x *ListInvestmentsRequest) GetPagination() *PaginationParams {
 	if x != nil {
-		return x.Name
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateInvestmentRequest) GetCurrentPrice() int64 {
+func (x *ListInvestmentsRequest) GetTypeFilter() InvestmentType {
 	if x != nil {
-		return x.CurrentPrice
+		return x.TypeFilter
 	}
-	return 0
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
 }
 
-type UpdateInvestmentResponse struct {
+type ListInvestmentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data      *Investment `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp string      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Success    bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data       []*Investment     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Timestamp  string            `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Pagination *PaginationResult `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *UpdateInvestmentResponse) Reset() {
-	*x = UpdateInvestmentResponse{}
+func (x *ListInvestmentsResponse) Reset() {
+	*x = ListInvestmentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[27]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateInvestmentResponse) String() string {
+func (x *ListInvestmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInvestmentResponse) ProtoMessage() {}
+func (*ListInvestmentsResponse) ProtoMessage() {}
 
-func (x *UpdateInvestmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[27]
+func (x *ListInvestmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,40 +3007,47 @@ func (x *UpdateInvestmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInvestmentResponse.ProtoReflect.Descriptor instead.
-func (*UpdateInvestmentResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListInvestmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvestmentsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateInvestmentResponse) GetSuccess() bool {
+func (x *ListInvestmentsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateInvestmentResponse) GetMessage() string {
+func (x *ListInvestmentsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateInvestmentResponse) GetData() *Investment {
+func (x *ListInvestmentsResponse) GetData() []*Investment {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *UpdateInvestmentResponse) GetTimestamp() string {
+func (x *ListInvestmentsResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-type DeleteInvestmentRequest struct {
+func (x *ListInvestmentsResponse) GetPagination() *PaginationResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetInvestmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2604,23 +3055,23 @@ type DeleteInvestmentRequest struct {
 	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteInvestmentRequest) Reset() {
-	*x = DeleteInvestmentRequest{}
+func (x *GetInvestmentRequest) Reset() {
+	*x = GetInvestmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[28]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteInvestmentRequest) String() string {
+func (x *GetInvestmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInvestmentRequest) ProtoMessage() {}
+func (*GetInvestmentRequest) ProtoMessage() {}
 
-func (x *DeleteInvestmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[28]
+func (x *GetInvestmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,45 +3082,46 @@ func (x *DeleteInvestmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInvestmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteInvestmentRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetInvestmentRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestmentRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DeleteInvestmentRequest) GetId() int32 {
+func (x *GetInvestmentRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteInvestmentResponse struct {
+type GetInvestmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Success   bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *Investment `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *DeleteInvestmentResponse) Reset() {
-	*x = DeleteInvestmentResponse{}
+func (x *GetInvestmentResponse) Reset() {
+	*x = GetInvestmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[29]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteInvestmentResponse) String() string {
+func (x *GetInvestmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInvestmentResponse) ProtoMessage() {}
+func (*GetInvestmentResponse) ProtoMessage() {}
 
-func (x *DeleteInvestmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[29]
+func (x *GetInvestmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2680,63 +3132,88 @@ func (x *DeleteInvestmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInvestmentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteInvestmentResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetInvestmentResponse.ProtoReflect.Descriptor instead.
+func (*GetInvestmentResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeleteInvestmentResponse) GetSuccess() bool {
+func (x *GetInvestmentResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteInvestmentResponse) GetMessage() string {
+func (x *GetInvestmentResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *DeleteInvestmentResponse) GetTimestamp() string {
+func (x *GetInvestmentResponse) GetData() *Investment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetInvestmentResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-type AddTransactionRequest struct {
+// CreateInvestmentRequest creates a new investment holding.
+//
+// For market-based investments (isCustom=false, default):
+// - Symbol must exist in market data APIs (Yahoo Finance, vang.today)
+// - Currency is validated against market data
+// - Current price fetched from market APIs
+//
+// For custom investments (isCustom=true):
+// - Symbol can be any user-defined string
+// - Currency must be provided manually
+// - Current price defaults to 0 (can be set manually later via UpdateInvestment)
+type CreateInvestmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvestmentId    int32                     `protobuf:"varint,1,opt,name=investmentId,proto3" json:"investmentId,omitempty"`
-	Type            InvestmentTransactionType `protobuf:"varint,2,opt,name=type,proto3,enum=wealthjourney.investment.v1.InvestmentTransactionType" json:"type,omitempty"`
-	Quantity        int64                     `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price           int64                     `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Fees            int64                     `protobuf:"varint,5,opt,name=fees,proto3" json:"fees,omitempty"`
-	TransactionDate int64                     `protobuf:"varint,6,opt,name=transactionDate,proto3" json:"transactionDate,omitempty"`
-	Notes           string                    `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	WalletId        int32          `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`
+	Symbol          string         `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name            string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type            InvestmentType `protobuf:"varint,4,opt,name=type,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"type,omitempty"`
+	InitialQuantity int64          `protobuf:"varint,5,opt,name=initialQuantity,proto3" json:"initialQuantity,omitempty"`
+	InitialCost     int64          `protobuf:"varint,6,opt,name=initialCost,proto3" json:"initialCost,omitempty"` // Total cost in smallest currency unit (cents for USD, dong for VND)
+	Currency        string         `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Optional: Provide decimal values for convenience (server converts to int64)
+	// If provided, these take precedence over initialQuantity/initialCost
+	InitialQuantityDecimal float64         `protobuf:"fixed64,8,opt,name=initialQuantityDecimal,proto3" json:"initialQuantityDecimal,omitempty"`
+	InitialCostDecimal     float64         `protobuf:"fixed64,9,opt,name=initialCostDecimal,proto3" json:"initialCostDecimal,omitempty"`                                            // Total cost as decimal (e.g., 1500.50 for $1,500.50)
+	IsCustom               bool            `protobuf:"varint,10,opt,name=isCustom,proto3" json:"isCustom,omitempty"`                                                                // If true, skip market data validation and allow currentPrice=0
+	PurchaseUnit           string          `protobuf:"bytes,13,opt,name=purchaseUnit,proto3" json:"purchaseUnit,omitempty"`                                                         // User's input unit ("tael", "kg", "oz", "gram")
+	CostBasisMethod        CostBasisMethod `protobuf:"varint,14,opt,name=costBasisMethod,proto3,enum=wealthjourney.investment.v1.CostBasisMethod" json:"costBasisMethod,omitempty"` // Defaults to the user's default method
 }
 
-func (x *AddTransactionRequest) Reset() {
-	*x = AddTransactionRequest{}
+func (x *CreateInvestmentRequest) Reset() {
+	*x = CreateInvestmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[30]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddTransactionRequest) String() string {
+func (x *CreateInvestmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTransactionRequest) ProtoMessage() {}
+func (*CreateInvestmentRequest) ProtoMessage() {}
 
-func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[30]
+func (x *CreateInvestmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2747,89 +3224,123 @@ func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTransactionRequest.ProtoReflect.Descriptor instead.
-func (*AddTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CreateInvestmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvestmentRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AddTransactionRequest) GetInvestmentId() int32 {
+func (x *CreateInvestmentRequest) GetWalletId() int32 {
 	if x != nil {
-		return x.InvestmentId
+		return x.WalletId
 	}
 	return 0
 }
 
-func (x *AddTransactionRequest) GetType() InvestmentTransactionType {
+func (x *CreateInvestmentRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateInvestmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateInvestmentRequest) GetType() InvestmentType {
 	if x != nil {
 		return x.Type
 	}
-	return InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_UNSPECIFIED
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
 }
 
-func (x *AddTransactionRequest) GetQuantity() int64 {
+func (x *CreateInvestmentRequest) GetInitialQuantity() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.InitialQuantity
 	}
 	return 0
 }
 
-func (x *AddTransactionRequest) GetPrice() int64 {
+func (x *CreateInvestmentRequest) GetInitialCost() int64 {
 	if x != nil {
-		return x.Price
+		return x.InitialCost
 	}
 	return 0
 }
 
-func (x *AddTransactionRequest) GetFees() int64 {
+func (x *CreateInvestmentRequest) GetCurrency() string {
 	if x != nil {
-		return x.Fees
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateInvestmentRequest) GetInitialQuantityDecimal() float64 {
+	if x != nil {
+		return x.InitialQuantityDecimal
 	}
 	return 0
 }
 
-func (x *AddTransactionRequest) GetTransactionDate() int64 {
+func (x *CreateInvestmentRequest) GetInitialCostDecimal() float64 {
 	if x != nil {
-		return x.TransactionDate
+		return x.InitialCostDecimal
 	}
 	return 0
 }
 
-func (x *AddTransactionRequest) GetNotes() string {
+func (x *CreateInvestmentRequest) GetIsCustom() bool {
 	if x != nil {
-		return x.Notes
+		return x.IsCustom
+	}
+	return false
+}
+
+func (x *CreateInvestmentRequest) GetPurchaseUnit() string {
+	if x != nil {
+		return x.PurchaseUnit
 	}
 	return ""
 }
 
-type AddTransactionResponse struct {
+func (x *CreateInvestmentRequest) GetCostBasisMethod() CostBasisMethod {
+	if x != nil {
+		return x.CostBasisMethod
+	}
+	return CostBasisMethod_COST_BASIS_METHOD_UNSPECIFIED
+}
+
+type CreateInvestmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message           string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data              *InvestmentTransaction `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	UpdatedInvestment *Investment            `protobuf:"bytes,4,opt,name=updatedInvestment,proto3" json:"updatedInvestment,omitempty"` // Investment with updated quantities
-	Timestamp         string                 `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Success   bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *Investment `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *AddTransactionResponse) Reset() {
-	*x = AddTransactionResponse{}
+func (x *CreateInvestmentResponse) Reset() {
+	*x = CreateInvestmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[31]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddTransactionResponse) String() string {
+func (x *CreateInvestmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTransactionResponse) ProtoMessage() {}
+func (*CreateInvestmentResponse) ProtoMessage() {}
 
-func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[31]
+func (x *CreateInvestmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2840,73 +3351,68 @@ func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTransactionResponse.ProtoReflect.Descriptor instead.
-func (*AddTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use CreateInvestmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvestmentResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *AddTransactionResponse) GetSuccess() bool {
+func (x *CreateInvestmentResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *AddTransactionResponse) GetMessage() string {
+func (x *CreateInvestmentResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *AddTransactionResponse) GetData() *InvestmentTransaction {
+func (x *CreateInvestmentResponse) GetData() *Investment {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *AddTransactionResponse) GetUpdatedInvestment() *Investment {
-	if x != nil {
-		return x.UpdatedInvestment
-	}
-	return nil
-}
-
-func (x *AddTransactionResponse) GetTimestamp() string {
+func (x *CreateInvestmentResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-type ListInvestmentTransactionsRequest struct {
+type UpdateInvestmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvestmentId int32                     `protobuf:"varint,1,opt,name=investmentId,proto3" json:"investmentId,omitempty"`
-	Pagination   *PaginationParams         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	TypeFilter   InvestmentTransactionType `protobuf:"varint,3,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentTransactionType" json:"typeFilter,omitempty"`
+	Id              int32           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CurrentPrice    int64           `protobuf:"varint,3,opt,name=currentPrice,proto3" json:"currentPrice,omitempty"`                                                        // Manual price override
+	CostBasisMethod CostBasisMethod `protobuf:"varint,4,opt,name=costBasisMethod,proto3,enum=wealthjourney.investment.v1.CostBasisMethod" json:"costBasisMethod,omitempty"` // Applies to future sells; UNSPECIFIED keeps the current method
+	AssetClass      *string         `protobuf:"bytes,5,opt,name=assetClass,proto3,oneof" json:"assetClass,omitempty"`                                                       // Omitted keeps the current tag; empty clears it
 }
 
-func (x *ListInvestmentTransactionsRequest) Reset() {
-	*x = ListInvestmentTransactionsRequest{}
+func (x *UpdateInvestmentRequest) Reset() {
+	*x = UpdateInvestmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[32]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListInvestmentTransactionsRequest) String() string {
+func (x *UpdateInvestmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvestmentTransactionsRequest) ProtoMessage() {}
+func (*UpdateInvestmentRequest) ProtoMessage() {}
 
-func (x *ListInvestmentTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[32]
+func (x *UpdateInvestmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2917,61 +3423,74 @@ func (x *ListInvestmentTransactionsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvestmentTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListInvestmentTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use UpdateInvestmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInvestmentRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListInvestmentTransactionsRequest) GetInvestmentId() int32 {
+func (x *UpdateInvestmentRequest) GetId() int32 {
 	if x != nil {
-		return x.InvestmentId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListInvestmentTransactionsRequest) GetPagination() *PaginationParams {
+func (x *UpdateInvestmentRequest) GetName() string {
 	if x != nil {
-		return x.Pagination
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListInvestmentTransactionsRequest) GetTypeFilter() InvestmentTransactionType {
+func (x *UpdateInvestmentRequest) GetCurrentPrice() int64 {
 	if x != nil {
-		return x.TypeFilter
+		return x.CurrentPrice
 	}
-	return InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_UNSPECIFIED
+	return 0
 }
 
-type ListInvestmentTransactionsResponse struct {
+func (x *UpdateInvestmentRequest) GetCostBasisMethod() CostBasisMethod {
+	if x != nil {
+		return x.CostBasisMethod
+	}
+	return CostBasisMethod_COST_BASIS_METHOD_UNSPECIFIED
+}
+
+func (x *UpdateInvestmentRequest) GetAssetClass() string {
+	if x != nil && x.AssetClass != nil {
+		return *x.AssetClass
+	}
+	return ""
+}
+
+type UpdateInvestmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success    bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message    string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data       []*InvestmentTransaction `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	Timestamp  string                   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Pagination *PaginationResult        `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Success   bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *Investment `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string      `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *ListInvestmentTransactionsResponse) Reset() {
-	*x = ListInvestmentTransactionsResponse{}
+func (x *UpdateInvestmentResponse) Reset() {
+	*x = UpdateInvestmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[33]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListInvestmentTransactionsResponse) String() string {
+func (x *UpdateInvestmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvestmentTransactionsResponse) ProtoMessage() {}
+func (*UpdateInvestmentResponse) ProtoMessage() {}
 
-func (x *ListInvestmentTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[33]
+func (x *UpdateInvestmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2982,76 +3501,64 @@ func (x *ListInvestmentTransactionsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvestmentTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListInvestmentTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UpdateInvestmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInvestmentResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListInvestmentTransactionsResponse) GetSuccess() bool {
+func (x *UpdateInvestmentResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ListInvestmentTransactionsResponse) GetMessage() string {
+func (x *UpdateInvestmentResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ListInvestmentTransactionsResponse) GetData() []*InvestmentTransaction {
+func (x *UpdateInvestmentResponse) GetData() *Investment {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ListInvestmentTransactionsResponse) GetTimestamp() string {
+func (x *UpdateInvestmentResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *ListInvestmentTransactionsResponse) GetPagination() *PaginationResult {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-type EditInvestmentTransactionRequest struct {
+type DeleteInvestmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Quantity        int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price           int64  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Fees            int64  `protobuf:"varint,4,opt,name=fees,proto3" json:"fees,omitempty"`
-	TransactionDate int64  `protobuf:"varint,5,opt,name=transactionDate,proto3" json:"transactionDate,omitempty"`
-	Notes           string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *EditInvestmentTransactionRequest) Reset() {
-	*x = EditInvestmentTransactionRequest{}
+func (x *DeleteInvestmentRequest) Reset() {
+	*x = DeleteInvestmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[34]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditInvestmentTransactionRequest) String() string {
+func (x *DeleteInvestmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditInvestmentTransactionRequest) ProtoMessage() {}
+func (*DeleteInvestmentRequest) ProtoMessage() {}
 
-func (x *EditInvestmentTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[34]
+func (x *DeleteInvestmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3062,81 +3569,45 @@ func (x *EditInvestmentTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditInvestmentTransactionRequest.ProtoReflect.Descriptor instead.
-func (*EditInvestmentTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DeleteInvestmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInvestmentRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *EditInvestmentTransactionRequest) GetId() int32 {
+func (x *DeleteInvestmentRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *EditInvestmentTransactionRequest) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *EditInvestmentTransactionRequest) GetPrice() int64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *EditInvestmentTransactionRequest) GetFees() int64 {
-	if x != nil {
-		return x.Fees
-	}
-	return 0
-}
-
-func (x *EditInvestmentTransactionRequest) GetTransactionDate() int64 {
-	if x != nil {
-		return x.TransactionDate
-	}
-	return 0
-}
-
-func (x *EditInvestmentTransactionRequest) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
-}
-
-type EditInvestmentTransactionResponse struct {
+type DeleteInvestmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data      *InvestmentTransaction `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *EditInvestmentTransactionResponse) Reset() {
-	*x = EditInvestmentTransactionResponse{}
+func (x *DeleteInvestmentResponse) Reset() {
+	*x = DeleteInvestmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[35]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditInvestmentTransactionResponse) String() string {
+func (x *DeleteInvestmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditInvestmentTransactionResponse) ProtoMessage() {}
+func (*DeleteInvestmentResponse) ProtoMessage() {}
 
-func (x *EditInvestmentTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[35]
+func (x *DeleteInvestmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3147,64 +3618,70 @@ func (x *EditInvestmentTransactionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditInvestmentTransactionResponse.ProtoReflect.Descriptor instead.
-func (*EditInvestmentTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DeleteInvestmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInvestmentResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *EditInvestmentTransactionResponse) GetSuccess() bool {
+func (x *DeleteInvestmentResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *EditInvestmentTransactionResponse) GetMessage() string {
+func (x *DeleteInvestmentResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *EditInvestmentTransactionResponse) GetData() *InvestmentTransaction {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *EditInvestmentTransactionResponse) GetTimestamp() string {
+func (x *DeleteInvestmentResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-type DeleteInvestmentTransactionRequest struct {
+type AddTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvestmentId    int32                     `protobuf:"varint,1,opt,name=investmentId,proto3" json:"investmentId,omitempty"`
+	Type            InvestmentTransactionType `protobuf:"varint,2,opt,name=type,proto3,enum=wealthjourney.investment.v1.InvestmentTransactionType" json:"type,omitempty"`
+	Quantity        int64                     `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price           int64                     `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Fees            int64                     `protobuf:"varint,5,opt,name=fees,proto3" json:"fees,omitempty"`
+	TransactionDate int64                     `protobuf:"varint,6,opt,name=transactionDate,proto3" json:"transactionDate,omitempty"`
+	Notes           string                    `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Split ratio, required for SPLIT transactions (quantity and price are ignored).
+	// A 2-for-1 split is 2/1, a 1-for-10 reverse split is 1/10.
+	SplitNumerator   int32 `protobuf:"varint,8,opt,name=splitNumerator,proto3" json:"splitNumerator,omitempty"`
+	SplitDenominator int32 `protobuf:"varint,9,opt,name=splitDenominator,proto3" json:"splitDenominator,omitempty"`
+	// Lots to sell from (SELL only). Required for SPECIFIC_LOT investments, optional for
+	// FIFO/LIFO/HIFO where it overrides the order, rejected for AVERAGE.
+	LotSelections []*LotSelection `protobuf:"bytes,10,rep,name=lotSelections,proto3" json:"lotSelections,omitempty"`
 }
 
-func (x *DeleteInvestmentTransactionRequest) Reset() {
-	*x = DeleteInvestmentTransactionRequest{}
+func (x *AddTransactionRequest) Reset() {
+	*x = AddTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[36]
+		mi := &file_protobuf_v1_investment_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteInvestmentTransactionRequest) String() string {
+func (x *AddTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInvestmentTransactionRequest) ProtoMessage() {}
+func (*AddTransactionRequest) ProtoMessage() {}
 
-func (x *DeleteInvestmentTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[36]
+func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3215,141 +3692,95 @@ func (x *DeleteInvestmentTransactionRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInvestmentTransactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteInvestmentTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AddTransactionRequest.ProtoReflect.Descriptor instead.
+func (*AddTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DeleteInvestmentTransactionRequest) GetId() int32 {
+func (x *AddTransactionRequest) GetInvestmentId() int32 {
 	if x != nil {
-		return x.Id
+		return x.InvestmentId
 	}
 	return 0
 }
 
-type DeleteInvestmentTransactionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (x *AddTransactionRequest) GetType() InvestmentTransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_UNSPECIFIED
 }
 
-func (x *DeleteInvestmentTransactionResponse) Reset() {
-	*x = DeleteInvestmentTransactionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AddTransactionRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
 	}
+	return 0
 }
 
-func (x *DeleteInvestmentTransactionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteInvestmentTransactionResponse) ProtoMessage() {}
-
-func (x *DeleteInvestmentTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AddTransactionRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteInvestmentTransactionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteInvestmentTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{37}
+	return 0
 }
 
-func (x *DeleteInvestmentTransactionResponse) GetSuccess() bool {
+func (x *AddTransactionRequest) GetFees() int64 {
 	if x != nil {
-		return x.Success
+		return x.Fees
 	}
-	return false
+	return 0
 }
 
-func (x *DeleteInvestmentTransactionResponse) GetMessage() string {
+func (x *AddTransactionRequest) GetTransactionDate() int64 {
 	if x != nil {
-		return x.Message
+		return x.TransactionDate
 	}
-	return ""
+	return 0
 }
 
-func (x *DeleteInvestmentTransactionResponse) GetTimestamp() string {
+func (x *AddTransactionRequest) GetNotes() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Notes
 	}
 	return ""
 }
 
-type GetPortfolioSummaryRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	WalletId int32 `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`
-}
-
-func (x *GetPortfolioSummaryRequest) Reset() {
-	*x = GetPortfolioSummaryRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AddTransactionRequest) GetSplitNumerator() int32 {
+	if x != nil {
+		return x.SplitNumerator
 	}
+	return 0
 }
 
-func (x *GetPortfolioSummaryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetPortfolioSummaryRequest) ProtoMessage() {}
-
-func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_v1_investment_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AddTransactionRequest) GetSplitDenominator() int32 {
+	if x != nil {
+		return x.SplitDenominator
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{38}
+	return 0
 }
 
-func (x *GetPortfolioSummaryRequest) GetWalletId() int32 {
+func (x *AddTransactionRequest) GetLotSelections() []*LotSelection {
 	if x != nil {
-		return x.WalletId
+		return x.LotSelections
 	}
-	return 0
+	return nil
 }
 
-type GetPortfolioSummaryResponse struct {
+type AddTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data      *PortfolioSummary `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp string            `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message           string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data              *InvestmentTransaction `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	UpdatedInvestment *Investment            `protobuf:"bytes,4,opt,name=updatedInvestment,proto3" json:"updatedInvestment,omitempty"` // Investment with updated quantities
+	Timestamp         string                 `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *GetPortfolioSummaryResponse) Reset() {
-	*x = GetPortfolioSummaryResponse{}
+func (x *AddTransactionResponse) Reset() {
+	*x = AddTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_v1_investment_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3357,13 +3788,13 @@ func (x *GetPortfolioSummaryResponse) Reset() {
 	}
 }
 
-func (x *GetPortfolioSummaryResponse) String() string {
+func (x *AddTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPortfolioSummaryResponse) ProtoMessage() {}
+func (*AddTransactionResponse) ProtoMessage() {}
 
-func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
+func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3375,50 +3806,58 @@ func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPortfolioSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddTransactionResponse.ProtoReflect.Descriptor instead.
+func (*AddTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetPortfolioSummaryResponse) GetSuccess() bool {
+func (x *AddTransactionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetPortfolioSummaryResponse) GetMessage() string {
+func (x *AddTransactionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetPortfolioSummaryResponse) GetData() *PortfolioSummary {
+func (x *AddTransactionResponse) GetData() *InvestmentTransaction {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *GetPortfolioSummaryResponse) GetTimestamp() string {
+func (x *AddTransactionResponse) GetUpdatedInvestment() *Investment {
+	if x != nil {
+		return x.UpdatedInvestment
+	}
+	return nil
+}
+
+func (x *AddTransactionResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-type UpdatePricesRequest struct {
+type ListInvestmentTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvestmentIds []int32 `protobuf:"varint,1,rep,packed,name=investmentIds,proto3" json:"investmentIds,omitempty"` // Empty = update all
-	ForceRefresh  bool    `protobuf:"varint,2,opt,name=forceRefresh,proto3" json:"forceRefresh,omitempty"`          // Force API call even if recently updated
+	InvestmentId int32                     `protobuf:"varint,1,opt,name=investmentId,proto3" json:"investmentId,omitempty"`
+	Pagination   *PaginationParams         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TypeFilter   InvestmentTransactionType `protobuf:"varint,3,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentTransactionType" json:"typeFilter,omitempty"`
 }
 
-func (x *UpdatePricesRequest) Reset() {
-	*x = UpdatePricesRequest{}
+func (x *ListInvestmentTransactionsRequest) Reset() {
+	*x = ListInvestmentTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_v1_investment_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3426,13 +3865,13 @@ func (x *UpdatePricesRequest) Reset() {
 	}
 }
 
-func (x *UpdatePricesRequest) String() string {
+func (x *ListInvestmentTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePricesRequest) ProtoMessage() {}
+func (*ListInvestmentTransactionsRequest) ProtoMessage() {}
 
-func (x *UpdatePricesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListInvestmentTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3444,38 +3883,46 @@ func (x *UpdatePricesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePricesRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePricesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListInvestmentTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvestmentTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UpdatePricesRequest) GetInvestmentIds() []int32 {
+func (x *ListInvestmentTransactionsRequest) GetInvestmentId() int32 {
 	if x != nil {
-		return x.InvestmentIds
+		return x.InvestmentId
+	}
+	return 0
+}
+
+func (x *ListInvestmentTransactionsRequest) GetPagination() *PaginationParams {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *UpdatePricesRequest) GetForceRefresh() bool {
+func (x *ListInvestmentTransactionsRequest) GetTypeFilter() InvestmentTransactionType {
 	if x != nil {
-		return x.ForceRefresh
+		return x.TypeFilter
 	}
-	return false
+	return InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_UNSPECIFIED
 }
 
-type UpdatePricesResponse struct {
+type ListInvestmentTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success            bool          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message            string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	UpdatedInvestments []*Investment `protobuf:"bytes,3,rep,name=updatedInvestments,proto3" json:"updatedInvestments,omitempty"`
-	Timestamp          string        `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Success    bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data       []*InvestmentTransaction `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Timestamp  string                   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Pagination *PaginationResult        `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *UpdatePricesResponse) Reset() {
-	*x = UpdatePricesResponse{}
+func (x *ListInvestmentTransactionsResponse) Reset() {
+	*x = ListInvestmentTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_v1_investment_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3483,13 +3930,13 @@ func (x *UpdatePricesResponse) Reset() {
 	}
 }
 
-func (x *UpdatePricesResponse) String() string {
+func (x *ListInvestmentTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePricesResponse) ProtoMessage() {}
+func (*ListInvestmentTransactionsResponse) ProtoMessage() {}
 
-func (x *UpdatePricesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListInvestmentTransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3501,51 +3948,61 @@ func (x *UpdatePricesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePricesResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePricesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListInvestmentTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvestmentTransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *UpdatePricesResponse) GetSuccess() bool {
+func (x *ListInvestmentTransactionsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdatePricesResponse) GetMessage() string {
+func (x *ListInvestmentTransactionsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdatePricesResponse) GetUpdatedInvestments() []*Investment {
+func (x *ListInvestmentTransactionsResponse) GetData() []*InvestmentTransaction {
 	if x != nil {
-		return x.UpdatedInvestments
+		return x.Data
 	}
 	return nil
 }
 
-func (x *UpdatePricesResponse) GetTimestamp() string {
+func (x *ListInvestmentTransactionsResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
 	return ""
 }
 
-// SearchSymbolsRequest represents the search request parameters
-type SearchSymbolsRequest struct {
+func (x *ListInvestmentTransactionsResponse) GetPagination() *PaginationResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type EditInvestmentTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`  // Search query (e.g., "AAPL", "Apple", "Bitcoin")
-	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Max results to return (default: 10, max: 20)
+	Id              int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity        int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price           int64  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Fees            int64  `protobuf:"varint,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	TransactionDate int64  `protobuf:"varint,5,opt,name=transactionDate,proto3" json:"transactionDate,omitempty"`
+	Notes           string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
 }
 
-func (x *SearchSymbolsRequest) Reset() {
-	*x = SearchSymbolsRequest{}
+func (x *EditInvestmentTransactionRequest) Reset() {
+	*x = EditInvestmentTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_v1_investment_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3553,13 +4010,13 @@ func (x *SearchSymbolsRequest) Reset() {
 	}
 }
 
-func (x *SearchSymbolsRequest) String() string {
+func (x *EditInvestmentTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSymbolsRequest) ProtoMessage() {}
+func (*EditInvestmentTransactionRequest) ProtoMessage() {}
 
-func (x *SearchSymbolsRequest) ProtoReflect() protoreflect.Message {
+func (x *EditInvestmentTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3571,55 +4028,80 @@ func (x *SearchSymbolsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSymbolsRequest.ProtoReflect.Descriptor instead.
-func (*SearchSymbolsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EditInvestmentTransactionRequest.ProtoReflect.Descriptor instead.
+func (*EditInvestmentTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SearchSymbolsRequest) GetQuery() string {
+func (x *EditInvestmentTransactionRequest) GetId() int32 {
 	if x != nil {
-		return x.Query
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchSymbolsRequest) GetLimit() int32 {
+func (x *EditInvestmentTransactionRequest) GetQuantity() int64 {
 	if x != nil {
-		return x.Limit
+		return x.Quantity
 	}
 	return 0
 }
 
-// SearchResult represents a single search result from Yahoo Finance
-type SearchResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Symbol   string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`     // Ticker symbol (e.g., "AAPL", "BTC-USD")
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // Company/asset name
-	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`         // Type from quoteType (EQUITY, ETF, CRYPTOCURRENCY, etc.)
-	Exchange string `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"` // Exchange code (NAS, NMS, VSE, etc.)
-	ExchDisp string `protobuf:"bytes,5,opt,name=exchDisp,proto3" json:"exchDisp,omitempty"` // Display name for exchange
-	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"` // Trading currency (ISO 4217), derived from exchange
+func (x *EditInvestmentTransactionRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *SearchResult) Reset() {
-	*x = SearchResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_v1_investment_proto_msgTypes[43]
+func (x *EditInvestmentTransactionRequest) GetFees() int64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *EditInvestmentTransactionRequest) GetTransactionDate() int64 {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return 0
+}
+
+func (x *EditInvestmentTransactionRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type EditInvestmentTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *InvestmentTransaction `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *EditInvestmentTransactionResponse) Reset() {
+	*x = EditInvestmentTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchResult) String() string {
+func (x *EditInvestmentTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResult) ProtoMessage() {}
+func (*EditInvestmentTransactionResponse) ProtoMessage() {}
 
-func (x *SearchResult) ProtoReflect() protoreflect.Message {
+func (x *EditInvestmentTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3631,67 +4113,49 @@ func (x *SearchResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
-func (*SearchResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use EditInvestmentTransactionResponse.ProtoReflect.Descriptor instead.
+func (*EditInvestmentTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SearchResult) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
-	}
-	return ""
-}
-
-func (x *SearchResult) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SearchResult) GetType() string {
+func (x *EditInvestmentTransactionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Type
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *SearchResult) GetExchange() string {
+func (x *EditInvestmentTransactionResponse) GetMessage() string {
 	if x != nil {
-		return x.Exchange
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SearchResult) GetExchDisp() string {
+func (x *EditInvestmentTransactionResponse) GetData() *InvestmentTransaction {
 	if x != nil {
-		return x.ExchDisp
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *SearchResult) GetCurrency() string {
+func (x *EditInvestmentTransactionResponse) GetTimestamp() string {
 	if x != nil {
-		return x.Currency
+		return x.Timestamp
 	}
 	return ""
 }
 
-// SearchSymbolsResponse represents the search response
-type SearchSymbolsResponse struct {
+type DeleteInvestmentTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data      []*SearchResult `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	Timestamp string          `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SearchSymbolsResponse) Reset() {
-	*x = SearchSymbolsResponse{}
+func (x *DeleteInvestmentTransactionRequest) Reset() {
+	*x = DeleteInvestmentTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_v1_investment_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3699,13 +4163,13 @@ func (x *SearchSymbolsResponse) Reset() {
 	}
 }
 
-func (x *SearchSymbolsResponse) String() string {
+func (x *DeleteInvestmentTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSymbolsResponse) ProtoMessage() {}
+func (*DeleteInvestmentTransactionRequest) ProtoMessage() {}
 
-func (x *SearchSymbolsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteInvestmentTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3717,52 +4181,30 @@ func (x *SearchSymbolsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSymbolsResponse.ProtoReflect.Descriptor instead.
-func (*SearchSymbolsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteInvestmentTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInvestmentTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *SearchSymbolsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *SearchSymbolsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *SearchSymbolsResponse) GetData() []*SearchResult {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *SearchSymbolsResponse) GetTimestamp() string {
+func (x *DeleteInvestmentTransactionRequest) GetId() int32 {
 	if x != nil {
-		return x.Timestamp
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-// ListUserInvestmentsRequest for listing investments across all wallets or filtered
-type ListUserInvestmentsRequest struct {
+type DeleteInvestmentTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *PaginationParams `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	TypeFilter InvestmentType    `protobuf:"varint,2,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by investment type
-	WalletId   int32             `protobuf:"varint,3,opt,name=walletId,proto3" json:"walletId,omitempty"`                                                     // Optional: 0 or omitted = all investment wallets
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *ListUserInvestmentsRequest) Reset() {
-	*x = ListUserInvestmentsRequest{}
+func (x *DeleteInvestmentTransactionResponse) Reset() {
+	*x = DeleteInvestmentTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_v1_investment_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3770,13 +4212,13 @@ func (x *ListUserInvestmentsRequest) Reset() {
 	}
 }
 
-func (x *ListUserInvestmentsRequest) String() string {
+func (x *DeleteInvestmentTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserInvestmentsRequest) ProtoMessage() {}
+func (*DeleteInvestmentTransactionResponse) ProtoMessage() {}
 
-func (x *ListUserInvestmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteInvestmentTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3788,48 +4230,43 @@ func (x *ListUserInvestmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserInvestmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListUserInvestmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteInvestmentTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInvestmentTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListUserInvestmentsRequest) GetPagination() *PaginationParams {
+func (x *DeleteInvestmentTransactionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Pagination
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *ListUserInvestmentsRequest) GetTypeFilter() InvestmentType {
+func (x *DeleteInvestmentTransactionResponse) GetMessage() string {
 	if x != nil {
-		return x.TypeFilter
+		return x.Message
 	}
-	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ListUserInvestmentsRequest) GetWalletId() int32 {
+func (x *DeleteInvestmentTransactionResponse) GetTimestamp() string {
 	if x != nil {
-		return x.WalletId
+		return x.Timestamp
 	}
-	return 0
+	return ""
 }
 
-// ListUserInvestmentsResponse for user investments list
-type ListUserInvestmentsResponse struct {
+type GetPortfolioSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success     bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message     string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Investments []*Investment     `protobuf:"bytes,3,rep,name=investments,proto3" json:"investments,omitempty"`
-	TotalCount  int32             `protobuf:"varint,4,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
-	Timestamp   string            `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Pagination  *PaginationResult `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	WalletId int32        `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`
+	Period   ReturnPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=wealthjourney.investment.v1.ReturnPeriod" json:"period,omitempty"` // Optional: include XIRR/TWR over this period
 }
 
-func (x *ListUserInvestmentsResponse) Reset() {
-	*x = ListUserInvestmentsResponse{}
+func (x *GetPortfolioSummaryRequest) Reset() {
+	*x = GetPortfolioSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_v1_investment_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3837,13 +4274,13 @@ func (x *ListUserInvestmentsResponse) Reset() {
 	}
 }
 
-func (x *ListUserInvestmentsResponse) String() string {
+func (x *GetPortfolioSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserInvestmentsResponse) ProtoMessage() {}
+func (*GetPortfolioSummaryRequest) ProtoMessage() {}
 
-func (x *ListUserInvestmentsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3855,65 +4292,38 @@ func (x *ListUserInvestmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserInvestmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserInvestmentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListUserInvestmentsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *ListUserInvestmentsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ListUserInvestmentsResponse) GetInvestments() []*Investment {
-	if x != nil {
-		return x.Investments
-	}
-	return nil
-}
-
-func (x *ListUserInvestmentsResponse) GetTotalCount() int32 {
+func (x *GetPortfolioSummaryRequest) GetWalletId() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.WalletId
 	}
 	return 0
 }
 
-func (x *ListUserInvestmentsResponse) GetTimestamp() string {
-	if x != nil {
-		return x.Timestamp
-	}
-	return ""
-}
-
-func (x *ListUserInvestmentsResponse) GetPagination() *PaginationResult {
+func (x *GetPortfolioSummaryRequest) GetPeriod() ReturnPeriod {
 	if x != nil {
-		return x.Pagination
+		return x.Period
 	}
-	return nil
+	return ReturnPeriod_RETURN_PERIOD_UNSPECIFIED
 }
 
-// GetAggregatedPortfolioSummaryRequest for aggregated portfolio summary
-type GetAggregatedPortfolioSummaryRequest struct {
+type GetPortfolioSummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WalletId   int32          `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`                                                     // Optional: 0 or omitted = aggregate all investment wallets
-	TypeFilter InvestmentType `protobuf:"varint,2,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by investment type
+	Success   bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *PortfolioSummary `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string            `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *GetAggregatedPortfolioSummaryRequest) Reset() {
-	*x = GetAggregatedPortfolioSummaryRequest{}
+func (x *GetPortfolioSummaryResponse) Reset() {
+	*x = GetPortfolioSummaryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_v1_investment_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3921,13 +4331,13 @@ func (x *GetAggregatedPortfolioSummaryRequest) Reset() {
 	}
 }
 
-func (x *GetAggregatedPortfolioSummaryRequest) String() string {
+func (x *GetPortfolioSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAggregatedPortfolioSummaryRequest) ProtoMessage() {}
+func (*GetPortfolioSummaryResponse) ProtoMessage() {}
 
-func (x *GetAggregatedPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_v1_investment_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3939,23 +4349,2220 @@ func (x *GetAggregatedPortfolioSummaryRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAggregatedPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetAggregatedPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPortfolioSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
 	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetAggregatedPortfolioSummaryRequest) GetWalletId() int32 {
+func (x *GetPortfolioSummaryResponse) GetSuccess() bool {
 	if x != nil {
-		return x.WalletId
+		return x.Success
 	}
-	return 0
+	return false
+}
+
+func (x *GetPortfolioSummaryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPortfolioSummaryResponse) GetData() *PortfolioSummary {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetPortfolioSummaryResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type UpdatePricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentIds []int32 `protobuf:"varint,1,rep,packed,name=investmentIds,proto3" json:"investmentIds,omitempty"` // Empty = update all
+	ForceRefresh  bool    `protobuf:"varint,2,opt,name=forceRefresh,proto3" json:"forceRefresh,omitempty"`          // Force API call even if recently updated
+}
+
+func (x *UpdatePricesRequest) Reset() {
+	*x = UpdatePricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePricesRequest) ProtoMessage() {}
+
+func (x *UpdatePricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePricesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePricesRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdatePricesRequest) GetInvestmentIds() []int32 {
+	if x != nil {
+		return x.InvestmentIds
+	}
+	return nil
+}
+
+func (x *UpdatePricesRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+type UpdatePricesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success            bool          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message            string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedInvestments []*Investment `protobuf:"bytes,3,rep,name=updatedInvestments,proto3" json:"updatedInvestments,omitempty"`
+	Timestamp          string        `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *UpdatePricesResponse) Reset() {
+	*x = UpdatePricesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePricesResponse) ProtoMessage() {}
+
+func (x *UpdatePricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePricesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePricesResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdatePricesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdatePricesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdatePricesResponse) GetUpdatedInvestments() []*Investment {
+	if x != nil {
+		return x.UpdatedInvestments
+	}
+	return nil
+}
+
+func (x *UpdatePricesResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// SearchSymbolsRequest represents the search request parameters
+type SearchSymbolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`  // Search query (e.g., "AAPL", "Apple", "Bitcoin")
+	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Max results to return (default: 10, max: 20)
+}
+
+func (x *SearchSymbolsRequest) Reset() {
+	*x = SearchSymbolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSymbolsRequest) ProtoMessage() {}
+
+func (x *SearchSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SearchSymbolsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSymbolsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchResult represents a single search result from Yahoo Finance
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol   string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`     // Ticker symbol (e.g., "AAPL", "BTC-USD")
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // Company/asset name
+	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`         // Type from quoteType (EQUITY, ETF, CRYPTOCURRENCY, etc.)
+	Exchange string `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"` // Exchange code (NAS, NMS, VSE, etc.)
+	ExchDisp string `protobuf:"bytes,5,opt,name=exchDisp,proto3" json:"exchDisp,omitempty"` // Display name for exchange
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"` // Trading currency (ISO 4217), derived from exchange
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SearchResult) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchResult) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchResult) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SearchResult) GetExchDisp() string {
+	if x != nil {
+		return x.ExchDisp
+	}
+	return ""
+}
+
+func (x *SearchResult) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// SearchSymbolsResponse represents the search response
+type SearchSymbolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      []*SearchResult `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Timestamp string          `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SearchSymbolsResponse) Reset() {
+	*x = SearchSymbolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSymbolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSymbolsResponse) ProtoMessage() {}
+
+func (x *SearchSymbolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SearchSymbolsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SearchSymbolsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchSymbolsResponse) GetData() []*SearchResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SearchSymbolsResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// ListUserInvestmentsRequest for listing investments across all wallets or filtered
+type ListUserInvestmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationParams `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TypeFilter InvestmentType    `protobuf:"varint,2,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by investment type
+	WalletId   int32             `protobuf:"varint,3,opt,name=walletId,proto3" json:"walletId,omitempty"`                                                     // Optional: 0 or omitted = all investment wallets
+}
+
+func (x *ListUserInvestmentsRequest) Reset() {
+	*x = ListUserInvestmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserInvestmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserInvestmentsRequest) ProtoMessage() {}
+
+func (x *ListUserInvestmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserInvestmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserInvestmentsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListUserInvestmentsRequest) GetPagination() *PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListUserInvestmentsRequest) GetTypeFilter() InvestmentType {
+	if x != nil {
+		return x.TypeFilter
+	}
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListUserInvestmentsRequest) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+// ListUserInvestmentsResponse for user investments list
+type ListUserInvestmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message     string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Investments []*Investment     `protobuf:"bytes,3,rep,name=investments,proto3" json:"investments,omitempty"`
+	TotalCount  int32             `protobuf:"varint,4,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Timestamp   string            `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Pagination  *PaginationResult `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListUserInvestmentsResponse) Reset() {
+	*x = ListUserInvestmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserInvestmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserInvestmentsResponse) ProtoMessage() {}
+
+func (x *ListUserInvestmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserInvestmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserInvestmentsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListUserInvestmentsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListUserInvestmentsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListUserInvestmentsResponse) GetInvestments() []*Investment {
+	if x != nil {
+		return x.Investments
+	}
+	return nil
+}
+
+func (x *ListUserInvestmentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListUserInvestmentsResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *ListUserInvestmentsResponse) GetPagination() *PaginationResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetAggregatedPortfolioSummaryRequest for aggregated portfolio summary
+type GetAggregatedPortfolioSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId   int32          `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`                                                     // Optional: 0 or omitted = aggregate all investment wallets
+	TypeFilter InvestmentType `protobuf:"varint,2,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by investment type
+	Period     ReturnPeriod   `protobuf:"varint,3,opt,name=period,proto3,enum=wealthjourney.investment.v1.ReturnPeriod" json:"period,omitempty"`           // Optional: include XIRR/TWR over this period
+}
+
+func (x *GetAggregatedPortfolioSummaryRequest) Reset() {
+	*x = GetAggregatedPortfolioSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAggregatedPortfolioSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAggregatedPortfolioSummaryRequest) ProtoMessage() {}
+
+func (x *GetAggregatedPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAggregatedPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetAggregatedPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetAggregatedPortfolioSummaryRequest) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *GetAggregatedPortfolioSummaryRequest) GetTypeFilter() InvestmentType {
+	if x != nil {
+		return x.TypeFilter
+	}
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+}
+
+func (x *GetAggregatedPortfolioSummaryRequest) GetPeriod() ReturnPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ReturnPeriod_RETURN_PERIOD_UNSPECIFIED
+}
+
+// RealizedGain is one disposal: the part of a sell that consumed a single lot.
+// Amounts are in the user's preferred currency, converted at the rate on the disposal date.
+type RealizedGain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId    int32          `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"` // Sell transaction
+	InvestmentId     int32          `protobuf:"varint,2,opt,name=investmentId,proto3" json:"investmentId,omitempty"`
+	WalletId         int32          `protobuf:"varint,3,opt,name=walletId,proto3" json:"walletId,omitempty"`
+	Symbol           string         `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name             string         `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Type             InvestmentType `protobuf:"varint,6,opt,name=type,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"type,omitempty"`
+	LotId            int32          `protobuf:"varint,7,opt,name=lotId,proto3" json:"lotId,omitempty"`                     // 0 when the lot is unknown
+	AcquisitionDate  int64          `protobuf:"varint,8,opt,name=acquisitionDate,proto3" json:"acquisitionDate,omitempty"` // 0 when the lot is unknown
+	DisposalDate     int64          `protobuf:"varint,9,opt,name=disposalDate,proto3" json:"disposalDate,omitempty"`
+	Quantity         int64          `protobuf:"varint,10,opt,name=quantity,proto3" json:"quantity,omitempty"` // In smallest units
+	HoldingDays      int32          `protobuf:"varint,11,opt,name=holdingDays,proto3" json:"holdingDays,omitempty"`
+	HoldingPeriod    HoldingPeriod  `protobuf:"varint,12,opt,name=holdingPeriod,proto3,enum=wealthjourney.investment.v1.HoldingPeriod" json:"holdingPeriod,omitempty"`
+	CostBasis        int64          `protobuf:"varint,13,opt,name=costBasis,proto3" json:"costBasis,omitempty"`
+	Proceeds         int64          `protobuf:"varint,14,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	Fees             int64          `protobuf:"varint,15,opt,name=fees,proto3" json:"fees,omitempty"`                        // Share of the sell's fees
+	GainLoss         int64          `protobuf:"varint,16,opt,name=gainLoss,proto3" json:"gainLoss,omitempty"`                // proceeds - costBasis - fees
+	Currency         string         `protobuf:"bytes,17,opt,name=currency,proto3" json:"currency,omitempty"`                 // User's preferred currency
+	OriginalCurrency string         `protobuf:"bytes,18,opt,name=originalCurrency,proto3" json:"originalCurrency,omitempty"` // Investment currency
+	ExchangeRate     float64        `protobuf:"fixed64,19,opt,name=exchangeRate,proto3" json:"exchangeRate,omitempty"`       // originalCurrency -> currency on the disposal date
+}
+
+func (x *RealizedGain) Reset() {
+	*x = RealizedGain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealizedGain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealizedGain) ProtoMessage() {}
+
+func (x *RealizedGain) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealizedGain.ProtoReflect.Descriptor instead.
+func (*RealizedGain) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RealizedGain) GetTransactionId() int32 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetInvestmentId() int32 {
+	if x != nil {
+		return x.InvestmentId
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetType() InvestmentType {
+	if x != nil {
+		return x.Type
+	}
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+}
+
+func (x *RealizedGain) GetLotId() int32 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetAcquisitionDate() int64 {
+	if x != nil {
+		return x.AcquisitionDate
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetDisposalDate() int64 {
+	if x != nil {
+		return x.DisposalDate
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetHoldingDays() int32 {
+	if x != nil {
+		return x.HoldingDays
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetHoldingPeriod() HoldingPeriod {
+	if x != nil {
+		return x.HoldingPeriod
+	}
+	return HoldingPeriod_HOLDING_PERIOD_UNSPECIFIED
+}
+
+func (x *RealizedGain) GetCostBasis() int64 {
+	if x != nil {
+		return x.CostBasis
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetProceeds() int64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetFees() int64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetGainLoss() int64 {
+	if x != nil {
+		return x.GainLoss
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetOriginalCurrency() string {
+	if x != nil {
+		return x.OriginalCurrency
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+// Totals of a realized gains report
+type RealizedGainsSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalProceeds     int64  `protobuf:"varint,1,opt,name=totalProceeds,proto3" json:"totalProceeds,omitempty"`
+	TotalCostBasis    int64  `protobuf:"varint,2,opt,name=totalCostBasis,proto3" json:"totalCostBasis,omitempty"`
+	TotalFees         int64  `protobuf:"varint,3,opt,name=totalFees,proto3" json:"totalFees,omitempty"`
+	TotalGainLoss     int64  `protobuf:"varint,4,opt,name=totalGainLoss,proto3" json:"totalGainLoss,omitempty"`
+	ShortTermGainLoss int64  `protobuf:"varint,5,opt,name=shortTermGainLoss,proto3" json:"shortTermGainLoss,omitempty"`
+	LongTermGainLoss  int64  `protobuf:"varint,6,opt,name=longTermGainLoss,proto3" json:"longTermGainLoss,omitempty"`
+	Currency          string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *RealizedGainsSummary) Reset() {
+	*x = RealizedGainsSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealizedGainsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealizedGainsSummary) ProtoMessage() {}
+
+func (x *RealizedGainsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealizedGainsSummary.ProtoReflect.Descriptor instead.
+func (*RealizedGainsSummary) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RealizedGainsSummary) GetTotalProceeds() int64 {
+	if x != nil {
+		return x.TotalProceeds
+	}
+	return 0
+}
+
+func (x *RealizedGainsSummary) GetTotalCostBasis() int64 {
+	if x != nil {
+		return x.TotalCostBasis
+	}
+	return 0
+}
+
+func (x *RealizedGainsSummary) GetTotalFees() int64 {
+	if x != nil {
+		return x.TotalFees
+	}
+	return 0
+}
+
+func (x *RealizedGainsSummary) GetTotalGainLoss() int64 {
+	if x != nil {
+		return x.TotalGainLoss
+	}
+	return 0
+}
+
+func (x *RealizedGainsSummary) GetShortTermGainLoss() int64 {
+	if x != nil {
+		return x.ShortTermGainLoss
+	}
+	return 0
+}
+
+func (x *RealizedGainsSummary) GetLongTermGainLoss() int64 {
+	if x != nil {
+		return x.LongTermGainLoss
+	}
+	return 0
+}
+
+func (x *RealizedGainsSummary) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// GetRealizedGainsReportRequest for the realized gains report
+type GetRealizedGainsReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year       int32          `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`                                                             // Tax year of the disposal date (UTC); 0 = all years
+	WalletId   int32          `protobuf:"varint,2,opt,name=walletId,proto3" json:"walletId,omitempty"`                                                     // Optional: 0 or omitted = all investment wallets
+	TypeFilter InvestmentType `protobuf:"varint,3,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional filter by investment type
+}
+
+func (x *GetRealizedGainsReportRequest) Reset() {
+	*x = GetRealizedGainsReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealizedGainsReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealizedGainsReportRequest) ProtoMessage() {}
+
+func (x *GetRealizedGainsReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealizedGainsReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRealizedGainsReportRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetRealizedGainsReportRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetRealizedGainsReportRequest) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *GetRealizedGainsReportRequest) GetTypeFilter() InvestmentType {
+	if x != nil {
+		return x.TypeFilter
+	}
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+}
+
+// GetRealizedGainsReportResponse with one row per disposal, oldest first
+type GetRealizedGainsReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool                  `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      []*RealizedGain       `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Summary   *RealizedGainsSummary `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Timestamp string                `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetRealizedGainsReportResponse) Reset() {
+	*x = GetRealizedGainsReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealizedGainsReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealizedGainsReportResponse) ProtoMessage() {}
+
+func (x *GetRealizedGainsReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealizedGainsReportResponse.ProtoReflect.Descriptor instead.
+func (*GetRealizedGainsReportResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetRealizedGainsReportResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetRealizedGainsReportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetRealizedGainsReportResponse) GetData() []*RealizedGain {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetRealizedGainsReportResponse) GetSummary() *RealizedGainsSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GetRealizedGainsReportResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// PerformanceReturns holds money-weighted and time-weighted returns over a period.
+// Rates are fractions (0.12 = 12%); a rate is omitted when it cannot be computed,
+// e.g. when nothing was invested during the period.
+type PerformanceReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period           ReturnPeriod `protobuf:"varint,1,opt,name=period,proto3,enum=wealthjourney.investment.v1.ReturnPeriod" json:"period,omitempty"`
+	StartDate        int64        `protobuf:"varint,2,opt,name=startDate,proto3" json:"startDate,omitempty"` // Later than the period start when the first transaction is
+	EndDate          int64        `protobuf:"varint,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Xirr             *float64     `protobuf:"fixed64,4,opt,name=xirr,proto3,oneof" json:"xirr,omitempty"`                   // Annualized money-weighted return
+	Twr              *float64     `protobuf:"fixed64,5,opt,name=twr,proto3,oneof" json:"twr,omitempty"`                     // Cumulative time-weighted return
+	AnnualizedTwr    *float64     `protobuf:"fixed64,6,opt,name=annualizedTwr,proto3,oneof" json:"annualizedTwr,omitempty"` // Equal to twr for periods under a year
+	StartValue       int64        `protobuf:"varint,7,opt,name=startValue,proto3" json:"startValue,omitempty"`              // Market value at startDate
+	EndValue         int64        `protobuf:"varint,8,opt,name=endValue,proto3" json:"endValue,omitempty"`                  // Market value at endDate
+	NetContributions int64        `protobuf:"varint,9,opt,name=netContributions,proto3" json:"netContributions,omitempty"`  // Buys minus sells and dividends in the period
+	Currency         string       `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *PerformanceReturns) Reset() {
+	*x = PerformanceReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerformanceReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceReturns) ProtoMessage() {}
+
+func (x *PerformanceReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceReturns.ProtoReflect.Descriptor instead.
+func (*PerformanceReturns) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PerformanceReturns) GetPeriod() ReturnPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ReturnPeriod_RETURN_PERIOD_UNSPECIFIED
+}
+
+func (x *PerformanceReturns) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *PerformanceReturns) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *PerformanceReturns) GetXirr() float64 {
+	if x != nil && x.Xirr != nil {
+		return *x.Xirr
+	}
+	return 0
+}
+
+func (x *PerformanceReturns) GetTwr() float64 {
+	if x != nil && x.Twr != nil {
+		return *x.Twr
+	}
+	return 0
+}
+
+func (x *PerformanceReturns) GetAnnualizedTwr() float64 {
+	if x != nil && x.AnnualizedTwr != nil {
+		return *x.AnnualizedTwr
+	}
+	return 0
+}
+
+func (x *PerformanceReturns) GetStartValue() int64 {
+	if x != nil {
+		return x.StartValue
+	}
+	return 0
+}
+
+func (x *PerformanceReturns) GetEndValue() int64 {
+	if x != nil {
+		return x.EndValue
+	}
+	return 0
+}
+
+func (x *PerformanceReturns) GetNetContributions() int64 {
+	if x != nil {
+		return x.NetContributions
+	}
+	return 0
+}
+
+func (x *PerformanceReturns) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Returns of a single holding
+type InvestmentReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentId int32               `protobuf:"varint,1,opt,name=investmentId,proto3" json:"investmentId,omitempty"`
+	WalletId     int32               `protobuf:"varint,2,opt,name=walletId,proto3" json:"walletId,omitempty"`
+	Symbol       string              `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name         string              `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Returns      *PerformanceReturns `protobuf:"bytes,5,opt,name=returns,proto3" json:"returns,omitempty"` // In the investment's currency
+}
+
+func (x *InvestmentReturns) Reset() {
+	*x = InvestmentReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvestmentReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestmentReturns) ProtoMessage() {}
+
+func (x *InvestmentReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestmentReturns.ProtoReflect.Descriptor instead.
+func (*InvestmentReturns) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InvestmentReturns) GetInvestmentId() int32 {
+	if x != nil {
+		return x.InvestmentId
+	}
+	return 0
+}
+
+func (x *InvestmentReturns) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *InvestmentReturns) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *InvestmentReturns) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InvestmentReturns) GetReturns() *PerformanceReturns {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+// GetPerformanceReturnsRequest selects the holding or portfolio to measure.
+// investmentId takes precedence over walletId; neither means all investment wallets.
+type GetPerformanceReturnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentId int32          `protobuf:"varint,1,opt,name=investmentId,proto3" json:"investmentId,omitempty"`
+	WalletId     int32          `protobuf:"varint,2,opt,name=walletId,proto3" json:"walletId,omitempty"`
+	Period       ReturnPeriod   `protobuf:"varint,3,opt,name=period,proto3,enum=wealthjourney.investment.v1.ReturnPeriod" json:"period,omitempty"`           // Defaults to since inception
+	TypeFilter   InvestmentType `protobuf:"varint,4,opt,name=typeFilter,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"typeFilter,omitempty"` // Optional; portfolio snapshots are not used when set
+}
+
+func (x *GetPerformanceReturnsRequest) Reset() {
+	*x = GetPerformanceReturnsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPerformanceReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPerformanceReturnsRequest) ProtoMessage() {}
+
+func (x *GetPerformanceReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPerformanceReturnsRequest.ProtoReflect.Descriptor instead.
+func (*GetPerformanceReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetPerformanceReturnsRequest) GetInvestmentId() int32 {
+	if x != nil {
+		return x.InvestmentId
+	}
+	return 0
+}
+
+func (x *GetPerformanceReturnsRequest) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *GetPerformanceReturnsRequest) GetPeriod() ReturnPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ReturnPeriod_RETURN_PERIOD_UNSPECIFIED
+}
+
+func (x *GetPerformanceReturnsRequest) GetTypeFilter() InvestmentType {
+	if x != nil {
+		return x.TypeFilter
+	}
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+}
+
+// GetPerformanceReturnsResponse with the portfolio returns and a per-holding breakdown
+type GetPerformanceReturnsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool                 `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message     string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data        *PerformanceReturns  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // In the user's preferred currency (investment currency for a single holding)
+	Investments []*InvestmentReturns `protobuf:"bytes,4,rep,name=investments,proto3" json:"investments,omitempty"`
+	Timestamp   string               `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetPerformanceReturnsResponse) Reset() {
+	*x = GetPerformanceReturnsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPerformanceReturnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPerformanceReturnsResponse) ProtoMessage() {}
+
+func (x *GetPerformanceReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPerformanceReturnsResponse.ProtoReflect.Descriptor instead.
+func (*GetPerformanceReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetPerformanceReturnsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetPerformanceReturnsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPerformanceReturnsResponse) GetData() *PerformanceReturns {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetPerformanceReturnsResponse) GetInvestments() []*InvestmentReturns {
+	if x != nil {
+		return x.Investments
+	}
+	return nil
+}
+
+func (x *GetPerformanceReturnsResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// AllocationTarget is the target weight of one group of holdings.
+// Only the field matching the dimension is set.
+type AllocationTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WalletId       int32               `protobuf:"varint,2,opt,name=walletId,proto3" json:"walletId,omitempty"` // 0 = all investment wallets
+	Dimension      AllocationDimension `protobuf:"varint,3,opt,name=dimension,proto3,enum=wealthjourney.investment.v1.AllocationDimension" json:"dimension,omitempty"`
+	InvestmentType InvestmentType      `protobuf:"varint,4,opt,name=investmentType,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"investmentType,omitempty"`
+	Symbol         string              `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	AssetClass     string              `protobuf:"bytes,6,opt,name=assetClass,proto3" json:"assetClass,omitempty"`
+	TargetPercent  float64             `protobuf:"fixed64,7,opt,name=targetPercent,proto3" json:"targetPercent,omitempty"` // 0-100; targets of a wallet add up to 100
+	CreatedAt      int64               `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      int64               `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *AllocationTarget) Reset() {
+	*x = AllocationTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllocationTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocationTarget) ProtoMessage() {}
+
+func (x *AllocationTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocationTarget.ProtoReflect.Descriptor instead.
+func (*AllocationTarget) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AllocationTarget) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AllocationTarget) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *AllocationTarget) GetDimension() AllocationDimension {
+	if x != nil {
+		return x.Dimension
+	}
+	return AllocationDimension_ALLOCATION_DIMENSION_UNSPECIFIED
+}
+
+func (x *AllocationTarget) GetInvestmentType() InvestmentType {
+	if x != nil {
+		return x.InvestmentType
+	}
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+}
+
+func (x *AllocationTarget) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AllocationTarget) GetAssetClass() string {
+	if x != nil {
+		return x.AssetClass
+	}
+	return ""
+}
+
+func (x *AllocationTarget) GetTargetPercent() float64 {
+	if x != nil {
+		return x.TargetPercent
+	}
+	return 0
+}
+
+func (x *AllocationTarget) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AllocationTarget) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// SetAllocationTargetsRequest replaces all targets of the wallet; an empty list clears them
+type SetAllocationTargetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId  int32               `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"` // 0 = all investment wallets
+	Dimension AllocationDimension `protobuf:"varint,2,opt,name=dimension,proto3,enum=wealthjourney.investment.v1.AllocationDimension" json:"dimension,omitempty"`
+	Targets   []*AllocationTarget `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"` // Only the dimension key and targetPercent are read
+}
+
+func (x *SetAllocationTargetsRequest) Reset() {
+	*x = SetAllocationTargetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAllocationTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllocationTargetsRequest) ProtoMessage() {}
+
+func (x *SetAllocationTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllocationTargetsRequest.ProtoReflect.Descriptor instead.
+func (*SetAllocationTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetAllocationTargetsRequest) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *SetAllocationTargetsRequest) GetDimension() AllocationDimension {
+	if x != nil {
+		return x.Dimension
+	}
+	return AllocationDimension_ALLOCATION_DIMENSION_UNSPECIFIED
+}
+
+func (x *SetAllocationTargetsRequest) GetTargets() []*AllocationTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type SetAllocationTargetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      []*AllocationTarget `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Timestamp string              `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SetAllocationTargetsResponse) Reset() {
+	*x = SetAllocationTargetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAllocationTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllocationTargetsResponse) ProtoMessage() {}
+
+func (x *SetAllocationTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllocationTargetsResponse.ProtoReflect.Descriptor instead.
+func (*SetAllocationTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetAllocationTargetsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetAllocationTargetsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetAllocationTargetsResponse) GetData() []*AllocationTarget {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SetAllocationTargetsResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type ListAllocationTargetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId int32 `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"` // 0 = all investment wallets
+}
+
+func (x *ListAllocationTargetsRequest) Reset() {
+	*x = ListAllocationTargetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllocationTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllocationTargetsRequest) ProtoMessage() {}
+
+func (x *ListAllocationTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllocationTargetsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllocationTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListAllocationTargetsRequest) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+type ListAllocationTargetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      []*AllocationTarget `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Timestamp string              `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ListAllocationTargetsResponse) Reset() {
+	*x = ListAllocationTargetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllocationTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllocationTargetsResponse) ProtoMessage() {}
+
+func (x *ListAllocationTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllocationTargetsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllocationTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListAllocationTargetsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListAllocationTargetsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListAllocationTargetsResponse) GetData() []*AllocationTarget {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListAllocationTargetsResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// GetRebalancingPlanRequest. Amounts are in the user's preferred currency smallest unit.
+type GetRebalancingPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletId       int32 `protobuf:"varint,1,opt,name=walletId,proto3" json:"walletId,omitempty"`             // 0 = all investment wallets
+	NewCash        int64 `protobuf:"varint,2,opt,name=newCash,proto3" json:"newCash,omitempty"`               // Optional cash to deploy on top of current holdings
+	MinTradeAmount int64 `protobuf:"varint,3,opt,name=minTradeAmount,proto3" json:"minTradeAmount,omitempty"` // Trades smaller than this are not suggested
+}
+
+func (x *GetRebalancingPlanRequest) Reset() {
+	*x = GetRebalancingPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRebalancingPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebalancingPlanRequest) ProtoMessage() {}
+
+func (x *GetRebalancingPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebalancingPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetRebalancingPlanRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetRebalancingPlanRequest) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *GetRebalancingPlanRequest) GetNewCash() int64 {
+	if x != nil {
+		return x.NewCash
+	}
+	return 0
+}
+
+func (x *GetRebalancingPlanRequest) GetMinTradeAmount() int64 {
+	if x != nil {
+		return x.MinTradeAmount
+	}
+	return 0
+}
+
+// AllocationDrift compares one target group with its current weight
+type AllocationDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dimension      AllocationDimension `protobuf:"varint,1,opt,name=dimension,proto3,enum=wealthjourney.investment.v1.AllocationDimension" json:"dimension,omitempty"`
+	InvestmentType InvestmentType      `protobuf:"varint,2,opt,name=investmentType,proto3,enum=wealthjourney.investment.v1.InvestmentType" json:"investmentType,omitempty"`
+	Symbol         string              `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	AssetClass     string              `protobuf:"bytes,4,opt,name=assetClass,proto3" json:"assetClass,omitempty"`         // Empty for holdings without an asset class
+	TargetPercent  float64             `protobuf:"fixed64,5,opt,name=targetPercent,proto3" json:"targetPercent,omitempty"` // 0 for holdings without a target
+	CurrentPercent float64             `protobuf:"fixed64,6,opt,name=currentPercent,proto3" json:"currentPercent,omitempty"`
+	DriftPercent   float64             `protobuf:"fixed64,7,opt,name=driftPercent,proto3" json:"driftPercent,omitempty"` // currentPercent - targetPercent
+	CurrentValue   int64               `protobuf:"varint,8,opt,name=currentValue,proto3" json:"currentValue,omitempty"`
+	TargetValue    int64               `protobuf:"varint,9,opt,name=targetValue,proto3" json:"targetValue,omitempty"`
+	DriftValue     int64               `protobuf:"varint,10,opt,name=driftValue,proto3" json:"driftValue,omitempty"` // currentValue - targetValue
+}
+
+func (x *AllocationDrift) Reset() {
+	*x = AllocationDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllocationDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocationDrift) ProtoMessage() {}
+
+func (x *AllocationDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocationDrift.ProtoReflect.Descriptor instead.
+func (*AllocationDrift) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AllocationDrift) GetDimension() AllocationDimension {
+	if x != nil {
+		return x.Dimension
+	}
+	return AllocationDimension_ALLOCATION_DIMENSION_UNSPECIFIED
+}
+
+func (x *AllocationDrift) GetInvestmentType() InvestmentType {
+	if x != nil {
+		return x.InvestmentType
+	}
+	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+}
+
+func (x *AllocationDrift) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AllocationDrift) GetAssetClass() string {
+	if x != nil {
+		return x.AssetClass
+	}
+	return ""
+}
+
+func (x *AllocationDrift) GetTargetPercent() float64 {
+	if x != nil {
+		return x.TargetPercent
+	}
+	return 0
+}
+
+func (x *AllocationDrift) GetCurrentPercent() float64 {
+	if x != nil {
+		return x.CurrentPercent
+	}
+	return 0
+}
+
+func (x *AllocationDrift) GetDriftPercent() float64 {
+	if x != nil {
+		return x.DriftPercent
+	}
+	return 0
+}
+
+func (x *AllocationDrift) GetCurrentValue() int64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *AllocationDrift) GetTargetValue() int64 {
+	if x != nil {
+		return x.TargetValue
+	}
+	return 0
+}
+
+func (x *AllocationDrift) GetDriftValue() int64 {
+	if x != nil {
+		return x.DriftValue
+	}
+	return 0
+}
+
+// RebalanceTrade is a suggested buy or sell of one investment
+type RebalanceTrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentId  int32                     `protobuf:"varint,1,opt,name=investmentId,proto3" json:"investmentId,omitempty"`
+	WalletId      int32                     `protobuf:"varint,2,opt,name=walletId,proto3" json:"walletId,omitempty"`
+	Symbol        string                    `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name          string                    `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Action        InvestmentTransactionType `protobuf:"varint,5,opt,name=action,proto3,enum=wealthjourney.investment.v1.InvestmentTransactionType" json:"action,omitempty"` // BUY or SELL
+	Quantity      int64                     `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                        // In storage units, like Investment.quantity
+	Price         int64                     `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`                                                              // Current price in the investment's currency
+	Amount        int64                     `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`                                                            // quantity × price in the investment's currency
+	Currency      string                    `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	DisplayAmount *Money                    `protobuf:"bytes,10,opt,name=displayAmount,proto3" json:"displayAmount,omitempty"` // Amount in the user's preferred currency
 }
 
-func (x *GetAggregatedPortfolioSummaryRequest) GetTypeFilter() InvestmentType {
+func (x *RebalanceTrade) Reset() {
+	*x = RebalanceTrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceTrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceTrade) ProtoMessage() {}
+
+func (x *RebalanceTrade) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceTrade.ProtoReflect.Descriptor instead.
+func (*RebalanceTrade) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RebalanceTrade) GetInvestmentId() int32 {
 	if x != nil {
-		return x.TypeFilter
+		return x.InvestmentId
 	}
-	return InvestmentType_INVESTMENT_TYPE_UNSPECIFIED
+	return 0
+}
+
+func (x *RebalanceTrade) GetWalletId() int32 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *RebalanceTrade) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RebalanceTrade) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RebalanceTrade) GetAction() InvestmentTransactionType {
+	if x != nil {
+		return x.Action
+	}
+	return InvestmentTransactionType_INVESTMENT_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *RebalanceTrade) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RebalanceTrade) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RebalanceTrade) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RebalanceTrade) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RebalanceTrade) GetDisplayAmount() *Money {
+	if x != nil {
+		return x.DisplayAmount
+	}
+	return nil
+}
+
+// RebalancingPlan. Values are in the user's preferred currency unless stated otherwise.
+type RebalancingPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dimension     AllocationDimension `protobuf:"varint,1,opt,name=dimension,proto3,enum=wealthjourney.investment.v1.AllocationDimension" json:"dimension,omitempty"`
+	TotalValue    int64               `protobuf:"varint,2,opt,name=totalValue,proto3" json:"totalValue,omitempty"` // Current holdings plus new cash
+	NewCash       int64               `protobuf:"varint,3,opt,name=newCash,proto3" json:"newCash,omitempty"`
+	RemainingCash int64               `protobuf:"varint,4,opt,name=remainingCash,proto3" json:"remainingCash,omitempty"` // newCash plus sells minus buys, left by rounding and skipped trades
+	Currency      string              `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Drift         []*AllocationDrift  `protobuf:"bytes,6,rep,name=drift,proto3" json:"drift,omitempty"`
+	Trades        []*RebalanceTrade   `protobuf:"bytes,7,rep,name=trades,proto3" json:"trades,omitempty"`
+}
+
+func (x *RebalancingPlan) Reset() {
+	*x = RebalancingPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalancingPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalancingPlan) ProtoMessage() {}
+
+func (x *RebalancingPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalancingPlan.ProtoReflect.Descriptor instead.
+func (*RebalancingPlan) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RebalancingPlan) GetDimension() AllocationDimension {
+	if x != nil {
+		return x.Dimension
+	}
+	return AllocationDimension_ALLOCATION_DIMENSION_UNSPECIFIED
+}
+
+func (x *RebalancingPlan) GetTotalValue() int64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *RebalancingPlan) GetNewCash() int64 {
+	if x != nil {
+		return x.NewCash
+	}
+	return 0
+}
+
+func (x *RebalancingPlan) GetRemainingCash() int64 {
+	if x != nil {
+		return x.RemainingCash
+	}
+	return 0
+}
+
+func (x *RebalancingPlan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RebalancingPlan) GetDrift() []*AllocationDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *RebalancingPlan) GetTrades() []*RebalanceTrade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+type GetRebalancingPlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *RebalancingPlan `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp string           `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetRebalancingPlanResponse) Reset() {
+	*x = GetRebalancingPlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_v1_investment_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRebalancingPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebalancingPlanResponse) ProtoMessage() {}
+
+func (x *GetRebalancingPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_v1_investment_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebalancingPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetRebalancingPlanResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_v1_investment_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetRebalancingPlanResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetRebalancingPlanResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetRebalancingPlanResponse) GetData() *RebalancingPlan {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetRebalancingPlanResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
 }
 
 var File_protobuf_v1_investment_proto protoreflect.FileDescriptor
@@ -3968,7 +6575,7 @@ var file_protobuf_v1_investment_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x09, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6f, 0x74, 0x6f, 0x22, 0xab, 0x0a, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
 	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16,
@@ -4043,319 +6650,479 @@ var file_protobuf_v1_investment_proto_rawDesc = []byte{
 	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c,
 	0x61, 0x79, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a,
 	0x08, 0x69, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x69, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x22, 0xbf, 0x05, 0x0a, 0x15, 0x49, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x08, 0x69, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x56, 0x0a, 0x0f, 0x63, 0x6f, 0x73,
+	0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x0f, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x22, 0x93, 0x06, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x65, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x6f,
+	0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x43, 0x6f, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x46, 0x65, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x4e, 0x75, 0x6d, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x74, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xb8, 0x08, 0x0a, 0x10, 0x50, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x6e, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x50, 0x6e, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x50, 0x6e, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
 	0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x72,
-	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
-	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a,
-	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x4c, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x11, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a,
+	0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x18, 0x0c, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
 	0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
-	0x65, 0x79, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x12,
-	0x40, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x65, 0x65, 0x73, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x65, 0x65,
-	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xed, 0x07, 0x0a, 0x10,
-	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x50, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x50, 0x6e, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75,
-	0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x2a, 0x0a, 0x10,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x12, 0x4c, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x11, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x4a, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x50, 0x6e, 0x6c, 0x12, 0x4e, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x65, 0x79, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x50, 0x6e, 0x6c, 0x12, 0x4e, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x50, 0x6e, 0x6c, 0x12, 0x52, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e,
+	0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
 	0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
-	0x79, 0x52, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x52, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x55, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x18, 0x0e, 0x20,
+	0x79, 0x52, 0x14, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x58, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65,
+	0x72, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x74, 0x6f,
+	0x70, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x5c, 0x0a, 0x0f, 0x77,
+	0x6f, 0x72, 0x73, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x07, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x80, 0x03, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e,
+	0x6c, 0x12, 0x32, 0x0a, 0x14, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50,
+	0x6e, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x14, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x18, 0x07, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
 	0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
 	0x6e, 0x65, 0x79, 0x52, 0x14, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x72, 0x65,
 	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0f, 0x20, 0x01,
+	0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x12, 0x58, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61,
+	0x6e, 0x63, 0x79, 0x22, 0xee, 0x01, 0x0a, 0x18, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
+	0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c,
+	0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x87, 0x02, 0x0a, 0x13,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x22, 0x93, 0x02, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4e, 0x0a, 0x09, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x52, 0x09, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x9a, 0x01, 0x0a, 0x0c,
+	0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x6e, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47,
+	0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0x91, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x68, 0x0a, 0x0e, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x37, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x69,
+	0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6c, 0x76,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8c,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa8, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xd9, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x75, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x62, 0x75, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x42, 0x75, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x42, 0x75, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x65, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe7,
+	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a,
+	0x0a, 0x04, 0x67, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x67, 0x6f, 0x6c, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x69,
+	0x6c, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x61,
 	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d,
-	0x74, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x5c, 0x0a,
-	0x0f, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73,
-	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x73,
-	0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x10,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
-	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80, 0x03, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x06, 0x73, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xd7, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e,
+	0x69, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
 	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
 	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x50, 0x6e, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x75, 0x6e, 0x72, 0x65,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x50, 0x6e, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x14,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x64, 0x50, 0x6e, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x14, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c,
-	0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa6, 0x01, 0x0a, 0x18, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
-	0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
-	0x52, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x22, 0xc3, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x77, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x47, 0x6f, 0x6c, 0x64, 0x54,
-	0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79,
-	0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x08, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f,
+	0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68,
+	0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x42, 0x61, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x1f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x13, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12,
+	0x30, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6f, 0x6c,
+	0x64, 0x6f, 0x77, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xb8, 0x01, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x44, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0xcc, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f,
-	0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x68,
-	0x0a, 0x0e, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x37, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53,
-	0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0xd9, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x62, 0x75, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x62, 0x75, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
-	0x65, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x75, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x75,
-	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x6c,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x67, 0x6f, 0x6c,
-	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x04, 0x67, 0x6f, 0x6c, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x73,
-	0x69, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0xd7, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
-	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xcc, 0x01,
-	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x4b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xf3, 0x01, 0x0a,
-	0x17, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61,
 	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
 	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0xf3, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8a, 0x04, 0x0a, 0x17, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12,
+	0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x56, 0x0a, 0x0f, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0f, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69,
+	0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
 	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -4365,526 +7132,950 @@ var file_protobuf_v1_investment_proto_rawDesc = []byte{
 	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
 	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0xb2, 0x03, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x73,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x43, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x12, 0x36, 0x0a, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x73,
-	0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
-	0x55, 0x6e, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x75, 0x72, 0x63,
-	0x68, 0x61, 0x73, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x61, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x61, 0x6d, 0x70, 0x22, 0xed, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
 	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72,
 	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6c,
-	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x63, 0x6f, 0x73, 0x74, 0x42,
+	0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0f,
+	0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x23, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6c, 0x0a, 0x18, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb2, 0x03, 0x0a, 0x15, 0x41, 0x64, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x2a, 0x0a, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x6c, 0x6f, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x89, 0x02,
+	0x0a, 0x16, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xea, 0x01, 0x0a, 0x21, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56,
+	0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x89, 0x02, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x46, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x20, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xbd, 0x01,
+	0x0a, 0x21, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x34, 0x0a,
+	0x22, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x77, 0x0a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
 	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
 	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8d, 0x02, 0x0a,
-	0x15, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x89, 0x02, 0x0a,
-	0x16, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xea, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
-	0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a,
-	0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x89, 0x02, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x46, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
-	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x20, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xbd, 0x01, 0x0a,
-	0x21, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x34, 0x0a, 0x22,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x77, 0x0a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7b, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x38, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x49, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72,
-	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5f, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0xc1, 0x01, 0x0a, 0x14,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0x42, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x44, 0x69, 0x73, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x44, 0x69, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa8, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5f,
+	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22,
+	0xc1, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x12,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0xd0, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a,
-	0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
-	0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x22, 0xa5, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x61, 0x6d, 0x70, 0x22, 0x42, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x44, 0x69, 0x73, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x44, 0x69, 0x73, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa8, 0x01, 0x0a,
+	0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
 	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x0b, 0x69, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xd0, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x22, 0xa5, 0x02, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49,
+	0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xd2, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xab, 0x05, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x47, 0x61, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x6f, 0x74, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x68,
+	0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x79, 0x73, 0x12, 0x50, 0x0a,
+	0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x67, 0x61, 0x69, 0x6e, 0x4c, 0x6f, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x67, 0x61, 0x69, 0x6e, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x9e, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x24,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x65, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73,
+	0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x47, 0x61, 0x69, 0x6e, 0x4c, 0x6f, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x69, 0x6e, 0x4c, 0x6f, 0x73, 0x73,
+	0x12, 0x2c, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x47, 0x61, 0x69,
+	0x6e, 0x4c, 0x6f, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x47, 0x61, 0x69, 0x6e, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x6c, 0x6f, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x47, 0x61, 0x69, 0x6e, 0x4c, 0x6f,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x6f, 0x6e, 0x67, 0x54, 0x65,
+	0x72, 0x6d, 0x47, 0x61, 0x69, 0x6e, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xfe, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x47, 0x61, 0x69, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4b, 0x0a, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x91, 0x03, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x41, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x78, 0x69, 0x72, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x78, 0x69, 0x72, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x77, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01,
+	0x52, 0x03, 0x74, 0x77, 0x72, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x61, 0x6e, 0x6e, 0x75,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x77, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x02, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x77, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x78, 0x69, 0x72, 0x72,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x77, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x6e, 0x6e,
+	0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x77, 0x72, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x07,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x07,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x74,
+	0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
 	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79,
+	0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x88, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x43,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x50, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8f,
-	0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
-	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6d, 0x70, 0x22, 0xfd, 0x02, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
 	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2a, 0xfa, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45,
-	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x59, 0x50, 0x54, 0x4f, 0x43, 0x55,
-	0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x45,
-	0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x43,
-	0x4b, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x54, 0x46, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b,
-	0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x4d, 0x55, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a,
-	0x14, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x42, 0x4f, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x45, 0x53,
-	0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x4f,
-	0x44, 0x49, 0x54, 0x59, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10,
-	0x07, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x4f, 0x4c, 0x44, 0x5f, 0x56, 0x4e, 0x44, 0x10, 0x08, 0x12,
-	0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x47, 0x4f, 0x4c, 0x44, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x09, 0x12, 0x1e, 0x0a,
-	0x1a, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x53, 0x49, 0x4c, 0x56, 0x45, 0x52, 0x5f, 0x56, 0x4e, 0x44, 0x10, 0x0a, 0x12, 0x1e, 0x0a,
-	0x1a, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x53, 0x49, 0x4c, 0x56, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x0b, 0x2a, 0xe4, 0x01,
-	0x0a, 0x19, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x49,
-	0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x4e, 0x56, 0x45,
-	0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x01, 0x12, 0x24, 0x0a,
-	0x20, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c,
-	0x4c, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x44, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x25, 0x0a,
-	0x21, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4c,
-	0x49, 0x54, 0x10, 0x04, 0x32, 0xf7, 0x1a, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xac, 0x01, 0x0a, 0x0f, 0x4c,
-	0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33,
-	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x73, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x77, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x4e, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x47, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x65,
 	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3a,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x41, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
 	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01,
-	0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x77, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
-	0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa1, 0x01,
-	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x79, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65,
+	0x77, 0x43, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65, 0x77,
+	0x43, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x69,
+	0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc6, 0x03, 0x0a,
+	0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x12, 0x4e, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x53, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
 	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x7d, 0x12, 0xbf, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32,
-	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a,
-	0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0xd6, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64,
+	0x72, 0x69, 0x66, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x64, 0x72, 0x69, 0x66, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x72, 0x69, 0x66, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x72, 0x69, 0x66, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf8, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xe6, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x4e, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x43, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x43, 0x61, 0x73, 0x68, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x42, 0x0a, 0x05, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x05, 0x64,
+	0x72, 0x69, 0x66, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0xfa, 0x02, 0x0a,
+	0x0e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x59, 0x50, 0x54, 0x4f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
+	0x43, 0x59, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x45, 0x54, 0x46, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x56, 0x45,
+	0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x54, 0x55,
+	0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56,
+	0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4e,
+	0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x4f, 0x44, 0x49, 0x54, 0x59,
+	0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x07, 0x12, 0x1c, 0x0a,
+	0x18, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x47, 0x4f, 0x4c, 0x44, 0x5f, 0x56, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x49,
+	0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47,
+	0x4f, 0x4c, 0x44, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x09, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56,
+	0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x4c,
+	0x56, 0x45, 0x52, 0x5f, 0x56, 0x4e, 0x44, 0x10, 0x0a, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56,
+	0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x4c,
+	0x56, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x0b, 0x2a, 0xcb, 0x01, 0x0a, 0x0f, 0x43, 0x6f,
+	0x73, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x21, 0x0a,
+	0x1d, 0x43, 0x4f, 0x53, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x53, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x4f, 0x53, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x4c, 0x49, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x53, 0x54,
+	0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x48, 0x49,
+	0x46, 0x4f, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x53, 0x54, 0x5f, 0x42, 0x41, 0x53,
+	0x49, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47,
+	0x45, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x53, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49,
+	0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x43, 0x5f, 0x4c, 0x4f, 0x54, 0x10, 0x05, 0x2a, 0xe4, 0x01, 0x0a, 0x19, 0x49, 0x6e, 0x76, 0x65,
 	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x3e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x61, 0x70,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x56, 0x45, 0x53,
+	0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x28, 0x0a,
+	0x24, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x56,
+	0x49, 0x44, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x4e, 0x56, 0x45, 0x53,
+	0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x04, 0x2a, 0x6c,
+	0x0a, 0x0d, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x1e, 0x0a, 0x1a, 0x48, 0x4f, 0x4c, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x48, 0x4f, 0x4c, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x1c,
+	0x0a, 0x18, 0x48, 0x4f, 0x4c, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
+	0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x10, 0x02, 0x2a, 0xa2, 0x01, 0x0a,
+	0x0c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a,
+	0x19, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x59, 0x54,
+	0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x50, 0x45,
+	0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x02, 0x12,
+	0x1d, 0x0a, 0x19, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
+	0x5f, 0x54, 0x48, 0x52, 0x45, 0x45, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x53, 0x10, 0x03, 0x12, 0x21,
+	0x0a, 0x1d, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f,
+	0x53, 0x49, 0x4e, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x04, 0x2a, 0xa1, 0x01, 0x0a, 0x13, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x4c, 0x4c,
+	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49,
+	0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12, 0x1f,
+	0x0a, 0x1b, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x4d,
+	0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x10, 0x02, 0x12,
+	0x24, 0x0a, 0x20, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49,
+	0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c,
+	0x41, 0x53, 0x53, 0x10, 0x03, 0x32, 0xff, 0x24, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xac, 0x01, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x73, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x2f, 0x7b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x7d, 0x2f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xcb, 0x01, 0x0a,
-	0x19, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x2e, 0x77, 0x65, 0x61,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a,
+	0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x77,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa1,
+	0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0xbf, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34,
+	0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xd6, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x7d,
+	0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xcb, 0x01,
+	0x0a, 0x19, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x77, 0x65, 0x61,
 	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
 	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76,
 	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x77, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x29, 0x3a, 0x01, 0x2a, 0x1a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xce, 0x01, 0x0a, 0x1b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x77, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x77, 0x65,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x29, 0x3a, 0x01, 0x2a, 0x1a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xce, 0x01, 0x0a, 0x1b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x77, 0x65,
 	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
 	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x2a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbe, 0x01, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x12, 0x37, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x77,
 	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x2f,
-	0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66,
-	0x6f, 0x6c, 0x69, 0x6f, 0x2d, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0xa1, 0x01, 0x0a,
-	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x2e,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x2a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbe, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x37, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
 	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x73, 0x12, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12,
+	0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73,
+	0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2d, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0xa1, 0x01,
+	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x12, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2f,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0xa5, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37,
+	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xbf,
+	0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x41, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2d, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0xa7, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
 	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2f, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0xa5, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x2e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x6c,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x67, 0x6f, 0x6c, 0x64, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0xaf, 0x01, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6c, 0x76, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x73, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0xd0, 0x01, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x40, 0x2e,
 	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xbf, 0x01,
-	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x50,
-	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x41, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
 	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66,
-	0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0xa3, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
 	0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
-	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2d, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
-	0xa7, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43,
-	0x6f, 0x64, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f,
-	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x67,
-	0x6f, 0x6c, 0x64, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0xaf, 0x01, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
-	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6c, 0x76, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73,
-	0x69, 0x6c, 0x76, 0x65, 0x72, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0xd0, 0x01, 0x0a, 0x1c,
-	0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72,
-	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x40, 0x2e, 0x77,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
-	0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
 	0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69,
 	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
-	0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2f, 0x68, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0xa3,
-	0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2d, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0xa7, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x33, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74,
 	0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
-	0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x42, 0x0d,
-	0x5a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0xbe, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x3a, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x2d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0xb1, 0x01, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3a, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0xbb,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47, 0x61,
+	0x69, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3a, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47,
+	0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2f, 0x72, 0x65,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x2d, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x12, 0xbc, 0x01, 0x0a,
+	0x14, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x38, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x29, 0x3a, 0x01, 0x2a, 0x1a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0xbc, 0x01, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0xaa, 0x01, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61,
+	0x6e, 0x12, 0x36, 0x2e, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x77, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x2f, 0x72, 0x65,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4899,162 +8090,243 @@ func file_protobuf_v1_investment_proto_rawDescGZIP() []byte {
 	return file_protobuf_v1_investment_proto_rawDescData
 }
 
-var file_protobuf_v1_investment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_protobuf_v1_investment_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_protobuf_v1_investment_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_protobuf_v1_investment_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_protobuf_v1_investment_proto_goTypes = []interface{}{
 	(InvestmentType)(0),                          // 0: wealthjourney.investment.v1.InvestmentType
-	(InvestmentTransactionType)(0),               // 1: wealthjourney.investment.v1.InvestmentTransactionType
-	(*Investment)(nil),                           // 2: wealthjourney.investment.v1.Investment
-	(*InvestmentTransaction)(nil),                // 3: wealthjourney.investment.v1.InvestmentTransaction
-	(*PortfolioSummary)(nil),                     // 4: wealthjourney.investment.v1.PortfolioSummary
-	(*InvestmentByType)(nil),                     // 5: wealthjourney.investment.v1.InvestmentByType
-	(*InvestmentPerformance)(nil),                // 6: wealthjourney.investment.v1.InvestmentPerformance
-	(*HistoricalPortfolioValue)(nil),             // 7: wealthjourney.investment.v1.HistoricalPortfolioValue
-	(*GetHistoricalPortfolioValuesRequest)(nil),  // 8: wealthjourney.investment.v1.GetHistoricalPortfolioValuesRequest
-	(*GetHistoricalPortfolioValuesResponse)(nil), // 9: wealthjourney.investment.v1.GetHistoricalPortfolioValuesResponse
-	(*GoldTypeCode)(nil),                         // 10: wealthjourney.investment.v1.GoldTypeCode
-	(*GetGoldTypeCodesRequest)(nil),              // 11: wealthjourney.investment.v1.GetGoldTypeCodesRequest
-	(*GetGoldTypeCodesResponse)(nil),             // 12: wealthjourney.investment.v1.GetGoldTypeCodesResponse
-	(*SilverTypeCode)(nil),                       // 13: wealthjourney.investment.v1.SilverTypeCode
-	(*GetSilverTypeCodesRequest)(nil),            // 14: wealthjourney.investment.v1.GetSilverTypeCodesRequest
-	(*GetSilverTypeCodesResponse)(nil),           // 15: wealthjourney.investment.v1.GetSilverTypeCodesResponse
-	(*GetMarketPriceRequest)(nil),                // 16: wealthjourney.investment.v1.GetMarketPriceRequest
-	(*GetMarketPriceResponse)(nil),               // 17: wealthjourney.investment.v1.GetMarketPriceResponse
-	(*PriceItem)(nil),                            // 18: wealthjourney.investment.v1.PriceItem
-	(*GetMarketPricesRequest)(nil),               // 19: wealthjourney.investment.v1.GetMarketPricesRequest
-	(*GetMarketPricesResponse)(nil),              // 20: wealthjourney.investment.v1.GetMarketPricesResponse
-	(*MarketPrice)(nil),                          // 21: wealthjourney.investment.v1.MarketPrice
-	(*ListInvestmentsRequest)(nil),               // 22: wealthjourney.investment.v1.ListInvestmentsRequest
-	(*ListInvestmentsResponse)(nil),              // 23: wealthjourney.investment.v1.ListInvestmentsResponse
-	(*GetInvestmentRequest)(nil),                 // 24: wealthjourney.investment.v1.GetInvestmentRequest
-	(*GetInvestmentResponse)(nil),                // 25: wealthjourney.investment.v1.GetInvestmentResponse
-	(*CreateInvestmentRequest)(nil),              // 26: wealthjourney.investment.v1.CreateInvestmentRequest
-	(*CreateInvestmentResponse)(nil),             // 27: wealthjourney.investment.v1.CreateInvestmentResponse
-	(*UpdateInvestmentRequest)(nil),              // 28: wealthjourney.investment.v1.UpdateInvestmentRequest
-	(*UpdateInvestmentResponse)(nil),             // 29: wealthjourney.investment.v1.UpdateInvestmentResponse
-	(*DeleteInvestmentRequest)(nil),              // 30: wealthjourney.investment.v1.DeleteInvestmentRequest
-	(*DeleteInvestmentResponse)(nil),             // 31: wealthjourney.investment.v1.DeleteInvestmentResponse
-	(*AddTransactionRequest)(nil),                // 32: wealthjourney.investment.v1.AddTransactionRequest
-	(*AddTransactionResponse)(nil),               // 33: wealthjourney.investment.v1.AddTransactionResponse
-	(*ListInvestmentTransactionsRequest)(nil),    // 34: wealthjourney.investment.v1.ListInvestmentTransactionsRequest
-	(*ListInvestmentTransactionsResponse)(nil),   // 35: wealthjourney.investment.v1.ListInvestmentTransactionsResponse
-	(*EditInvestmentTransactionRequest)(nil),     // 36: wealthjourney.investment.v1.EditInvestmentTransactionRequest
-	(*EditInvestmentTransactionResponse)(nil),    // 37: wealthjourney.investment.v1.EditInvestmentTransactionResponse
-	(*DeleteInvestmentTransactionRequest)(nil),   // 38: wealthjourney.investment.v1.DeleteInvestmentTransactionRequest
-	(*DeleteInvestmentTransactionResponse)(nil),  // 39: wealthjourney.investment.v1.DeleteInvestmentTransactionResponse
-	(*GetPortfolioSummaryRequest)(nil),           // 40: wealthjourney.investment.v1.GetPortfolioSummaryRequest
-	(*GetPortfolioSummaryResponse)(nil),          // 41: wealthjourney.investment.v1.GetPortfolioSummaryResponse
-	(*UpdatePricesRequest)(nil),                  // 42: wealthjourney.investment.v1.UpdatePricesRequest
-	(*UpdatePricesResponse)(nil),                 // 43: wealthjourney.investment.v1.UpdatePricesResponse
-	(*SearchSymbolsRequest)(nil),                 // 44: wealthjourney.investment.v1.SearchSymbolsRequest
-	(*SearchResult)(nil),                         // 45: wealthjourney.investment.v1.SearchResult
-	(*SearchSymbolsResponse)(nil),                // 46: wealthjourney.investment.v1.SearchSymbolsResponse
-	(*ListUserInvestmentsRequest)(nil),           // 47: wealthjourney.investment.v1.ListUserInvestmentsRequest
-	(*ListUserInvestmentsResponse)(nil),          // 48: wealthjourney.investment.v1.ListUserInvestmentsResponse
-	(*GetAggregatedPortfolioSummaryRequest)(nil), // 49: wealthjourney.investment.v1.GetAggregatedPortfolioSummaryRequest
-	(*Money)(nil),                                // 50: wealthjourney.common.v1.Money
-	(*PaginationParams)(nil),                     // 51: wealthjourney.common.v1.PaginationParams
-	(*PaginationResult)(nil),                     // 52: wealthjourney.common.v1.PaginationResult
+	(CostBasisMethod)(0),                         // 1: wealthjourney.investment.v1.CostBasisMethod
+	(InvestmentTransactionType)(0),               // 2: wealthjourney.investment.v1.InvestmentTransactionType
+	(HoldingPeriod)(0),                           // 3: wealthjourney.investment.v1.HoldingPeriod
+	(ReturnPeriod)(0),                            // 4: wealthjourney.investment.v1.ReturnPeriod
+	(AllocationDimension)(0),                     // 5: wealthjourney.investment.v1.AllocationDimension
+	(*Investment)(nil),                           // 6: wealthjourney.investment.v1.Investment
+	(*InvestmentTransaction)(nil),                // 7: wealthjourney.investment.v1.InvestmentTransaction
+	(*LotSelection)(nil),                         // 8: wealthjourney.investment.v1.LotSelection
+	(*PortfolioSummary)(nil),                     // 9: wealthjourney.investment.v1.PortfolioSummary
+	(*InvestmentByType)(nil),                     // 10: wealthjourney.investment.v1.InvestmentByType
+	(*InvestmentPerformance)(nil),                // 11: wealthjourney.investment.v1.InvestmentPerformance
+	(*HistoricalPortfolioValue)(nil),             // 12: wealthjourney.investment.v1.HistoricalPortfolioValue
+	(*GetHistoricalPortfolioValuesRequest)(nil),  // 13: wealthjourney.investment.v1.GetHistoricalPortfolioValuesRequest
+	(*BenchmarkComparison)(nil),                  // 14: wealthjourney.investment.v1.BenchmarkComparison
+	(*GetHistoricalPortfolioValuesResponse)(nil), // 15: wealthjourney.investment.v1.GetHistoricalPortfolioValuesResponse
+	(*GoldTypeCode)(nil),                         // 16: wealthjourney.investment.v1.GoldTypeCode
+	(*GetGoldTypeCodesRequest)(nil),              // 17: wealthjourney.investment.v1.GetGoldTypeCodesRequest
+	(*GetGoldTypeCodesResponse)(nil),             // 18: wealthjourney.investment.v1.GetGoldTypeCodesResponse
+	(*SilverTypeCode)(nil),                       // 19: wealthjourney.investment.v1.SilverTypeCode
+	(*GetSilverTypeCodesRequest)(nil),            // 20: wealthjourney.investment.v1.GetSilverTypeCodesRequest
+	(*GetSilverTypeCodesResponse)(nil),           // 21: wealthjourney.investment.v1.GetSilverTypeCodesResponse
+	(*GetMarketPriceRequest)(nil),                // 22: wealthjourney.investment.v1.GetMarketPriceRequest
+	(*GetMarketPriceResponse)(nil),               // 23: wealthjourney.investment.v1.GetMarketPriceResponse
+	(*PriceItem)(nil),                            // 24: wealthjourney.investment.v1.PriceItem
+	(*GetMarketPricesRequest)(nil),               // 25: wealthjourney.investment.v1.GetMarketPricesRequest
+	(*GetMarketPricesResponse)(nil),              // 26: wealthjourney.investment.v1.GetMarketPricesResponse
+	(*MarketPrice)(nil),                          // 27: wealthjourney.investment.v1.MarketPrice
+	(*GetPriceHistoryRequest)(nil),               // 28: wealthjourney.investment.v1.GetPriceHistoryRequest
+	(*PriceBar)(nil),                             // 29: wealthjourney.investment.v1.PriceBar
+	(*GetPriceHistoryResponse)(nil),              // 30: wealthjourney.investment.v1.GetPriceHistoryResponse
+	(*GetPriceProviderHealthRequest)(nil),        // 31: wealthjourney.investment.v1.GetPriceProviderHealthRequest
+	(*PriceProviderHealth)(nil),                  // 32: wealthjourney.investment.v1.PriceProviderHealth
+	(*GetPriceProviderHealthResponse)(nil),       // 33: wealthjourney.investment.v1.GetPriceProviderHealthResponse
+	(*ListInvestmentsRequest)(nil),               // 34: wealthjourney.investment.v1.ListInvestmentsRequest
+	(*ListInvestmentsResponse)(nil),              // 35: wealthjourney.investment.v1.ListInvestmentsResponse
+	(*GetInvestmentRequest)(nil),                 // 36: wealthjourney.investment.v1.GetInvestmentRequest
+	(*GetInvestmentResponse)(nil),                // 37: wealthjourney.investment.v1.GetInvestmentResponse
+	(*CreateInvestmentRequest)(nil),              // 38: wealthjourney.investment.v1.CreateInvestmentRequest
+	(*CreateInvestmentResponse)(nil),             // 39: wealthjourney.investment.v1.CreateInvestmentResponse
+	(*UpdateInvestmentRequest)(nil),              // 40: wealthjourney.investment.v1.UpdateInvestmentRequest
+	(*UpdateInvestmentResponse)(nil),             // 41: wealthjourney.investment.v1.UpdateInvestmentResponse
+	(*DeleteInvestmentRequest)(nil),              // 42: wealthjourney.investment.v1.DeleteInvestmentRequest
+	(*DeleteInvestmentResponse)(nil),             // 43: wealthjourney.investment.v1.DeleteInvestmentResponse
+	(*AddTransactionRequest)(nil),                // 44: wealthjourney.investment.v1.AddTransactionRequest
+	(*AddTransactionResponse)(nil),               // 45: wealthjourney.investment.v1.AddTransactionResponse
+	(*ListInvestmentTransactionsRequest)(nil),    // 46: wealthjourney.investment.v1.ListInvestmentTransactionsRequest
+	(*ListInvestmentTransactionsResponse)(nil),   // 47: wealthjourney.investment.v1.ListInvestmentTransactionsResponse
+	(*EditInvestmentTransactionRequest)(nil),     // 48: wealthjourney.investment.v1.EditInvestmentTransactionRequest
+	(*EditInvestmentTransactionResponse)(nil),    // 49: wealthjourney.investment.v1.EditInvestmentTransactionResponse
+	(*DeleteInvestmentTransactionRequest)(nil),   // 50: wealthjourney.investment.v1.DeleteInvestmentTransactionRequest
+	(*DeleteInvestmentTransactionResponse)(nil),  // 51: wealthjourney.investment.v1.DeleteInvestmentTransactionResponse
+	(*GetPortfolioSummaryRequest)(nil),           // 52: wealthjourney.investment.v1.GetPortfolioSummaryRequest
+	(*GetPortfolioSummaryResponse)(nil),          // 53: wealthjourney.investment.v1.GetPortfolioSummaryResponse
+	(*UpdatePricesRequest)(nil),                  // 54: wealthjourney.investment.v1.UpdatePricesRequest
+	(*UpdatePricesResponse)(nil),                 // 55: wealthjourney.investment.v1.UpdatePricesResponse
+	(*SearchSymbolsRequest)(nil),                 // 56: wealthjourney.investment.v1.SearchSymbolsRequest
+	(*SearchResult)(nil),                         // 57: wealthjourney.investment.v1.SearchResult
+	(*SearchSymbolsResponse)(nil),                // 58: wealthjourney.investment.v1.SearchSymbolsResponse
+	(*ListUserInvestmentsRequest)(nil),           // 59: wealthjourney.investment.v1.ListUserInvestmentsRequest
+	(*ListUserInvestmentsResponse)(nil),          // 60: wealthjourney.investment.v1.ListUserInvestmentsResponse
+	(*GetAggregatedPortfolioSummaryRequest)(nil), // 61: wealthjourney.investment.v1.GetAggregatedPortfolioSummaryRequest
+	(*RealizedGain)(nil),                         // 62: wealthjourney.investment.v1.RealizedGain
+	(*RealizedGainsSummary)(nil),                 // 63: wealthjourney.investment.v1.RealizedGainsSummary
+	(*GetRealizedGainsReportRequest)(nil),        // 64: wealthjourney.investment.v1.GetRealizedGainsReportRequest
+	(*GetRealizedGainsReportResponse)(nil),       // 65: wealthjourney.investment.v1.GetRealizedGainsReportResponse
+	(*PerformanceReturns)(nil),                   // 66: wealthjourney.investment.v1.PerformanceReturns
+	(*InvestmentReturns)(nil),                    // 67: wealthjourney.investment.v1.InvestmentReturns
+	(*GetPerformanceReturnsRequest)(nil),         // 68: wealthjourney.investment.v1.GetPerformanceReturnsRequest
+	(*GetPerformanceReturnsResponse)(nil),        // 69: wealthjourney.investment.v1.GetPerformanceReturnsResponse
+	(*AllocationTarget)(nil),                     // 70: wealthjourney.investment.v1.AllocationTarget
+	(*SetAllocationTargetsRequest)(nil),          // 71: wealthjourney.investment.v1.SetAllocationTargetsRequest
+	(*SetAllocationTargetsResponse)(nil),         // 72: wealthjourney.investment.v1.SetAllocationTargetsResponse
+	(*ListAllocationTargetsRequest)(nil),         // 73: wealthjourney.investment.v1.ListAllocationTargetsRequest
+	(*ListAllocationTargetsResponse)(nil),        // 74: wealthjourney.investment.v1.ListAllocationTargetsResponse
+	(*GetRebalancingPlanRequest)(nil),            // 75: wealthjourney.investment.v1.GetRebalancingPlanRequest
+	(*AllocationDrift)(nil),                      // 76: wealthjourney.investment.v1.AllocationDrift
+	(*RebalanceTrade)(nil),                       // 77: wealthjourney.investment.v1.RebalanceTrade
+	(*RebalancingPlan)(nil),                      // 78: wealthjourney.investment.v1.RebalancingPlan
+	(*GetRebalancingPlanResponse)(nil),           // 79: wealthjourney.investment.v1.GetRebalancingPlanResponse
+	(*Money)(nil),                                // 80: wealthjourney.common.v1.Money
+	(*PaginationParams)(nil),                     // 81: wealthjourney.common.v1.PaginationParams
+	(*PaginationResult)(nil),                     // 82: wealthjourney.common.v1.PaginationResult
 }
 var file_protobuf_v1_investment_proto_depIdxs = []int32{
-	0,  // 0: wealthjourney.investment.v1.Investment.type:type_name -> wealthjourney.investment.v1.InvestmentType
-	50, // 1: wealthjourney.investment.v1.Investment.displayTotalCost:type_name -> wealthjourney.common.v1.Money
-	50, // 2: wealthjourney.investment.v1.Investment.displayCurrentValue:type_name -> wealthjourney.common.v1.Money
-	50, // 3: wealthjourney.investment.v1.Investment.displayUnrealizedPnl:type_name -> wealthjourney.common.v1.Money
-	50, // 4: wealthjourney.investment.v1.Investment.displayRealizedPnl:type_name -> wealthjourney.common.v1.Money
-	50, // 5: wealthjourney.investment.v1.Investment.displayCurrentPrice:type_name -> wealthjourney.common.v1.Money
-	50, // 6: wealthjourney.investment.v1.Investment.displayAverageCost:type_name -> wealthjourney.common.v1.Money
-	1,  // 7: wealthjourney.investment.v1.InvestmentTransaction.type:type_name -> wealthjourney.investment.v1.InvestmentTransactionType
-	50, // 8: wealthjourney.investment.v1.InvestmentTransaction.displayPrice:type_name -> wealthjourney.common.v1.Money
-	50, // 9: wealthjourney.investment.v1.InvestmentTransaction.displayCost:type_name -> wealthjourney.common.v1.Money
-	50, // 10: wealthjourney.investment.v1.InvestmentTransaction.displayFees:type_name -> wealthjourney.common.v1.Money
-	5,  // 11: wealthjourney.investment.v1.PortfolioSummary.investmentsByType:type_name -> wealthjourney.investment.v1.InvestmentByType
-	50, // 12: wealthjourney.investment.v1.PortfolioSummary.displayTotalValue:type_name -> wealthjourney.common.v1.Money
-	50, // 13: wealthjourney.investment.v1.PortfolioSummary.displayTotalCost:type_name -> wealthjourney.common.v1.Money
-	50, // 14: wealthjourney.investment.v1.PortfolioSummary.displayTotalPnl:type_name -> wealthjourney.common.v1.Money
-	50, // 15: wealthjourney.investment.v1.PortfolioSummary.displayRealizedPnl:type_name -> wealthjourney.common.v1.Money
-	50, // 16: wealthjourney.investment.v1.PortfolioSummary.displayUnrealizedPnl:type_name -> wealthjourney.common.v1.Money
-	6,  // 17: wealthjourney.investment.v1.PortfolioSummary.topPerformers:type_name -> wealthjourney.investment.v1.InvestmentPerformance
-	6,  // 18: wealthjourney.investment.v1.PortfolioSummary.worstPerformers:type_name -> wealthjourney.investment.v1.InvestmentPerformance
-	0,  // 19: wealthjourney.investment.v1.InvestmentByType.type:type_name -> wealthjourney.investment.v1.InvestmentType
-	0,  // 20: wealthjourney.investment.v1.InvestmentPerformance.type:type_name -> wealthjourney.investment.v1.InvestmentType
-	50, // 21: wealthjourney.investment.v1.InvestmentPerformance.displayUnrealizedPnl:type_name -> wealthjourney.common.v1.Money
-	50, // 22: wealthjourney.investment.v1.HistoricalPortfolioValue.displayTotalValue:type_name -> wealthjourney.common.v1.Money
-	0,  // 23: wealthjourney.investment.v1.GetHistoricalPortfolioValuesRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
-	7,  // 24: wealthjourney.investment.v1.GetHistoricalPortfolioValuesResponse.data:type_name -> wealthjourney.investment.v1.HistoricalPortfolioValue
-	10, // 25: wealthjourney.investment.v1.GetGoldTypeCodesResponse.data:type_name -> wealthjourney.investment.v1.GoldTypeCode
-	13, // 26: wealthjourney.investment.v1.GetSilverTypeCodesResponse.data:type_name -> wealthjourney.investment.v1.SilverTypeCode
-	0,  // 27: wealthjourney.investment.v1.GetMarketPriceRequest.type:type_name -> wealthjourney.investment.v1.InvestmentType
-	21, // 28: wealthjourney.investment.v1.GetMarketPriceResponse.data:type_name -> wealthjourney.investment.v1.MarketPrice
-	18, // 29: wealthjourney.investment.v1.GetMarketPricesResponse.gold:type_name -> wealthjourney.investment.v1.PriceItem
-	18, // 30: wealthjourney.investment.v1.GetMarketPricesResponse.silver:type_name -> wealthjourney.investment.v1.PriceItem
-	51, // 31: wealthjourney.investment.v1.ListInvestmentsRequest.pagination:type_name -> wealthjourney.common.v1.PaginationParams
-	0,  // 32: wealthjourney.investment.v1.ListInvestmentsRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
-	2,  // 33: wealthjourney.investment.v1.ListInvestmentsResponse.data:type_name -> wealthjourney.investment.v1.Investment
-	52, // 34: wealthjourney.investment.v1.ListInvestmentsResponse.pagination:type_name -> wealthjourney.common.v1.PaginationResult
-	2,  // 35: wealthjourney.investment.v1.GetInvestmentResponse.data:type_name -> wealthjourney.investment.v1.Investment
-	0,  // 36: wealthjourney.investment.v1.CreateInvestmentRequest.type:type_name -> wealthjourney.investment.v1.InvestmentType
-	2,  // 37: wealthjourney.investment.v1.CreateInvestmentResponse.data:type_name -> wealthjourney.investment.v1.Investment
-	2,  // 38: wealthjourney.investment.v1.UpdateInvestmentResponse.data:type_name -> wealthjourney.investment.v1.Investment
-	1,  // 39: wealthjourney.investment.v1.AddTransactionRequest.type:type_name -> wealthjourney.investment.v1.InvestmentTransactionType
-	3,  // 40: wealthjourney.investment.v1.AddTransactionResponse.data:type_name -> wealthjourney.investment.v1.InvestmentTransaction
-	2,  // 41: wealthjourney.investment.v1.AddTransactionResponse.updatedInvestment:type_name -> wealthjourney.investment.v1.Investment
-	51, // 42: wealthjourney.investment.v1.ListInvestmentTransactionsRequest.pagination:type_name -> wealthjourney.common.v1.PaginationParams
-	1,  // 43: wealthjourney.investment.v1.ListInvestmentTransactionsRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentTransactionType
-	3,  // 44: wealthjourney.investment.v1.ListInvestmentTransactionsResponse.data:type_name -> wealthjourney.investment.v1.InvestmentTransaction
-	52, // 45: wealthjourney.investment.v1.ListInvestmentTransactionsResponse.pagination:type_name -> wealthjourney.common.v1.PaginationResult
-	3,  // 46: wealthjourney.investment.v1.EditInvestmentTransactionResponse.data:type_name -> wealthjourney.investment.v1.InvestmentTransaction
-	4,  // 47: wealthjourney.investment.v1.GetPortfolioSummaryResponse.data:type_name -> wealthjourney.investment.v1.PortfolioSummary
-	2,  // 48: wealthjourney.investment.v1.UpdatePricesResponse.updatedInvestments:type_name -> wealthjourney.investment.v1.Investment
-	45, // 49: wealthjourney.investment.v1.SearchSymbolsResponse.data:type_name -> wealthjourney.investment.v1.SearchResult
-	51, // 50: wealthjourney.investment.v1.ListUserInvestmentsRequest.pagination:type_name -> wealthjourney.common.v1.PaginationParams
-	0,  // 51: wealthjourney.investment.v1.ListUserInvestmentsRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
-	2,  // 52: wealthjourney.investment.v1.ListUserInvestmentsResponse.investments:type_name -> wealthjourney.investment.v1.Investment
-	52, // 53: wealthjourney.investment.v1.ListUserInvestmentsResponse.pagination:type_name -> wealthjourney.common.v1.PaginationResult
-	0,  // 54: wealthjourney.investment.v1.GetAggregatedPortfolioSummaryRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
-	22, // 55: wealthjourney.investment.v1.InvestmentService.ListInvestments:input_type -> wealthjourney.investment.v1.ListInvestmentsRequest
-	24, // 56: wealthjourney.investment.v1.InvestmentService.GetInvestment:input_type -> wealthjourney.investment.v1.GetInvestmentRequest
-	26, // 57: wealthjourney.investment.v1.InvestmentService.CreateInvestment:input_type -> wealthjourney.investment.v1.CreateInvestmentRequest
-	28, // 58: wealthjourney.investment.v1.InvestmentService.UpdateInvestment:input_type -> wealthjourney.investment.v1.UpdateInvestmentRequest
-	30, // 59: wealthjourney.investment.v1.InvestmentService.DeleteInvestment:input_type -> wealthjourney.investment.v1.DeleteInvestmentRequest
-	32, // 60: wealthjourney.investment.v1.InvestmentService.AddInvestmentTransaction:input_type -> wealthjourney.investment.v1.AddTransactionRequest
-	34, // 61: wealthjourney.investment.v1.InvestmentService.ListInvestmentTransactions:input_type -> wealthjourney.investment.v1.ListInvestmentTransactionsRequest
-	36, // 62: wealthjourney.investment.v1.InvestmentService.EditInvestmentTransaction:input_type -> wealthjourney.investment.v1.EditInvestmentTransactionRequest
-	38, // 63: wealthjourney.investment.v1.InvestmentService.DeleteInvestmentTransaction:input_type -> wealthjourney.investment.v1.DeleteInvestmentTransactionRequest
-	40, // 64: wealthjourney.investment.v1.InvestmentService.GetPortfolioSummary:input_type -> wealthjourney.investment.v1.GetPortfolioSummaryRequest
-	42, // 65: wealthjourney.investment.v1.InvestmentService.UpdatePrices:input_type -> wealthjourney.investment.v1.UpdatePricesRequest
-	44, // 66: wealthjourney.investment.v1.InvestmentService.SearchSymbols:input_type -> wealthjourney.investment.v1.SearchSymbolsRequest
-	47, // 67: wealthjourney.investment.v1.InvestmentService.ListUserInvestments:input_type -> wealthjourney.investment.v1.ListUserInvestmentsRequest
-	49, // 68: wealthjourney.investment.v1.InvestmentService.GetAggregatedPortfolioSummary:input_type -> wealthjourney.investment.v1.GetAggregatedPortfolioSummaryRequest
-	11, // 69: wealthjourney.investment.v1.InvestmentService.GetGoldTypeCodes:input_type -> wealthjourney.investment.v1.GetGoldTypeCodesRequest
-	14, // 70: wealthjourney.investment.v1.InvestmentService.GetSilverTypeCodes:input_type -> wealthjourney.investment.v1.GetSilverTypeCodesRequest
-	8,  // 71: wealthjourney.investment.v1.InvestmentService.GetHistoricalPortfolioValues:input_type -> wealthjourney.investment.v1.GetHistoricalPortfolioValuesRequest
-	16, // 72: wealthjourney.investment.v1.InvestmentService.GetMarketPrice:input_type -> wealthjourney.investment.v1.GetMarketPriceRequest
-	19, // 73: wealthjourney.investment.v1.InvestmentService.GetMarketPrices:input_type -> wealthjourney.investment.v1.GetMarketPricesRequest
-	23, // 74: wealthjourney.investment.v1.InvestmentService.ListInvestments:output_type -> wealthjourney.investment.v1.ListInvestmentsResponse
-	25, // 75: wealthjourney.investment.v1.InvestmentService.GetInvestment:output_type -> wealthjourney.investment.v1.GetInvestmentResponse
-	27, // 76: wealthjourney.investment.v1.InvestmentService.CreateInvestment:output_type -> wealthjourney.investment.v1.CreateInvestmentResponse
-	29, // 77: wealthjourney.investment.v1.InvestmentService.UpdateInvestment:output_type -> wealthjourney.investment.v1.UpdateInvestmentResponse
-	31, // 78: wealthjourney.investment.v1.InvestmentService.DeleteInvestment:output_type -> wealthjourney.investment.v1.DeleteInvestmentResponse
-	33, // 79: wealthjourney.investment.v1.InvestmentService.AddInvestmentTransaction:output_type -> wealthjourney.investment.v1.AddTransactionResponse
-	35, // 80: wealthjourney.investment.v1.InvestmentService.ListInvestmentTransactions:output_type -> wealthjourney.investment.v1.ListInvestmentTransactionsResponse
-	37, // 81: wealthjourney.investment.v1.InvestmentService.EditInvestmentTransaction:output_type -> wealthjourney.investment.v1.EditInvestmentTransactionResponse
-	39, // 82: wealthjourney.investment.v1.InvestmentService.DeleteInvestmentTransaction:output_type -> wealthjourney.investment.v1.DeleteInvestmentTransactionResponse
-	41, // 83: wealthjourney.investment.v1.InvestmentService.GetPortfolioSummary:output_type -> wealthjourney.investment.v1.GetPortfolioSummaryResponse
-	43, // 84: wealthjourney.investment.v1.InvestmentService.UpdatePrices:output_type -> wealthjourney.investment.v1.UpdatePricesResponse
-	46, // 85: wealthjourney.investment.v1.InvestmentService.SearchSymbols:output_type -> wealthjourney.investment.v1.SearchSymbolsResponse
-	48, // 86: wealthjourney.investment.v1.InvestmentService.ListUserInvestments:output_type -> wealthjourney.investment.v1.ListUserInvestmentsResponse
-	41, // 87: wealthjourney.investment.v1.InvestmentService.GetAggregatedPortfolioSummary:output_type -> wealthjourney.investment.v1.GetPortfolioSummaryResponse
-	12, // 88: wealthjourney.investment.v1.InvestmentService.GetGoldTypeCodes:output_type -> wealthjourney.investment.v1.GetGoldTypeCodesResponse
-	15, // 89: wealthjourney.investment.v1.InvestmentService.GetSilverTypeCodes:output_type -> wealthjourney.investment.v1.GetSilverTypeCodesResponse
-	9,  // 90: wealthjourney.investment.v1.InvestmentService.GetHistoricalPortfolioValues:output_type -> wealthjourney.investment.v1.GetHistoricalPortfolioValuesResponse
-	17, // 91: wealthjourney.investment.v1.InvestmentService.GetMarketPrice:output_type -> wealthjourney.investment.v1.GetMarketPriceResponse
-	20, // 92: wealthjourney.investment.v1.InvestmentService.GetMarketPrices:output_type -> wealthjourney.investment.v1.GetMarketPricesResponse
-	74, // [74:93] is the sub-list for method output_type
-	55, // [55:74] is the sub-list for method input_type
-	55, // [55:55] is the sub-list for extension type_name
-	55, // [55:55] is the sub-list for extension extendee
-	0,  // [0:55] is the sub-list for field type_name
+	0,   // 0: wealthjourney.investment.v1.Investment.type:type_name -> wealthjourney.investment.v1.InvestmentType
+	80,  // 1: wealthjourney.investment.v1.Investment.displayTotalCost:type_name -> wealthjourney.common.v1.Money
+	80,  // 2: wealthjourney.investment.v1.Investment.displayCurrentValue:type_name -> wealthjourney.common.v1.Money
+	80,  // 3: wealthjourney.investment.v1.Investment.displayUnrealizedPnl:type_name -> wealthjourney.common.v1.Money
+	80,  // 4: wealthjourney.investment.v1.Investment.displayRealizedPnl:type_name -> wealthjourney.common.v1.Money
+	80,  // 5: wealthjourney.investment.v1.Investment.displayCurrentPrice:type_name -> wealthjourney.common.v1.Money
+	80,  // 6: wealthjourney.investment.v1.Investment.displayAverageCost:type_name -> wealthjourney.common.v1.Money
+	1,   // 7: wealthjourney.investment.v1.Investment.costBasisMethod:type_name -> wealthjourney.investment.v1.CostBasisMethod
+	2,   // 8: wealthjourney.investment.v1.InvestmentTransaction.type:type_name -> wealthjourney.investment.v1.InvestmentTransactionType
+	80,  // 9: wealthjourney.investment.v1.InvestmentTransaction.displayPrice:type_name -> wealthjourney.common.v1.Money
+	80,  // 10: wealthjourney.investment.v1.InvestmentTransaction.displayCost:type_name -> wealthjourney.common.v1.Money
+	80,  // 11: wealthjourney.investment.v1.InvestmentTransaction.displayFees:type_name -> wealthjourney.common.v1.Money
+	10,  // 12: wealthjourney.investment.v1.PortfolioSummary.investmentsByType:type_name -> wealthjourney.investment.v1.InvestmentByType
+	80,  // 13: wealthjourney.investment.v1.PortfolioSummary.displayTotalValue:type_name -> wealthjourney.common.v1.Money
+	80,  // 14: wealthjourney.investment.v1.PortfolioSummary.displayTotalCost:type_name -> wealthjourney.common.v1.Money
+	80,  // 15: wealthjourney.investment.v1.PortfolioSummary.displayTotalPnl:type_name -> wealthjourney.common.v1.Money
+	80,  // 16: wealthjourney.investment.v1.PortfolioSummary.displayRealizedPnl:type_name -> wealthjourney.common.v1.Money
+	80,  // 17: wealthjourney.investment.v1.PortfolioSummary.displayUnrealizedPnl:type_name -> wealthjourney.common.v1.Money
+	11,  // 18: wealthjourney.investment.v1.PortfolioSummary.topPerformers:type_name -> wealthjourney.investment.v1.InvestmentPerformance
+	11,  // 19: wealthjourney.investment.v1.PortfolioSummary.worstPerformers:type_name -> wealthjourney.investment.v1.InvestmentPerformance
+	66,  // 20: wealthjourney.investment.v1.PortfolioSummary.returns:type_name -> wealthjourney.investment.v1.PerformanceReturns
+	0,   // 21: wealthjourney.investment.v1.InvestmentByType.type:type_name -> wealthjourney.investment.v1.InvestmentType
+	0,   // 22: wealthjourney.investment.v1.InvestmentPerformance.type:type_name -> wealthjourney.investment.v1.InvestmentType
+	80,  // 23: wealthjourney.investment.v1.InvestmentPerformance.displayUnrealizedPnl:type_name -> wealthjourney.common.v1.Money
+	80,  // 24: wealthjourney.investment.v1.HistoricalPortfolioValue.displayTotalValue:type_name -> wealthjourney.common.v1.Money
+	80,  // 25: wealthjourney.investment.v1.HistoricalPortfolioValue.benchmarkValue:type_name -> wealthjourney.common.v1.Money
+	0,   // 26: wealthjourney.investment.v1.GetHistoricalPortfolioValuesRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
+	12,  // 27: wealthjourney.investment.v1.GetHistoricalPortfolioValuesResponse.data:type_name -> wealthjourney.investment.v1.HistoricalPortfolioValue
+	14,  // 28: wealthjourney.investment.v1.GetHistoricalPortfolioValuesResponse.benchmark:type_name -> wealthjourney.investment.v1.BenchmarkComparison
+	16,  // 29: wealthjourney.investment.v1.GetGoldTypeCodesResponse.data:type_name -> wealthjourney.investment.v1.GoldTypeCode
+	19,  // 30: wealthjourney.investment.v1.GetSilverTypeCodesResponse.data:type_name -> wealthjourney.investment.v1.SilverTypeCode
+	0,   // 31: wealthjourney.investment.v1.GetMarketPriceRequest.type:type_name -> wealthjourney.investment.v1.InvestmentType
+	27,  // 32: wealthjourney.investment.v1.GetMarketPriceResponse.data:type_name -> wealthjourney.investment.v1.MarketPrice
+	24,  // 33: wealthjourney.investment.v1.GetMarketPricesResponse.gold:type_name -> wealthjourney.investment.v1.PriceItem
+	24,  // 34: wealthjourney.investment.v1.GetMarketPricesResponse.silver:type_name -> wealthjourney.investment.v1.PriceItem
+	0,   // 35: wealthjourney.investment.v1.GetPriceHistoryRequest.type:type_name -> wealthjourney.investment.v1.InvestmentType
+	29,  // 36: wealthjourney.investment.v1.GetPriceHistoryResponse.data:type_name -> wealthjourney.investment.v1.PriceBar
+	32,  // 37: wealthjourney.investment.v1.GetPriceProviderHealthResponse.data:type_name -> wealthjourney.investment.v1.PriceProviderHealth
+	81,  // 38: wealthjourney.investment.v1.ListInvestmentsRequest.pagination:type_name -> wealthjourney.common.v1.PaginationParams
+	0,   // 39: wealthjourney.investment.v1.ListInvestmentsRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
+	6,   // 40: wealthjourney.investment.v1.ListInvestmentsResponse.data:type_name -> wealthjourney.investment.v1.Investment
+	82,  // 41: wealthjourney.investment.v1.ListInvestmentsResponse.pagination:type_name -> wealthjourney.common.v1.PaginationResult
+	6,   // 42: wealthjourney.investment.v1.GetInvestmentResponse.data:type_name -> wealthjourney.investment.v1.Investment
+	0,   // 43: wealthjourney.investment.v1.CreateInvestmentRequest.type:type_name -> wealthjourney.investment.v1.InvestmentType
+	1,   // 44: wealthjourney.investment.v1.CreateInvestmentRequest.costBasisMethod:type_name -> wealthjourney.investment.v1.CostBasisMethod
+	6,   // 45: wealthjourney.investment.v1.CreateInvestmentResponse.data:type_name -> wealthjourney.investment.v1.Investment
+	1,   // 46: wealthjourney.investment.v1.UpdateInvestmentRequest.costBasisMethod:type_name -> wealthjourney.investment.v1.CostBasisMethod
+	6,   // 47: wealthjourney.investment.v1.UpdateInvestmentResponse.data:type_name -> wealthjourney.investment.v1.Investment
+	2,   // 48: wealthjourney.investment.v1.AddTransactionRequest.type:type_name -> wealthjourney.investment.v1.InvestmentTransactionType
+	8,   // 49: wealthjourney.investment.v1.AddTransactionRequest.lotSelections:type_name -> wealthjourney.investment.v1.LotSelection
+	7,   // 50: wealthjourney.investment.v1.AddTransactionResponse.data:type_name -> wealthjourney.investment.v1.InvestmentTransaction
+	6,   // 51: wealthjourney.investment.v1.AddTransactionResponse.updatedInvestment:type_name -> wealthjourney.investment.v1.Investment
+	81,  // 52: wealthjourney.investment.v1.ListInvestmentTransactionsRequest.pagination:type_name -> wealthjourney.common.v1.PaginationParams
+	2,   // 53: wealthjourney.investment.v1.ListInvestmentTransactionsRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentTransactionType
+	7,   // 54: wealthjourney.investment.v1.ListInvestmentTransactionsResponse.data:type_name -> wealthjourney.investment.v1.InvestmentTransaction
+	82,  // 55: wealthjourney.investment.v1.ListInvestmentTransactionsResponse.pagination:type_name -> wealthjourney.common.v1.PaginationResult
+	7,   // 56: wealthjourney.investment.v1.EditInvestmentTransactionResponse.data:type_name -> wealthjourney.investment.v1.InvestmentTransaction
+	4,   // 57: wealthjourney.investment.v1.GetPortfolioSummaryRequest.period:type_name -> wealthjourney.investment.v1.ReturnPeriod
+	9,   // 58: wealthjourney.investment.v1.GetPortfolioSummaryResponse.data:type_name -> wealthjourney.investment.v1.PortfolioSummary
+	6,   // 59: wealthjourney.investment.v1.UpdatePricesResponse.updatedInvestments:type_name -> wealthjourney.investment.v1.Investment
+	57,  // 60: wealthjourney.investment.v1.SearchSymbolsResponse.data:type_name -> wealthjourney.investment.v1.SearchResult
+	81,  // 61: wealthjourney.investment.v1.ListUserInvestmentsRequest.pagination:type_name -> wealthjourney.common.v1.PaginationParams
+	0,   // 62: wealthjourney.investment.v1.ListUserInvestmentsRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
+	6,   // 63: wealthjourney.investment.v1.ListUserInvestmentsResponse.investments:type_name -> wealthjourney.investment.v1.Investment
+	82,  // 64: wealthjourney.investment.v1.ListUserInvestmentsResponse.pagination:type_name -> wealthjourney.common.v1.PaginationResult
+	0,   // 65: wealthjourney.investment.v1.GetAggregatedPortfolioSummaryRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
+	4,   // 66: wealthjourney.investment.v1.GetAggregatedPortfolioSummaryRequest.period:type_name -> wealthjourney.investment.v1.ReturnPeriod
+	0,   // 67: wealthjourney.investment.v1.RealizedGain.type:type_name -> wealthjourney.investment.v1.InvestmentType
+	3,   // 68: wealthjourney.investment.v1.RealizedGain.holdingPeriod:type_name -> wealthjourney.investment.v1.HoldingPeriod
+	0,   // 69: wealthjourney.investment.v1.GetRealizedGainsReportRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
+	62,  // 70: wealthjourney.investment.v1.GetRealizedGainsReportResponse.data:type_name -> wealthjourney.investment.v1.RealizedGain
+	63,  // 71: wealthjourney.investment.v1.GetRealizedGainsReportResponse.summary:type_name -> wealthjourney.investment.v1.RealizedGainsSummary
+	4,   // 72: wealthjourney.investment.v1.PerformanceReturns.period:type_name -> wealthjourney.investment.v1.ReturnPeriod
+	66,  // 73: wealthjourney.investment.v1.InvestmentReturns.returns:type_name -> wealthjourney.investment.v1.PerformanceReturns
+	4,   // 74: wealthjourney.investment.v1.GetPerformanceReturnsRequest.period:type_name -> wealthjourney.investment.v1.ReturnPeriod
+	0,   // 75: wealthjourney.investment.v1.GetPerformanceReturnsRequest.typeFilter:type_name -> wealthjourney.investment.v1.InvestmentType
+	66,  // 76: wealthjourney.investment.v1.GetPerformanceReturnsResponse.data:type_name -> wealthjourney.investment.v1.PerformanceReturns
+	67,  // 77: wealthjourney.investment.v1.GetPerformanceReturnsResponse.investments:type_name -> wealthjourney.investment.v1.InvestmentReturns
+	5,   // 78: wealthjourney.investment.v1.AllocationTarget.dimension:type_name -> wealthjourney.investment.v1.AllocationDimension
+	0,   // 79: wealthjourney.investment.v1.AllocationTarget.investmentType:type_name -> wealthjourney.investment.v1.InvestmentType
+	5,   // 80: wealthjourney.investment.v1.SetAllocationTargetsRequest.dimension:type_name -> wealthjourney.investment.v1.AllocationDimension
+	70,  // 81: wealthjourney.investment.v1.SetAllocationTargetsRequest.targets:type_name -> wealthjourney.investment.v1.AllocationTarget
+	70,  // 82: wealthjourney.investment.v1.SetAllocationTargetsResponse.data:type_name -> wealthjourney.investment.v1.AllocationTarget
+	70,  // 83: wealthjourney.investment.v1.ListAllocationTargetsResponse.data:type_name -> wealthjourney.investment.v1.AllocationTarget
+	5,   // 84: wealthjourney.investment.v1.AllocationDrift.dimension:type_name -> wealthjourney.investment.v1.AllocationDimension
+	0,   // 85: wealthjourney.investment.v1.AllocationDrift.investmentType:type_name -> wealthjourney.investment.v1.InvestmentType
+	2,   // 86: wealthjourney.investment.v1.RebalanceTrade.action:type_name -> wealthjourney.investment.v1.InvestmentTransactionType
+	80,  // 87: wealthjourney.investment.v1.RebalanceTrade.displayAmount:type_name -> wealthjourney.common.v1.Money
+	5,   // 88: wealthjourney.investment.v1.RebalancingPlan.dimension:type_name -> wealthjourney.investment.v1.AllocationDimension
+	76,  // 89: wealthjourney.investment.v1.RebalancingPlan.drift:type_name -> wealthjourney.investment.v1.AllocationDrift
+	77,  // 90: wealthjourney.investment.v1.RebalancingPlan.trades:type_name -> wealthjourney.investment.v1.RebalanceTrade
+	78,  // 91: wealthjourney.investment.v1.GetRebalancingPlanResponse.data:type_name -> wealthjourney.investment.v1.RebalancingPlan
+	34,  // 92: wealthjourney.investment.v1.InvestmentService.ListInvestments:input_type -> wealthjourney.investment.v1.ListInvestmentsRequest
+	36,  // 93: wealthjourney.investment.v1.InvestmentService.GetInvestment:input_type -> wealthjourney.investment.v1.GetInvestmentRequest
+	38,  // 94: wealthjourney.investment.v1.InvestmentService.CreateInvestment:input_type -> wealthjourney.investment.v1.CreateInvestmentRequest
+	40,  // 95: wealthjourney.investment.v1.InvestmentService.UpdateInvestment:input_type -> wealthjourney.investment.v1.UpdateInvestmentRequest
+	42,  // 96: wealthjourney.investment.v1.InvestmentService.DeleteInvestment:input_type -> wealthjourney.investment.v1.DeleteInvestmentRequest
+	44,  // 97: wealthjourney.investment.v1.InvestmentService.AddInvestmentTransaction:input_type -> wealthjourney.investment.v1.AddTransactionRequest
+	46,  // 98: wealthjourney.investment.v1.InvestmentService.ListInvestmentTransactions:input_type -> wealthjourney.investment.v1.ListInvestmentTransactionsRequest
+	48,  // 99: wealthjourney.investment.v1.InvestmentService.EditInvestmentTransaction:input_type -> wealthjourney.investment.v1.EditInvestmentTransactionRequest
+	50,  // 100: wealthjourney.investment.v1.InvestmentService.DeleteInvestmentTransaction:input_type -> wealthjourney.investment.v1.DeleteInvestmentTransactionRequest
+	52,  // 101: wealthjourney.investment.v1.InvestmentService.GetPortfolioSummary:input_type -> wealthjourney.investment.v1.GetPortfolioSummaryRequest
+	54,  // 102: wealthjourney.investment.v1.InvestmentService.UpdatePrices:input_type -> wealthjourney.investment.v1.UpdatePricesRequest
+	56,  // 103: wealthjourney.investment.v1.InvestmentService.SearchSymbols:input_type -> wealthjourney.investment.v1.SearchSymbolsRequest
+	59,  // 104: wealthjourney.investment.v1.InvestmentService.ListUserInvestments:input_type -> wealthjourney.investment.v1.ListUserInvestmentsRequest
+	61,  // 105: wealthjourney.investment.v1.InvestmentService.GetAggregatedPortfolioSummary:input_type -> wealthjourney.investment.v1.GetAggregatedPortfolioSummaryRequest
+	17,  // 106: wealthjourney.investment.v1.InvestmentService.GetGoldTypeCodes:input_type -> wealthjourney.investment.v1.GetGoldTypeCodesRequest
+	20,  // 107: wealthjourney.investment.v1.InvestmentService.GetSilverTypeCodes:input_type -> wealthjourney.investment.v1.GetSilverTypeCodesRequest
+	13,  // 108: wealthjourney.investment.v1.InvestmentService.GetHistoricalPortfolioValues:input_type -> wealthjourney.investment.v1.GetHistoricalPortfolioValuesRequest
+	22,  // 109: wealthjourney.investment.v1.InvestmentService.GetMarketPrice:input_type -> wealthjourney.investment.v1.GetMarketPriceRequest
+	25,  // 110: wealthjourney.investment.v1.InvestmentService.GetMarketPrices:input_type -> wealthjourney.investment.v1.GetMarketPricesRequest
+	28,  // 111: wealthjourney.investment.v1.InvestmentService.GetPriceHistory:input_type -> wealthjourney.investment.v1.GetPriceHistoryRequest
+	31,  // 112: wealthjourney.investment.v1.InvestmentService.GetPriceProviderHealth:input_type -> wealthjourney.investment.v1.GetPriceProviderHealthRequest
+	68,  // 113: wealthjourney.investment.v1.InvestmentService.GetPerformanceReturns:input_type -> wealthjourney.investment.v1.GetPerformanceReturnsRequest
+	64,  // 114: wealthjourney.investment.v1.InvestmentService.GetRealizedGainsReport:input_type -> wealthjourney.investment.v1.GetRealizedGainsReportRequest
+	71,  // 115: wealthjourney.investment.v1.InvestmentService.SetAllocationTargets:input_type -> wealthjourney.investment.v1.SetAllocationTargetsRequest
+	73,  // 116: wealthjourney.investment.v1.InvestmentService.ListAllocationTargets:input_type -> wealthjourney.investment.v1.ListAllocationTargetsRequest
+	75,  // 117: wealthjourney.investment.v1.InvestmentService.GetRebalancingPlan:input_type -> wealthjourney.investment.v1.GetRebalancingPlanRequest
+	35,  // 118: wealthjourney.investment.v1.InvestmentService.ListInvestments:output_type -> wealthjourney.investment.v1.ListInvestmentsResponse
+	37,  // 119: wealthjourney.investment.v1.InvestmentService.GetInvestment:output_type -> wealthjourney.investment.v1.GetInvestmentResponse
+	39,  // 120: wealthjourney.investment.v1.InvestmentService.CreateInvestment:output_type -> wealthjourney.investment.v1.CreateInvestmentResponse
+	41,  // 121: wealthjourney.investment.v1.InvestmentService.UpdateInvestment:output_type -> wealthjourney.investment.v1.UpdateInvestmentResponse
+	43,  // 122: wealthjourney.investment.v1.InvestmentService.DeleteInvestment:output_type -> wealthjourney.investment.v1.DeleteInvestmentResponse
+	45,  // 123: wealthjourney.investment.v1.InvestmentService.AddInvestmentTransaction:output_type -> wealthjourney.investment.v1.AddTransactionResponse
+	47,  // 124: wealthjourney.investment.v1.InvestmentService.ListInvestmentTransactions:output_type -> wealthjourney.investment.v1.ListInvestmentTransactionsResponse
+	49,  // 125: wealthjourney.investment.v1.InvestmentService.EditInvestmentTransaction:output_type -> wealthjourney.investment.v1.EditInvestmentTransactionResponse
+	51,  // 126: wealthjourney.investment.v1.InvestmentService.DeleteInvestmentTransaction:output_type -> wealthjourney.investment.v1.DeleteInvestmentTransactionResponse
+	53,  // 127: wealthjourney.investment.v1.InvestmentService.GetPortfolioSummary:output_type -> wealthjourney.investment.v1.GetPortfolioSummaryResponse
+	55,  // 128: wealthjourney.investment.v1.InvestmentService.UpdatePrices:output_type -> wealthjourney.investment.v1.UpdatePricesResponse
+	58,  // 129: wealthjourney.investment.v1.InvestmentService.SearchSymbols:output_type -> wealthjourney.investment.v1.SearchSymbolsResponse
+	60,  // 130: wealthjourney.investment.v1.InvestmentService.ListUserInvestments:output_type -> wealthjourney.investment.v1.ListUserInvestmentsResponse
+	53,  // 131: wealthjourney.investment.v1.InvestmentService.GetAggregatedPortfolioSummary:output_type -> wealthjourney.investment.v1.GetPortfolioSummaryResponse
+	18,  // 132: wealthjourney.investment.v1.InvestmentService.GetGoldTypeCodes:output_type -> wealthjourney.investment.v1.GetGoldTypeCodesResponse
+	21,  // 133: wealthjourney.investment.v1.InvestmentService.GetSilverTypeCodes:output_type -> wealthjourney.investment.v1.GetSilverTypeCodesResponse
+	15,  // 134: wealthjourney.investment.v1.InvestmentService.GetHistoricalPortfolioValues:output_type -> wealthjourney.investment.v1.GetHistoricalPortfolioValuesResponse
+	23,  // 135: wealthjourney.investment.v1.InvestmentService.GetMarketPrice:output_type -> wealthjourney.investment.v1.GetMarketPriceResponse
+	26,  // 136: wealthjourney.investment.v1.InvestmentService.GetMarketPrices:output_type -> wealthjourney.investment.v1.GetMarketPricesResponse
+	30,  // 137: wealthjourney.investment.v1.InvestmentService.GetPriceHistory:output_type -> wealthjourney.investment.v1.GetPriceHistoryResponse
+	33,  // 138: wealthjourney.investment.v1.InvestmentService.GetPriceProviderHealth:output_type -> wealthjourney.investment.v1.GetPriceProviderHealthResponse
+	69,  // 139: wealthjourney.investment.v1.InvestmentService.GetPerformanceReturns:output_type -> wealthjourney.investment.v1.GetPerformanceReturnsResponse
+	65,  // 140: wealthjourney.investment.v1.InvestmentService.GetRealizedGainsReport:output_type -> wealthjourney.investment.v1.GetRealizedGainsReportResponse
+	72,  // 141: wealthjourney.investment.v1.InvestmentService.SetAllocationTargets:output_type -> wealthjourney.investment.v1.SetAllocationTargetsResponse
+	74,  // 142: wealthjourney.investment.v1.InvestmentService.ListAllocationTargets:output_type -> wealthjourney.investment.v1.ListAllocationTargetsResponse
+	79,  // 143: wealthjourney.investment.v1.InvestmentService.GetRebalancingPlan:output_type -> wealthjourney.investment.v1.GetRebalancingPlanResponse
+	118, // [118:144] is the sub-list for method output_type
+	92,  // [92:118] is the sub-list for method input_type
+	92,  // [92:92] is the sub-list for extension type_name
+	92,  // [92:92] is the sub-list for extension extendee
+	0,   // [0:92] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_v1_investment_proto_init() }
@@ -5076,8 +8348,212 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvestmentTransaction); i {
+		file_protobuf_v1_investment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvestmentTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotSelection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortfolioSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvestmentByType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvestmentPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricalPortfolioValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoricalPortfolioValuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoricalPortfolioValuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoldTypeCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGoldTypeCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGoldTypeCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SilverTypeCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSilverTypeCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSilverTypeCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5088,8 +8564,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortfolioSummary); i {
+		file_protobuf_v1_investment_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketPricesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5100,8 +8576,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvestmentByType); i {
+		file_protobuf_v1_investment_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketPricesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5112,8 +8588,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvestmentPerformance); i {
+		file_protobuf_v1_investment_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketPrice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5124,8 +8600,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoricalPortfolioValue); i {
+		file_protobuf_v1_investment_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5136,8 +8612,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHistoricalPortfolioValuesRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceBar); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5148,8 +8624,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHistoricalPortfolioValuesResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5160,8 +8636,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GoldTypeCode); i {
+		file_protobuf_v1_investment_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceProviderHealthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5172,8 +8648,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGoldTypeCodesRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceProviderHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5184,8 +8660,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGoldTypeCodesResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceProviderHealthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5196,8 +8672,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SilverTypeCode); i {
+		file_protobuf_v1_investment_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvestmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5208,8 +8684,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSilverTypeCodesRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvestmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5220,8 +8696,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSilverTypeCodesResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvestmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5232,8 +8708,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarketPriceRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvestmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5244,8 +8720,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarketPriceResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvestmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5256,8 +8732,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceItem); i {
+		file_protobuf_v1_investment_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvestmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5268,8 +8744,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarketPricesRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInvestmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5280,8 +8756,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarketPricesResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInvestmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5292,8 +8768,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketPrice); i {
+		file_protobuf_v1_investment_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInvestmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5304,8 +8780,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInvestmentsRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInvestmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5316,8 +8792,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInvestmentsResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5328,8 +8804,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInvestmentRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5340,8 +8816,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInvestmentResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvestmentTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5352,8 +8828,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInvestmentRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvestmentTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5364,8 +8840,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInvestmentResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditInvestmentTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5376,8 +8852,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateInvestmentRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditInvestmentTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5388,8 +8864,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateInvestmentResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInvestmentTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5400,8 +8876,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInvestmentRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInvestmentTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5412,8 +8888,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInvestmentResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPortfolioSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5424,8 +8900,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTransactionRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPortfolioSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5436,8 +8912,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTransactionResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePricesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5448,8 +8924,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInvestmentTransactionsRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePricesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5460,8 +8936,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInvestmentTransactionsResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSymbolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5472,8 +8948,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditInvestmentTransactionRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5484,8 +8960,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditInvestmentTransactionResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSymbolsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5496,8 +8972,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInvestmentTransactionRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserInvestmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5508,8 +8984,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInvestmentTransactionResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserInvestmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5520,8 +8996,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPortfolioSummaryRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAggregatedPortfolioSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5532,8 +9008,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPortfolioSummaryResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealizedGain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5544,8 +9020,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePricesRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealizedGainsSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5556,8 +9032,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePricesResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealizedGainsReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5568,8 +9044,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchSymbolsRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealizedGainsReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5580,8 +9056,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResult); i {
+		file_protobuf_v1_investment_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerformanceReturns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5592,8 +9068,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchSymbolsResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvestmentReturns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5604,8 +9080,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserInvestmentsRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPerformanceReturnsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5616,8 +9092,8 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserInvestmentsResponse); i {
+		file_protobuf_v1_investment_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPerformanceReturnsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5628,8 +9104,116 @@ func file_protobuf_v1_investment_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_v1_investment_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAggregatedPortfolioSummaryRequest); i {
+		file_protobuf_v1_investment_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllocationTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllocationTargetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllocationTargetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllocationTargetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllocationTargetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRebalancingPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllocationDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebalanceTrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebalancingPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_v1_investment_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRebalancingPlanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5641,13 +9225,15 @@ func file_protobuf_v1_investment_proto_init() {
 			}
 		}
 	}
+	file_protobuf_v1_investment_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_protobuf_v1_investment_proto_msgTypes[60].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_v1_investment_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   48,
+			NumEnums:      6,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,6 +38,13 @@ const (
 	InvestmentService_GetHistoricalPortfolioValues_FullMethodName  = "/wealthjourney.investment.v1.InvestmentService/GetHistoricalPortfolioValues"
 	InvestmentService_GetMarketPrice_FullMethodName                = "/wealthjourney.investment.v1.InvestmentService/GetMarketPrice"
 	InvestmentService_GetMarketPrices_FullMethodName               = "/wealthjourney.investment.v1.InvestmentService/GetMarketPrices"
+	InvestmentService_GetPriceHistory_FullMethodName               = "/wealthjourney.investment.v1.InvestmentService/GetPriceHistory"
+	InvestmentService_GetPriceProviderHealth_FullMethodName        = "/wealthjourney.investment.v1.InvestmentService/GetPriceProviderHealth"
+	InvestmentService_GetPerformanceReturns_FullMethodName         = "/wealthjourney.investment.v1.InvestmentService/GetPerformanceReturns"
+	InvestmentService_GetRealizedGainsReport_FullMethodName        = "/wealthjourney.investment.v1.InvestmentService/GetRealizedGainsReport"
+	InvestmentService_SetAllocationTargets_FullMethodName          = "/wealthjourney.investment.v1.InvestmentService/SetAllocationTargets"
+	InvestmentService_ListAllocationTargets_FullMethodName         = "/wealthjourney.investment.v1.InvestmentService/ListAllocationTargets"
+	InvestmentService_GetRebalancingPlan_FullMethodName            = "/wealthjourney.investment.v1.InvestmentService/GetRebalancingPlan"
 )
 
 // InvestmentServiceClient is the client API for InvestmentService service.
@@ -84,6 +91,25 @@ type InvestmentServiceClient interface {
 	GetMarketPrice(ctx context.Context, in *GetMarketPriceRequest, opts ...grpc.CallOption) (*GetMarketPriceResponse, error)
 	// GetMarketPrices returns all gold and silver prices in one call
 	GetMarketPrices(ctx context.Context, in *GetMarketPricesRequest, opts ...grpc.CallOption) (*GetMarketPricesResponse, error)
+	// GetPriceHistory returns stored daily prices, backfilling missing days first
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// GetPriceProviderHealth reports which market price providers are failing over
+	GetPriceProviderHealth(ctx context.Context, in *GetPriceProviderHealthRequest, opts ...grpc.CallOption) (*GetPriceProviderHealthResponse, error)
+	// GetPerformanceReturns returns money-weighted (XIRR) and time-weighted (TWR) returns
+	// for one investment, one wallet or all investment wallets over a period
+	GetPerformanceReturns(ctx context.Context, in *GetPerformanceReturnsRequest, opts ...grpc.CallOption) (*GetPerformanceReturnsResponse, error)
+	// GetRealizedGainsReport lists every disposal (sell) with its cost basis and gain/loss
+	// in the user's preferred currency, for tax reporting
+	GetRealizedGainsReport(ctx context.Context, in *GetRealizedGainsReportRequest, opts ...grpc.CallOption) (*GetRealizedGainsReportResponse, error)
+	// SetAllocationTargets replaces the target weights of an investment wallet, or of all
+	// investment wallets when walletId is 0
+	SetAllocationTargets(ctx context.Context, in *SetAllocationTargetsRequest, opts ...grpc.CallOption) (*SetAllocationTargetsResponse, error)
+	// ListAllocationTargets returns the target weights of an investment wallet, or of all
+	// investment wallets when walletId is 0
+	ListAllocationTargets(ctx context.Context, in *ListAllocationTargetsRequest, opts ...grpc.CallOption) (*ListAllocationTargetsResponse, error)
+	// GetRebalancingPlan compares current holdings with the allocation targets and suggests
+	// the buys and sells that bring them back on target
+	GetRebalancingPlan(ctx context.Context, in *GetRebalancingPlanRequest, opts ...grpc.CallOption) (*GetRebalancingPlanResponse, error)
 }
 
 type investmentServiceClient struct {
@@ -265,6 +291,69 @@ func (c *investmentServiceClient) GetMarketPrices(ctx context.Context, in *GetMa
 	return out, nil
 }
 
+func (c *investmentServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, InvestmentService_GetPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investmentServiceClient) GetPriceProviderHealth(ctx context.Context, in *GetPriceProviderHealthRequest, opts ...grpc.CallOption) (*GetPriceProviderHealthResponse, error) {
+	out := new(GetPriceProviderHealthResponse)
+	err := c.cc.Invoke(ctx, InvestmentService_GetPriceProviderHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investmentServiceClient) GetPerformanceReturns(ctx context.Context, in *GetPerformanceReturnsRequest, opts ...grpc.CallOption) (*GetPerformanceReturnsResponse, error) {
+	out := new(GetPerformanceReturnsResponse)
+	err := c.cc.Invoke(ctx, InvestmentService_GetPerformanceReturns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investmentServiceClient) GetRealizedGainsReport(ctx context.Context, in *GetRealizedGainsReportRequest, opts ...grpc.CallOption) (*GetRealizedGainsReportResponse, error) {
+	out := new(GetRealizedGainsReportResponse)
+	err := c.cc.Invoke(ctx, InvestmentService_GetRealizedGainsReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investmentServiceClient) SetAllocationTargets(ctx context.Context, in *SetAllocationTargetsRequest, opts ...grpc.CallOption) (*SetAllocationTargetsResponse, error) {
+	out := new(SetAllocationTargetsResponse)
+	err := c.cc.Invoke(ctx, InvestmentService_SetAllocationTargets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investmentServiceClient) ListAllocationTargets(ctx context.Context, in *ListAllocationTargetsRequest, opts ...grpc.CallOption) (*ListAllocationTargetsResponse, error) {
+	out := new(ListAllocationTargetsResponse)
+	err := c.cc.Invoke(ctx, InvestmentService_ListAllocationTargets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investmentServiceClient) GetRebalancingPlan(ctx context.Context, in *GetRebalancingPlanRequest, opts ...grpc.CallOption) (*GetRebalancingPlanResponse, error) {
+	out := new(GetRebalancingPlanResponse)
+	err := c.cc.Invoke(ctx, InvestmentService_GetRebalancingPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvestmentServiceServer is the server API for InvestmentService service.
 // All implementations must embed UnimplementedInvestmentServiceServer
 // for forward compatibility
@@ -309,6 +398,25 @@ type InvestmentServiceServer interface {
 	GetMarketPrice(context.Context, *GetMarketPriceRequest) (*GetMarketPriceResponse, error)
 	// GetMarketPrices returns all gold and silver prices in one call
 	GetMarketPrices(context.Context, *GetMarketPricesRequest) (*GetMarketPricesResponse, error)
+	// GetPriceHistory returns stored daily prices, backfilling missing days first
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// GetPriceProviderHealth reports which market price providers are failing over
+	GetPriceProviderHealth(context.Context, *GetPriceProviderHealthRequest) (*GetPriceProviderHealthResponse, error)
+	// GetPerformanceReturns returns money-weighted (XIRR) and time-weighted (TWR) returns
+	// for one investment, one wallet or all investment wallets over a period
+	GetPerformanceReturns(context.Context, *GetPerformanceReturnsRequest) (*GetPerformanceReturnsResponse, error)
+	// GetRealizedGainsReport lists every disposal (sell) with its cost basis and gain/loss
+	// in the user's preferred currency, for tax reporting
+	GetRealizedGainsReport(context.Context, *GetRealizedGainsReportRequest) (*GetRealizedGainsReportResponse, error)
+	// SetAllocationTargets replaces the target weights of an investment wallet, or of all
+	// investment wallets when walletId is 0
+	SetAllocationTargets(context.Context, *SetAllocationTargetsRequest) (*SetAllocationTargetsResponse, error)
+	// ListAllocationTargets returns the target weights of an investment wallet, or of all
+	// investment wallets when walletId is 0
+	ListAllocationTargets(context.Context, *ListAllocationTargetsRequest) (*ListAllocationTargetsResponse, error)
+	// GetRebalancingPlan compares current holdings with the allocation targets and suggests
+	// the buys and sells that bring them back on target
+	GetRebalancingPlan(context.Context, *GetRebalancingPlanRequest) (*GetRebalancingPlanResponse, error)
 	mustEmbedUnimplementedInvestmentServiceServer()
 }
 
@@ -373,6 +481,27 @@ func (UnimplementedInvestmentServiceServer) GetMarketPrice(context.Context, *Get
 func (UnimplementedInvestmentServiceServer) GetMarketPrices(context.Context, *GetMarketPricesRequest) (*GetMarketPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMarketPrices not implemented")
 }
+func (UnimplementedInvestmentServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedInvestmentServiceServer) GetPriceProviderHealth(context.Context, *GetPriceProviderHealthRequest) (*GetPriceProviderHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceProviderHealth not implemented")
+}
+func (UnimplementedInvestmentServiceServer) GetPerformanceReturns(context.Context, *GetPerformanceReturnsRequest) (*GetPerformanceReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerformanceReturns not implemented")
+}
+func (UnimplementedInvestmentServiceServer) GetRealizedGainsReport(context.Context, *GetRealizedGainsReportRequest) (*GetRealizedGainsReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealizedGainsReport not implemented")
+}
+func (UnimplementedInvestmentServiceServer) SetAllocationTargets(context.Context, *SetAllocationTargetsRequest) (*SetAllocationTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllocationTargets not implemented")
+}
+func (UnimplementedInvestmentServiceServer) ListAllocationTargets(context.Context, *ListAllocationTargetsRequest) (*ListAllocationTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllocationTargets not implemented")
+}
+func (UnimplementedInvestmentServiceServer) GetRebalancingPlan(context.Context, *GetRebalancingPlanRequest) (*GetRebalancingPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRebalancingPlan not implemented")
+}
 func (UnimplementedInvestmentServiceServer) mustEmbedUnimplementedInvestmentServiceServer() {}
 
 // UnsafeInvestmentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -728,6 +857,132 @@ func _InvestmentService_GetMarketPrices_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InvestmentService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestmentServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvestmentService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestmentServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestmentService_GetPriceProviderHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceProviderHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestmentServiceServer).GetPriceProviderHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvestmentService_GetPriceProviderHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestmentServiceServer).GetPriceProviderHealth(ctx, req.(*GetPriceProviderHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestmentService_GetPerformanceReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPerformanceReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestmentServiceServer).GetPerformanceReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvestmentService_GetPerformanceReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestmentServiceServer).GetPerformanceReturns(ctx, req.(*GetPerformanceReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestmentService_GetRealizedGainsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealizedGainsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestmentServiceServer).GetRealizedGainsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvestmentService_GetRealizedGainsReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestmentServiceServer).GetRealizedGainsReport(ctx, req.(*GetRealizedGainsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestmentService_SetAllocationTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllocationTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestmentServiceServer).SetAllocationTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvestmentService_SetAllocationTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestmentServiceServer).SetAllocationTargets(ctx, req.(*SetAllocationTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestmentService_ListAllocationTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllocationTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestmentServiceServer).ListAllocationTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvestmentService_ListAllocationTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestmentServiceServer).ListAllocationTargets(ctx, req.(*ListAllocationTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestmentService_GetRebalancingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebalancingPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestmentServiceServer).GetRebalancingPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvestmentService_GetRebalancingPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestmentServiceServer).GetRebalancingPlan(ctx, req.(*GetRebalancingPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InvestmentService_ServiceDesc is the grpc.ServiceDesc for InvestmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -811,6 +1066,34 @@ var InvestmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMarketPrices",
 			Handler:    _InvestmentService_GetMarketPrices_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _InvestmentService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetPriceProviderHealth",
+			Handler:    _InvestmentService_GetPriceProviderHealth_Handler,
+		},
+		{
+			MethodName: "GetPerformanceReturns",
+			Handler:    _InvestmentService_GetPerformanceReturns_Handler,
+		},
+		{
+			MethodName: "GetRealizedGainsReport",
+			Handler:    _InvestmentService_GetRealizedGainsReport_Handler,
+		},
+		{
+			MethodName: "SetAllocationTargets",
+			Handler:    _InvestmentService_SetAllocationTargets_Handler,
+		},
+		{
+			MethodName: "ListAllocationTargets",
+			Handler:    _InvestmentService_ListAllocationTargets_Handler,
+		},
+		{
+			MethodName: "GetRebalancingPlan",
+			Handler:    _InvestmentService_GetRebalancingPlan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protobuf/v1/investment.proto",
@@ -27,6 +27,7 @@ const (
 	TransactionService_GetAvailableYears_FullMethodName    = "/wealthjourney.transaction.v1.TransactionService/GetAvailableYears"
 	TransactionService_GetFinancialReport_FullMethodName   = "/wealthjourney.transaction.v1.TransactionService/GetFinancialReport"
 	TransactionService_GetCategoryBreakdown_FullMethodName = "/wealthjourney.transaction.v1.TransactionService/GetCategoryBreakdown"
+	TransactionService_GetTagBreakdown_FullMethodName      = "/wealthjourney.transaction.v1.TransactionService/GetTagBreakdown"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -49,6 +50,8 @@ type TransactionServiceClient interface {
 	GetFinancialReport(ctx context.Context, in *GetFinancialReportRequest, opts ...grpc.CallOption) (*GetFinancialReportResponse, error)
 	// Get category breakdown for a date range
 	GetCategoryBreakdown(ctx context.Context, in *GetCategoryBreakdownRequest, opts ...grpc.CallOption) (*GetCategoryBreakdownResponse, error)
+	// Get tag breakdown for a date range
+	GetTagBreakdown(ctx context.Context, in *GetTagBreakdownRequest, opts ...grpc.CallOption) (*GetTagBreakdownResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -131,6 +134,15 @@ func (c *transactionServiceClient) GetCategoryBreakdown(ctx context.Context, in
 	return out, nil
 }
 
+func (c *transactionServiceClient) GetTagBreakdown(ctx context.Context, in *GetTagBreakdownRequest, opts ...grpc.CallOption) (*GetTagBreakdownResponse, error) {
+	out := new(GetTagBreakdownResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetTagBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility
@@ -151,6 +163,8 @@ type TransactionServiceServer interface {
 	GetFinancialReport(context.Context, *GetFinancialReportRequest) (*GetFinancialReportResponse, error)
 	// Get category breakdown for a date range
 	GetCategoryBreakdown(context.Context, *GetCategoryBreakdownRequest) (*GetCategoryBreakdownResponse, error)
+	// Get tag breakdown for a date range
+	GetTagBreakdown(context.Context, *GetTagBreakdownRequest) (*GetTagBreakdownResponse, error)
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -182,6 +196,9 @@ func (UnimplementedTransactionServiceServer) GetFinancialReport(context.Context,
 func (UnimplementedTransactionServiceServer) GetCategoryBreakdown(context.Context, *GetCategoryBreakdownRequest) (*GetCategoryBreakdownResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryBreakdown not implemented")
 }
+func (UnimplementedTransactionServiceServer) GetTagBreakdown(context.Context, *GetTagBreakdownRequest) (*GetTagBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagBreakdown not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 
 // UnsafeTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -339,6 +356,24 @@ func _TransactionService_GetCategoryBreakdown_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_GetTagBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetTagBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetTagBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetTagBreakdown(ctx, req.(*GetTagBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -378,6 +413,10 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCategoryBreakdown",
 			Handler:    _TransactionService_GetCategoryBreakdown_Handler,
 		},
+		{
+			MethodName: "GetTagBreakdown",
+			Handler:    _TransactionService_GetTagBreakdown_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protobuf/v1/transaction.proto",
@@ -630,3 +669,904 @@ var CategoryService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protobuf/v1/transaction.proto",
 }
+
+const (
+	TagService_GetTag_FullMethodName    = "/wealthjourney.transaction.v1.TagService/GetTag"
+	TagService_ListTags_FullMethodName  = "/wealthjourney.transaction.v1.TagService/ListTags"
+	TagService_CreateTag_FullMethodName = "/wealthjourney.transaction.v1.TagService/CreateTag"
+	TagService_UpdateTag_FullMethodName = "/wealthjourney.transaction.v1.TagService/UpdateTag"
+	TagService_DeleteTag_FullMethodName = "/wealthjourney.transaction.v1.TagService/DeleteTag"
+)
+
+// TagServiceClient is the client API for TagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TagServiceClient interface {
+	// Get a tag by ID
+	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error)
+	// List all tags for authenticated user with pagination
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// Create a new tag
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	// Rename a tag
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
+	// Delete a tag and remove it from all transactions
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+}
+
+type tagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagServiceClient(cc grpc.ClientConnInterface) TagServiceClient {
+	return &tagServiceClient{cc}
+}
+
+func (c *tagServiceClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error) {
+	out := new(GetTagResponse)
+	err := c.cc.Invoke(ctx, TagService_GetTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, TagService_CreateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error) {
+	out := new(UpdateTagResponse)
+	err := c.cc.Invoke(ctx, TagService_UpdateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, TagService_DeleteTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagServiceServer is the server API for TagService service.
+// All implementations must embed UnimplementedTagServiceServer
+// for forward compatibility
+type TagServiceServer interface {
+	// Get a tag by ID
+	GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error)
+	// List all tags for authenticated user with pagination
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// Create a new tag
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	// Rename a tag
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	// Delete a tag and remove it from all transactions
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+// UnimplementedTagServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTagServiceServer struct {
+}
+
+func (UnimplementedTagServiceServer) GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
+}
+func (UnimplementedTagServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedTagServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedTagServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedTagServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
+
+// UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagServiceServer will
+// result in compilation errors.
+type UnsafeTagServiceServer interface {
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+func RegisterTagServiceServer(s grpc.ServiceRegistrar, srv TagServiceServer) {
+	s.RegisterService(&TagService_ServiceDesc, srv)
+}
+
+func _TagService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_GetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).GetTag(ctx, req.(*GetTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wealthjourney.transaction.v1.TagService",
+	HandlerType: (*TagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTag",
+			Handler:    _TagService_GetTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _TagService_ListTags_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _TagService_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _TagService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _TagService_DeleteTag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protobuf/v1/transaction.proto",
+}
+
+const (
+	AttachmentService_UploadTransactionAttachment_FullMethodName           = "/wealthjourney.transaction.v1.AttachmentService/UploadTransactionAttachment"
+	AttachmentService_ListTransactionAttachments_FullMethodName            = "/wealthjourney.transaction.v1.AttachmentService/ListTransactionAttachments"
+	AttachmentService_UploadInvestmentTransactionAttachment_FullMethodName = "/wealthjourney.transaction.v1.AttachmentService/UploadInvestmentTransactionAttachment"
+	AttachmentService_ListInvestmentTransactionAttachments_FullMethodName  = "/wealthjourney.transaction.v1.AttachmentService/ListInvestmentTransactionAttachments"
+	AttachmentService_GetAttachment_FullMethodName                         = "/wealthjourney.transaction.v1.AttachmentService/GetAttachment"
+	AttachmentService_DeleteAttachment_FullMethodName                      = "/wealthjourney.transaction.v1.AttachmentService/DeleteAttachment"
+)
+
+// AttachmentServiceClient is the client API for AttachmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttachmentServiceClient interface {
+	// Attach a receipt or document to a transaction
+	UploadTransactionAttachment(ctx context.Context, in *UploadTransactionAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error)
+	// List the attachments of a transaction
+	ListTransactionAttachments(ctx context.Context, in *ListTransactionAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// Attach a receipt or document to an investment transaction
+	UploadInvestmentTransactionAttachment(ctx context.Context, in *UploadInvestmentTransactionAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error)
+	// List the attachments of an investment transaction
+	ListInvestmentTransactionAttachments(ctx context.Context, in *ListInvestmentTransactionAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// Get an attachment with a URL to download it
+	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*GetAttachmentResponse, error)
+	// Delete an attachment and its stored file
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+}
+
+type attachmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentServiceClient(cc grpc.ClientConnInterface) AttachmentServiceClient {
+	return &attachmentServiceClient{cc}
+}
+
+func (c *attachmentServiceClient) UploadTransactionAttachment(ctx context.Context, in *UploadTransactionAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error) {
+	out := new(UploadAttachmentResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_UploadTransactionAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) ListTransactionAttachments(ctx context.Context, in *ListTransactionAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_ListTransactionAttachments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) UploadInvestmentTransactionAttachment(ctx context.Context, in *UploadInvestmentTransactionAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error) {
+	out := new(UploadAttachmentResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_UploadInvestmentTransactionAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) ListInvestmentTransactionAttachments(ctx context.Context, in *ListInvestmentTransactionAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_ListInvestmentTransactionAttachments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*GetAttachmentResponse, error) {
+	out := new(GetAttachmentResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_GetAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_DeleteAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttachmentServiceServer is the server API for AttachmentService service.
+// All implementations must embed UnimplementedAttachmentServiceServer
+// for forward compatibility
+type AttachmentServiceServer interface {
+	// Attach a receipt or document to a transaction
+	UploadTransactionAttachment(context.Context, *UploadTransactionAttachmentRequest) (*UploadAttachmentResponse, error)
+	// List the attachments of a transaction
+	ListTransactionAttachments(context.Context, *ListTransactionAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// Attach a receipt or document to an investment transaction
+	UploadInvestmentTransactionAttachment(context.Context, *UploadInvestmentTransactionAttachmentRequest) (*UploadAttachmentResponse, error)
+	// List the attachments of an investment transaction
+	ListInvestmentTransactionAttachments(context.Context, *ListInvestmentTransactionAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// Get an attachment with a URL to download it
+	GetAttachment(context.Context, *GetAttachmentRequest) (*GetAttachmentResponse, error)
+	// Delete an attachment and its stored file
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+// UnimplementedAttachmentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAttachmentServiceServer struct {
+}
+
+func (UnimplementedAttachmentServiceServer) UploadTransactionAttachment(context.Context, *UploadTransactionAttachmentRequest) (*UploadAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadTransactionAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) ListTransactionAttachments(context.Context, *ListTransactionAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactionAttachments not implemented")
+}
+func (UnimplementedAttachmentServiceServer) UploadInvestmentTransactionAttachment(context.Context, *UploadInvestmentTransactionAttachmentRequest) (*UploadAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadInvestmentTransactionAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) ListInvestmentTransactionAttachments(context.Context, *ListInvestmentTransactionAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvestmentTransactionAttachments not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetAttachment(context.Context, *GetAttachmentRequest) (*GetAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
+
+// UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServiceServer will
+// result in compilation errors.
+type UnsafeAttachmentServiceServer interface {
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+func RegisterAttachmentServiceServer(s grpc.ServiceRegistrar, srv AttachmentServiceServer) {
+	s.RegisterService(&AttachmentService_ServiceDesc, srv)
+}
+
+func _AttachmentService_UploadTransactionAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadTransactionAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).UploadTransactionAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_UploadTransactionAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).UploadTransactionAttachment(ctx, req.(*UploadTransactionAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_ListTransactionAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).ListTransactionAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_ListTransactionAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).ListTransactionAttachments(ctx, req.(*ListTransactionAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_UploadInvestmentTransactionAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadInvestmentTransactionAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).UploadInvestmentTransactionAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_UploadInvestmentTransactionAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).UploadInvestmentTransactionAttachment(ctx, req.(*UploadInvestmentTransactionAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_ListInvestmentTransactionAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvestmentTransactionAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).ListInvestmentTransactionAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_ListInvestmentTransactionAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).ListInvestmentTransactionAttachments(ctx, req.(*ListInvestmentTransactionAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).GetAttachment(ctx, req.(*GetAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttachmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wealthjourney.transaction.v1.AttachmentService",
+	HandlerType: (*AttachmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadTransactionAttachment",
+			Handler:    _AttachmentService_UploadTransactionAttachment_Handler,
+		},
+		{
+			MethodName: "ListTransactionAttachments",
+			Handler:    _AttachmentService_ListTransactionAttachments_Handler,
+		},
+		{
+			MethodName: "UploadInvestmentTransactionAttachment",
+			Handler:    _AttachmentService_UploadInvestmentTransactionAttachment_Handler,
+		},
+		{
+			MethodName: "ListInvestmentTransactionAttachments",
+			Handler:    _AttachmentService_ListInvestmentTransactionAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _AttachmentService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _AttachmentService_DeleteAttachment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protobuf/v1/transaction.proto",
+}
+
+const (
+	RecurringTransactionService_GetRecurringTransaction_FullMethodName    = "/wealthjourney.transaction.v1.RecurringTransactionService/GetRecurringTransaction"
+	RecurringTransactionService_ListRecurringTransactions_FullMethodName  = "/wealthjourney.transaction.v1.RecurringTransactionService/ListRecurringTransactions"
+	RecurringTransactionService_CreateRecurringTransaction_FullMethodName = "/wealthjourney.transaction.v1.RecurringTransactionService/CreateRecurringTransaction"
+	RecurringTransactionService_UpdateRecurringTransaction_FullMethodName = "/wealthjourney.transaction.v1.RecurringTransactionService/UpdateRecurringTransaction"
+	RecurringTransactionService_DeleteRecurringTransaction_FullMethodName = "/wealthjourney.transaction.v1.RecurringTransactionService/DeleteRecurringTransaction"
+	RecurringTransactionService_PauseRecurringTransaction_FullMethodName  = "/wealthjourney.transaction.v1.RecurringTransactionService/PauseRecurringTransaction"
+	RecurringTransactionService_ResumeRecurringTransaction_FullMethodName = "/wealthjourney.transaction.v1.RecurringTransactionService/ResumeRecurringTransaction"
+	RecurringTransactionService_SkipRecurringOccurrence_FullMethodName    = "/wealthjourney.transaction.v1.RecurringTransactionService/SkipRecurringOccurrence"
+)
+
+// RecurringTransactionServiceClient is the client API for RecurringTransactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecurringTransactionServiceClient interface {
+	// Get a recurring transaction by ID
+	GetRecurringTransaction(ctx context.Context, in *GetRecurringTransactionRequest, opts ...grpc.CallOption) (*GetRecurringTransactionResponse, error)
+	// List all recurring transactions for authenticated user
+	ListRecurringTransactions(ctx context.Context, in *ListRecurringTransactionsRequest, opts ...grpc.CallOption) (*ListRecurringTransactionsResponse, error)
+	// Create a new recurring transaction
+	CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionRequest, opts ...grpc.CallOption) (*CreateRecurringTransactionResponse, error)
+	// Update a recurring transaction (applies to future occurrences only)
+	UpdateRecurringTransaction(ctx context.Context, in *UpdateRecurringTransactionRequest, opts ...grpc.CallOption) (*UpdateRecurringTransactionResponse, error)
+	// Delete a recurring transaction (already generated transactions are kept)
+	DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionRequest, opts ...grpc.CallOption) (*DeleteRecurringTransactionResponse, error)
+	// Pause a recurring transaction
+	PauseRecurringTransaction(ctx context.Context, in *PauseRecurringTransactionRequest, opts ...grpc.CallOption) (*PauseRecurringTransactionResponse, error)
+	// Resume a paused recurring transaction
+	ResumeRecurringTransaction(ctx context.Context, in *ResumeRecurringTransactionRequest, opts ...grpc.CallOption) (*ResumeRecurringTransactionResponse, error)
+	// Skip the next (or a specific upcoming) occurrence
+	SkipRecurringOccurrence(ctx context.Context, in *SkipRecurringOccurrenceRequest, opts ...grpc.CallOption) (*SkipRecurringOccurrenceResponse, error)
+}
+
+type recurringTransactionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecurringTransactionServiceClient(cc grpc.ClientConnInterface) RecurringTransactionServiceClient {
+	return &recurringTransactionServiceClient{cc}
+}
+
+func (c *recurringTransactionServiceClient) GetRecurringTransaction(ctx context.Context, in *GetRecurringTransactionRequest, opts ...grpc.CallOption) (*GetRecurringTransactionResponse, error) {
+	out := new(GetRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, RecurringTransactionService_GetRecurringTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) ListRecurringTransactions(ctx context.Context, in *ListRecurringTransactionsRequest, opts ...grpc.CallOption) (*ListRecurringTransactionsResponse, error) {
+	out := new(ListRecurringTransactionsResponse)
+	err := c.cc.Invoke(ctx, RecurringTransactionService_ListRecurringTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionRequest, opts ...grpc.CallOption) (*CreateRecurringTransactionResponse, error) {
+	out := new(CreateRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, RecurringTransactionService_CreateRecurringTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) UpdateRecurringTransaction(ctx context.Context, in *UpdateRecurringTransactionRequest, opts ...grpc.CallOption) (*UpdateRecurringTransactionResponse, error) {
+	out := new(UpdateRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, RecurringTransactionService_UpdateRecurringTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionRequest, opts ...grpc.CallOption) (*DeleteRecurringTransactionResponse, error) {
+	out := new(DeleteRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, RecurringTransactionService_DeleteRecurringTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) PauseRecurringTransaction(ctx context.Context, in *PauseRecurringTransactionRequest, opts ...grpc.CallOption) (*PauseRecurringTransactionResponse, error) {
+	out := new(PauseRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, RecurringTransactionService_PauseRecurringTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) ResumeRecurringTransaction(ctx context.Context, in *ResumeRecurringTransactionRequest, opts ...grpc.CallOption) (*ResumeRecurringTransactionResponse, error) {
+	out := new(ResumeRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, RecurringTransactionService_ResumeRecurringTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) SkipRecurringOccurrence(ctx context.Context, in *SkipRecurringOccurrenceRequest, opts ...grpc.CallOption) (*SkipRecurringOccurrenceResponse, error) {
+	out := new(SkipRecurringOccurrenceResponse)
+	err := c.cc.Invoke(ctx, RecurringTransactionService_SkipRecurringOccurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecurringTransactionServiceServer is the server API for RecurringTransactionService service.
+// All implementations must embed UnimplementedRecurringTransactionServiceServer
+// for forward compatibility
+type RecurringTransactionServiceServer interface {
+	// Get a recurring transaction by ID
+	GetRecurringTransaction(context.Context, *GetRecurringTransactionRequest) (*GetRecurringTransactionResponse, error)
+	// List all recurring transactions for authenticated user
+	ListRecurringTransactions(context.Context, *ListRecurringTransactionsRequest) (*ListRecurringTransactionsResponse, error)
+	// Create a new recurring transaction
+	CreateRecurringTransaction(context.Context, *CreateRecurringTransactionRequest) (*CreateRecurringTransactionResponse, error)
+	// Update a recurring transaction (applies to future occurrences only)
+	UpdateRecurringTransaction(context.Context, *UpdateRecurringTransactionRequest) (*UpdateRecurringTransactionResponse, error)
+	// Delete a recurring transaction (already generated transactions are kept)
+	DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionRequest) (*DeleteRecurringTransactionResponse, error)
+	// Pause a recurring transaction
+	PauseRecurringTransaction(context.Context, *PauseRecurringTransactionRequest) (*PauseRecurringTransactionResponse, error)
+	// Resume a paused recurring transaction
+	ResumeRecurringTransaction(context.Context, *ResumeRecurringTransactionRequest) (*ResumeRecurringTransactionResponse, error)
+	// Skip the next (or a specific upcoming) occurrence
+	SkipRecurringOccurrence(context.Context, *SkipRecurringOccurrenceRequest) (*SkipRecurringOccurrenceResponse, error)
+	mustEmbedUnimplementedRecurringTransactionServiceServer()
+}
+
+// UnimplementedRecurringTransactionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRecurringTransactionServiceServer struct {
+}
+
+func (UnimplementedRecurringTransactionServiceServer) GetRecurringTransaction(context.Context, *GetRecurringTransactionRequest) (*GetRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) ListRecurringTransactions(context.Context, *ListRecurringTransactionsRequest) (*ListRecurringTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringTransactions not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) CreateRecurringTransaction(context.Context, *CreateRecurringTransactionRequest) (*CreateRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) UpdateRecurringTransaction(context.Context, *UpdateRecurringTransactionRequest) (*UpdateRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionRequest) (*DeleteRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) PauseRecurringTransaction(context.Context, *PauseRecurringTransactionRequest) (*PauseRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) ResumeRecurringTransaction(context.Context, *ResumeRecurringTransactionRequest) (*ResumeRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) SkipRecurringOccurrence(context.Context, *SkipRecurringOccurrenceRequest) (*SkipRecurringOccurrenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipRecurringOccurrence not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) mustEmbedUnimplementedRecurringTransactionServiceServer() {
+}
+
+// UnsafeRecurringTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecurringTransactionServiceServer will
+// result in compilation errors.
+type UnsafeRecurringTransactionServiceServer interface {
+	mustEmbedUnimplementedRecurringTransactionServiceServer()
+}
+
+func RegisterRecurringTransactionServiceServer(s grpc.ServiceRegistrar, srv RecurringTransactionServiceServer) {
+	s.RegisterService(&RecurringTransactionService_ServiceDesc, srv)
+}
+
+func _RecurringTransactionService_GetRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).GetRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringTransactionService_GetRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).GetRecurringTransaction(ctx, req.(*GetRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_ListRecurringTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).ListRecurringTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringTransactionService_ListRecurringTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).ListRecurringTransactions(ctx, req.(*ListRecurringTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_CreateRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).CreateRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringTransactionService_CreateRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).CreateRecurringTransaction(ctx, req.(*CreateRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_UpdateRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).UpdateRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringTransactionService_UpdateRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).UpdateRecurringTransaction(ctx, req.(*UpdateRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_DeleteRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).DeleteRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringTransactionService_DeleteRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).DeleteRecurringTransaction(ctx, req.(*DeleteRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_PauseRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).PauseRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringTransactionService_PauseRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).PauseRecurringTransaction(ctx, req.(*PauseRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_ResumeRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).ResumeRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringTransactionService_ResumeRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).ResumeRecurringTransaction(ctx, req.(*ResumeRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_SkipRecurringOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipRecurringOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).SkipRecurringOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringTransactionService_SkipRecurringOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).SkipRecurringOccurrence(ctx, req.(*SkipRecurringOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecurringTransactionService_ServiceDesc is the grpc.ServiceDesc for RecurringTransactionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecurringTransactionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wealthjourney.transaction.v1.RecurringTransactionService",
+	HandlerType: (*RecurringTransactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRecurringTransaction",
+			Handler:    _RecurringTransactionService_GetRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "ListRecurringTransactions",
+			Handler:    _RecurringTransactionService_ListRecurringTransactions_Handler,
+		},
+		{
+			MethodName: "CreateRecurringTransaction",
+			Handler:    _RecurringTransactionService_CreateRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringTransaction",
+			Handler:    _RecurringTransactionService_UpdateRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringTransaction",
+			Handler:    _RecurringTransactionService_DeleteRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "PauseRecurringTransaction",
+			Handler:    _RecurringTransactionService_PauseRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "ResumeRecurringTransaction",
+			Handler:    _RecurringTransactionService_ResumeRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "SkipRecurringOccurrence",
+			Handler:    _RecurringTransactionService_SkipRecurringOccurrence_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protobuf/v1/transaction.proto",
+}
@@ -19,18 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	WalletService_GetWallet_FullMethodName           = "/wealthjourney.wallet.v1.WalletService/GetWallet"
-	WalletService_ListWallets_FullMethodName         = "/wealthjourney.wallet.v1.WalletService/ListWallets"
-	WalletService_CreateWallet_FullMethodName        = "/wealthjourney.wallet.v1.WalletService/CreateWallet"
-	WalletService_UpdateWallet_FullMethodName        = "/wealthjourney.wallet.v1.WalletService/UpdateWallet"
-	WalletService_DeleteWallet_FullMethodName        = "/wealthjourney.wallet.v1.WalletService/DeleteWallet"
-	WalletService_AddFunds_FullMethodName            = "/wealthjourney.wallet.v1.WalletService/AddFunds"
-	WalletService_WithdrawFunds_FullMethodName       = "/wealthjourney.wallet.v1.WalletService/WithdrawFunds"
-	WalletService_TransferFunds_FullMethodName       = "/wealthjourney.wallet.v1.WalletService/TransferFunds"
-	WalletService_AdjustBalance_FullMethodName       = "/wealthjourney.wallet.v1.WalletService/AdjustBalance"
-	WalletService_GetTotalBalance_FullMethodName     = "/wealthjourney.wallet.v1.WalletService/GetTotalBalance"
-	WalletService_GetBalanceHistory_FullMethodName   = "/wealthjourney.wallet.v1.WalletService/GetBalanceHistory"
-	WalletService_GetMonthlyDominance_FullMethodName = "/wealthjourney.wallet.v1.WalletService/GetMonthlyDominance"
+	WalletService_GetWallet_FullMethodName                        = "/wealthjourney.wallet.v1.WalletService/GetWallet"
+	WalletService_ListWallets_FullMethodName                      = "/wealthjourney.wallet.v1.WalletService/ListWallets"
+	WalletService_CreateWallet_FullMethodName                     = "/wealthjourney.wallet.v1.WalletService/CreateWallet"
+	WalletService_UpdateWallet_FullMethodName                     = "/wealthjourney.wallet.v1.WalletService/UpdateWallet"
+	WalletService_DeleteWallet_FullMethodName                     = "/wealthjourney.wallet.v1.WalletService/DeleteWallet"
+	WalletService_AddFunds_FullMethodName                         = "/wealthjourney.wallet.v1.WalletService/AddFunds"
+	WalletService_WithdrawFunds_FullMethodName                    = "/wealthjourney.wallet.v1.WalletService/WithdrawFunds"
+	WalletService_TransferFunds_FullMethodName                    = "/wealthjourney.wallet.v1.WalletService/TransferFunds"
+	WalletService_AdjustBalance_FullMethodName                    = "/wealthjourney.wallet.v1.WalletService/AdjustBalance"
+	WalletService_GetTotalBalance_FullMethodName                  = "/wealthjourney.wallet.v1.WalletService/GetTotalBalance"
+	WalletService_GetBalanceHistory_FullMethodName                = "/wealthjourney.wallet.v1.WalletService/GetBalanceHistory"
+	WalletService_GetMonthlyDominance_FullMethodName              = "/wealthjourney.wallet.v1.WalletService/GetMonthlyDominance"
+	WalletService_StartReconciliation_FullMethodName              = "/wealthjourney.wallet.v1.WalletService/StartReconciliation"
+	WalletService_ListReconciliations_FullMethodName              = "/wealthjourney.wallet.v1.WalletService/ListReconciliations"
+	WalletService_GetReconciliation_FullMethodName                = "/wealthjourney.wallet.v1.WalletService/GetReconciliation"
+	WalletService_UpdateReconciliationTransactions_FullMethodName = "/wealthjourney.wallet.v1.WalletService/UpdateReconciliationTransactions"
+	WalletService_FinishReconciliation_FullMethodName             = "/wealthjourney.wallet.v1.WalletService/FinishReconciliation"
+	WalletService_CancelReconciliation_FullMethodName             = "/wealthjourney.wallet.v1.WalletService/CancelReconciliation"
 )
 
 // WalletServiceClient is the client API for WalletService service.
@@ -61,6 +67,18 @@ type WalletServiceClient interface {
 	GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error)
 	// Get monthly dominance data showing each wallet's balance over time
 	GetMonthlyDominance(ctx context.Context, in *GetMonthlyDominanceRequest, opts ...grpc.CallOption) (*GetMonthlyDominanceResponse, error)
+	// Start reconciling a wallet against a bank statement, or resume the open reconciliation
+	StartReconciliation(ctx context.Context, in *StartReconciliationRequest, opts ...grpc.CallOption) (*StartReconciliationResponse, error)
+	// List the reconciliations of a wallet, most recent first
+	ListReconciliations(ctx context.Context, in *ListReconciliationsRequest, opts ...grpc.CallOption) (*ListReconciliationsResponse, error)
+	// Get a reconciliation with the transactions that can be ticked
+	GetReconciliation(ctx context.Context, in *GetReconciliationRequest, opts ...grpc.CallOption) (*GetReconciliationResponse, error)
+	// Tick (clear) or untick transactions in an open reconciliation
+	UpdateReconciliationTransactions(ctx context.Context, in *UpdateReconciliationTransactionsRequest, opts ...grpc.CallOption) (*UpdateReconciliationTransactionsResponse, error)
+	// Finish a reconciliation whose difference is zero, locking the cleared transactions
+	FinishReconciliation(ctx context.Context, in *FinishReconciliationRequest, opts ...grpc.CallOption) (*FinishReconciliationResponse, error)
+	// Cancel an open reconciliation. Ticked transactions stay cleared.
+	CancelReconciliation(ctx context.Context, in *CancelReconciliationRequest, opts ...grpc.CallOption) (*CancelReconciliationResponse, error)
 }
 
 type walletServiceClient struct {
@@ -179,6 +197,60 @@ func (c *walletServiceClient) GetMonthlyDominance(ctx context.Context, in *GetMo
 	return out, nil
 }
 
+func (c *walletServiceClient) StartReconciliation(ctx context.Context, in *StartReconciliationRequest, opts ...grpc.CallOption) (*StartReconciliationResponse, error) {
+	out := new(StartReconciliationResponse)
+	err := c.cc.Invoke(ctx, WalletService_StartReconciliation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListReconciliations(ctx context.Context, in *ListReconciliationsRequest, opts ...grpc.CallOption) (*ListReconciliationsResponse, error) {
+	out := new(ListReconciliationsResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListReconciliations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetReconciliation(ctx context.Context, in *GetReconciliationRequest, opts ...grpc.CallOption) (*GetReconciliationResponse, error) {
+	out := new(GetReconciliationResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetReconciliation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UpdateReconciliationTransactions(ctx context.Context, in *UpdateReconciliationTransactionsRequest, opts ...grpc.CallOption) (*UpdateReconciliationTransactionsResponse, error) {
+	out := new(UpdateReconciliationTransactionsResponse)
+	err := c.cc.Invoke(ctx, WalletService_UpdateReconciliationTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FinishReconciliation(ctx context.Context, in *FinishReconciliationRequest, opts ...grpc.CallOption) (*FinishReconciliationResponse, error) {
+	out := new(FinishReconciliationResponse)
+	err := c.cc.Invoke(ctx, WalletService_FinishReconciliation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CancelReconciliation(ctx context.Context, in *CancelReconciliationRequest, opts ...grpc.CallOption) (*CancelReconciliationResponse, error) {
+	out := new(CancelReconciliationResponse)
+	err := c.cc.Invoke(ctx, WalletService_CancelReconciliation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletServiceServer is the server API for WalletService service.
 // All implementations must embed UnimplementedWalletServiceServer
 // for forward compatibility
@@ -207,6 +279,18 @@ type WalletServiceServer interface {
 	GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error)
 	// Get monthly dominance data showing each wallet's balance over time
 	GetMonthlyDominance(context.Context, *GetMonthlyDominanceRequest) (*GetMonthlyDominanceResponse, error)
+	// Start reconciling a wallet against a bank statement, or resume the open reconciliation
+	StartReconciliation(context.Context, *StartReconciliationRequest) (*StartReconciliationResponse, error)
+	// List the reconciliations of a wallet, most recent first
+	ListReconciliations(context.Context, *ListReconciliationsRequest) (*ListReconciliationsResponse, error)
+	// Get a reconciliation with the transactions that can be ticked
+	GetReconciliation(context.Context, *GetReconciliationRequest) (*GetReconciliationResponse, error)
+	// Tick (clear) or untick transactions in an open reconciliation
+	UpdateReconciliationTransactions(context.Context, *UpdateReconciliationTransactionsRequest) (*UpdateReconciliationTransactionsResponse, error)
+	// Finish a reconciliation whose difference is zero, locking the cleared transactions
+	FinishReconciliation(context.Context, *FinishReconciliationRequest) (*FinishReconciliationResponse, error)
+	// Cancel an open reconciliation. Ticked transactions stay cleared.
+	CancelReconciliation(context.Context, *CancelReconciliationRequest) (*CancelReconciliationResponse, error)
 	mustEmbedUnimplementedWalletServiceServer()
 }
 
@@ -250,6 +334,24 @@ func (UnimplementedWalletServiceServer) GetBalanceHistory(context.Context, *GetB
 func (UnimplementedWalletServiceServer) GetMonthlyDominance(context.Context, *GetMonthlyDominanceRequest) (*GetMonthlyDominanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMonthlyDominance not implemented")
 }
+func (UnimplementedWalletServiceServer) StartReconciliation(context.Context, *StartReconciliationRequest) (*StartReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReconciliation not implemented")
+}
+func (UnimplementedWalletServiceServer) ListReconciliations(context.Context, *ListReconciliationsRequest) (*ListReconciliationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReconciliations not implemented")
+}
+func (UnimplementedWalletServiceServer) GetReconciliation(context.Context, *GetReconciliationRequest) (*GetReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliation not implemented")
+}
+func (UnimplementedWalletServiceServer) UpdateReconciliationTransactions(context.Context, *UpdateReconciliationTransactionsRequest) (*UpdateReconciliationTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReconciliationTransactions not implemented")
+}
+func (UnimplementedWalletServiceServer) FinishReconciliation(context.Context, *FinishReconciliationRequest) (*FinishReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishReconciliation not implemented")
+}
+func (UnimplementedWalletServiceServer) CancelReconciliation(context.Context, *CancelReconciliationRequest) (*CancelReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReconciliation not implemented")
+}
 func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
 
 // UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -479,6 +581,114 @@ func _WalletService_GetMonthlyDominance_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_StartReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).StartReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_StartReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).StartReconciliation(ctx, req.(*StartReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListReconciliations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListReconciliations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListReconciliations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListReconciliations(ctx, req.(*ListReconciliationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetReconciliation(ctx, req.(*GetReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_UpdateReconciliationTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReconciliationTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UpdateReconciliationTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_UpdateReconciliationTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UpdateReconciliationTransactions(ctx, req.(*UpdateReconciliationTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_FinishReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).FinishReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_FinishReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).FinishReconciliation(ctx, req.(*FinishReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CancelReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CancelReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CancelReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CancelReconciliation(ctx, req.(*CancelReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -534,6 +744,30 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMonthlyDominance",
 			Handler:    _WalletService_GetMonthlyDominance_Handler,
 		},
+		{
+			MethodName: "StartReconciliation",
+			Handler:    _WalletService_StartReconciliation_Handler,
+		},
+		{
+			MethodName: "ListReconciliations",
+			Handler:    _WalletService_ListReconciliations_Handler,
+		},
+		{
+			MethodName: "GetReconciliation",
+			Handler:    _WalletService_GetReconciliation_Handler,
+		},
+		{
+			MethodName: "UpdateReconciliationTransactions",
+			Handler:    _WalletService_UpdateReconciliationTransactions_Handler,
+		},
+		{
+			MethodName: "FinishReconciliation",
+			Handler:    _WalletService_FinishReconciliation_Handler,
+		},
+		{
+			MethodName: "CancelReconciliation",
+			Handler:    _WalletService_CancelReconciliation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protobuf/v1/wallet.proto",